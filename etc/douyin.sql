@@ -1,3 +1,6 @@
+-- Extensions
+CREATE EXTENSION IF NOT EXISTS pg_trgm;
+
 -- Table structure for users
 DROP TABLE IF EXISTS users;
 CREATE TABLE users (
@@ -6,6 +9,7 @@ CREATE TABLE users (
   avatar VARCHAR NOT NULL DEFAULT '',
   background_image VARCHAR NOT NULL DEFAULT '',
   signature VARCHAR NOT NULL DEFAULT '',
+  message_privacy SMALLINT NOT NULL DEFAULT 0,
   create_time TIMESTAMPTZ(3) NOT NULL DEFAULT CURRENT_TIMESTAMP,
   update_time TIMESTAMPTZ(3) NOT NULL DEFAULT CURRENT_TIMESTAMP
 );
@@ -17,6 +21,7 @@ COMMENT ON COLUMN users.name IS '用户名';
 COMMENT ON COLUMN users.avatar IS '头像地址';
 COMMENT ON COLUMN users.background_image IS '背景图地址';
 COMMENT ON COLUMN users.signature IS '个性签名';
+COMMENT ON COLUMN users.message_privacy IS '私信权限: 0-所有人, 1-仅好友';
 COMMENT ON COLUMN users.create_time IS '创建时间';
 COMMENT ON COLUMN users.update_time IS '更新时间';
 
@@ -46,11 +51,20 @@ CREATE TABLE videos (
   play_url VARCHAR NOT NULL DEFAULT '',
   cover_url VARCHAR NOT NULL DEFAULT '',
   upload_time TIMESTAMPTZ(3) NOT NULL DEFAULT CURRENT_TIMESTAMP,
-  title VARCHAR NOT NULL DEFAULT ''
+  title VARCHAR NOT NULL DEFAULT '',
+  status SMALLINT NOT NULL DEFAULT 0,
+  hls_url VARCHAR NOT NULL DEFAULT '',
+  duration INTEGER NOT NULL DEFAULT 0,
+  width INTEGER NOT NULL DEFAULT 0,
+  height INTEGER NOT NULL DEFAULT 0,
+  visibility SMALLINT NOT NULL DEFAULT 0
 );
 
 CREATE INDEX idx_author_id ON videos (author_id);
-CREATE INDEX idx_videos_upload_time ON videos (upload_time);
+CREATE INDEX idx_videos_status_upload_time ON videos (status, upload_time);
+
+-- 删除时Debezium需要before中的author_id删除作品数缓存
+ALTER TABLE videos REPLICA IDENTITY FULL;
 
 -- Add comments
 COMMENT ON COLUMN videos.author_id IS '作者ID';
@@ -58,6 +72,41 @@ COMMENT ON COLUMN videos.play_url IS '视频地址';
 COMMENT ON COLUMN videos.cover_url IS '封面地址';
 COMMENT ON COLUMN videos.upload_time IS '上传时间';
 COMMENT ON COLUMN videos.title IS '标题';
+COMMENT ON COLUMN videos.status IS '状态: 0-上传中, 1-转码中, 2-已发布, 3-处理失败, 4-已删除';
+COMMENT ON COLUMN videos.hls_url IS 'HLS播放列表地址';
+COMMENT ON COLUMN videos.duration IS '时长，单位毫秒';
+COMMENT ON COLUMN videos.width IS '宽度';
+COMMENT ON COLUMN videos.height IS '高度';
+COMMENT ON COLUMN videos.visibility IS '可见范围: 0-公开, 1-好友可见, 2-仅自己可见';
+
+-- Table structure for tags
+DROP TABLE IF EXISTS tags;
+CREATE TABLE tags (
+  id BIGINT PRIMARY KEY NOT NULL,
+  name VARCHAR NOT NULL DEFAULT '',
+  create_time TIMESTAMPTZ(3) NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE UNIQUE INDEX idx_tag_name ON tags (name);
+
+-- Add comments
+COMMENT ON COLUMN tags.name IS '话题名称';
+COMMENT ON COLUMN tags.create_time IS '创建时间';
+
+-- Table structure for video_tags
+DROP TABLE IF EXISTS video_tags;
+CREATE TABLE video_tags (
+  id BIGINT PRIMARY KEY NOT NULL,
+  video_id BIGINT NOT NULL DEFAULT 0,
+  tag_id BIGINT NOT NULL DEFAULT 0
+);
+
+CREATE UNIQUE INDEX idx_video_tag ON video_tags (video_id, tag_id);
+CREATE INDEX idx_video_tag_tag_id ON video_tags (tag_id, video_id);
+
+-- Add comments
+COMMENT ON COLUMN video_tags.video_id IS '视频ID';
+COMMENT ON COLUMN video_tags.tag_id IS '话题ID';
 
 -- Table structure for comments
 DROP TABLE IF EXISTS comments;
@@ -65,19 +114,42 @@ CREATE TABLE comments (
   id BIGINT PRIMARY KEY NOT NULL,
   video_id BIGINT NOT NULL DEFAULT 0,
   user_id BIGINT NOT NULL DEFAULT 0,
+  parent_id BIGINT NOT NULL DEFAULT 0,
+  root_id BIGINT NOT NULL DEFAULT 0,
   content VARCHAR NOT NULL DEFAULT '',
   create_time TIMESTAMPTZ(3) NOT NULL DEFAULT CURRENT_TIMESTAMP
 );
 
 CREATE INDEX idx_comment_video_id ON comments (video_id);
 CREATE INDEX idx_user_id ON comments (user_id);
+CREATE INDEX idx_comment_root_id ON comments (root_id);
+
+-- 删除时Debezium需要before中的video_id和root_id更新计数缓存
+ALTER TABLE comments REPLICA IDENTITY FULL;
 
 -- Add comments
 COMMENT ON COLUMN comments.video_id IS '视频ID';
 COMMENT ON COLUMN comments.user_id IS '用户ID';
+COMMENT ON COLUMN comments.parent_id IS '回复的评论ID';
+COMMENT ON COLUMN comments.root_id IS '根评论ID';
 COMMENT ON COLUMN comments.content IS '评论内容';
 COMMENT ON COLUMN comments.create_time IS '创建时间';
 
+-- Table structure for comment_favorites
+DROP TABLE IF EXISTS comment_favorites;
+CREATE TABLE comment_favorites (
+  id BIGINT PRIMARY KEY NOT NULL,
+  user_id BIGINT NOT NULL DEFAULT 0,
+  comment_id BIGINT NOT NULL DEFAULT 0
+);
+
+CREATE UNIQUE INDEX idx_user_comment ON comment_favorites (user_id, comment_id);
+CREATE INDEX idx_comment_favorite_comment_id ON comment_favorites (comment_id);
+
+-- Add comments
+COMMENT ON COLUMN comment_favorites.user_id IS '用户ID';
+COMMENT ON COLUMN comment_favorites.comment_id IS '评论ID';
+
 -- Table structure for favorites
 DROP TABLE IF EXISTS favorites;
 CREATE TABLE favorites (
@@ -101,7 +173,13 @@ CREATE TABLE messages (
   to_user_id BIGINT NOT NULL DEFAULT 0,
   convert_id VARCHAR NOT NULL DEFAULT '',
   content VARCHAR NOT NULL DEFAULT '',
-  create_time BIGINT NOT NULL DEFAULT 0
+  create_time BIGINT NOT NULL DEFAULT 0,
+  status SMALLINT NOT NULL DEFAULT 0,
+  from_deleted BOOLEAN NOT NULL DEFAULT FALSE,
+  to_deleted BOOLEAN NOT NULL DEFAULT FALSE,
+  msg_type SMALLINT NOT NULL DEFAULT 0,
+  group_id BIGINT NOT NULL DEFAULT 0,
+  payload VARCHAR NOT NULL DEFAULT ''
 );
 
 CREATE INDEX idx_convertId_createTime ON messages (convert_id, create_time);
@@ -112,3 +190,138 @@ COMMENT ON COLUMN messages.to_user_id IS '接收者ID';
 COMMENT ON COLUMN messages.convert_id IS '会话ID';
 COMMENT ON COLUMN messages.content IS '消息内容';
 COMMENT ON COLUMN messages.create_time IS '创建时间';
+COMMENT ON COLUMN messages.status IS '状态: 0-已发送, 1-已送达, 2-已读, 3-已撤回';
+COMMENT ON COLUMN messages.from_deleted IS '发送者是否已删除';
+COMMENT ON COLUMN messages.to_deleted IS '接收者是否已删除';
+COMMENT ON COLUMN messages.msg_type IS '消息类型: 0-文本, 1-图片, 2-视频分享, 3-名片, 4-表情';
+COMMENT ON COLUMN messages.group_id IS '群聊ID，单聊为0';
+COMMENT ON COLUMN messages.payload IS '非文本消息的结构化内容，JSON格式';
+
+-- Table structure for chat_groups
+DROP TABLE IF EXISTS chat_groups;
+CREATE TABLE chat_groups (
+  id BIGINT PRIMARY KEY NOT NULL,
+  name VARCHAR NOT NULL DEFAULT '',
+  owner_id BIGINT NOT NULL DEFAULT 0,
+  member_count BIGINT NOT NULL DEFAULT 0,
+  joinable BOOLEAN NOT NULL DEFAULT FALSE,
+  create_time TIMESTAMPTZ(3) NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+-- Add comments
+COMMENT ON COLUMN chat_groups.name IS '群聊名称';
+COMMENT ON COLUMN chat_groups.owner_id IS '群主ID';
+COMMENT ON COLUMN chat_groups.member_count IS '成员数';
+COMMENT ON COLUMN chat_groups.joinable IS '是否允许自由加入';
+COMMENT ON COLUMN chat_groups.create_time IS '创建时间';
+
+-- Table structure for group_members
+DROP TABLE IF EXISTS group_members;
+CREATE TABLE group_members (
+  id BIGINT PRIMARY KEY NOT NULL,
+  group_id BIGINT NOT NULL DEFAULT 0,
+  user_id BIGINT NOT NULL DEFAULT 0,
+  role SMALLINT NOT NULL DEFAULT 0,
+  create_time TIMESTAMPTZ(3) NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE UNIQUE INDEX idx_group_member ON group_members (group_id, user_id);
+
+-- Add comments
+COMMENT ON COLUMN group_members.group_id IS '群聊ID';
+COMMENT ON COLUMN group_members.user_id IS '用户ID';
+COMMENT ON COLUMN group_members.role IS '角色: 0-成员, 1-管理员, 2-群主';
+COMMENT ON COLUMN group_members.create_time IS '加入时间';
+
+-- Table structure for group_bans
+DROP TABLE IF EXISTS group_bans;
+CREATE TABLE group_bans (
+  id BIGINT PRIMARY KEY NOT NULL,
+  group_id BIGINT NOT NULL DEFAULT 0,
+  user_id BIGINT NOT NULL DEFAULT 0,
+  create_time TIMESTAMPTZ(3) NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE UNIQUE INDEX idx_group_ban ON group_bans (group_id, user_id);
+
+-- Add comments
+COMMENT ON COLUMN group_bans.group_id IS '群聊ID';
+COMMENT ON COLUMN group_bans.user_id IS '被移出的用户ID';
+COMMENT ON COLUMN group_bans.create_time IS '移出时间';
+
+-- Table structure for message_deletions
+DROP TABLE IF EXISTS message_deletions;
+CREATE TABLE message_deletions (
+  id BIGINT PRIMARY KEY NOT NULL,
+  user_id BIGINT NOT NULL DEFAULT 0,
+  convert_id VARCHAR NOT NULL DEFAULT '',
+  message_id BIGINT NOT NULL DEFAULT 0,
+  create_time TIMESTAMPTZ(3) NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE UNIQUE INDEX idx_message_deletion ON message_deletions (user_id, message_id);
+CREATE INDEX idx_message_deletion_convert ON message_deletions (user_id, convert_id);
+
+-- Add comments
+COMMENT ON COLUMN message_deletions.user_id IS '删除消息的用户ID';
+COMMENT ON COLUMN message_deletions.convert_id IS '会话ID';
+COMMENT ON COLUMN message_deletions.message_id IS '被删除的消息ID';
+COMMENT ON COLUMN message_deletions.create_time IS '删除时间';
+
+-- Table structure for moderation_records
+DROP TABLE IF EXISTS moderation_records;
+CREATE TABLE moderation_records (
+  id BIGINT PRIMARY KEY NOT NULL,
+  user_id BIGINT NOT NULL DEFAULT 0,
+  scene VARCHAR NOT NULL DEFAULT '',
+  content VARCHAR NOT NULL DEFAULT '',
+  verdict SMALLINT NOT NULL DEFAULT 0,
+  hits VARCHAR NOT NULL DEFAULT '',
+  create_time TIMESTAMPTZ(3) NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX idx_moderation_scene_create_time ON moderation_records (scene, create_time);
+
+-- Add comments
+COMMENT ON COLUMN moderation_records.user_id IS '用户ID';
+COMMENT ON COLUMN moderation_records.scene IS '审核场景';
+COMMENT ON COLUMN moderation_records.content IS '原始内容';
+COMMENT ON COLUMN moderation_records.verdict IS '审核结论';
+COMMENT ON COLUMN moderation_records.hits IS '命中的敏感词';
+COMMENT ON COLUMN moderation_records.create_time IS '创建时间';
+
+-- Table structure for search_users
+DROP TABLE IF EXISTS search_users;
+CREATE TABLE search_users (
+  id BIGINT PRIMARY KEY NOT NULL,
+  name VARCHAR NOT NULL DEFAULT '',
+  signature VARCHAR NOT NULL DEFAULT '',
+  document TSVECTOR NOT NULL
+);
+
+CREATE INDEX idx_search_users_document ON search_users USING GIN (document);
+CREATE INDEX idx_search_users_name_trgm ON search_users USING GIN (name gin_trgm_ops);
+
+-- Add comments
+COMMENT ON COLUMN search_users.name IS '用户名';
+COMMENT ON COLUMN search_users.signature IS '个性签名';
+COMMENT ON COLUMN search_users.document IS '全文检索向量，由Debezium变更同步';
+
+-- Table structure for search_videos
+DROP TABLE IF EXISTS search_videos;
+CREATE TABLE search_videos (
+  id BIGINT PRIMARY KEY NOT NULL,
+  author_id BIGINT NOT NULL DEFAULT 0,
+  title VARCHAR NOT NULL DEFAULT '',
+  upload_time TIMESTAMPTZ(3) NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  document TSVECTOR NOT NULL
+);
+
+CREATE INDEX idx_search_videos_document ON search_videos USING GIN (document);
+CREATE INDEX idx_search_videos_title_trgm ON search_videos USING GIN (title gin_trgm_ops);
+
+-- Add comments
+COMMENT ON COLUMN search_videos.author_id IS '作者ID';
+COMMENT ON COLUMN search_videos.title IS '标题';
+COMMENT ON COLUMN search_videos.upload_time IS '上传时间';
+COMMENT ON COLUMN search_videos.document IS '全文检索向量，由Debezium变更同步';
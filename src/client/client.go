@@ -7,6 +7,7 @@ import (
 	"douyin/src/kitex_gen/favorite/favoriteservice"
 	"douyin/src/kitex_gen/message/messageservice"
 	"douyin/src/kitex_gen/relation/relationservice"
+	"douyin/src/kitex_gen/search/searchservice"
 	"douyin/src/kitex_gen/user/userservice"
 	"douyin/src/kitex_gen/video/videoservice"
 )
@@ -16,6 +17,7 @@ var (
 	FavoriteClient favoriteservice.Client
 	MessageClient  messageservice.Client
 	RelationClient relationservice.Client
+	SearchClient   searchservice.Client
 	UserClient     userservice.Client
 	VideoClient    videoservice.Client
 	once           sync.Once
@@ -28,6 +30,7 @@ func Init() {
 		initFavoriteClient()
 		initMessageClient()
 		initRelationClient()
+		initSearchClient()
 		initUserClient()
 		initVideoClient()
 	})
@@ -0,0 +1,23 @@
+package client
+
+import (
+	"douyin/src/common/clientsuite"
+	"douyin/src/config"
+	"douyin/src/kitex_gen/search/searchservice"
+
+	"github.com/cloudwego/kitex/client"
+)
+
+func initSearchClient() {
+	opts := []client.Option{
+		client.WithSuite(clientsuite.CommonClientSuite{
+			RegistryAddr: config.Conf.ConsulConfig.ConsulAddr,
+			ServiceName:  config.Conf.OpenTelemetryConfig.SearchName,
+		}),
+	}
+
+	SearchClient, err = searchservice.NewClient(config.Conf.OpenTelemetryConfig.SearchName, opts...)
+	if err != nil {
+		panic(err)
+	}
+}
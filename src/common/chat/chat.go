@@ -0,0 +1,83 @@
+package chat
+
+import (
+	"context"
+	"encoding/json"
+
+	"douyin/src/dal"
+	"douyin/src/kitex_gen/message"
+)
+
+// 推送事件类型
+const (
+	EventMessage = "message" // 新消息
+	EventReceipt = "receipt" // 消息回执
+	EventRecall  = "recall"  // 消息撤回
+)
+
+// Event 推送给客户端的事件
+type Event struct {
+	Type    string           `json:"type"`              // 事件类型
+	Message *message.Message `json:"message,omitempty"` // 新消息或被撤回的消息
+	Receipt *Receipt         `json:"receipt,omitempty"` // 消息回执
+}
+
+// Receipt 消息回执，会话中发给UserID且发送时间不晚于Time的消息都已推进到Status
+type Receipt struct {
+	ConvertID string `json:"convert_id"` // 会话id
+	UserID    int64  `json:"user_id"`    // 接收者id
+	Status    int16  `json:"status"`     // 1-已送达, 2-已读
+	Time      int64  `json:"time"`       // 时间戳
+}
+
+// Envelope 通过网关推送频道传递的事件及其接收者
+type Envelope struct {
+	UserIDs []int64         `json:"user_ids"`
+	Event   json.RawMessage `json:"event"`
+}
+
+// Push 将事件推送给在线的用户，按用户所在的网关实例分组发布，返回已交给网关的用户，离线用户通过拉取接口获取
+func Push(ctx context.Context, userIDs []int64, event *Event) ([]int64, error) {
+	presence, err := dal.BatchGetPresence(ctx, userIDs)
+	if err != nil {
+		return nil, err
+	}
+	if len(presence) == 0 {
+		return nil, nil
+	}
+
+	data, err := json.Marshal(event)
+	if err != nil {
+		return nil, err
+	}
+	gateways := make(map[string][]int64)
+	for userID, gatewayIDs := range presence {
+		for _, gatewayID := range gatewayIDs {
+			gateways[gatewayID] = append(gateways[gatewayID], userID)
+		}
+	}
+	handed := make(map[int64]struct{}, len(presence))
+	for gatewayID, ids := range gateways {
+		payload, err := json.Marshal(&Envelope{UserIDs: ids, Event: data})
+		if err != nil {
+			return nil, err
+		}
+		n, err := dal.PublishGateway(ctx, gatewayID, payload)
+		if err != nil {
+			return nil, err
+		}
+		// 没有订阅者说明网关实例已下线
+		if n == 0 {
+			continue
+		}
+		for _, id := range ids {
+			handed[id] = struct{}{}
+		}
+	}
+
+	handedIDs := make([]int64, 0, len(handed))
+	for id := range handed {
+		handedIDs = append(handedIDs, id)
+	}
+	return handedIDs, nil
+}
@@ -0,0 +1,172 @@
+package cursor
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/binary"
+	"errors"
+	"math"
+
+	"douyin/src/config"
+)
+
+const (
+	DefaultCount = 30  // 默认每页数量
+	MaxCount     = 100 // 每页最大数量
+	idSize       = 8
+	signSize     = 8
+)
+
+var ErrInvalid = errors.New("invalid cursor")
+
+// Kind 游标所属的列表类型，与列表所属的用户、视频等ID一起签名，
+// 游标只能用于签发它的同一个列表，不同列表之间不能混用
+type Kind byte
+
+const (
+	KindFollow        Kind = iota + 1 // 关注列表，scopeID为用户ID
+	KindFollower                      // 粉丝列表，scopeID为用户ID
+	KindFriend                        // 好友列表，scopeID为用户ID
+	KindBlock                         // 拉黑列表，scopeID为用户ID
+	KindFavorite                      // 点赞列表，scopeID为用户ID
+	KindPublish                       // 发布列表，scopeID为用户ID
+	KindComment                       // 评论列表，scopeID为视频ID
+	KindHotComment                    // 热门评论列表，scopeID为视频ID
+	KindReply                         // 评论回复列表，scopeID为评论ID
+	KindTopicVideo                    // 话题视频列表，scopeID为话题ID
+	KindHotTopicVideo                 // 话题热门视频列表，scopeID为话题ID
+	KindRankedFeed                    // 推荐排序的候选窗口，scopeID为当前用户ID，未登录时为0
+	KindConversation                  // 会话列表，scopeID为用户ID
+	KindGroupMember                   // 群聊成员列表，scopeID为群聊ID
+	KindSearchUser                    // 用户搜索结果
+	KindSearchVideo                   // 视频搜索结果
+)
+
+// Parse 解析请求中的游标和每页数量，游标为空时从头开始
+func Parse(kind Kind, scopeID int64, token *string, count int32) (lastID int64, limit int, err error) {
+	limit = int(count)
+	if limit <= 0 {
+		limit = DefaultCount
+	}
+	limit = min(limit, MaxCount)
+
+	if token == nil || len(*token) == 0 {
+		return math.MaxInt64, limit, nil
+	}
+
+	lastID, err = decode(kind, scopeID, *token)
+	return
+}
+
+// ParseKey 解析排序值可能重复的列表的游标，游标中同时记录最后一条数据的排序值和用于区分先后的字符串键，
+// 游标为空时从头开始，lastKey为空
+func ParseKey(kind Kind, scopeID int64, token *string, count int32) (lastID int64, lastKey string, limit int, err error) {
+	lastID, limit, _ = Parse(kind, scopeID, nil, count)
+	if token == nil || len(*token) == 0 {
+		return lastID, "", limit, nil
+	}
+
+	lastID, lastKey, err = decodeKey(kind, scopeID, *token)
+	return
+}
+
+// ParseOffset 解析按偏移量分页的游标，用于无法按ID排序的列表，游标为空时偏移量为0
+func ParseOffset(kind Kind, scopeID int64, token *string, count int32) (offset, limit int, err error) {
+	lastID, limit, err := Parse(kind, scopeID, token, count)
+	if err != nil || lastID == math.MaxInt64 {
+		return 0, limit, err
+	}
+	if lastID < 0 {
+		return 0, 0, ErrInvalid
+	}
+	return int(lastID), limit, nil
+}
+
+// PaginateOffset 从offset处截取一页数据，返回本页数据、下一页游标和是否还有更多
+func PaginateOffset[T any](kind Kind, scopeID int64, items []T, offset, limit int) ([]T, *string, bool) {
+	if offset >= len(items) {
+		return []T{}, nil, false
+	}
+	if offset+limit >= len(items) {
+		return items[offset:], nil, false
+	}
+
+	next := encode(kind, scopeID, int64(offset+limit))
+	return items[offset : offset+limit], &next, true
+}
+
+// Paginate 对多查询一条的结果分页，返回本页数据、下一页游标和是否还有更多
+func Paginate[T any](kind Kind, scopeID int64, items []T, limit int, id func(T) int64) ([]T, *string, bool) {
+	if len(items) <= limit {
+		return items, nil, false
+	}
+
+	items = items[:limit]
+	next := encode(kind, scopeID, id(items[limit-1]))
+	return items, &next, true
+}
+
+// PaginateKey 对多查询一条的结果分页，下一页游标记录本页最后一条数据的排序值和字符串键
+func PaginateKey[T any](kind Kind, scopeID int64, items []T, limit int, key func(T) (int64, string)) ([]T, *string, bool) {
+	if len(items) <= limit {
+		return items, nil, false
+	}
+
+	items = items[:limit]
+	lastID, lastKey := key(items[limit-1])
+	next := encodeKey(kind, scopeID, lastID, lastKey)
+	return items, &next, true
+}
+
+// PaginateOffsetQuery 对从offset处多查询一条的结果分页，返回本页数据、下一页游标和是否还有更多
+func PaginateOffsetQuery[T any](kind Kind, scopeID int64, items []T, offset, limit int) ([]T, *string, bool) {
+	if len(items) <= limit {
+		return items, nil, false
+	}
+
+	next := encode(kind, scopeID, int64(offset+limit))
+	return items[:limit], &next, true
+}
+
+func encode(kind Kind, scopeID, lastID int64) string {
+	return encodeKey(kind, scopeID, lastID, "")
+}
+
+func decode(kind Kind, scopeID int64, token string) (int64, error) {
+	lastID, lastKey, err := decodeKey(kind, scopeID, token)
+	if err != nil || len(lastKey) > 0 {
+		return 0, ErrInvalid
+	}
+	return lastID, nil
+}
+
+func encodeKey(kind Kind, scopeID, lastID int64, lastKey string) string {
+	buf := make([]byte, idSize, idSize+len(lastKey)+signSize)
+	binary.BigEndian.PutUint64(buf, uint64(lastID))
+	buf = append(buf, lastKey...)
+	buf = append(buf, sign(kind, scopeID, buf)...)
+	return base64.RawURLEncoding.EncodeToString(buf)
+}
+
+func decodeKey(kind Kind, scopeID int64, token string) (int64, string, error) {
+	buf, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil || len(buf) < idSize+signSize {
+		return 0, "", ErrInvalid
+	}
+	data, signature := buf[:len(buf)-signSize], buf[len(buf)-signSize:]
+	if !hmac.Equal(signature, sign(kind, scopeID, data)) {
+		return 0, "", ErrInvalid
+	}
+	return int64(binary.BigEndian.Uint64(data[:idSize])), string(data[idSize:]), nil
+}
+
+// sign 使用jwt密钥签名，防止客户端伪造游标。列表类型和scopeID只参与签名不写入游标，
+// 用于其他列表时签名校验失败
+func sign(kind Kind, scopeID int64, data []byte) []byte {
+	mac := hmac.New(sha256.New, []byte(config.Conf.JwtKey))
+	mac.Write([]byte{byte(kind)})
+	mac.Write(binary.BigEndian.AppendUint64(nil, uint64(scopeID)))
+	mac.Write(data)
+	return mac.Sum(nil)[:signSize]
+}
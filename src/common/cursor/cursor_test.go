@@ -0,0 +1,261 @@
+package cursor
+
+import (
+	"encoding/base64"
+	"math"
+	"os"
+	"reflect"
+	"testing"
+
+	"douyin/src/config"
+)
+
+func TestMain(m *testing.M) {
+	config.Conf = &config.Config{JwtKey: "test-key"}
+	os.Exit(m.Run())
+}
+
+func TestEncodeDecode(t *testing.T) {
+	for _, id := range []int64{0, 1, 1 << 40, math.MaxInt64} {
+		got, err := decode(KindFollow, 1, encode(KindFollow, 1, id))
+		if err != nil {
+			t.Fatalf("decode(encode(%d)) error: %v", id, err)
+		}
+		if got != id {
+			t.Errorf("decode(encode(%d)) = %d", id, got)
+		}
+	}
+}
+
+func TestDecodeRejectsTampering(t *testing.T) {
+	token := encode(KindFollow, 1, 42)
+	buf, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	flip := func(i int) string {
+		b := append([]byte(nil), buf...)
+		b[i] ^= 0x01
+		return base64.RawURLEncoding.EncodeToString(b)
+	}
+
+	tests := []struct {
+		name  string
+		token string
+	}{
+		{"empty", ""},
+		{"not base64", "!!!"},
+		{"id modified", flip(idSize - 1)},
+		{"signature modified", flip(idSize)},
+		{"truncated", base64.RawURLEncoding.EncodeToString(buf[:idSize])},
+		{"extra bytes", base64.RawURLEncoding.EncodeToString(append(append([]byte(nil), buf...), 0))},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := decode(KindFollow, 1, tt.token); err != ErrInvalid {
+				t.Errorf("decode(%q) error = %v, want ErrInvalid", tt.token, err)
+			}
+		})
+	}
+
+	// 更换密钥后旧游标失效
+	config.Conf.JwtKey = "rotated-key"
+	defer func() { config.Conf.JwtKey = "test-key" }()
+	if _, err := decode(KindFollow, 1, token); err != ErrInvalid {
+		t.Errorf("decode with rotated key error = %v, want ErrInvalid", err)
+	}
+}
+
+func TestDecodeRejectsOtherList(t *testing.T) {
+	token := encode(KindFollow, 1, 42)
+
+	tests := []struct {
+		name    string
+		kind    Kind
+		scopeID int64
+	}{
+		{"other kind", KindFollower, 1},
+		{"other scope", KindFollow, 2},
+		{"other kind and scope", KindComment, 2},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := decode(tt.kind, tt.scopeID, token); err != ErrInvalid {
+				t.Errorf("decode(%d, %d) error = %v, want ErrInvalid", tt.kind, tt.scopeID, err)
+			}
+		})
+	}
+}
+
+func TestParse(t *testing.T) {
+	token := encode(KindFollow, 1, 7)
+	empty := ""
+	bad := "bad"
+
+	tests := []struct {
+		name      string
+		token     *string
+		count     int32
+		wantID    int64
+		wantLimit int
+		wantErr   error
+	}{
+		{"nil token", nil, 10, math.MaxInt64, 10, nil},
+		{"empty token", &empty, 10, math.MaxInt64, 10, nil},
+		{"default count", nil, 0, math.MaxInt64, DefaultCount, nil},
+		{"negative count", nil, -5, math.MaxInt64, DefaultCount, nil},
+		{"count capped", nil, MaxCount + 1, math.MaxInt64, MaxCount, nil},
+		{"valid token", &token, 20, 7, 20, nil},
+		{"invalid token", &bad, 20, 0, 20, ErrInvalid},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			id, limit, err := Parse(KindFollow, 1, tt.token, tt.count)
+			if err != tt.wantErr || id != tt.wantID || limit != tt.wantLimit {
+				t.Errorf("Parse = (%d, %d, %v), want (%d, %d, %v)", id, limit, err, tt.wantID, tt.wantLimit, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestParseOffset(t *testing.T) {
+	zero := encode(KindFollow, 1, 0)
+	five := encode(KindFollow, 1, 5)
+	negative := encode(KindFollow, 1, -1)
+	bad := "bad"
+
+	tests := []struct {
+		name       string
+		token      *string
+		wantOffset int
+		wantLimit  int
+		wantErr    error
+	}{
+		{"nil token", nil, 0, 10, nil},
+		{"zero offset", &zero, 0, 10, nil},
+		{"positive offset", &five, 5, 10, nil},
+		{"negative offset", &negative, 0, 0, ErrInvalid},
+		{"invalid token", &bad, 0, 10, ErrInvalid},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			offset, limit, err := ParseOffset(KindFollow, 1, tt.token, 10)
+			if err != tt.wantErr || offset != tt.wantOffset || limit != tt.wantLimit {
+				t.Errorf("ParseOffset = (%d, %d, %v), want (%d, %d, %v)", offset, limit, err, tt.wantOffset, tt.wantLimit, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestPaginateOffset(t *testing.T) {
+	items := []int{0, 1, 2, 3, 4}
+
+	tests := []struct {
+		name        string
+		offset      int
+		limit       int
+		want        []int
+		wantNext    int64 // 0表示没有下一页
+		wantHasMore bool
+	}{
+		{"first page", 0, 2, []int{0, 1}, 2, true},
+		{"middle page", 2, 2, []int{2, 3}, 4, true},
+		{"last partial page", 4, 2, []int{4}, 0, false},
+		{"exactly to end", 3, 2, []int{3, 4}, 0, false},
+		{"offset at end", 5, 2, []int{}, 0, false},
+		{"offset past end", 9, 2, []int{}, 0, false},
+		{"limit covers all", 0, 10, []int{0, 1, 2, 3, 4}, 0, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			page, next, hasMore := PaginateOffset(KindFollow, 1, items, tt.offset, tt.limit)
+			if !reflect.DeepEqual(page, tt.want) || hasMore != tt.wantHasMore {
+				t.Errorf("PaginateOffset = (%v, %v), want (%v, %v)", page, hasMore, tt.want, tt.wantHasMore)
+			}
+			assertNext(t, next, tt.wantNext)
+		})
+	}
+}
+
+func TestPaginate(t *testing.T) {
+	id := func(i int64) int64 { return i }
+
+	page, next, hasMore := Paginate(KindFollow, 1, []int64{9, 8, 7}, 2, id)
+	if !reflect.DeepEqual(page, []int64{9, 8}) || !hasMore {
+		t.Errorf("Paginate = (%v, %v), want ([9 8], true)", page, hasMore)
+	}
+	assertNext(t, next, 8)
+
+	page, next, hasMore = Paginate(KindFollow, 1, []int64{9, 8}, 2, id)
+	if !reflect.DeepEqual(page, []int64{9, 8}) || hasMore {
+		t.Errorf("Paginate = (%v, %v), want ([9 8], false)", page, hasMore)
+	}
+	assertNext(t, next, 0)
+}
+
+func TestPaginateOffsetQuery(t *testing.T) {
+	page, next, hasMore := PaginateOffsetQuery(KindFollow, 1, []int{5, 6, 7}, 5, 2)
+	if !reflect.DeepEqual(page, []int{5, 6}) || !hasMore {
+		t.Errorf("PaginateOffsetQuery = (%v, %v), want ([5 6], true)", page, hasMore)
+	}
+	assertNext(t, next, 7)
+
+	page, next, hasMore = PaginateOffsetQuery(KindFollow, 1, []int{5, 6}, 5, 2)
+	if !reflect.DeepEqual(page, []int{5, 6}) || hasMore {
+		t.Errorf("PaginateOffsetQuery = (%v, %v), want ([5 6], false)", page, hasMore)
+	}
+	assertNext(t, next, 0)
+}
+
+func TestPaginateKey(t *testing.T) {
+	type item struct {
+		time int64
+		key  string
+	}
+	items := []item{{9, "b"}, {9, "a"}, {8, "c"}}
+	key := func(i item) (int64, string) { return i.time, i.key }
+
+	page, next, hasMore := PaginateKey(KindConversation, 1, items, 2, key)
+	if !reflect.DeepEqual(page, items[:2]) || !hasMore || next == nil {
+		t.Fatalf("PaginateKey = (%v, %v, %v), want first two items with next cursor", page, next, hasMore)
+	}
+	lastTime, lastKey, limit, err := ParseKey(KindConversation, 1, next, 2)
+	if err != nil || lastTime != 9 || lastKey != "a" || limit != 2 {
+		t.Errorf("ParseKey(next) = (%d, %q, %d, %v), want (9, \"a\", 2, nil)", lastTime, lastKey, limit, err)
+	}
+	if _, err := decode(KindConversation, 1, *next); err != ErrInvalid {
+		t.Errorf("decode(key cursor) error = %v, want ErrInvalid", err)
+	}
+	if _, _, _, err := ParseKey(KindConversation, 2, next, 2); err != ErrInvalid {
+		t.Errorf("ParseKey with other scope error = %v, want ErrInvalid", err)
+	}
+
+	lastTime, lastKey, limit, err = ParseKey(KindConversation, 1, nil, 0)
+	if err != nil || lastTime != math.MaxInt64 || lastKey != "" || limit != DefaultCount {
+		t.Errorf("ParseKey(nil) = (%d, %q, %d, %v), want first page", lastTime, lastKey, limit, err)
+	}
+
+	page, next, hasMore = PaginateKey(KindConversation, 1, items, 3, key)
+	if len(page) != 3 || next != nil || hasMore {
+		t.Errorf("PaginateKey = (%v, %v, %v), want all items without next cursor", page, next, hasMore)
+	}
+}
+
+// assertNext 检查KindFollow列表的下一页游标，want为0时应该没有游标
+func assertNext(t *testing.T, next *string, want int64) {
+	t.Helper()
+	if want == 0 {
+		if next != nil {
+			t.Errorf("next cursor = %q, want nil", *next)
+		}
+		return
+	}
+	if next == nil {
+		t.Fatalf("next cursor = nil, want %d", want)
+	}
+	got, err := decode(KindFollow, 1, *next)
+	if err != nil || got != want {
+		t.Errorf("decode(next) = (%d, %v), want %d", got, err, want)
+	}
+}
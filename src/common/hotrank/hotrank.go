@@ -0,0 +1,33 @@
+package hotrank
+
+import (
+	"math"
+	"sort"
+	"time"
+)
+
+const gravity = 1.5 // 时间衰减指数
+
+// Score 计算热度: 互动热度加1后按发布时长衰减，没有互动的新内容也有基础热度
+func Score(heat float64, publishTime time.Time) float64 {
+	hours := math.Max(time.Since(publishTime).Hours(), 0)
+	return (heat + 1) / math.Pow(hours+2, gravity)
+}
+
+// Sort 按score计算的热度倒序排列items，热度相同时保持原有顺序
+func Sort[T any](items []T, score func(T) float64) {
+	type ranked struct {
+		item  T
+		score float64
+	}
+	list := make([]ranked, len(items))
+	for i, item := range items {
+		list[i] = ranked{item: item, score: score(item)}
+	}
+	sort.SliceStable(list, func(i, j int) bool {
+		return list[i].score > list[j].score
+	})
+	for i := range list {
+		items[i] = list[i].item
+	}
+}
@@ -8,6 +8,7 @@ import (
 	"douyin/src/dal"
 
 	"github.com/cloudwego/kitex/pkg/klog"
+	"github.com/redis/go-redis/v9"
 	"go.opentelemetry.io/otel"
 )
 
@@ -49,35 +50,49 @@ func (mq *cacheMQ) removeCache(ctx context.Context) {
 
 		pipe := dal.RDB.Pipeline()
 		switch msg.Table {
-		case "user":
+		case "users":
 			if msg.Op == "u" || msg.Op == "d" {
-				keyUserInfo := dal.GetRedisKey(dal.KeyUserInfoPF, strconv.FormatInt(msg.ID, 10))
+				keyUserInfo := dal.GetRedisKey(dal.KeyUserInfoPF, strconv.FormatInt(msg.row().ID, 10))
 				pipe.Del(ctx, keyUserInfo)
 			}
-		case "video":
-			if msg.Op == "c" {
-				keyUserWorkCnt := dal.GetRedisKey(dal.KeyUserWorkCountPF, strconv.FormatInt(msg.AuthorID, 10))
-				dal.IncrByScript.Run(ctx, pipe, []string{keyUserWorkCnt}, 1)
-			} else if msg.Op == "u" {
-				keyVideoInfo := dal.GetRedisKey(dal.KeyVideoInfoPF, strconv.FormatInt(msg.ID, 10))
-				pipe.Del(ctx, keyVideoInfo)
-			} else if msg.Op == "d" {
-				keyUserWorkCnt := dal.GetRedisKey(dal.KeyUserWorkCountPF, strconv.FormatInt(msg.AuthorID, 10))
-				dal.IncrByScript.Run(ctx, pipe, []string{keyUserWorkCnt}, -1)
-				keyVideoInfo := dal.GetRedisKey(dal.KeyVideoInfoPF, strconv.FormatInt(msg.ID, 10))
+		case "videos":
+			// 作品数只统计已发布的视频，新建的视频处于上传中状态，状态变化或删除时重新统计
+			if msg.Op == "u" || msg.Op == "d" {
+				row := msg.row()
+				keyUserWorkCnt := dal.GetRedisKey(dal.KeyUserWorkCountPF, strconv.FormatInt(row.AuthorID, 10))
+				pipe.Del(ctx, keyUserWorkCnt)
+				keyVideoInfo := dal.GetRedisKey(dal.KeyVideoInfoPF, strconv.FormatInt(row.ID, 10))
 				pipe.Del(ctx, keyVideoInfo)
 			}
-		case "comment":
+		case "comments":
+			row := msg.row()
+			keyVideoCommentCnt := dal.GetRedisKey(dal.KeyVideoCommentCountPF, strconv.FormatInt(row.VideoID, 10))
+			keyRootReplyCnt := dal.GetRedisKey(dal.KeyCommentReplyCountPF, strconv.FormatInt(row.RootID, 10))
 			if msg.Op == "c" {
-				keyVideoCommentCnt := dal.GetRedisKey(dal.KeyVideoCommentCountPF, strconv.FormatInt(msg.VideoID, 10))
 				dal.IncrByScript.Run(ctx, pipe, []string{keyVideoCommentCnt}, 1)
+				// 回复评论时更新根评论的回复数和互动热度
+				if row.RootID != 0 {
+					dal.IncrByScript.Run(ctx, pipe, []string{keyRootReplyCnt}, 1)
+					dal.IncrCommentHeat(ctx, pipe, row.VideoID, row.RootID, dal.CommentHeatReply)
+				}
 			} else if msg.Op == "d" {
-				keyVideoCommentCnt := dal.GetRedisKey(dal.KeyVideoCommentCountPF, strconv.FormatInt(msg.VideoID, 10))
 				dal.IncrByScript.Run(ctx, pipe, []string{keyVideoCommentCnt}, -1)
+				if row.RootID != 0 {
+					dal.IncrByScript.Run(ctx, pipe, []string{keyRootReplyCnt}, -1)
+					dal.IncrCommentHeat(ctx, pipe, row.VideoID, row.RootID, -dal.CommentHeatReply)
+				} else {
+					// 删除根评论时删除其回复数缓存和互动热度
+					keyReplyCnt := dal.GetRedisKey(dal.KeyCommentReplyCountPF, strconv.FormatInt(row.ID, 10))
+					pipe.Del(ctx, keyReplyCnt)
+					dal.RemoveCommentHeat(ctx, pipe, row.VideoID, row.ID)
+				}
+				keyFavoriteCnt := dal.GetRedisKey(dal.KeyCommentFavoriteCountPF, strconv.FormatInt(row.ID, 10))
+				pipe.Del(ctx, keyFavoriteCnt)
 			}
 		}
 
-		if _, err := pipe.Exec(ctx); err != nil {
+		// 计数缓存不存在时IncrByScript返回nil
+		if _, err := pipe.Exec(ctx); err != nil && err != redis.Nil {
 			klog.Error("failed to u or d cache: ", err)
 			span.End()
 			continue
@@ -2,6 +2,7 @@ package kafka
 
 import (
 	"context"
+	"time"
 
 	"douyin/src/dal"
 	"douyin/src/dal/model"
@@ -43,7 +44,7 @@ func (mq *commentMQ) consumeComment(ctx context.Context) {
 			break
 		}
 
-		// 解析为Comment，成功则创建评论
+		// 解析为Comment，成功则创建评论，否则解析为CommentID并删除评论
 		comment := &model.Comment{}
 		if err := msgpack.Unmarshal(m.Value, comment); err == nil {
 			if err := dal.CreateComment(ctx, comment); err != nil {
@@ -51,20 +52,33 @@ func (mq *commentMQ) consumeComment(ctx context.Context) {
 				span.End()
 				continue
 			}
-		}
 
-		// 解析为CommentID，成功则删除评论
-		var commentID int64
-		if err := msgpack.Unmarshal(m.Value, &commentID); err == nil {
-			if err := dal.DeleteComment(ctx, commentID); err != nil {
+			// 更新热榜，失败不影响评论
+			heat := map[int64]float64{comment.VideoID: dal.HeatComment}
+			if err := dal.IncrVideoHeat(ctx, heat, time.Now()); err != nil {
+				klog.Error("failed to incr video heat: ", err)
+			}
+		} else {
+			var commentID int64
+			if err := msgpack.Unmarshal(m.Value, &commentID); err != nil {
+				klog.Error("failed to unmarshal message: ", err)
+				span.End()
+				continue
+			}
+			videoID, count, err := dal.DeleteComment(ctx, commentID)
+			if err != nil {
 				klog.Error("failed to delete comment: ", err)
 				span.End()
 				continue
 			}
-		} else {
-			klog.Error("failed to unmarshal message: ", err)
-			span.End()
-			continue
+
+			// 减去被删除评论及其回复的热度，失败不影响删除
+			if count > 0 {
+				heat := map[int64]float64{videoID: -dal.HeatComment * float64(count)}
+				if err := dal.IncrVideoHeat(ctx, heat, time.Now()); err != nil {
+					klog.Error("failed to incr video heat: ", err)
+				}
+			}
 		}
 
 		if err := mq.Reader.CommitMessages(ctx, m); err != nil {
@@ -0,0 +1,115 @@
+package kafka
+
+import (
+	"context"
+	"strconv"
+
+	"douyin/src/dal"
+
+	"github.com/cloudwego/kitex/pkg/klog"
+	"github.com/redis/go-redis/v9"
+	"github.com/segmentio/kafka-go"
+	"github.com/vmihailenco/msgpack/v5"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+)
+
+type commentFavoriteMQ struct {
+	*mq
+}
+
+// CommentFavoriteEvent 评论点赞事件，ActionType为1-点赞，-1-取消点赞
+type CommentFavoriteEvent struct {
+	UserID     int64
+	CommentID  int64
+	VideoID    int64
+	RootID     int64
+	ActionType int64
+}
+
+var commentFavoriteMQInstance *commentFavoriteMQ
+
+func initCommentFavoriteMQ() {
+	commentFavoriteMQInstance = &commentFavoriteMQ{
+		&mq{
+			Topic:  topicCommentFavorite,
+			Writer: NewWriter(topicCommentFavorite),
+			Reader: NewReader(topicCommentFavorite),
+		},
+	}
+
+	go commentFavoriteMQInstance.consumeCommentFavorite(context.Background())
+}
+
+func (mq *commentFavoriteMQ) consumeCommentFavorite(ctx context.Context) {
+	// 接收消息
+	for {
+		ctx, span := otel.Tracer("kafka").Start(ctx, "consumeCommentFavorite")
+
+		m, err := mq.Reader.FetchMessage(ctx)
+		if err != nil {
+			klog.Error("failed to fetch message: ", err)
+			span.End()
+			break
+		}
+
+		event := &CommentFavoriteEvent{}
+		if err := msgpack.Unmarshal(m.Value, event); err != nil {
+			klog.Error("failed to unmarshal message: ", err)
+			span.End()
+			continue
+		}
+
+		var changed bool
+		if event.ActionType == 1 {
+			changed, err = dal.CreateCommentFavorite(ctx, event.UserID, event.CommentID)
+		} else {
+			changed, err = dal.DeleteCommentFavorite(ctx, event.UserID, event.CommentID)
+		}
+		if err != nil {
+			klog.Error("failed to update comment favorite: ", err)
+			span.End()
+			continue
+		}
+
+		// 写入数据库后更新点赞数和一级评论的互动热度，重复消费时不重复计数
+		if changed {
+			pipe := dal.RDB.Pipeline()
+			keyCommentFavoriteCnt := dal.GetRedisKey(dal.KeyCommentFavoriteCountPF, strconv.FormatInt(event.CommentID, 10))
+			dal.IncrByScript.Run(ctx, pipe, []string{keyCommentFavoriteCnt}, event.ActionType)
+			if event.RootID == 0 {
+				dal.IncrCommentHeat(ctx, pipe, event.VideoID, event.CommentID, float64(event.ActionType)*dal.CommentHeatFavorite)
+			}
+			if _, err := pipe.Exec(ctx); err != nil && err != redis.Nil {
+				klog.Error("failed to update comment favorite cache: ", err)
+			}
+		}
+
+		if err := mq.Reader.CommitMessages(ctx, m); err != nil {
+			klog.Error("failed to commit message: ", err)
+		}
+
+		span.End()
+	}
+
+	// 程序退出前关闭Reader
+	if err := mq.Reader.Close(); err != nil {
+		klog.Fatal("failed to close reader:", err)
+	}
+}
+
+func CommentFavorite(ctx context.Context, event *CommentFavoriteEvent) error {
+	ctx, span := otel.Tracer("kafka").Start(ctx, "CommentFavorite")
+	defer span.End()
+
+	value, err := msgpack.Marshal(event)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, "failed to marshal message")
+		klog.Error("failed to marshal message: ", err)
+		return err
+	}
+	return commentFavoriteMQInstance.Writer.WriteMessages(ctx, kafka.Message{
+		Value: value,
+	})
+}
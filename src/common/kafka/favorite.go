@@ -87,13 +87,16 @@ func (mq *favoriteMQ) consumeFavorite(ctx context.Context) {
 
 		creates := make([]*model.Favorite, 0, len(favorites))
 		deletes := make([]int64, 0, len(favorites))
+		heat := make(map[int64]float64, len(favorites))
 
 		for _, favorite := range favorites {
 			if favorite.ID == 1 {
 				favorite.ID = snowflake.GenerateID()
 				creates = append(creates, favorite)
+				heat[favorite.VideoID] += dal.HeatFavorite
 			} else {
 				deletes = append(deletes, favorite.UserID)
+				heat[favorite.VideoID] -= dal.HeatFavorite
 			}
 		}
 
@@ -108,6 +111,11 @@ func (mq *favoriteMQ) consumeFavorite(ctx context.Context) {
 			continue
 		}
 
+		// 更新热榜，失败不影响点赞
+		if err := dal.IncrVideoHeat(ctx, heat, time.Now()); err != nil {
+			klog.Error("failed to incr video heat: ", err)
+		}
+
 		if err := mq.Reader.CommitMessages(ctx, m); err != nil {
 			klog.Error("failed to commit message: ", err)
 		}
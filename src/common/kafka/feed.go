@@ -0,0 +1,88 @@
+package kafka
+
+import (
+	"context"
+
+	"douyin/src/dal"
+	"douyin/src/dal/model"
+
+	"github.com/cloudwego/kitex/pkg/klog"
+	"github.com/segmentio/kafka-go"
+	"github.com/vmihailenco/msgpack/v5"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+)
+
+type feedMQ struct {
+	*mq
+}
+
+var feedMQInstance *feedMQ
+
+func initFeedMQ() {
+	feedMQInstance = &feedMQ{
+		&mq{
+			Topic:  topicFeed,
+			Writer: NewWriter(topicFeed),
+			Reader: NewReader(topicFeed),
+		},
+	}
+
+	go feedMQInstance.consumeFeed(context.Background())
+}
+
+func (mq *feedMQ) consumeFeed(ctx context.Context) {
+	// 接收消息
+	for {
+		ctx, span := otel.Tracer("kafka").Start(ctx, "consumeFeed")
+
+		m, err := mq.Reader.FetchMessage(ctx)
+		if err != nil {
+			klog.Error("failed to fetch message: ", err)
+			span.End()
+			break
+		}
+
+		video := &model.Video{}
+		if err := msgpack.Unmarshal(m.Value, video); err != nil {
+			klog.Error("failed to unmarshal message: ", err)
+			span.End()
+			continue
+		}
+
+		// 分发到发件箱和粉丝收件箱
+		if err := dal.PushFeed(ctx, video); err != nil {
+			klog.Error("failed to push feed: ", err)
+			span.End()
+			continue
+		}
+
+		if err := mq.Reader.CommitMessages(ctx, m); err != nil {
+			klog.Error("failed to commit message: ", err)
+		}
+
+		span.End()
+	}
+
+	// 程序退出前关闭Reader
+	if err := mq.Reader.Close(); err != nil {
+		klog.Fatal("failed to close reader:", err)
+	}
+}
+
+func PushFeed(ctx context.Context, video *model.Video) error {
+	ctx, span := otel.Tracer("kafka").Start(ctx, "kafka.PushFeed")
+	defer span.End()
+
+	data, err := msgpack.Marshal(video)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, "failed to marshal message")
+		klog.Error("failed to marshal message: ", err)
+		return err
+	}
+
+	return feedMQInstance.Writer.WriteMessages(ctx, kafka.Message{
+		Value: data,
+	})
+}
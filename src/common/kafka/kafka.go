@@ -9,13 +9,17 @@ import (
 )
 
 const (
-	topicDebezium  = "debezium"
-	topicComment   = "comment"
-	topicFavorite  = "favorite"
-	topicRelation  = "relation"
-	topicMessage   = "message"
-	groupID        = "backend"
-	commitInterval = 1 * time.Second
+	topicDebezium        = "debezium"
+	topicComment         = "comment"
+	topicCommentFavorite = "comment_favorite"
+	topicFavorite        = "favorite"
+	topicRelation        = "relation"
+	topicMessage         = "message"
+	topicFeed            = "feed"
+	topicTranscode       = "transcode"
+	groupID              = "backend"
+	groupSearch          = "search"
+	commitInterval       = 1 * time.Second
 )
 
 type mq struct {
@@ -30,26 +34,39 @@ type dbMessage struct {
 
 type payload struct {
 	data   `json:"after"`
+	Before data `json:"before"` // 删除时只有before中有数据
 	source `json:"source"`
-	Op     string `json:"op"` // "c"->create, "u"->update, "d"->delete
+	Op     string `json:"op"` // "c"->create, "u"->update, "d"->delete, "r"->snapshot
 }
 
 type data struct {
 	ID       int64 `json:"id"`
 	AuthorID int64 `json:"author_id"`
 	VideoID  int64 `json:"video_id"`
+	RootID   int64 `json:"root_id"`
 }
 
 type source struct {
 	Table string `json:"table"`
 }
 
+// row 返回变更的行数据，删除时after为空，使用before中的数据
+func (p *payload) row() data {
+	if p.Op == "d" {
+		return p.Before
+	}
+	return p.data
+}
+
 func Init() {
 	initCacheMQ()
 	initCommentMQ()
+	initCommentFavoriteMQ()
 	initFavoriteMQ()
+	initFeedMQ()
 	initMessageMQ()
 	initRelationMQ()
+	initTranscodeMQ()
 }
 
 func NewWriter(topic string) *kafka.Writer {
@@ -63,10 +80,15 @@ func NewWriter(topic string) *kafka.Writer {
 }
 
 func NewReader(topic string) *kafka.Reader {
+	return NewGroupReader(topic, groupID)
+}
+
+// NewGroupReader 创建使用独立消费者组的Reader，用于需要完整消费同一topic的不同业务
+func NewGroupReader(topic, group string) *kafka.Reader {
 	return kafka.NewReader(kafka.ReaderConfig{
 		Brokers:        config.Conf.KafkaConfig.Brokers,
 		Topic:          topic,
-		GroupID:        groupID,
+		GroupID:        group,
 		MaxBytes:       10e6,           // 10MB
 		CommitInterval: commitInterval, // 每秒刷新一次提交给 Kafka
 	})
@@ -50,13 +50,39 @@ func (mq *messageMQ) consumeMessage(ctx context.Context) {
 			continue
 		}
 
+		// 单聊接收者在消息写入前已读或已收到推送时直接标记，避免消费延迟导致状态丢失
+		if message.GroupID == 0 {
+			readTime, err := dal.GetReadCursor(ctx, message.ToUserID, message.ConvertID)
+			if err != nil {
+				klog.Error("failed to get read cursor: ", err)
+			} else if message.CreateTime <= readTime {
+				message.Status = dal.MessageStatusRead
+			}
+		}
+		if message.GroupID == 0 && message.Status < dal.MessageStatusDelivered {
+			deliverTime, err := dal.GetDeliverCursor(ctx, message.ToUserID, message.ConvertID)
+			if err != nil {
+				klog.Error("failed to get deliver cursor: ", err)
+			} else if message.CreateTime <= deliverTime {
+				message.Status = dal.MessageStatusDelivered
+			}
+		}
+
 		// 写入数据库
-		if err := dal.MessageAction(ctx, message); err != nil {
+		created, err := dal.MessageAction(ctx, message)
+		if err != nil {
 			klog.Error("failed to write message to database: ", err)
 			span.End()
 			continue
 		}
 
+		// 更新会话列表和接收者的未读数，重复消费的消息不再计数
+		if created {
+			if err := updateConversation(ctx, message); err != nil {
+				klog.Error("failed to update conversation: ", err)
+			}
+		}
+
 		if err := mq.Reader.CommitMessages(ctx, m); err != nil {
 			klog.Error("failed to commit message: ", err)
 		}
@@ -86,3 +112,22 @@ func SendMessage(ctx context.Context, message *model.Message) error {
 		Value: data,
 	})
 }
+
+// updateConversation 单聊消息的接收者为对方，群聊消息的接收者为除发送者外的全部成员
+func updateConversation(ctx context.Context, message *model.Message) error {
+	if message.GroupID == 0 {
+		return dal.UpdateConversation(ctx, message, []int64{message.ToUserID})
+	}
+
+	memberIDs, err := dal.GetGroupMemberIDs(ctx, message.GroupID)
+	if err != nil {
+		return err
+	}
+	receiverIDs := make([]int64, 0, len(memberIDs))
+	for _, memberID := range memberIDs {
+		if memberID != message.FromUserID {
+			receiverIDs = append(receiverIDs, memberID)
+		}
+	}
+	return dal.UpdateConversation(ctx, message, receiverIDs)
+}
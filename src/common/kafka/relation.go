@@ -61,14 +61,12 @@ func (mq *relationMQ) consumeRelation(ctx context.Context) {
 				continue
 			}
 			dal.IncrByScript.Run(ctx, pipe, []string{dal.GetRedisKey(dal.KeyUserFollowCountPF, strconv.FormatInt(relation.FollowerID, 10))}, 1)
-			dal.IncrByScript.Run(ctx, pipe, []string{dal.GetRedisKey(dal.KeyUserFollowerCountPF, strconv.FormatInt(relation.FollowerID, 10))}, 1)
+			dal.IncrByScript.Run(ctx, pipe, []string{dal.GetRedisKey(dal.KeyUserFollowerCountPF, strconv.FormatInt(relation.AuthorID, 10))}, 1)
 			pipe.SAdd(ctx, dal.GetRedisKey(dal.KeyUserFollowPF, strconv.FormatInt(relation.FollowerID, 10)), relation.AuthorID)
-			pipe.SAdd(ctx, dal.GetRedisKey(dal.KeyUserFollowerPF, strconv.FormatInt(relation.AuthorID, 10)), relation.FollowerID)
-			if exist, err := dal.CheckRelationExist(ctx, relation.AuthorID, relation.FollowerID); err != nil {
-				klog.Error("查询关注记录失败, err: ", err)
-			} else if exist {
-				pipe.SAdd(ctx, dal.GetRedisKey(dal.KeyUserFriendPF, strconv.FormatInt(relation.FollowerID, 10)), relation.AuthorID)
-				pipe.SAdd(ctx, dal.GetRedisKey(dal.KeyUserFriendPF, strconv.FormatInt(relation.AuthorID, 10)), relation.FollowerID)
+
+			// 将作者已发布的视频补充到粉丝收件箱
+			if err := dal.BackfillInbox(ctx, relation.FollowerID, relation.AuthorID); err != nil {
+				klog.Error("补充收件箱失败, err: ", err)
 			}
 		} else {
 			// 取关
@@ -78,14 +76,12 @@ func (mq *relationMQ) consumeRelation(ctx context.Context) {
 				continue
 			}
 			dal.IncrByScript.Run(ctx, pipe, []string{dal.GetRedisKey(dal.KeyUserFollowCountPF, strconv.FormatInt(relation.FollowerID, 10))}, -1)
-			dal.IncrByScript.Run(ctx, pipe, []string{dal.GetRedisKey(dal.KeyUserFollowerCountPF, strconv.FormatInt(relation.FollowerID, 10))}, -1)
+			dal.IncrByScript.Run(ctx, pipe, []string{dal.GetRedisKey(dal.KeyUserFollowerCountPF, strconv.FormatInt(relation.AuthorID, 10))}, -1)
 			pipe.SRem(ctx, dal.GetRedisKey(dal.KeyUserFollowPF, strconv.FormatInt(relation.FollowerID, 10)), relation.AuthorID)
-			pipe.SRem(ctx, dal.GetRedisKey(dal.KeyUserFollowerPF, strconv.FormatInt(relation.AuthorID, 10)), relation.FollowerID)
-			if exist, err := dal.CheckRelationExist(ctx, relation.AuthorID, relation.FollowerID); err != nil {
-				klog.Error("查询关注记录失败, err: ", err)
-			} else if exist {
-				pipe.SRem(ctx, dal.GetRedisKey(dal.KeyUserFriendPF, strconv.FormatInt(relation.FollowerID, 10)), relation.AuthorID)
-				pipe.SRem(ctx, dal.GetRedisKey(dal.KeyUserFriendPF, strconv.FormatInt(relation.AuthorID, 10)), relation.FollowerID)
+
+			// 从粉丝收件箱中移除作者的视频
+			if err := dal.RemoveFromInbox(ctx, relation.FollowerID, relation.AuthorID); err != nil {
+				klog.Error("清理收件箱失败, err: ", err)
 			}
 		}
 
@@ -0,0 +1,94 @@
+package kafka
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/cloudwego/kitex/pkg/klog"
+	"go.opentelemetry.io/otel"
+)
+
+type searchMQ struct {
+	*mq
+}
+
+// RowChange Debezium捕获的数据库行变更
+type RowChange struct {
+	Table string
+	Op    string // "c"->create, "u"->update, "d"->delete, "r"->snapshot
+	ID    int64
+}
+
+// SearchHandler 搜索索引同步函数，返回错误时记录日志并跳过该变更
+type SearchHandler func(ctx context.Context, change *RowChange) error
+
+var (
+	searchMQInstance *searchMQ
+	searchHandler    SearchHandler
+)
+
+// RegisterSearchHandler 注册搜索索引同步函数，需要在InitSearch之前调用
+func RegisterSearchHandler(handler SearchHandler) {
+	searchHandler = handler
+}
+
+// InitSearch 只启动搜索索引同步的Reader，使用独立的消费者组消费Debezium变更。
+// 搜索服务不能调用Init，否则会加入backend消费者组并分到无法处理的分区
+func InitSearch() {
+	initSearchMQ()
+}
+
+func initSearchMQ() {
+	if searchHandler == nil {
+		return
+	}
+
+	searchMQInstance = &searchMQ{
+		&mq{
+			Topic:  topicDebezium,
+			Reader: NewGroupReader(topicDebezium, groupSearch),
+		},
+	}
+	go searchMQInstance.syncIndex(context.Background())
+}
+
+// syncIndex 根据数据库变更同步搜索索引
+func (mq *searchMQ) syncIndex(ctx context.Context) {
+	// 接收消息
+	for {
+		ctx, span := otel.Tracer("kafka").Start(ctx, "syncIndex")
+
+		m, err := mq.Reader.FetchMessage(ctx)
+		if err != nil {
+			klog.Error("failed to fetch message: ", err)
+			span.End()
+			break
+		}
+		msg := &dbMessage{}
+		if err := json.Unmarshal(m.Value, msg); err != nil {
+			klog.Error("failed to unmarshal message: ", err)
+			span.End()
+			continue
+		}
+
+		change := &RowChange{Table: msg.Table, Op: msg.Op, ID: msg.ID}
+		if msg.Op == "d" {
+			change.ID = msg.Before.ID
+		}
+		if err := searchHandler(ctx, change); err != nil {
+			klog.Error("failed to sync search index: ", err)
+			span.End()
+			continue
+		}
+
+		if err := mq.Reader.CommitMessages(ctx, m); err != nil {
+			klog.Error("failed to commit message: ", err)
+		}
+		span.End()
+	}
+
+	// 程序退出前关闭Reader
+	if err := mq.Reader.Close(); err != nil {
+		klog.Fatal("failed to close reader:", err)
+	}
+}
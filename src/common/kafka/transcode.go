@@ -0,0 +1,108 @@
+package kafka
+
+import (
+	"context"
+	"time"
+
+	"github.com/cloudwego/kitex/pkg/klog"
+	"github.com/segmentio/kafka-go"
+	"github.com/vmihailenco/msgpack/v5"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+)
+
+type transcodeMQ struct {
+	*mq
+}
+
+// TranscodeJob 视频转码任务
+type TranscodeJob struct {
+	VideoID     int64
+	AuthorID    int64
+	UploadTime  time.Time
+	VideoName   string // 原视频在对象存储中的文件名
+	CoverName   string // 封面在对象存储中的文件名
+	CustomCover bool   // 作者上传了自定义封面，无需从视频中生成
+	Visibility  int16  // 可见范围，公开视频计入话题热度
+}
+
+// TranscodeHandler 转码任务处理函数，返回错误时任务不会重试
+type TranscodeHandler func(ctx context.Context, job *TranscodeJob) error
+
+var (
+	transcodeMQInstance *transcodeMQ
+	transcodeHandler    TranscodeHandler
+)
+
+// RegisterTranscodeHandler 注册转码任务处理函数，需要在Init之前调用。
+// 转码依赖ffmpeg和对象存储，只有注册了处理函数的服务才会消费转码任务
+func RegisterTranscodeHandler(handler TranscodeHandler) {
+	transcodeHandler = handler
+}
+
+func initTranscodeMQ() {
+	transcodeMQInstance = &transcodeMQ{
+		&mq{
+			Topic:  topicTranscode,
+			Writer: NewWriter(topicTranscode),
+		},
+	}
+
+	if transcodeHandler != nil {
+		transcodeMQInstance.Reader = NewReader(topicTranscode)
+		go transcodeMQInstance.consumeTranscode(context.Background())
+	}
+}
+
+func (mq *transcodeMQ) consumeTranscode(ctx context.Context) {
+	// 接收消息
+	for {
+		ctx, span := otel.Tracer("kafka").Start(ctx, "consumeTranscode")
+
+		m, err := mq.Reader.FetchMessage(ctx)
+		if err != nil {
+			klog.Error("failed to fetch message: ", err)
+			span.End()
+			break
+		}
+
+		job := &TranscodeJob{}
+		if err := msgpack.Unmarshal(m.Value, job); err != nil {
+			klog.Error("failed to unmarshal message: ", err)
+			span.End()
+			continue
+		}
+
+		// 转码失败由处理函数标记视频状态，不重复消费
+		if err := transcodeHandler(ctx, job); err != nil {
+			klog.Error("failed to transcode video: ", err)
+		}
+
+		if err := mq.Reader.CommitMessages(ctx, m); err != nil {
+			klog.Error("failed to commit message: ", err)
+		}
+
+		span.End()
+	}
+
+	// 程序退出前关闭Reader
+	if err := mq.Reader.Close(); err != nil {
+		klog.Fatal("failed to close reader:", err)
+	}
+}
+
+func Transcode(ctx context.Context, job *TranscodeJob) error {
+	ctx, span := otel.Tracer("kafka").Start(ctx, "Transcode")
+	defer span.End()
+
+	value, err := msgpack.Marshal(job)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, "failed to marshal message")
+		klog.Error("failed to marshal message: ", err)
+		return err
+	}
+	return transcodeMQInstance.Writer.WriteMessages(ctx, kafka.Message{
+		Value: value,
+	})
+}
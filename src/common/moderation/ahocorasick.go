@@ -0,0 +1,96 @@
+package moderation
+
+import "unicode"
+
+// acNode Aho–Corasick自动机节点
+type acNode struct {
+	children map[rune]int
+	fail     int
+	outputs  []int // 以该节点结尾的模式串下标，包含失败链上的模式串
+}
+
+// match 一次命中，start、end为rune下标，左闭右开
+type match struct {
+	start   int
+	end     int
+	pattern int
+}
+
+// automaton Aho–Corasick多模式匹配自动机，匹配时忽略大小写
+type automaton struct {
+	nodes    []acNode
+	patterns [][]rune
+}
+
+func newAutomaton(patterns []string) *automaton {
+	a := &automaton{nodes: []acNode{{children: make(map[rune]int)}}}
+
+	// 构建字典树
+	for _, p := range patterns {
+		runes := []rune(p)
+		if len(runes) == 0 {
+			continue
+		}
+		cur := 0
+		for _, r := range runes {
+			r = unicode.ToLower(r)
+			next, ok := a.nodes[cur].children[r]
+			if !ok {
+				next = len(a.nodes)
+				a.nodes = append(a.nodes, acNode{children: make(map[rune]int)})
+				a.nodes[cur].children[r] = next
+			}
+			cur = next
+		}
+		a.nodes[cur].outputs = append(a.nodes[cur].outputs, len(a.patterns))
+		a.patterns = append(a.patterns, runes)
+	}
+
+	// 广度优先构建失败指针
+	queue := make([]int, 0, len(a.nodes))
+	for _, child := range a.nodes[0].children {
+		queue = append(queue, child)
+	}
+	for len(queue) > 0 {
+		cur := queue[0]
+		queue = queue[1:]
+		for r, child := range a.nodes[cur].children {
+			fail := a.nodes[cur].fail
+			for fail != 0 {
+				if _, ok := a.nodes[fail].children[r]; ok {
+					break
+				}
+				fail = a.nodes[fail].fail
+			}
+			if next, ok := a.nodes[fail].children[r]; ok && next != child {
+				a.nodes[child].fail = next
+			}
+			a.nodes[child].outputs = append(a.nodes[child].outputs, a.nodes[a.nodes[child].fail].outputs...)
+			queue = append(queue, child)
+		}
+	}
+
+	return a
+}
+
+// findAll 返回文本中所有命中的模式串
+func (a *automaton) findAll(text []rune) []match {
+	var matches []match
+	cur := 0
+	for i, r := range text {
+		r = unicode.ToLower(r)
+		for cur != 0 {
+			if _, ok := a.nodes[cur].children[r]; ok {
+				break
+			}
+			cur = a.nodes[cur].fail
+		}
+		if next, ok := a.nodes[cur].children[r]; ok {
+			cur = next
+		}
+		for _, p := range a.nodes[cur].outputs {
+			matches = append(matches, match{start: i + 1 - len(a.patterns[p]), end: i + 1, pattern: p})
+		}
+	}
+	return matches
+}
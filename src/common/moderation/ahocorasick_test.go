@@ -0,0 +1,88 @@
+package moderation
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+	"testing"
+)
+
+func TestAutomatonFindAll(t *testing.T) {
+	tests := []struct {
+		name     string
+		patterns []string
+		text     string
+		want     []string // pattern@start-end
+	}{
+		{
+			name:     "no match",
+			patterns: []string{"foo", "bar"},
+			text:     "hello world",
+			want:     nil,
+		},
+		{
+			name:     "overlapping patterns",
+			patterns: []string{"he", "she", "his", "hers"},
+			text:     "ushers",
+			want:     []string{"she@1-4", "he@2-4", "hers@2-6"},
+		},
+		{
+			name:     "nested patterns",
+			patterns: []string{"a", "aa", "aaa"},
+			text:     "aaa",
+			want:     []string{"a@0-1", "a@1-2", "aa@0-2", "a@2-3", "aa@1-3", "aaa@0-3"},
+		},
+		{
+			name:     "suffix reached through fail link",
+			patterns: []string{"abcd", "bc"},
+			text:     "abce",
+			want:     []string{"bc@1-3"},
+		},
+		{
+			name:     "repeated occurrences",
+			patterns: []string{"ab"},
+			text:     "abxab",
+			want:     []string{"ab@0-2", "ab@3-5"},
+		},
+		{
+			name:     "case folding",
+			patterns: []string{"bad"},
+			text:     "so BaD and bAd",
+			want:     []string{"bad@3-6", "bad@11-14"},
+		},
+		{
+			name:     "multi-byte input",
+			patterns: []string{"敏感", "感词"},
+			text:     "这是敏感词",
+			want:     []string{"敏感@2-4", "感词@3-5"},
+		},
+		{
+			name:     "mixed scripts",
+			patterns: []string{"垃圾ad"},
+			text:     "看看垃圾AD吧",
+			want:     []string{"垃圾ad@2-6"},
+		},
+		{
+			name:     "empty pattern ignored",
+			patterns: []string{"", "x"},
+			text:     "xx",
+			want:     []string{"x@0-1", "x@1-2"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			a := newAutomaton(tt.patterns)
+			var got []string
+			for _, m := range a.findAll([]rune(tt.text)) {
+				got = append(got, fmt.Sprintf("%s@%d-%d", string(a.patterns[m.pattern]), m.start, m.end))
+			}
+			sort.Strings(got)
+			want := append([]string(nil), tt.want...)
+			sort.Strings(want)
+			if !reflect.DeepEqual(got, want) {
+				t.Errorf("findAll(%q) = %v, want %v", tt.text, got, want)
+			}
+		})
+	}
+}
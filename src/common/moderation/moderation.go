@@ -0,0 +1,178 @@
+package moderation
+
+import (
+	"context"
+	"strings"
+	"sync"
+
+	"douyin/src/config"
+	"douyin/src/dal"
+	"douyin/src/dal/model"
+
+	"github.com/cloudwego/kitex/pkg/klog"
+)
+
+// Verdict 审核结论，数值越大越严格
+type Verdict int16
+
+const (
+	VerdictPass   Verdict = iota // 通过
+	VerdictMask                  // 替换敏感词后通过
+	VerdictReview                // 放行并送人工复审
+	VerdictReject                // 拒绝
+)
+
+// Scene 审核场景
+type Scene string
+
+const (
+	SceneComment    Scene = "comment"
+	SceneMessage    Scene = "message"
+	SceneVideoTitle Scene = "video_title"
+	SceneGroupName  Scene = "group_name"
+)
+
+// Result 审核结果
+type Result struct {
+	Verdict Verdict
+	Text    string   // 审核后的文本，mask时敏感词被替换为*
+	Hits    []string // 命中的敏感词
+}
+
+// Moderator 内容审核器
+type Moderator interface {
+	Check(ctx context.Context, text string) (*Result, error)
+}
+
+// WordFilter 基于Aho–Corasick自动机的敏感词过滤器
+type WordFilter struct {
+	ac       *automaton
+	verdicts []Verdict // 与自动机中模式串下标一一对应
+}
+
+// NewWordFilter 根据配置中的词表构建敏感词过滤器，同一个词出现在多个词表中时取最严格的结论
+func NewWordFilter(conf *config.ModerationConfig) *WordFilter {
+	if conf == nil {
+		conf = &config.ModerationConfig{}
+	}
+
+	levels := make(map[string]Verdict)
+	add := func(words []string, verdict Verdict) {
+		for _, word := range words {
+			word = strings.ToLower(strings.TrimSpace(word))
+			if word == "" {
+				continue
+			}
+			if v, ok := levels[word]; !ok || verdict > v {
+				levels[word] = verdict
+			}
+		}
+	}
+	add(conf.MaskWords, VerdictMask)
+	add(conf.ReviewWords, VerdictReview)
+	add(conf.RejectWords, VerdictReject)
+
+	words := make([]string, 0, len(levels))
+	for word := range levels {
+		words = append(words, word)
+	}
+	f := &WordFilter{ac: newAutomaton(words)}
+	f.verdicts = make([]Verdict, len(f.ac.patterns))
+	for i, p := range f.ac.patterns {
+		f.verdicts[i] = levels[string(p)]
+	}
+	return f
+}
+
+// Check 实现Moderator接口
+func (f *WordFilter) Check(ctx context.Context, text string) (*Result, error) {
+	runes := []rune(text)
+	result := &Result{Verdict: VerdictPass, Text: text}
+
+	matches := f.ac.findAll(runes)
+	if len(matches) == 0 {
+		return result, nil
+	}
+
+	seen := make(map[int]struct{}, len(matches))
+	masked := false
+	for _, m := range matches {
+		verdict := f.verdicts[m.pattern]
+		if verdict > result.Verdict {
+			result.Verdict = verdict
+		}
+		if _, ok := seen[m.pattern]; !ok {
+			seen[m.pattern] = struct{}{}
+			result.Hits = append(result.Hits, string(f.ac.patterns[m.pattern]))
+		}
+		// 所有命中的词都替换为*，避免review放行的内容中仍包含mask词
+		if verdict == VerdictMask {
+			for i := m.start; i < m.end; i++ {
+				runes[i] = '*'
+			}
+			masked = true
+		}
+	}
+	if masked {
+		result.Text = string(runes)
+	}
+
+	return result, nil
+}
+
+var (
+	mu         sync.Mutex
+	moderator  Moderator
+	builtConf  *config.ModerationConfig
+	customized bool
+)
+
+// SetModerator 替换默认的敏感词过滤器，如接入第三方审核服务
+func SetModerator(m Moderator) {
+	mu.Lock()
+	defer mu.Unlock()
+	moderator = m
+	customized = true
+}
+
+// getModerator 获取当前审核器，词表配置变化时重新构建过滤器
+func getModerator() Moderator {
+	mu.Lock()
+	defer mu.Unlock()
+	if customized {
+		return moderator
+	}
+	if moderator == nil || builtConf != config.Conf.ModerationConfig {
+		builtConf = config.Conf.ModerationConfig
+		moderator = NewWordFilter(builtConf)
+	}
+	return moderator
+}
+
+// Moderate 审核用户提交的文本，返回审核后可保存的文本。
+// 被拒绝时返回dal.ErrContentRejected，被拒绝和需要复审的内容会记录到数据库供审计
+func Moderate(ctx context.Context, scene Scene, userID int64, text string) (string, error) {
+	result, err := getModerator().Check(ctx, text)
+	if err != nil {
+		return "", err
+	}
+
+	if result.Verdict == VerdictReject || result.Verdict == VerdictReview {
+		record := &model.ModerationRecord{
+			UserID:  userID,
+			Scene:   string(scene),
+			Content: text,
+			Verdict: int16(result.Verdict),
+			Hits:    strings.Join(result.Hits, ","),
+		}
+		if err := dal.CreateModerationRecord(ctx, record); err != nil {
+			klog.Error("记录审核结果失败, err: ", err)
+		}
+	}
+
+	if result.Verdict == VerdictReject {
+		return "", dal.ErrContentRejected
+	}
+
+	return result.Text, nil
+}
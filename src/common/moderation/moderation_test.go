@@ -0,0 +1,113 @@
+package moderation
+
+import (
+	"context"
+	"reflect"
+	"sort"
+	"testing"
+
+	"douyin/src/config"
+)
+
+func TestWordFilterCheck(t *testing.T) {
+	conf := &config.ModerationConfig{
+		MaskWords:   []string{"笨蛋", "ab", "bc", "Damn", " shared "},
+		ReviewWords: []string{"广告", "shared"},
+		RejectWords: []string{"违禁", "SHARED"},
+	}
+	f := NewWordFilter(conf)
+
+	tests := []struct {
+		name    string
+		text    string
+		verdict Verdict
+		output  string
+		hits    []string
+	}{
+		{
+			name:    "pass",
+			text:    "今天天气不错",
+			verdict: VerdictPass,
+			output:  "今天天气不错",
+		},
+		{
+			name:    "mask multi-byte word",
+			text:    "你这个笨蛋!",
+			verdict: VerdictMask,
+			output:  "你这个**!",
+			hits:    []string{"笨蛋"},
+		},
+		{
+			name:    "mask overlapping words",
+			text:    "xabcx",
+			verdict: VerdictMask,
+			output:  "x***x",
+			hits:    []string{"ab", "bc"},
+		},
+		{
+			name:    "mask ignores case",
+			text:    "DAMN It",
+			verdict: VerdictMask,
+			output:  "**** It",
+			hits:    []string{"damn"},
+		},
+		{
+			name:    "mask repeated word",
+			text:    "笨蛋笨蛋",
+			verdict: VerdictMask,
+			output:  "****",
+			hits:    []string{"笨蛋"},
+		},
+		{
+			name:    "review over mask",
+			text:    "笨蛋看广告",
+			verdict: VerdictReview,
+			output:  "**看广告",
+			hits:    []string{"广告", "笨蛋"},
+		},
+		{
+			name:    "reject over review and mask",
+			text:    "笨蛋广告违禁",
+			verdict: VerdictReject,
+			output:  "**广告违禁",
+			hits:    []string{"广告", "笨蛋", "违禁"},
+		},
+		{
+			name:    "strictest list wins for duplicated word",
+			text:    "a Shared link",
+			verdict: VerdictReject,
+			output:  "a Shared link",
+			hits:    []string{"shared"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := f.Check(context.Background(), tt.text)
+			if err != nil {
+				t.Fatalf("Check(%q) error: %v", tt.text, err)
+			}
+			if result.Verdict != tt.verdict {
+				t.Errorf("Check(%q).Verdict = %d, want %d", tt.text, result.Verdict, tt.verdict)
+			}
+			if result.Text != tt.output {
+				t.Errorf("Check(%q).Text = %q, want %q", tt.text, result.Text, tt.output)
+			}
+			hits := append([]string(nil), result.Hits...)
+			sort.Strings(hits)
+			if !reflect.DeepEqual(hits, tt.hits) {
+				t.Errorf("Check(%q).Hits = %v, want %v", tt.text, hits, tt.hits)
+			}
+		})
+	}
+}
+
+func TestNewWordFilterNilConfig(t *testing.T) {
+	result, err := NewWordFilter(nil).Check(context.Background(), "anything")
+	if err != nil {
+		t.Fatalf("Check error: %v", err)
+	}
+	if result.Verdict != VerdictPass || result.Text != "anything" || len(result.Hits) != 0 {
+		t.Errorf("Check = %+v, want pass without hits", result)
+	}
+}
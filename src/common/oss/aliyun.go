@@ -0,0 +1,114 @@
+package oss
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"strconv"
+	"time"
+
+	"douyin/src/config"
+
+	"github.com/aliyun/aliyun-oss-go-sdk/oss"
+)
+
+// aliyunStorage 阿里云OSS
+type aliyunStorage struct {
+	bucket  *oss.Bucket
+	baseURL string
+}
+
+func newAliyunStorage(conf *config.OssConfig) (*aliyunStorage, error) {
+	client, err := oss.New(conf.Endpoint, conf.AccessKeyId, conf.AccessKeySecret)
+	if err != nil {
+		return nil, err
+	}
+	bucket, err := client.Bucket(conf.BucketName)
+	if err != nil {
+		return nil, err
+	}
+
+	baseURL := conf.BaseURL
+	if baseURL == "" {
+		baseURL = "https://" + conf.BucketName + "." + conf.Endpoint
+	}
+	return &aliyunStorage{bucket: bucket, baseURL: baseURL}, nil
+}
+
+func (s *aliyunStorage) Put(ctx context.Context, key string, r io.Reader) error {
+	return s.bucket.PutObject(key, r, oss.WithContext(ctx))
+}
+
+func (s *aliyunStorage) Get(ctx context.Context, key string) (io.ReadCloser, error) {
+	body, err := s.bucket.GetObject(key, oss.WithContext(ctx))
+	if err != nil {
+		return nil, aliyunError(err)
+	}
+	return body, nil
+}
+
+func (s *aliyunStorage) Delete(ctx context.Context, keys ...string) error {
+	switch len(keys) {
+	case 0:
+		return nil
+	case 1:
+		return s.bucket.DeleteObject(keys[0], oss.WithContext(ctx))
+	}
+	_, err := s.bucket.DeleteObjects(keys, oss.DeleteObjectsQuiet(true), oss.WithContext(ctx))
+	return err
+}
+
+func (s *aliyunStorage) Stat(ctx context.Context, key string) (*ObjectInfo, error) {
+	header, err := s.bucket.GetObjectDetailedMeta(key, oss.WithContext(ctx))
+	if err != nil {
+		return nil, aliyunError(err)
+	}
+
+	size, err := strconv.ParseInt(header.Get("Content-Length"), 10, 64)
+	if err != nil {
+		return nil, err
+	}
+	lastModified, _ := http.ParseTime(header.Get("Last-Modified"))
+	return &ObjectInfo{
+		Key:          key,
+		Size:         size,
+		ContentType:  header.Get("Content-Type"),
+		LastModified: lastModified,
+	}, nil
+}
+
+func (s *aliyunStorage) List(ctx context.Context, prefix string) ([]string, error) {
+	var keys []string
+	token := ""
+	for {
+		result, err := s.bucket.ListObjectsV2(oss.Prefix(prefix), oss.ContinuationToken(token), oss.WithContext(ctx))
+		if err != nil {
+			return nil, err
+		}
+		for _, object := range result.Objects {
+			keys = append(keys, object.Key)
+		}
+		if !result.IsTruncated {
+			return keys, nil
+		}
+		token = result.NextContinuationToken
+	}
+}
+
+func (s *aliyunStorage) PresignURL(ctx context.Context, key string, expire time.Duration) (string, error) {
+	return s.bucket.SignURL(key, oss.HTTPGet, int64(expire.Seconds()))
+}
+
+func (s *aliyunStorage) URL(key string) string {
+	return joinURL(s.baseURL, key)
+}
+
+// aliyunError 将对象不存在的错误转换为ErrObjectNotExist
+func aliyunError(err error) error {
+	var serviceErr oss.ServiceError
+	if errors.As(err, &serviceErr) && serviceErr.StatusCode == http.StatusNotFound {
+		return ErrObjectNotExist
+	}
+	return err
+}
@@ -0,0 +1,178 @@
+package oss
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"image"
+	_ "image/jpeg"
+	"io"
+	"math"
+	"strconv"
+	"time"
+
+	"github.com/cloudwego/kitex/pkg/klog"
+	ffmpeg "github.com/u2takey/ffmpeg-go"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+)
+
+const (
+	coverSamples  = 8    // 采样帧数
+	coverMargin   = 0.05 // 跳过片头片尾的比例，避免选中黑场和转场
+	coverGridSize = 160  // 评分时将画面采样为不超过该边长的灰度网格
+	minBrightness = 24   // 平均亮度低于该值视为黑屏
+	maxBrightness = 232  // 平均亮度高于该值视为白屏
+	sharpnessNorm = 200  // 拉普拉斯方差归一化参数，方差等于该值时清晰度得分为0.5
+)
+
+// 各项评分的权重
+const (
+	weightBrightness = 0.3
+	weightSharpness  = 0.4
+	weightContrast   = 0.3
+)
+
+var errNoFrame = errors.New("未能截取到视频帧")
+
+// frame 采样帧及其评分
+type frame struct {
+	data  []byte
+	score float64
+}
+
+// selectCover 在视频中均匀采样多帧，按亮度、清晰度和画面丰富程度评分，返回得分最高的一帧
+func selectCover(ctx context.Context, videoName string, duration time.Duration) (io.Reader, error) {
+	ctx, span := otel.Tracer("oss").Start(ctx, "SelectCover")
+	defer span.End()
+
+	var best *frame
+	for _, offset := range sampleOffsets(duration) {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+
+		data, err := extractFrame(videoName, offset)
+		if err != nil || len(data) == 0 {
+			klog.Warn("截取视频帧失败, offset: ", offset, ", err: ", err)
+			continue
+		}
+		img, _, err := image.Decode(bytes.NewReader(data))
+		if err != nil {
+			klog.Warn("解码视频帧失败, offset: ", offset, ", err: ", err)
+			continue
+		}
+
+		f := &frame{data: data, score: scoreFrame(img)}
+		if best == nil || f.score > best.score {
+			best = f
+		}
+	}
+	if best == nil {
+		span.RecordError(errNoFrame)
+		span.SetStatus(codes.Error, "获取封面失败")
+		return nil, errNoFrame
+	}
+
+	return bytes.NewReader(best.data), nil
+}
+
+// sampleOffsets 返回采样时间点，时长未知时只截取开头一帧
+func sampleOffsets(duration time.Duration) []time.Duration {
+	if duration <= 0 {
+		return []time.Duration{0}
+	}
+
+	start := time.Duration(float64(duration) * coverMargin)
+	length := duration - 2*start
+	offsets := make([]time.Duration, coverSamples)
+	for i := range offsets {
+		offsets[i] = start + length*time.Duration(i+1)/time.Duration(coverSamples+1)
+	}
+	return offsets
+}
+
+// extractFrame 截取视频指定时间点的一帧，编码为jpeg
+func extractFrame(videoName string, offset time.Duration) ([]byte, error) {
+	buf := bytes.NewBuffer(nil)
+	err := ffmpeg.Input(videoName, ffmpeg.KwArgs{"ss": strconv.FormatFloat(offset.Seconds(), 'f', 3, 64)}).
+		Output("pipe:", ffmpeg.KwArgs{"vframes": 1, "format": "image2", "vcodec": "mjpeg"}).
+		WithOutput(buf, nil).
+		Run()
+	if err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// scoreFrame 计算帧的综合得分，取值0-1:
+// 亮度越接近中间值得分越高，黑屏和白屏直接记0分；清晰度取拉普拉斯方差；画面丰富程度取灰度直方图的熵
+func scoreFrame(img image.Image) float64 {
+	gray := grayGrid(img)
+	h := len(gray)
+	if h < 3 || len(gray[0]) < 3 {
+		return 0
+	}
+	w := len(gray[0])
+
+	// 亮度
+	var sum float64
+	var hist [256]int
+	for _, row := range gray {
+		for _, v := range row {
+			sum += v
+			hist[int(v)]++
+		}
+	}
+	total := float64(w * h)
+	mean := sum / total
+	if mean < minBrightness || mean > maxBrightness {
+		return 0
+	}
+	brightness := 1 - math.Abs(mean-128)/128
+
+	// 清晰度
+	var lapSum, lapSqSum float64
+	for y := 1; y < h-1; y++ {
+		for x := 1; x < w-1; x++ {
+			lap := gray[y-1][x] + gray[y+1][x] + gray[y][x-1] + gray[y][x+1] - 4*gray[y][x]
+			lapSum += lap
+			lapSqSum += lap * lap
+		}
+	}
+	n := float64((w - 2) * (h - 2))
+	lapMean := lapSum / n
+	variance := lapSqSum/n - lapMean*lapMean
+	sharpness := variance / (variance + sharpnessNorm)
+
+	// 画面丰富程度，纯色画面的熵为0，最大为8
+	var entropy float64
+	for _, c := range hist {
+		if c == 0 {
+			continue
+		}
+		p := float64(c) / total
+		entropy -= p * math.Log2(p)
+	}
+	contrast := entropy / 8
+
+	return weightBrightness*brightness + weightSharpness*sharpness + weightContrast*contrast
+}
+
+// grayGrid 将图像等间隔采样为灰度网格，灰度取值0-255
+func grayGrid(img image.Image) [][]float64 {
+	bounds := img.Bounds()
+	step := max(bounds.Dx(), bounds.Dy())/coverGridSize + 1
+
+	grid := make([][]float64, 0, bounds.Dy()/step+1)
+	for y := bounds.Min.Y; y < bounds.Max.Y; y += step {
+		row := make([]float64, 0, bounds.Dx()/step+1)
+		for x := bounds.Min.X; x < bounds.Max.X; x += step {
+			r, g, b, _ := img.At(x, y).RGBA()
+			// ITU-R BT.601 亮度公式，RGBA返回16位颜色分量
+			row = append(row, (0.299*float64(r)+0.587*float64(g)+0.114*float64(b))/257)
+		}
+		grid = append(grid, row)
+	}
+	return grid
+}
@@ -0,0 +1,140 @@
+package oss
+
+import (
+	"context"
+	"io"
+	"io/fs"
+	"mime"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"douyin/src/config"
+)
+
+// LocalURLPath 本地存储时API服务提供对象访问的路由前缀
+const LocalURLPath = "/oss"
+
+// localStorage 本地文件系统存储，用于开发和测试
+type localStorage struct {
+	root    string
+	baseURL string
+}
+
+// LocalRoot 返回本地存储的根目录
+func LocalRoot(conf *config.OssConfig) string {
+	if conf.LocalPath == "" {
+		return pathName
+	}
+	return conf.LocalPath
+}
+
+func newLocalStorage(conf *config.OssConfig) (*localStorage, error) {
+	root := LocalRoot(conf)
+	if err := os.MkdirAll(root, 0o755); err != nil {
+		return nil, err
+	}
+
+	baseURL := conf.BaseURL
+	if baseURL == "" {
+		baseURL = LocalURLPath
+	}
+	return &localStorage{root: root, baseURL: baseURL}, nil
+}
+
+// path 将对象key转换为本地路径，不允许访问根目录以外的文件
+func (s *localStorage) path(key string) string {
+	return filepath.Join(s.root, filepath.FromSlash(path.Clean("/"+key)))
+}
+
+func (s *localStorage) Put(ctx context.Context, key string, r io.Reader) error {
+	name := s.path(key)
+	if err := os.MkdirAll(filepath.Dir(name), 0o755); err != nil {
+		return err
+	}
+
+	// 先写入临时文件再重命名，避免读到写了一半的文件
+	tmp, err := os.CreateTemp(filepath.Dir(name), filepath.Base(name)+".tmp*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+
+	if _, err := io.Copy(tmp, r); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+	return os.Rename(tmp.Name(), name)
+}
+
+func (s *localStorage) Get(ctx context.Context, key string) (io.ReadCloser, error) {
+	file, err := os.Open(s.path(key))
+	if os.IsNotExist(err) {
+		return nil, ErrObjectNotExist
+	}
+	return file, err
+}
+
+func (s *localStorage) Delete(ctx context.Context, keys ...string) error {
+	for _, key := range keys {
+		if err := os.Remove(s.path(key)); err != nil && !os.IsNotExist(err) {
+			return err
+		}
+	}
+	return nil
+}
+
+func (s *localStorage) Stat(ctx context.Context, key string) (*ObjectInfo, error) {
+	info, err := os.Stat(s.path(key))
+	if os.IsNotExist(err) {
+		return nil, ErrObjectNotExist
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &ObjectInfo{
+		Key:          key,
+		Size:         info.Size(),
+		ContentType:  mime.TypeByExtension(path.Ext(key)),
+		LastModified: info.ModTime(),
+	}, nil
+}
+
+func (s *localStorage) List(ctx context.Context, prefix string) ([]string, error) {
+	// 只遍历前缀所在的目录
+	var keys []string
+	err := filepath.WalkDir(s.path(path.Dir(prefix+"_")), func(name string, d fs.DirEntry, err error) error {
+		if os.IsNotExist(err) {
+			return fs.SkipDir
+		}
+		if err != nil || d.IsDir() {
+			return err
+		}
+		rel, err := filepath.Rel(s.root, name)
+		if err != nil {
+			return err
+		}
+		if key := filepath.ToSlash(rel); strings.HasPrefix(key, prefix) {
+			keys = append(keys, key)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return keys, nil
+}
+
+// PresignURL 本地存储不做访问控制，直接返回公开地址
+func (s *localStorage) PresignURL(ctx context.Context, key string, expire time.Duration) (string, error) {
+	return s.URL(key), nil
+}
+
+func (s *localStorage) URL(key string) string {
+	return joinURL(s.baseURL, key)
+}
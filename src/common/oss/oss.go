@@ -1,114 +1,248 @@
 package oss
 
 import (
-	"bytes"
 	"context"
 	"io"
+	"io/fs"
 	"os"
 	"path"
-	"sync"
+	"path/filepath"
+	"strings"
+	"time"
 
-	"douyin/src/config"
-
-	"github.com/aliyun/aliyun-oss-go-sdk/oss"
 	"github.com/cloudwego/kitex/pkg/klog"
-	ffmpeg "github.com/u2takey/ffmpeg-go"
 	"go.opentelemetry.io/otel"
 	"go.opentelemetry.io/otel/codes"
 )
 
-var bucket *oss.Bucket
-
 const (
 	pathName  = "./public/"
 	videoPath = "video/"
 	imagePath = "image/"
+	chatPath  = "chat/"
+	hlsPath   = "hls/"
+
+	deleteBatchSize = 1000
 )
 
-func Init() {
-	client, err := oss.New(config.Conf.OssConfig.Endpoint, config.Conf.OssConfig.AccessKeyId, config.Conf.OssConfig.AccessKeySecret)
+// UploadVideo 上传视频到oss
+func UploadVideo(ctx context.Context, r io.Reader, videoName string) error {
+	ctx, span := otel.Tracer("oss").Start(ctx, "UploadVideo")
+	defer span.End()
+
+	if err := store.Put(ctx, videoKey(videoName), r); err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, "上传视频失败")
+		klog.Error("上传视频失败, err: ", err)
+		return err
+	}
+	return nil
+}
+
+// DeleteVideo 删除oss中的视频
+func DeleteVideo(ctx context.Context, videoName string) error {
+	ctx, span := otel.Tracer("oss").Start(ctx, "DeleteVideo")
+	defer span.End()
+
+	if err := store.Delete(ctx, videoKey(videoName)); err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, "删除视频失败")
+		klog.Error("删除视频失败, err: ", err)
+		return err
+	}
+	return nil
+}
+
+// StatVideo 获取oss中视频的元信息，视频不存在时返回ErrObjectNotExist
+func StatVideo(ctx context.Context, videoName string) (*ObjectInfo, error) {
+	ctx, span := otel.Tracer("oss").Start(ctx, "StatVideo")
+	defer span.End()
+
+	info, err := store.Stat(ctx, videoKey(videoName))
 	if err != nil {
-		panic(err)
+		span.RecordError(err)
+		span.SetStatus(codes.Error, "获取视频元信息失败")
+		klog.Error("获取视频元信息失败, err: ", err)
+		return nil, err
+	}
+	return info, nil
+}
+
+// DownloadVideo 下载oss中的视频到本地
+func DownloadVideo(ctx context.Context, videoName, localName string) error {
+	ctx, span := otel.Tracer("oss").Start(ctx, "DownloadVideo")
+	defer span.End()
+
+	if err := download(ctx, videoKey(videoName), localName); err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, "下载视频失败")
+		klog.Error("下载视频失败, err: ", err)
+		return err
+	}
+	return nil
+}
+
+// UploadCover 上传作者自定义的封面到oss
+func UploadCover(ctx context.Context, r io.Reader, coverName string) error {
+	ctx, span := otel.Tracer("oss").Start(ctx, "UploadCover")
+	defer span.End()
+
+	if err := store.Put(ctx, coverKey(coverName), r); err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, "上传封面失败")
+		klog.Error("上传封面失败, err: ", err)
+		return err
+	}
+	return nil
+}
+
+// UploadChatImage 上传聊天图片到oss
+func UploadChatImage(ctx context.Context, r io.Reader, imageName string) error {
+	ctx, span := otel.Tracer("oss").Start(ctx, "UploadChatImage")
+	defer span.End()
+
+	if err := store.Put(ctx, chatImageKey(imageName), r); err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, "上传聊天图片失败")
+		klog.Error("上传聊天图片失败, err: ", err)
+		return err
+	}
+	return nil
+}
+
+// DeleteCover 删除oss中的封面
+func DeleteCover(ctx context.Context, coverName string) error {
+	ctx, span := otel.Tracer("oss").Start(ctx, "DeleteCover")
+	defer span.End()
+
+	if err := store.Delete(ctx, coverKey(coverName)); err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, "删除封面失败")
+		klog.Error("删除封面失败, err: ", err)
+		return err
 	}
-	bucket, err = client.Bucket(config.Conf.OssConfig.BucketName)
+	return nil
+}
+
+// StatCover 获取oss中封面的元信息，封面不存在时返回ErrObjectNotExist
+func StatCover(ctx context.Context, coverName string) (*ObjectInfo, error) {
+	ctx, span := otel.Tracer("oss").Start(ctx, "StatCover")
+	defer span.End()
+
+	info, err := store.Stat(ctx, coverKey(coverName))
 	if err != nil {
-		panic(err)
+		span.RecordError(err)
+		span.SetStatus(codes.Error, "获取封面元信息失败")
+		klog.Error("获取封面元信息失败, err: ", err)
+		return nil, err
 	}
+	return info, nil
 }
 
-// UploadFile 上传文件到oss
-func UploadFile(ctx context.Context, data []byte, uuidName string) error {
-	ctx, span := otel.Tracer("oss").Start(ctx, "UploadFile")
+// GenerateCover 从本地视频中挑选画面质量最好的一帧作为封面上传到oss，duration为视频时长
+func GenerateCover(ctx context.Context, localName, coverName string, duration time.Duration) error {
+	ctx, span := otel.Tracer("oss").Start(ctx, "GenerateCover")
 	defer span.End()
 
-	videoName := uuidName + ".mp4"
-	coverName := uuidName + ".jpeg"
+	// 获取视频封面
+	imageData, err := selectCover(ctx, localName, duration)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, "获取封面失败")
+		klog.Error("获取封面失败, err: ", err)
+		return err
+	}
+	if err := store.Put(ctx, coverKey(coverName), imageData); err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, "上传封面失败")
+		klog.Error("上传封面失败", err)
+		return err
+	}
 
-	// 使用协程并发执行文件上传操作
-	var uploadErr error
-	var wg sync.WaitGroup
+	return nil
+}
 
-	wg.Add(2)
+// UploadHLS 上传本地目录中的HLS播放列表和分片，返回主播放列表的访问地址
+func UploadHLS(ctx context.Context, localDir, name, playlist string) (string, error) {
+	ctx, span := otel.Tracer("oss").Start(ctx, "UploadHLS")
+	defer span.End()
 
-	go func() {
-		defer wg.Done()
-		// 上传视频
-		if err := bucket.PutObject(path.Join(videoPath, videoName), bytes.NewReader(data)); err != nil {
-			span.RecordError(err)
-			span.SetStatus(codes.Error, "上传视频失败")
-			klog.Error("上传视频失败", err)
-			uploadErr = err
+	err := filepath.WalkDir(localDir, func(localName string, d fs.DirEntry, err error) error {
+		if err != nil || d.IsDir() {
+			return err
 		}
-	}()
-
-	go func() {
-		defer wg.Done()
-		// 获取视频封面
-		imageData, err := getCoverImage(ctx, videoName)
+		rel, err := filepath.Rel(localDir, localName)
 		if err != nil {
-			span.RecordError(err)
-			span.SetStatus(codes.Error, "获取封面失败")
-			klog.Error("获取封面失败, err: ", err)
-			uploadErr = err
-			return
+			return err
 		}
-		if err := bucket.PutObject(path.Join(imagePath, coverName), imageData); err != nil {
-			span.RecordError(err)
-			span.SetStatus(codes.Error, "上传封面失败")
-			klog.Error("上传封面失败", err)
-			uploadErr = err
+		file, err := os.Open(localName)
+		if err != nil {
+			return err
 		}
-	}()
+		defer file.Close()
+		return store.Put(ctx, hlsKey(name, filepath.ToSlash(rel)), file)
+	})
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, "上传HLS文件失败")
+		klog.Error("上传HLS文件失败, err: ", err)
+		return "", err
+	}
 
-	wg.Wait()
+	return store.URL(hlsKey(name, playlist)), nil
+}
+
+// RemoveVideoFiles 删除视频的原文件、封面和HLS文件，参数为数据库中保存的访问地址，不是当前存储后端的地址会被忽略
+func RemoveVideoFiles(ctx context.Context, playURL, coverURL, hlsURL string) error {
+	ctx, span := otel.Tracer("oss").Start(ctx, "RemoveVideoFiles")
+	defer span.End()
 
-	// 删除本地视频
-	go func() {
-		if err := os.Remove(videoName); err != nil {
+	var keys []string
+	if key := objectKey(playURL); strings.HasPrefix(key, videoPath) {
+		keys = append(keys, key)
+	}
+	if key := objectKey(coverURL); strings.HasPrefix(key, imagePath) {
+		keys = append(keys, key)
+	}
+	// HLS文件位于hls/<name>/目录下，列出目录中的全部分片
+	if dir := path.Dir(objectKey(hlsURL)); strings.HasPrefix(dir, hlsPath) {
+		hlsKeys, err := store.List(ctx, dir+"/")
+		if err != nil {
 			span.RecordError(err)
-			span.SetStatus(codes.Error, "删除视频失败")
-			klog.Error("删除视频失败, err: ", err)
+			span.SetStatus(codes.Error, "列出HLS文件失败")
+			klog.Error("列出HLS文件失败, err: ", err)
+			return err
 		}
-	}()
+		keys = append(keys, hlsKeys...)
+	}
 
-	return uploadErr
+	// 批量删除接口单次最多删除1000个对象
+	for start := 0; start < len(keys); start += deleteBatchSize {
+		if err := store.Delete(ctx, keys[start:min(start+deleteBatchSize, len(keys))]...); err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, "删除视频文件失败")
+			klog.Error("删除视频文件失败, err: ", err)
+			return err
+		}
+	}
+	return nil
 }
 
-// getCoverImage 获取视频第15帧作为封面
-func getCoverImage(ctx context.Context, videoName string) (io.Reader, error) {
-	_, span := otel.Tracer("oss").Start(ctx, "GetCoverImage")
-	defer span.End()
+// download 下载对象到本地文件
+func download(ctx context.Context, key, localName string) error {
+	body, err := store.Get(ctx, key)
+	if err != nil {
+		return err
+	}
+	defer body.Close()
 
-	buf := bytes.NewBuffer(nil)
-	err := ffmpeg.Input(videoName).Filter("select", ffmpeg.Args{"gte(n,15)"}).
-		Output("pipe:", ffmpeg.KwArgs{"vframes": 1, "format": "image2", "vcodec": "mjpeg"}).
-		WithOutput(buf, nil).
-		Run()
+	file, err := os.Create(localName)
 	if err != nil {
-		span.RecordError(err)
-		span.SetStatus(codes.Error, "获取封面失败")
-		klog.Error("获取封面失败, err: ", err)
-		return nil, err
+		return err
+	}
+	if _, err := io.Copy(file, body); err != nil {
+		file.Close()
+		return err
 	}
-	return bytes.NewReader(buf.Bytes()), nil
+	return file.Close()
 }
@@ -0,0 +1,126 @@
+package oss
+
+import (
+	"context"
+	"errors"
+	"io"
+	"strings"
+	"time"
+
+	"douyin/src/config"
+)
+
+var ErrObjectNotExist = errors.New("对象不存在")
+
+// ObjectInfo 对象元信息
+type ObjectInfo struct {
+	Key          string
+	Size         int64
+	ContentType  string
+	LastModified time.Time
+}
+
+// Storage 对象存储后端
+type Storage interface {
+	// Put 上传对象，已存在时覆盖
+	Put(ctx context.Context, key string, r io.Reader) error
+	// Get 读取对象，调用方负责关闭返回的ReadCloser，对象不存在时返回ErrObjectNotExist
+	Get(ctx context.Context, key string) (io.ReadCloser, error)
+	// Delete 删除对象，对象不存在时不报错
+	Delete(ctx context.Context, keys ...string) error
+	// Stat 获取对象元信息，对象不存在时返回ErrObjectNotExist
+	Stat(ctx context.Context, key string) (*ObjectInfo, error)
+	// List 列出指定前缀下的全部对象key
+	List(ctx context.Context, prefix string) ([]string, error)
+	// PresignURL 生成有时效的访问地址
+	PresignURL(ctx context.Context, key string, expire time.Duration) (string, error)
+	// URL 返回对象的公开访问地址
+	URL(key string) string
+}
+
+var store Storage
+
+// Init 根据配置选择存储后端
+func Init() {
+	conf := config.Conf.OssConfig
+
+	var err error
+	switch conf.Backend {
+	case "", "aliyun":
+		store, err = newAliyunStorage(conf)
+	case "s3":
+		store, err = newS3Storage(conf)
+	case "local":
+		store, err = newLocalStorage(conf)
+	default:
+		err = errors.New("unknown oss backend: " + conf.Backend)
+	}
+	if err != nil {
+		panic(err)
+	}
+}
+
+// Default 返回当前使用的存储后端
+func Default() Storage {
+	return store
+}
+
+// VideoURL 返回视频的访问地址
+func VideoURL(videoName string) string {
+	return store.URL(videoKey(videoName))
+}
+
+// CoverURL 返回封面的访问地址
+func CoverURL(coverName string) string {
+	return store.URL(coverKey(coverName))
+}
+
+// CoverName 从封面访问地址中解析出文件名，不是当前存储后端的地址时返回空字符串
+func CoverName(coverURL string) string {
+	key := objectKey(coverURL)
+	if !strings.HasPrefix(key, imagePath) {
+		return ""
+	}
+	return strings.TrimPrefix(key, imagePath)
+}
+
+// ChatImageURL 返回聊天图片的访问地址
+func ChatImageURL(imageName string) string {
+	return store.URL(chatImageKey(imageName))
+}
+
+// IsChatImageURL 判断是否是当前存储后端中的聊天图片地址
+func IsChatImageURL(imageURL string) bool {
+	key := objectKey(imageURL)
+	return strings.HasPrefix(key, chatPath) && len(key) > len(chatPath)
+}
+
+// objectKey 从访问地址中解析出对象key，不是当前存储后端的地址时返回空字符串
+func objectKey(url string) string {
+	prefix := store.URL("")
+	if !strings.HasPrefix(url, prefix) {
+		return ""
+	}
+	return strings.TrimPrefix(url, prefix)
+}
+
+func videoKey(videoName string) string {
+	return videoPath + videoName
+}
+
+func coverKey(coverName string) string {
+	return imagePath + coverName
+}
+
+func chatImageKey(imageName string) string {
+	return chatPath + imageName
+}
+
+func hlsKey(name, file string) string {
+	return hlsPath + name + "/" + file
+}
+
+// joinURL 拼接访问地址前缀和对象key
+func joinURL(baseURL, key string) string {
+	return strings.TrimSuffix(baseURL, "/") + "/" + strings.TrimPrefix(key, "/")
+}
@@ -0,0 +1,127 @@
+package oss
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"io"
+	"path"
+	"strconv"
+	"strings"
+
+	"github.com/cloudwego/kitex/pkg/klog"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+)
+
+const uploadPath = "upload/"
+
+func chunkKey(uploadID string, index int64) string {
+	return path.Join(uploadPath, uploadID, strconv.FormatInt(index, 10))
+}
+
+// PutChunk 暂存分片到oss，重复上传同一分片会覆盖之前的内容
+func PutChunk(ctx context.Context, uploadID string, index int64, r io.Reader) error {
+	ctx, span := otel.Tracer("oss").Start(ctx, "PutChunk")
+	defer span.End()
+
+	if err := store.Put(ctx, chunkKey(uploadID, index), r); err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, "上传分片失败")
+		klog.Error("上传分片失败, err: ", err)
+		return err
+	}
+	return nil
+}
+
+// ComposeChunks 按序号依次读取分片合并为视频文件，返回合并后文件的sha256
+func ComposeChunks(ctx context.Context, uploadID string, chunkCount int64, videoName string) (string, error) {
+	ctx, span := otel.Tracer("oss").Start(ctx, "ComposeChunks")
+	defer span.End()
+
+	// 边读取分片边上传，避免将整个文件读入内存
+	pr, pw := io.Pipe()
+	go func() {
+		for i := int64(0); i < chunkCount; i++ {
+			body, err := store.Get(ctx, chunkKey(uploadID, i))
+			if err != nil {
+				pw.CloseWithError(err)
+				return
+			}
+			_, err = io.Copy(pw, body)
+			body.Close()
+			if err != nil {
+				pw.CloseWithError(err)
+				return
+			}
+		}
+		pw.Close()
+	}()
+
+	hash := sha256.New()
+	if err := UploadVideo(ctx, io.TeeReader(pr, hash), videoName); err != nil {
+		pr.CloseWithError(err)
+		span.RecordError(err)
+		span.SetStatus(codes.Error, "合并分片失败")
+		klog.Error("合并分片失败, err: ", err)
+		return "", err
+	}
+
+	return hex.EncodeToString(hash.Sum(nil)), nil
+}
+
+// DeleteChunks 删除暂存的分片
+func DeleteChunks(ctx context.Context, uploadID string, chunkCount int64) error {
+	ctx, span := otel.Tracer("oss").Start(ctx, "DeleteChunks")
+	defer span.End()
+
+	keys := make([]string, 0, chunkCount)
+	for i := int64(0); i < chunkCount; i++ {
+		keys = append(keys, chunkKey(uploadID, i))
+	}
+	if err := store.Delete(ctx, keys...); err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, "删除分片失败")
+		klog.Error("删除分片失败, err: ", err)
+		return err
+	}
+	return nil
+}
+
+// ListUploads 列出暂存了分片的上传任务ID
+func ListUploads(ctx context.Context) ([]string, error) {
+	keys, err := store.List(ctx, uploadPath)
+	if err != nil {
+		return nil, err
+	}
+
+	var uploadIDs []string
+	seen := make(map[string]bool)
+	for _, key := range keys {
+		uploadID, _, ok := strings.Cut(strings.TrimPrefix(key, uploadPath), "/")
+		if !ok || seen[uploadID] {
+			continue
+		}
+		seen[uploadID] = true
+		uploadIDs = append(uploadIDs, uploadID)
+	}
+	return uploadIDs, nil
+}
+
+// DeleteUpload 删除上传任务暂存的全部分片，用于清理分片数已无从得知的过期任务
+func DeleteUpload(ctx context.Context, uploadID string) error {
+	ctx, span := otel.Tracer("oss").Start(ctx, "DeleteUpload")
+	defer span.End()
+
+	keys, err := store.List(ctx, uploadPath+uploadID+"/")
+	if err == nil && len(keys) > 0 {
+		err = store.Delete(ctx, keys...)
+	}
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, "删除分片失败")
+		klog.Error("删除分片失败, err: ", err)
+		return err
+	}
+	return nil
+}
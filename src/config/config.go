@@ -2,6 +2,7 @@ package config
 
 import (
 	"reflect"
+	"time"
 
 	"github.com/cloudwego/kitex/pkg/klog"
 	"github.com/kitex-contrib/config-consul/consul"
@@ -36,6 +37,11 @@ type Config struct {
 	*ConsulConfig        `yaml:"consul"`
 	*KafkaConfig         `yaml:"kafka"`
 	*OpenTelemetryConfig `yaml:"open_telemetry"`
+	*FeedConfig          `yaml:"feed"`
+	*ModerationConfig    `yaml:"moderation"`
+	*TranscodeConfig     `yaml:"transcode"`
+	*VideoConfig         `yaml:"video"`
+	*MessageConfig       `yaml:"message"`
 }
 
 type SnowflakeConfig struct {
@@ -44,10 +50,15 @@ type SnowflakeConfig struct {
 }
 
 type OssConfig struct {
+	Backend         string `yaml:"backend"` // 存储后端: aliyun(默认)、s3、local
 	Endpoint        string `yaml:"endpoint"`
 	AccessKeyId     string `yaml:"access_key_id"`
 	AccessKeySecret string `yaml:"access_key_secret"`
 	BucketName      string `yaml:"bucket_name"`
+	Region          string `yaml:"region"`         // s3区域
+	UsePathStyle    bool   `yaml:"use_path_style"` // s3兼容存储(如MinIO)使用路径风格访问
+	LocalPath       string `yaml:"local_path"`     // 本地存储根目录
+	BaseURL         string `yaml:"base_url"`       // 对象公开访问地址前缀，不填时根据后端推导
 }
 
 type HertzConfig struct {
@@ -101,6 +112,7 @@ type ConsulConfig struct {
 	CommentAddr  string `yaml:"comment_addr"`
 	RelationAddr string `yaml:"relation_addr"`
 	MessageAddr  string `yaml:"message_addr"`
+	SearchAddr   string `yaml:"search_addr"`
 }
 
 type KafkaConfig struct {
@@ -115,10 +127,42 @@ type OpenTelemetryConfig struct {
 	CommentName  string `yaml:"comment_name"`
 	RelationName string `yaml:"relation_name"`
 	MessageName  string `yaml:"message_name"`
+	SearchName   string `yaml:"search_name"`
 	MetricAddr   string `yaml:"metric_addr"`
 	JaegerAddr   string `yaml:"jaeger_addr"`
 }
 
+type FeedConfig struct {
+	SeenTTL time.Duration `yaml:"seen_ttl"` // 已看视频记录的过期时间
+}
+
+type ModerationConfig struct {
+	RejectWords []string `yaml:"reject_words"` // 命中直接拒绝的敏感词
+	ReviewWords []string `yaml:"review_words"` // 命中后放行并送人工复审的敏感词
+	MaskWords   []string `yaml:"mask_words"`   // 命中后替换为*的敏感词
+}
+
+type TranscodeConfig struct {
+	SegmentTime int          `yaml:"segment_time"` // HLS分片时长，单位秒
+	Renditions  []*Rendition `yaml:"renditions"`   // 转码清晰度，不会超过原视频清晰度
+}
+
+type Rendition struct {
+	Name         string `yaml:"name"`
+	Height       int    `yaml:"height"`        // 短边像素数
+	VideoBitrate int    `yaml:"video_bitrate"` // 视频码率，单位kbps
+	AudioBitrate int    `yaml:"audio_bitrate"` // 音频码率，单位kbps
+}
+
+type VideoConfig struct {
+	DeleteGracePeriod time.Duration `yaml:"delete_grace_period"` // 视频删除后保留的时间，到期后彻底清理数据和文件
+}
+
+type MessageConfig struct {
+	RecallWindow time.Duration `yaml:"recall_window"` // 消息发送后允许撤回的时间
+	Stickers     []string      `yaml:"stickers"`      // 可发送的表情id
+}
+
 func Init() {
 	client, err := consul.NewClient(consul.Options{
 		Addr: consulEndpoint,
@@ -192,5 +236,30 @@ func Init() {
 			Conf.OpenTelemetryConfig = newConf.OpenTelemetryConfig
 			NoticeOpenTelemetry <- struct{}{}
 		}
+
+		// 使用时读取，无需通知
+		if !reflect.DeepEqual(Conf.FeedConfig, newConf.FeedConfig) {
+			Conf.FeedConfig = newConf.FeedConfig
+		}
+
+		// 使用时读取，无需通知
+		if !reflect.DeepEqual(Conf.ModerationConfig, newConf.ModerationConfig) {
+			Conf.ModerationConfig = newConf.ModerationConfig
+		}
+
+		// 使用时读取，无需通知
+		if !reflect.DeepEqual(Conf.TranscodeConfig, newConf.TranscodeConfig) {
+			Conf.TranscodeConfig = newConf.TranscodeConfig
+		}
+
+		// 使用时读取，无需通知
+		if !reflect.DeepEqual(Conf.VideoConfig, newConf.VideoConfig) {
+			Conf.VideoConfig = newConf.VideoConfig
+		}
+
+		// 使用时读取，无需通知
+		if !reflect.DeepEqual(Conf.MessageConfig, newConf.MessageConfig) {
+			Conf.MessageConfig = newConf.MessageConfig
+		}
 	})
 }
@@ -0,0 +1,238 @@
+package dal
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/allegro/bigcache/v3"
+	"github.com/cloudwego/kitex/pkg/klog"
+	"github.com/redis/go-redis/v9"
+	"github.com/vmihailenco/msgpack/v5"
+)
+
+// uniqueIDs 对ID列表去重，保持原有顺序
+func uniqueIDs(ids []int64) []int64 {
+	set := make(map[int64]struct{}, len(ids))
+	result := make([]int64, 0, len(ids))
+	for _, id := range ids {
+		if _, ok := set[id]; ok {
+			continue
+		}
+		set[id] = struct{}{}
+		result = append(result, id)
+	}
+	return result
+}
+
+// batchGetCount 批量获取计数: 依次查询本地缓存、redis，未命中的ID通过load批量查询数据库并写入redis缓存
+func batchGetCount(ctx context.Context, prefix string, ids []int64, load func(ids []int64) (map[int64]int64, error)) (map[int64]int64, error) {
+	ids = uniqueIDs(ids)
+	result := make(map[int64]int64, len(ids))
+
+	// 查询本地缓存
+	missIDs := make([]int64, 0, len(ids))
+	for _, id := range ids {
+		key := GetRedisKey(prefix, strconv.FormatInt(id, 10))
+		if val, err := Cache.Get(key); err == nil {
+			if cnt, err := strconv.ParseInt(string(val), 10, 64); err == nil {
+				result[id] = cnt
+				continue
+			}
+		} else if err != bigcache.ErrEntryNotFound {
+			klog.Error("Cache.Get failed, err: ", err)
+		}
+		missIDs = append(missIDs, id)
+	}
+	if len(missIDs) == 0 {
+		return result, nil
+	}
+
+	// 批量查询redis缓存
+	pipe := RDB.Pipeline()
+	cmds := make([]*redis.StringCmd, len(missIDs))
+	for i, id := range missIDs {
+		cmds[i] = pipe.Get(ctx, GetRedisKey(prefix, strconv.FormatInt(id, 10)))
+	}
+	if _, err := pipe.Exec(ctx); err != nil && err != redis.Nil {
+		return nil, err
+	}
+	dbIDs := make([]int64, 0, len(missIDs))
+	for i, id := range missIDs {
+		cnt, err := cmds[i].Int64()
+		if err != nil {
+			dbIDs = append(dbIDs, id)
+			continue
+		}
+		result[id] = cnt
+		// 写入本地缓存
+		if err := Cache.Set(cmds[i].Args()[1].(string), []byte(strconv.FormatInt(cnt, 10))); err != nil {
+			klog.Error("Cache.Set failed, err: ", err)
+		}
+	}
+	if len(dbIDs) == 0 {
+		return result, nil
+	}
+
+	// 缓存未命中，批量查询数据库
+	counts, err := load(dbIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	// 写入redis缓存，数据库中没有记录的计数为0
+	pipe = RDB.Pipeline()
+	for _, id := range dbIDs {
+		result[id] = counts[id]
+		pipe.Set(ctx, GetRedisKey(prefix, strconv.FormatInt(id, 10)), counts[id], ExpireTime+GetRandomTime())
+	}
+	if _, err := pipe.Exec(ctx); err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// batchGetObject 批量获取对象信息: 依次查询本地缓存、redis，未命中的ID通过load批量查询数据库并写入redis缓存
+func batchGetObject[T any](ctx context.Context, prefix string, ids []int64, load func(ids []int64) (map[int64]*T, error)) (map[int64]*T, error) {
+	ids = uniqueIDs(ids)
+	result := make(map[int64]*T, len(ids))
+
+	// 查询本地缓存
+	missIDs := make([]int64, 0, len(ids))
+	for _, id := range ids {
+		key := GetRedisKey(prefix, strconv.FormatInt(id, 10))
+		if val, err := Cache.Get(key); err == nil {
+			obj := new(T)
+			if err := msgpack.Unmarshal(val, obj); err == nil {
+				result[id] = obj
+				continue
+			}
+		} else if err != bigcache.ErrEntryNotFound {
+			klog.Error("Cache.Get failed, err: ", err)
+		}
+		missIDs = append(missIDs, id)
+	}
+	if len(missIDs) == 0 {
+		return result, nil
+	}
+
+	// 批量查询redis缓存
+	pipe := RDB.Pipeline()
+	cmds := make([]*redis.StringCmd, len(missIDs))
+	for i, id := range missIDs {
+		cmds[i] = pipe.Get(ctx, GetRedisKey(prefix, strconv.FormatInt(id, 10)))
+	}
+	if _, err := pipe.Exec(ctx); err != nil && err != redis.Nil {
+		return nil, err
+	}
+	dbIDs := make([]int64, 0, len(missIDs))
+	for i, id := range missIDs {
+		val, err := cmds[i].Bytes()
+		if err != nil {
+			dbIDs = append(dbIDs, id)
+			continue
+		}
+		obj := new(T)
+		if err := msgpack.Unmarshal(val, obj); err != nil {
+			dbIDs = append(dbIDs, id)
+			continue
+		}
+		result[id] = obj
+		// 写入本地缓存
+		if err := Cache.Set(cmds[i].Args()[1].(string), val); err != nil {
+			klog.Error("Cache.Set failed, err: ", err)
+		}
+	}
+	if len(dbIDs) == 0 {
+		return result, nil
+	}
+
+	// 缓存未命中，批量查询数据库
+	objs, err := load(dbIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	// 写入redis缓存
+	pipe = RDB.Pipeline()
+	for id, obj := range objs {
+		result[id] = obj
+		val, err := msgpack.Marshal(obj)
+		if err != nil {
+			return nil, err
+		}
+		pipe.Set(ctx, GetRedisKey(prefix, strconv.FormatInt(id, 10)), val, ExpireTime+GetRandomTime())
+	}
+	if _, err := pipe.Exec(ctx); err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// idCount 分组计数查询结果
+type idCount struct {
+	ID  int64
+	Cnt int64
+}
+
+func countMap(rows []idCount) map[int64]int64 {
+	counts := make(map[int64]int64, len(rows))
+	for _, row := range rows {
+		counts[row.ID] = row.Cnt
+	}
+	return counts
+}
+
+// batchCheckMember 批量判断ID是否在redis集合中，集合中不存在的ID通过load查询数据库，存在的写回集合
+func batchCheckMember(ctx context.Context, key string, ids []int64, load func(ids []int64) ([]int64, error)) (map[int64]bool, error) {
+	ids = uniqueIDs(ids)
+	result := make(map[int64]bool, len(ids))
+	if len(ids) == 0 {
+		return result, nil
+	}
+
+	// 查询redis缓存
+	members := make([]interface{}, len(ids))
+	for i, id := range ids {
+		members[i] = id
+	}
+	exists, err := RDB.SMIsMember(ctx, key, members...).Result()
+	if err != nil {
+		return nil, err
+	}
+	missIDs := make([]int64, 0, len(ids))
+	for i, id := range ids {
+		result[id] = exists[i]
+		if !exists[i] {
+			missIDs = append(missIDs, id)
+		}
+	}
+	if len(missIDs) == 0 {
+		return result, nil
+	}
+
+	// 缓存未命中，查询数据库中是否有记录
+	foundIDs, err := load(missIDs)
+	if err != nil {
+		return nil, err
+	}
+	if len(foundIDs) == 0 {
+		return result, nil
+	}
+
+	// 写入redis缓存
+	members = make([]interface{}, len(foundIDs))
+	for i, id := range foundIDs {
+		result[id] = true
+		members[i] = id
+	}
+	pipe := RDB.Pipeline()
+	pipe.SAdd(ctx, key, members...)
+	pipe.Expire(ctx, key, ExpireTime+GetRandomTime())
+	if _, err := pipe.Exec(ctx); err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
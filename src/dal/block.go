@@ -0,0 +1,150 @@
+package dal
+
+import (
+	"context"
+	"strconv"
+	"strings"
+)
+
+// CheckBlockExist 检查userID是否拉黑了authorID
+func CheckBlockExist(ctx context.Context, userID, authorID int64) (bool, error) {
+	var builder strings.Builder
+	builder.WriteString("match (v:user)-[:block]->(v2:user) where id(v) == ")
+	builder.WriteString(strconv.FormatInt(userID, 10))
+	builder.WriteString(" and id(v2) == ")
+	builder.WriteString(strconv.FormatInt(authorID, 10))
+	builder.WriteString(" return count(*)>0 as result")
+	resp, err := sessionPool.Execute(builder.String())
+	if err != nil {
+		return false, err
+	}
+
+	res, err := resp.GetValuesByColName("result")
+	if err != nil {
+		return false, err
+	}
+	exist, _ := res[0].AsBool()
+
+	return exist, nil
+}
+
+// CheckBlocked 检查userID与authorID之间是否存在拉黑关系，任意一方拉黑另一方都算
+func CheckBlocked(ctx context.Context, userID, authorID int64) (bool, error) {
+	blocked, err := BatchCheckBlocked(ctx, userID, []int64{authorID})
+	if err != nil {
+		return false, err
+	}
+	return blocked[authorID], nil
+}
+
+// BatchCheckBlocked 批量检查userID与authorIDs中的用户之间是否存在拉黑关系
+func BatchCheckBlocked(ctx context.Context, userID int64, authorIDs []int64) (map[int64]bool, error) {
+	authorIDs = uniqueIDs(authorIDs)
+	result := make(map[int64]bool, len(authorIDs))
+	if len(authorIDs) == 0 {
+		return result, nil
+	}
+
+	var builder strings.Builder
+	builder.WriteString("match (v:user)-[:block]-(v2:user) where id(v) == ")
+	builder.WriteString(strconv.FormatInt(userID, 10))
+	builder.WriteString(" and id(v2) in [")
+	builder.WriteString(joinIDs(authorIDs))
+	builder.WriteString("] return distinct id(v2) as blockList")
+	blockList, err := executeIDList(builder.String(), "blockList")
+	if err != nil {
+		return nil, err
+	}
+	for _, id := range blockList {
+		result[id] = true
+	}
+
+	return result, nil
+}
+
+// Block userID拉黑authorID，同时解除双方之间的关注关系并清理双方收件箱中对方的视频。
+// 拉黑边最后写入，中途失败时重试会重新执行全部清理
+func Block(ctx context.Context, userID, authorID int64) error {
+	// 添加用户点
+	var builder strings.Builder
+	builder.WriteString("insert vertex user() values ")
+	builder.WriteString(strconv.FormatInt(userID, 10))
+	builder.WriteString(":(), ")
+	builder.WriteString(strconv.FormatInt(authorID, 10))
+	builder.WriteString(":()")
+	_, err := sessionPool.Execute(builder.String())
+	if err != nil {
+		return err
+	}
+
+	// 删除双向的关注边
+	builder.Reset()
+	builder.WriteString("delete edge follow ")
+	builder.WriteString(strconv.FormatInt(userID, 10))
+	builder.WriteString("->")
+	builder.WriteString(strconv.FormatInt(authorID, 10))
+	builder.WriteString(", ")
+	builder.WriteString(strconv.FormatInt(authorID, 10))
+	builder.WriteString("->")
+	builder.WriteString(strconv.FormatInt(userID, 10))
+	_, err = sessionPool.Execute(builder.String())
+	if err != nil {
+		return err
+	}
+
+	// 删除双方的关注列表和关注数缓存，下次查询时重建
+	pipe := RDB.Pipeline()
+	for _, id := range []int64{userID, authorID} {
+		idStr := strconv.FormatInt(id, 10)
+		pipe.Del(ctx, GetRedisKey(KeyUserFollowPF, idStr))
+		pipe.Del(ctx, GetRedisKey(KeyUserFollowCountPF, idStr))
+		pipe.Del(ctx, GetRedisKey(KeyUserFollowerCountPF, idStr))
+	}
+	if _, err = pipe.Exec(ctx); err != nil {
+		return err
+	}
+
+	// 从双方的收件箱中移除对方的视频
+	if err = RemoveFromInbox(ctx, userID, authorID); err != nil {
+		return err
+	}
+	if err = RemoveFromInbox(ctx, authorID, userID); err != nil {
+		return err
+	}
+
+	// 添加拉黑边
+	builder.Reset()
+	builder.WriteString("insert edge block() values ")
+	builder.WriteString(strconv.FormatInt(userID, 10))
+	builder.WriteString("->")
+	builder.WriteString(strconv.FormatInt(authorID, 10))
+	builder.WriteString(":()")
+	_, err = sessionPool.Execute(builder.String())
+
+	return err
+}
+
+// UnBlock userID取消拉黑authorID，不恢复之前的关注关系
+func UnBlock(ctx context.Context, userID, authorID int64) error {
+	var builder strings.Builder
+	builder.WriteString("delete edge block ")
+	builder.WriteString(strconv.FormatInt(userID, 10))
+	builder.WriteString("->")
+	builder.WriteString(strconv.FormatInt(authorID, 10))
+	_, err := sessionPool.Execute(builder.String())
+
+	return err
+}
+
+// BlockList 按用户ID倒序分页查询黑名单，返回ID小于lastID的至多count个用户
+func BlockList(ctx context.Context, userID, lastID int64, count int) ([]int64, error) {
+	var builder strings.Builder
+	builder.WriteString("match (v:user)-[:block]->(v2:user) where id(v) == ")
+	builder.WriteString(strconv.FormatInt(userID, 10))
+	builder.WriteString(" and id(v2) < ")
+	builder.WriteString(strconv.FormatInt(lastID, 10))
+	builder.WriteString(" return id(v2) as blockList order by blockList desc limit ")
+	builder.WriteString(strconv.Itoa(count))
+
+	return executeIDList(builder.String(), "blockList")
+}
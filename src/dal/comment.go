@@ -7,6 +7,7 @@ import (
 
 	"douyin/src/common/snowflake"
 	"douyin/src/dal/model"
+	"douyin/src/dal/query"
 
 	"github.com/allegro/bigcache/v3"
 	"github.com/cloudwego/kitex/pkg/klog"
@@ -19,9 +20,36 @@ func CreateComment(ctx context.Context, comment *model.Comment) error {
 	return qComment.WithContext(ctx).Create(comment)
 }
 
-func DeleteComment(ctx context.Context, commentID int64) (err error) {
-	_, err = qComment.WithContext(ctx).Where(qComment.ID.Eq(commentID)).Delete()
-	return
+// DeleteComment 删除评论及其点赞，删除根评论时同时删除其下的全部回复，返回评论所属的视频和删除的评论数
+func DeleteComment(ctx context.Context, commentID int64) (videoID int64, count int, err error) {
+	err = q.Transaction(func(tx *query.Query) error {
+		comments, err := tx.Comment.WithContext(ctx).Where(tx.Comment.ID.Eq(commentID)).Or(tx.Comment.RootID.Eq(commentID)).
+			Select(tx.Comment.ID, tx.Comment.VideoID).Find()
+		if err != nil {
+			return err
+		}
+		if len(comments) == 0 {
+			return nil
+		}
+
+		commentIDs := make([]int64, len(comments))
+		for i, c := range comments {
+			commentIDs[i] = c.ID
+		}
+		if _, err := tx.Comment.WithContext(ctx).Where(tx.Comment.ID.In(commentIDs...)).Delete(); err != nil {
+			return err
+		}
+		if _, err := tx.CommentFavorite.WithContext(ctx).Where(tx.CommentFavorite.CommentID.In(commentIDs...)).Delete(); err != nil {
+			return err
+		}
+
+		videoID, count = comments[0].VideoID, len(comments)
+		return nil
+	})
+	if err != nil {
+		return 0, 0, err
+	}
+	return videoID, count, nil
 }
 
 func GetCommentByID(ctx context.Context, commentID int64) (*model.Comment, error) {
@@ -36,15 +64,25 @@ func GetCommentByID(ctx context.Context, commentID int64) (*model.Comment, error
 	return comment, nil
 }
 
-func GetCommentList(ctx context.Context, videoID int64) ([]*model.Comment, error) {
-	commentList, err := qComment.WithContext(ctx).Where(qComment.VideoID.Eq(videoID)).Limit(30).Find()
+// GetCommentList 按评论ID倒序分页查询视频的一级评论，返回ID小于lastID的至多count条评论
+func GetCommentList(ctx context.Context, videoID, lastID int64, count int) ([]*model.Comment, error) {
+	commentList, err := qComment.WithContext(ctx).
+		Where(qComment.VideoID.Eq(videoID), qComment.RootID.Eq(0), qComment.ID.Lt(lastID)).
+		Order(qComment.ID.Desc()).Limit(count).Find()
 	if err != nil {
 		return nil, err
 	}
 	return commentList, nil
 }
 
-// GetVideoFavoriteCount 获取视频点赞数
+// GetCommentReplyList 按评论ID倒序分页查询根评论下的回复，返回ID小于lastID的至多count条回复
+func GetCommentReplyList(ctx context.Context, rootID, lastID int64, count int) ([]*model.Comment, error) {
+	return qComment.WithContext(ctx).
+		Where(qComment.RootID.Eq(rootID), qComment.ID.Lt(lastID)).
+		Order(qComment.ID.Desc()).Limit(count).Find()
+}
+
+// GetVideoCommentCount 获取视频评论数
 func GetVideoCommentCount(ctx context.Context, videoID int64) (count int64, err error) {
 	key := GetRedisKey(KeyVideoCommentCountPF, strconv.FormatInt(videoID, 10))
 	// 查询本地缓存
@@ -83,3 +121,29 @@ func GetVideoCommentCount(ctx context.Context, videoID int64) (count int64, err
 
 	return
 }
+
+// BatchGetVideoCommentCount 批量获取视频评论数
+func BatchGetVideoCommentCount(ctx context.Context, videoIDs []int64) (map[int64]int64, error) {
+	return batchGetCount(ctx, KeyVideoCommentCountPF, videoIDs, func(ids []int64) (map[int64]int64, error) {
+		var rows []idCount
+		err := qComment.WithContext(ctx).Select(qComment.VideoID.As("id"), qComment.ID.Count().As("cnt")).
+			Where(qComment.VideoID.In(ids...)).Group(qComment.VideoID).Scan(&rows)
+		if err != nil {
+			return nil, err
+		}
+		return countMap(rows), nil
+	})
+}
+
+// BatchGetCommentReplyCount 批量获取根评论的回复数
+func BatchGetCommentReplyCount(ctx context.Context, commentIDs []int64) (map[int64]int64, error) {
+	return batchGetCount(ctx, KeyCommentReplyCountPF, commentIDs, func(ids []int64) (map[int64]int64, error) {
+		var rows []idCount
+		err := qComment.WithContext(ctx).Select(qComment.RootID.As("id"), qComment.ID.Count().As("cnt")).
+			Where(qComment.RootID.In(ids...)).Group(qComment.RootID).Scan(&rows)
+		if err != nil {
+			return nil, err
+		}
+		return countMap(rows), nil
+	})
+}
@@ -0,0 +1,63 @@
+package dal
+
+import (
+	"context"
+	"strconv"
+
+	"douyin/src/common/snowflake"
+	"douyin/src/dal/model"
+
+	"gorm.io/gorm/clause"
+)
+
+// CheckCommentFavoriteExist 查询用户是否点赞了评论
+func CheckCommentFavoriteExist(ctx context.Context, userID, commentID int64) (bool, error) {
+	exists, err := BatchCheckCommentFavoriteExist(ctx, userID, []int64{commentID})
+	if err != nil {
+		return false, err
+	}
+	return exists[commentID], nil
+}
+
+// BatchCheckCommentFavoriteExist 批量查询用户是否点赞了评论
+func BatchCheckCommentFavoriteExist(ctx context.Context, userID int64, commentIDs []int64) (map[int64]bool, error) {
+	key := GetRedisKey(KeyUserCommentFavoritePF, strconv.FormatInt(userID, 10))
+	return batchCheckMember(ctx, key, commentIDs, func(ids []int64) ([]int64, error) {
+		var favoriteIDs []int64
+		err := qCommentFavorite.WithContext(ctx).
+			Where(qCommentFavorite.UserID.Eq(userID), qCommentFavorite.CommentID.In(ids...)).
+			Select(qCommentFavorite.CommentID).Scan(&favoriteIDs)
+		return favoriteIDs, err
+	})
+}
+
+// BatchGetCommentFavoriteCount 批量获取评论点赞数
+func BatchGetCommentFavoriteCount(ctx context.Context, commentIDs []int64) (map[int64]int64, error) {
+	return batchGetCount(ctx, KeyCommentFavoriteCountPF, commentIDs, func(ids []int64) (map[int64]int64, error) {
+		var rows []idCount
+		err := qCommentFavorite.WithContext(ctx).
+			Select(qCommentFavorite.CommentID.As("id"), qCommentFavorite.ID.Count().As("cnt")).
+			Where(qCommentFavorite.CommentID.In(ids...)).Group(qCommentFavorite.CommentID).Scan(&rows)
+		if err != nil {
+			return nil, err
+		}
+		return countMap(rows), nil
+	})
+}
+
+// CreateCommentFavorite 保存评论点赞，返回是否新写入，重复点赞时忽略
+func CreateCommentFavorite(ctx context.Context, userID, commentID int64) (bool, error) {
+	favorite := &model.CommentFavorite{ID: snowflake.GenerateID(), UserID: userID, CommentID: commentID}
+	result := db.WithContext(ctx).Clauses(clause.OnConflict{DoNothing: true}).Create(favorite)
+	return result.RowsAffected > 0, result.Error
+}
+
+// DeleteCommentFavorite 删除评论点赞，返回是否删除了记录
+func DeleteCommentFavorite(ctx context.Context, userID, commentID int64) (bool, error) {
+	info, err := qCommentFavorite.WithContext(ctx).
+		Where(qCommentFavorite.UserID.Eq(userID), qCommentFavorite.CommentID.Eq(commentID)).Delete()
+	if err != nil {
+		return false, err
+	}
+	return info.RowsAffected > 0, nil
+}
@@ -0,0 +1,151 @@
+package dal
+
+import (
+	"context"
+	"strconv"
+
+	"douyin/src/dal/model"
+
+	"github.com/redis/go-redis/v9"
+)
+
+// 互动行为对评论热度的贡献
+const (
+	CommentHeatFavorite float64 = 1
+	CommentHeatReply    float64 = 2
+)
+
+const commentHotLoadSize = 1000 // 重建缓存时按点赞数和回复数各加载的最大评论数
+
+func commentHotKey(videoID int64) string {
+	return GetRedisKey(KeyCommentHotPF, strconv.FormatInt(videoID, 10))
+}
+
+// IncrCommentHeat 在pipe中更新一级评论的互动热度，缓存不存在时跳过，下次查询时重建
+func IncrCommentHeat(ctx context.Context, pipe redis.Pipeliner, videoID, commentID int64, delta float64) {
+	ZIncrByScript.Run(ctx, pipe, []string{commentHotKey(videoID)}, delta, commentID)
+}
+
+// RemoveCommentHeat 在pipe中删除一级评论的互动热度
+func RemoveCommentHeat(ctx context.Context, pipe redis.Pipeliner, videoID, commentID int64) {
+	pipe.ZRem(ctx, commentHotKey(videoID), commentID)
+}
+
+// GetHotCommentCandidates 获取计算热度排序的候选一级评论: 互动热度最高的至多count条和最新的至多count条
+func GetHotCommentCandidates(ctx context.Context, videoID int64, count int) ([]*model.Comment, error) {
+	hotIDs, err := getHotCommentIDs(ctx, videoID, count)
+	if err != nil {
+		return nil, err
+	}
+
+	commentList, err := qComment.WithContext(ctx).
+		Where(qComment.VideoID.Eq(videoID), qComment.RootID.Eq(0)).
+		Order(qComment.ID.Desc()).Limit(count).Find()
+	if err != nil {
+		return nil, err
+	}
+
+	// 补充不在最新评论中的热门评论
+	loaded := make(map[int64]struct{}, len(commentList))
+	for _, c := range commentList {
+		loaded[c.ID] = struct{}{}
+	}
+	missIDs := make([]int64, 0, len(hotIDs))
+	for _, id := range hotIDs {
+		if _, ok := loaded[id]; !ok {
+			missIDs = append(missIDs, id)
+		}
+	}
+	if len(missIDs) == 0 {
+		return commentList, nil
+	}
+	hotList, err := qComment.WithContext(ctx).
+		Where(qComment.ID.In(missIDs...), qComment.VideoID.Eq(videoID), qComment.RootID.Eq(0)).Find()
+	if err != nil {
+		return nil, err
+	}
+
+	return append(commentList, hotList...), nil
+}
+
+// getHotCommentIDs 获取互动热度最高的至多count条一级评论，缓存不存在时从数据库重建
+func getHotCommentIDs(ctx context.Context, videoID int64, count int) ([]int64, error) {
+	key := commentHotKey(videoID)
+	exist, err := RDB.Exists(ctx, key).Result()
+	if err != nil {
+		return nil, err
+	}
+	if exist == 0 {
+		// 使用singleflight避免并发重建
+		_, err, _ = G.Do(key, func() (interface{}, error) {
+			return nil, buildCommentHot(ctx, key, videoID)
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	members, err := RDB.ZRevRangeByScore(ctx, key, &redis.ZRangeBy{
+		Min:   "(0",
+		Max:   "+inf",
+		Count: int64(count),
+	}).Result()
+	if err != nil {
+		return nil, err
+	}
+
+	commentIDs := make([]int64, 0, len(members))
+	for _, member := range members {
+		commentID, err := strconv.ParseInt(member, 10, 64)
+		if err != nil {
+			return nil, err
+		}
+		commentIDs = append(commentIDs, commentID)
+	}
+	return commentIDs, nil
+}
+
+// buildCommentHot 统计视频一级评论的点赞数和回复数，重建互动热度缓存
+func buildCommentHot(ctx context.Context, key string, videoID int64) error {
+	var favoriteRows []idCount
+	err := qCommentFavorite.WithContext(ctx).
+		Select(qCommentFavorite.CommentID.As("id"), qCommentFavorite.ID.Count().As("cnt")).
+		Join(qComment, qComment.ID.EqCol(qCommentFavorite.CommentID)).
+		Where(qComment.VideoID.Eq(videoID), qComment.RootID.Eq(0)).
+		Group(qCommentFavorite.CommentID).Order(qCommentFavorite.ID.Count().Desc()).
+		Limit(commentHotLoadSize).Scan(&favoriteRows)
+	if err != nil {
+		return err
+	}
+
+	var replyRows []idCount
+	err = qComment.WithContext(ctx).
+		Select(qComment.RootID.As("id"), qComment.ID.Count().As("cnt")).
+		Where(qComment.VideoID.Eq(videoID), qComment.RootID.Neq(0)).
+		Group(qComment.RootID).Order(qComment.ID.Count().Desc()).
+		Limit(commentHotLoadSize).Scan(&replyRows)
+	if err != nil {
+		return err
+	}
+
+	heat := make(map[int64]float64, len(favoriteRows)+len(replyRows))
+	for _, row := range favoriteRows {
+		heat[row.ID] += CommentHeatFavorite * float64(row.Cnt)
+	}
+	for _, row := range replyRows {
+		heat[row.ID] += CommentHeatReply * float64(row.Cnt)
+	}
+	if len(heat) == 0 {
+		return nil
+	}
+
+	members := make([]redis.Z, 0, len(heat))
+	for commentID, score := range heat {
+		members = append(members, redis.Z{Score: score, Member: commentID})
+	}
+	pipe := RDB.Pipeline()
+	pipe.ZAdd(ctx, key, members...)
+	pipe.Expire(ctx, key, ExpireTime+GetRandomTime())
+	_, err = pipe.Exec(ctx)
+	return err
+}
@@ -0,0 +1,227 @@
+package dal
+
+import (
+	"context"
+	"strconv"
+
+	"douyin/src/dal/model"
+
+	"github.com/redis/go-redis/v9"
+	"github.com/vmihailenco/msgpack/v5"
+)
+
+// Conversation 会话摘要
+type Conversation struct {
+	ConvertID   string
+	LastTime    int64 // 会话列表中的排序值，即最后一条消息的时间
+	LastMessage *model.Message
+	UnreadCount int64
+}
+
+// UpdateConversation 收到新消息后更新发送者和接收者的会话列表、会话的最后一条消息和接收者的未读数，群聊的接收者为除发送者外的全部成员
+func UpdateConversation(ctx context.Context, message *model.Message, receiverIDs []int64) error {
+	val, err := msgpack.Marshal(message)
+	if err != nil {
+		return err
+	}
+
+	z := redis.Z{Score: float64(message.CreateTime), Member: message.ConvertID}
+	keyLast := GetRedisKey(KeyConversationLastPF, message.ConvertID)
+	pipe := RDB.Pipeline()
+	pipe.ZAddGT(ctx, GetRedisKey(KeyUserConversationPF, strconv.FormatInt(message.FromUserID, 10)), z)
+	for _, receiverID := range receiverIDs {
+		uid := strconv.FormatInt(receiverID, 10)
+		pipe.ZAddGT(ctx, GetRedisKey(KeyUserConversationPF, uid), z)
+		// 写入前接收者已读到该消息之后时不计入未读
+		if message.Status < MessageStatusRead {
+			pipe.HIncrBy(ctx, GetRedisKey(KeyUserUnreadPF, uid), message.ConvertID, 1)
+		}
+	}
+	if _, err := pipe.Exec(ctx); err != nil {
+		return err
+	}
+
+	// 消费顺序可能与发送顺序不同，只保留时间最新的消息
+	last, err := getLastMessage(ctx, keyLast)
+	if err != nil {
+		return err
+	}
+	if last == nil || last.CreateTime <= message.CreateTime {
+		return RDB.Set(ctx, keyLast, val, 0).Err()
+	}
+	return nil
+}
+
+// RefreshConversationLast 消息状态变化(如撤回)后，如果是会话的最后一条消息则同步更新
+func RefreshConversationLast(ctx context.Context, message *model.Message) error {
+	keyLast := GetRedisKey(KeyConversationLastPF, message.ConvertID)
+	last, err := getLastMessage(ctx, keyLast)
+	if err != nil {
+		return err
+	}
+	if last == nil || last.ID != message.ID {
+		return nil
+	}
+	val, err := msgpack.Marshal(message)
+	if err != nil {
+		return err
+	}
+	return RDB.Set(ctx, keyLast, val, 0).Err()
+}
+
+// GetConversationList 按最后一条消息的时间倒序分页查询用户的会话，时间相同时按会话ID倒序，
+// 返回排在(lastTime, lastConvertID)之后的至多count个会话，lastConvertID为空时返回时间早于lastTime的会话
+func GetConversationList(ctx context.Context, userID, lastTime int64, lastConvertID string, count int) ([]*Conversation, error) {
+	uid := strconv.FormatInt(userID, 10)
+	key := GetRedisKey(KeyUserConversationPF, uid)
+	maxScore := strconv.FormatInt(lastTime, 10)
+	var zs []redis.Z
+	if lastConvertID != "" {
+		// 与上一页最后一个会话时间相同的会话，redis按成员倒序返回
+		ties, err := RDB.ZRevRangeByScoreWithScores(ctx, key, &redis.ZRangeBy{Min: maxScore, Max: maxScore}).Result()
+		if err != nil {
+			return nil, err
+		}
+		for _, z := range ties {
+			if member, _ := z.Member.(string); member < lastConvertID {
+				zs = append(zs, z)
+			}
+		}
+	}
+	if len(zs) < count {
+		older, err := RDB.ZRevRangeByScoreWithScores(ctx, key, &redis.ZRangeBy{
+			Min:   "-inf",
+			Max:   "(" + maxScore,
+			Count: int64(count - len(zs)),
+		}).Result()
+		if err != nil {
+			return nil, err
+		}
+		zs = append(zs, older...)
+	}
+	zs = zs[:min(len(zs), count)]
+	if len(zs) == 0 {
+		return []*Conversation{}, nil
+	}
+	convertIDs := make([]string, len(zs))
+	for i, z := range zs {
+		convertIDs[i], _ = z.Member.(string)
+	}
+
+	// 批量查询最后一条消息和未读数
+	pipe := RDB.Pipeline()
+	lastCmds := make([]*redis.StringCmd, len(convertIDs))
+	for i, convertID := range convertIDs {
+		lastCmds[i] = pipe.Get(ctx, GetRedisKey(KeyConversationLastPF, convertID))
+	}
+	unreadCmd := pipe.HMGet(ctx, GetRedisKey(KeyUserUnreadPF, uid), convertIDs...)
+	if _, err := pipe.Exec(ctx); err != nil && err != redis.Nil {
+		return nil, err
+	}
+
+	unread := unreadCmd.Val()
+	conversations := make([]*Conversation, 0, len(convertIDs))
+	for i, convertID := range convertIDs {
+		val, err := lastCmds[i].Bytes()
+		if err != nil {
+			continue
+		}
+		last := &model.Message{}
+		if err := msgpack.Unmarshal(val, last); err != nil {
+			return nil, err
+		}
+		conversation := &Conversation{ConvertID: convertID, LastTime: int64(zs[i].Score), LastMessage: last}
+		if s, ok := unread[i].(string); ok {
+			conversation.UnreadCount, _ = strconv.ParseInt(s, 10, 64)
+		}
+		conversations = append(conversations, conversation)
+	}
+	return conversations, nil
+}
+
+// MarkRead 将用户在会话中的已读位置更新到readTime，已读到最后一条消息时清空未读数，否则重新统计已读位置之后的未读数
+func MarkRead(ctx context.Context, userID int64, convertID string, readTime int64) error {
+	uid := strconv.FormatInt(userID, 10)
+	keyCursor := GetRedisKey(KeyUserReadCursorPF, uid)
+	pipe := RDB.Pipeline()
+	currentCmd := pipe.HGet(ctx, keyCursor, convertID)
+	lastCmd := pipe.ZScore(ctx, GetRedisKey(KeyUserConversationPF, uid), convertID)
+	if _, err := pipe.Exec(ctx); err != nil && err != redis.Nil {
+		return err
+	}
+	current, err := currentCmd.Int64()
+	if err != nil && err != redis.Nil {
+		return err
+	}
+
+	// 已读位置只前进不后退
+	pipe = RDB.Pipeline()
+	if readTime > current {
+		pipe.HSet(ctx, keyCursor, convertID, readTime)
+	} else {
+		readTime = current
+	}
+	keyUnread := GetRedisKey(KeyUserUnreadPF, uid)
+	if readTime >= int64(lastCmd.Val()) {
+		pipe.HDel(ctx, keyUnread, convertID)
+	} else {
+		unread, err := countUnread(ctx, userID, convertID, readTime)
+		if err != nil {
+			return err
+		}
+		pipe.HSet(ctx, keyUnread, convertID, unread)
+	}
+	_, err = pipe.Exec(ctx)
+	return err
+}
+
+// RemoveConversation 从用户的会话列表中移除会话，用于退出群聊
+func RemoveConversation(ctx context.Context, userID int64, convertID string) error {
+	uid := strconv.FormatInt(userID, 10)
+	pipe := RDB.Pipeline()
+	pipe.ZRem(ctx, GetRedisKey(KeyUserConversationPF, uid), convertID)
+	pipe.HDel(ctx, GetRedisKey(KeyUserUnreadPF, uid), convertID)
+	pipe.HDel(ctx, GetRedisKey(KeyUserReadCursorPF, uid), convertID)
+	pipe.ZRem(ctx, GetRedisKey(KeyUserDeliverCursorPF, uid), convertID)
+	_, err := pipe.Exec(ctx)
+	return err
+}
+
+// GetReadCursor 获取用户在会话中的已读位置，即已读到的消息发送时间
+func GetReadCursor(ctx context.Context, userID int64, convertID string) (int64, error) {
+	readTime, err := RDB.HGet(ctx, GetRedisKey(KeyUserReadCursorPF, strconv.FormatInt(userID, 10)), convertID).Int64()
+	if err == redis.Nil {
+		return 0, nil
+	}
+	return readTime, err
+}
+
+// SetDeliverCursor 将用户在会话中的送达位置推进到deliverTime，只前进不后退
+func SetDeliverCursor(ctx context.Context, userID int64, convertID string, deliverTime int64) error {
+	key := GetRedisKey(KeyUserDeliverCursorPF, strconv.FormatInt(userID, 10))
+	return RDB.ZAddGT(ctx, key, redis.Z{Score: float64(deliverTime), Member: convertID}).Err()
+}
+
+// GetDeliverCursor 获取用户在会话中的送达位置，即已推送给用户的消息发送时间
+func GetDeliverCursor(ctx context.Context, userID int64, convertID string) (int64, error) {
+	deliverTime, err := RDB.ZScore(ctx, GetRedisKey(KeyUserDeliverCursorPF, strconv.FormatInt(userID, 10)), convertID).Result()
+	if err == redis.Nil {
+		return 0, nil
+	}
+	return int64(deliverTime), err
+}
+
+func getLastMessage(ctx context.Context, key string) (*model.Message, error) {
+	val, err := RDB.Get(ctx, key).Bytes()
+	if err == redis.Nil {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	last := &model.Message{}
+	if err := msgpack.Unmarshal(val, last); err != nil {
+		return nil, err
+	}
+	return last, nil
+}
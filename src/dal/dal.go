@@ -35,36 +35,64 @@ const (
 )
 
 var (
-	ErrUserExist       = errors.New("用户已存在")
-	ErrUserNotExist    = errors.New("用户不存在")
-	ErrPassword        = errors.New("密码错误")
-	ErrAlreadyFollow   = errors.New("已经关注过了")
-	ErrNotFollow       = errors.New("还没有关注过")
-	ErrFollowLimit     = errors.New("关注数超过限制")
-	ErrAlreadyFavorite = errors.New("已经点赞过了")
-	ErrNotFavorite     = errors.New("还没有点赞过")
-	ErrCommentNotExist = errors.New("comment not exist")
-	ErrVideoNotExist   = errors.New("video not exist")
+	ErrUserExist        = errors.New("用户已存在")
+	ErrUserNotExist     = errors.New("用户不存在")
+	ErrPassword         = errors.New("密码错误")
+	ErrAlreadyFollow    = errors.New("已经关注过了")
+	ErrNotFollow        = errors.New("还没有关注过")
+	ErrFollowLimit      = errors.New("关注数超过限制")
+	ErrAlreadyFavorite  = errors.New("已经点赞过了")
+	ErrNotFavorite      = errors.New("还没有点赞过")
+	ErrCommentNotExist  = errors.New("comment not exist")
+	ErrVideoNotExist    = errors.New("video not exist")
+	ErrContentRejected  = errors.New("内容包含违规信息")
+	ErrUploadNotExist   = errors.New("上传任务不存在")
+	ErrUploadIncomplete = errors.New("分片未全部上传")
+	ErrChecksumMismatch = errors.New("文件校验失败")
+	ErrVideoStatus      = errors.New("视频当前状态不允许该操作")
+	ErrTagNotExist      = errors.New("话题不存在")
+	ErrMessageNotExist  = errors.New("消息不存在")
+	ErrRecallExpired    = errors.New("超过撤回时限")
+	ErrInvalidMessage   = errors.New("消息内容不合法")
+	ErrGroupNotExist    = errors.New("群聊不存在")
+	ErrNotGroupMember   = errors.New("不是群聊成员")
+	ErrAlreadyInGroup   = errors.New("已经是群聊成员")
+	ErrGroupFull        = errors.New("群聊成员数超过限制")
+	ErrGroupPermission  = errors.New("没有群聊操作权限")
+	ErrGroupBanned      = errors.New("已被移出群聊")
+	ErrGroupNotJoinable = errors.New("群聊不允许自由加入")
+	ErrAlreadyBlock     = errors.New("已经拉黑过了")
+	ErrNotBlock         = errors.New("还没有拉黑过")
+	ErrBlocked          = errors.New("存在拉黑关系")
+	ErrFriendOnly       = errors.New("对方仅接收好友私信")
 )
 
 var (
-	db           *gorm.DB
-	RDB          *redis.ClusterClient
-	IncrByScript *redis.Script
-	sessionPool  *nebula.SessionPool
-	Cache        *bigcache.BigCache
-	G            = &singleflight.Group{}
-	bloomFilter  *bloom.BloomFilter
-	err          error
+	db            *gorm.DB
+	RDB           *redis.ClusterClient
+	IncrByScript  *redis.Script
+	ZIncrByScript *redis.Script
+	sessionPool   *nebula.SessionPool
+	Cache         *bigcache.BigCache
+	G             = &singleflight.Group{}
+	bloomFilter   *bloom.BloomFilter
+	err           error
 )
 
 var (
-	q          = new(query.Query)
-	qComment   = q.Comment
-	qFavorite  = q.Favorite
-	qUser      = q.User
-	qUserLogin = q.UserLogin
-	qVideo     = q.Video
+	q                = new(query.Query)
+	qChatGroup       = q.ChatGroup
+	qComment         = q.Comment
+	qCommentFavorite = q.CommentFavorite
+	qFavorite        = q.Favorite
+	qGroupMember     = q.GroupMember
+	qMessageDeletion = q.MessageDeletion
+	qModeration      = q.ModerationRecord
+	qTag             = q.Tag
+	qUser            = q.User
+	qUserLogin       = q.UserLogin
+	qVideo           = q.Video
+	qVideoTag        = q.VideoTag
 )
 
 func Init() {
@@ -156,11 +184,18 @@ func InitPostgreSQL() {
 	}
 
 	q = query.Use(db)
+	qChatGroup = q.ChatGroup
 	qComment = q.Comment
+	qCommentFavorite = q.CommentFavorite
 	qFavorite = q.Favorite
+	qGroupMember = q.GroupMember
+	qMessageDeletion = q.MessageDeletion
+	qModeration = q.ModerationRecord
+	qTag = q.Tag
 	qUser = q.User
 	qUserLogin = q.UserLogin
 	qVideo = q.Video
+	qVideoTag = q.VideoTag
 
 	generateMessageTables()
 }
@@ -188,6 +223,17 @@ func InitRedis() {
         return nil
     end
 	`)
+
+	// 减少热度时成员不存在则跳过，避免已删除的评论被重新加入
+	ZIncrByScript = redis.NewScript(`
+	if redis.call('EXISTS', KEYS[1]) == 0 then
+        return 0
+    end
+    if tonumber(ARGV[1]) < 0 and not redis.call('ZSCORE', KEYS[1], ARGV[2]) then
+        return 0
+    end
+    return redis.call('ZINCRBY', KEYS[1], ARGV[1], ARGV[2])
+	`)
 }
 
 func InitNebula() {
@@ -323,12 +369,37 @@ func generateMessageTables() {
 				 convert_id varchar NOT NULL DEFAULT '',
 				 content varchar NOT NULL DEFAULT '',
 				 create_time BIGINT NOT NULL DEFAULT 0,
+				 status SMALLINT NOT NULL DEFAULT 0,
+				 from_deleted BOOLEAN NOT NULL DEFAULT FALSE,
+				 to_deleted BOOLEAN NOT NULL DEFAULT FALSE,
+				 msg_type SMALLINT NOT NULL DEFAULT 0,
+				 group_id BIGINT NOT NULL DEFAULT 0,
+				 payload varchar NOT NULL DEFAULT ''
 			 );`, table)
 
 		if err := db.Exec(createTableSQL).Error; err != nil {
 			panic(err)
 		}
 
+		// 已存在的分表不会执行上面的建表语句，需要补充后续新增的列
+		addColumns := []string{
+			"status SMALLINT NOT NULL DEFAULT 0",
+			"from_deleted BOOLEAN NOT NULL DEFAULT FALSE",
+			"to_deleted BOOLEAN NOT NULL DEFAULT FALSE",
+			"msg_type SMALLINT NOT NULL DEFAULT 0",
+			"payload varchar NOT NULL DEFAULT ''",
+			"group_id BIGINT NOT NULL DEFAULT 0",
+		}
+
+		for _, col := range addColumns {
+			addColumnSQL := fmt.Sprintf(`
+				 ALTER TABLE %s ADD COLUMN IF NOT EXISTS %s;`, table, col)
+
+			if err := db.Exec(addColumnSQL).Error; err != nil {
+				panic(err)
+			}
+		}
+
 		// 创建索引
 		createIndexSQL := fmt.Sprintf(`
 			 CREATE INDEX IF NOT EXISTS idx_convertId_createTime_%s
@@ -348,6 +419,12 @@ func generateMessageTables() {
 			{"convert_id", "会话ID"},
 			{"content", "消息内容"},
 			{"create_time", "创建时间"},
+			{"status", "状态: 0-已发送, 1-已送达, 2-已读, 3-已撤回"},
+			{"from_deleted", "发送者是否已删除"},
+			{"to_deleted", "接收者是否已删除"},
+			{"msg_type", "消息类型: 0-文本, 1-图片, 2-视频分享, 3-名片, 4-表情"},
+			{"group_id", "群聊ID，单聊为0"},
+			{"payload", "非文本消息的结构化内容，JSON格式"},
 		}
 
 		for _, col := range commentColumns {
@@ -50,48 +50,12 @@ func BatchDeleteFavorite(ctx context.Context, userIDs []int64) error {
 	return err
 }
 
-func GetFavoriteList(ctx context.Context, userID int64) (videoIDs []int64, err error) {
-	// 使用singleflight防止缓存击穿并减少redis压力
-	key := GetRedisKey(KeyUserFavoritePF, strconv.FormatInt(userID, 10))
-	_, err, _ = G.Do(key, func() (interface{}, error) {
-		go func() {
-			time.Sleep(DelayTime)
-			G.Forget(key)
-		}()
-
-		// 先查询redis缓存
-		videoIDStrs, err := RDB.SMembers(ctx, key).Result()
-		if err == redis.Nil {
-			// 缓存未命中，查询mysql
-			if err := qFavorite.WithContext(ctx).Where(qFavorite.UserID.Eq(userID)).Select(qFavorite.VideoID).Scan(&videoIDs); err != nil {
-				return nil, err
-			}
-
-			// 写入redis缓存
-			if len(videoIDs) > 0 {
-				RDB.SAdd(ctx, key, videoIDs)
-				RDB.Expire(ctx, key, ExpireTime+GetRandomTime())
-			}
-
-			return nil, err
-		} else if err != nil {
-			return nil, err
-		} else {
-			// 缓存命中，转换为int64
-			videoIDs = make([]int64, len(videoIDStrs))
-			for i, videoIDStr := range videoIDStrs {
-				videoID, err := strconv.ParseInt(videoIDStr, 10, 64)
-				if err != nil {
-					return nil, err
-				}
-				videoIDs[i] = videoID
-			}
-
-			return nil, nil
-		}
-	})
-
-	return
+// GetFavoriteList 按点赞记录ID倒序分页查询用户点赞，返回ID小于lastID的至多count条记录
+func GetFavoriteList(ctx context.Context, userID, lastID int64, count int) ([]*model.Favorite, error) {
+	return qFavorite.WithContext(ctx).
+		Where(qFavorite.UserID.Eq(userID), qFavorite.ID.Lt(lastID)).
+		Select(qFavorite.ID, qFavorite.VideoID).
+		Order(qFavorite.ID.Desc()).Limit(count).Find()
 }
 
 // GetUserFavoriteCount 获取用户点赞数
@@ -172,3 +136,40 @@ func GetVideoFavoriteCount(ctx context.Context, videoID int64) (cnt int64, err e
 
 	return
 }
+
+// BatchGetVideoFavoriteCount 批量获取视频点赞数
+func BatchGetVideoFavoriteCount(ctx context.Context, videoIDs []int64) (map[int64]int64, error) {
+	return batchGetCount(ctx, KeyVideoFavoriteCountPF, videoIDs, func(ids []int64) (map[int64]int64, error) {
+		var rows []idCount
+		err := qFavorite.WithContext(ctx).Select(qFavorite.VideoID.As("id"), qFavorite.ID.Count().As("cnt")).
+			Where(qFavorite.VideoID.In(ids...)).Group(qFavorite.VideoID).Scan(&rows)
+		if err != nil {
+			return nil, err
+		}
+		return countMap(rows), nil
+	})
+}
+
+// BatchCheckFavoriteExist 批量查询用户是否点赞了视频
+func BatchCheckFavoriteExist(ctx context.Context, userID int64, videoIDs []int64) (map[int64]bool, error) {
+	key := GetRedisKey(KeyUserFavoritePF, strconv.FormatInt(userID, 10))
+	return batchCheckMember(ctx, key, videoIDs, func(ids []int64) ([]int64, error) {
+		var favoriteIDs []int64
+		err := qFavorite.WithContext(ctx).Where(qFavorite.UserID.Eq(userID), qFavorite.VideoID.In(ids...)).
+			Select(qFavorite.VideoID).Scan(&favoriteIDs)
+		return favoriteIDs, err
+	})
+}
+
+// BatchGetUserFavoriteCount 批量获取用户点赞数
+func BatchGetUserFavoriteCount(ctx context.Context, userIDs []int64) (map[int64]int64, error) {
+	return batchGetCount(ctx, KeyUserFavoriteCountPF, userIDs, func(ids []int64) (map[int64]int64, error) {
+		var rows []idCount
+		err := qFavorite.WithContext(ctx).Select(qFavorite.UserID.As("id"), qFavorite.ID.Count().As("cnt")).
+			Where(qFavorite.UserID.In(ids...)).Group(qFavorite.UserID).Scan(&rows)
+		if err != nil {
+			return nil, err
+		}
+		return countMap(rows), nil
+	})
+}
@@ -0,0 +1,243 @@
+package dal
+
+import (
+	"context"
+	"sort"
+	"strconv"
+	"time"
+
+	"douyin/src/dal/model"
+
+	"github.com/redis/go-redis/v9"
+)
+
+const (
+	inboxSize     = 1000 // 收件箱最多保留的视频数
+	outboxSize    = 200  // 发件箱最多保留的视频数
+	BigVThreshold = 5000 // 粉丝数达到该值的作者只写发件箱，由粉丝读取时拉取
+)
+
+// PushFeed 推拉结合分发新视频: 写入作者发件箱，非大V作者同时推送到所有粉丝的收件箱。
+// 与buildOutbox一致，仅自己可见的视频不分发
+func PushFeed(ctx context.Context, video *model.Video) error {
+	if video.Visibility == VideoVisibilityPrivate {
+		return nil
+	}
+	authorID := strconv.FormatInt(video.AuthorID, 10)
+	member := redis.Z{Score: float64(video.UploadTime.Unix()), Member: video.ID}
+
+	// 写入作者发件箱，发件箱不存在时先从数据库重建，避免只包含新视频
+	keyOutbox := GetRedisKey(KeyUserOutboxPF, authorID)
+	if err := buildOutbox(ctx, keyOutbox, video.AuthorID); err != nil {
+		return err
+	}
+	pipe := RDB.Pipeline()
+	pipe.ZAdd(ctx, keyOutbox, member)
+	pipe.ZRemRangeByRank(ctx, keyOutbox, 0, -outboxSize-1)
+	if _, err := pipe.Exec(ctx); err != nil {
+		return err
+	}
+
+	// 大V只写发件箱，避免一次写扩散到海量收件箱
+	followerCnt, err := GetUserFollowerCount(ctx, video.AuthorID)
+	if err != nil {
+		return err
+	}
+	keyBigV := GetRedisKey(KeyBigVSet)
+	if followerCnt >= BigVThreshold {
+		return RDB.SAdd(ctx, keyBigV, video.AuthorID).Err()
+	}
+	if err := RDB.SRem(ctx, keyBigV, video.AuthorID).Err(); err != nil {
+		return err
+	}
+
+	// 推送到粉丝收件箱
+	followerIDs, err := FollowerIDList(ctx, video.AuthorID)
+	if err != nil {
+		return err
+	}
+	pipe = RDB.Pipeline()
+	for _, followerID := range followerIDs {
+		keyInbox := GetRedisKey(KeyUserInboxPF, strconv.FormatInt(followerID, 10))
+		pipe.ZAdd(ctx, keyInbox, member)
+		pipe.ZRemRangeByRank(ctx, keyInbox, 0, -inboxSize-1)
+	}
+	_, err = pipe.Exec(ctx)
+
+	return err
+}
+
+// GetFollowFeed 获取关注Feed流: 合并收件箱与关注的大V发件箱，按发布时间倒序，发布时间相同时按视频ID倒序。
+// lastID为上一页最后一个视频的ID，用于继续读取与其发布时间相同的视频，为0时只读取发布时间早于latestTime的视频。
+// nextTime和nextID为最后一个被扫描的视频的发布时间和ID，即使全部被过滤也可以继续向更早的视频翻页，没有更多视频时为零值
+func GetFollowFeed(ctx context.Context, userID int64, latestTime time.Time, lastID int64, count int) (videoIDs []int64, nextTime time.Time, nextID int64, err error) {
+	// 读取收件箱
+	keyInbox := GetRedisKey(KeyUserInboxPF, strconv.FormatInt(userID, 10))
+	feed, err := rangeFeed(ctx, keyInbox, latestTime, lastID, count)
+	if err != nil {
+		return nil, time.Time{}, 0, err
+	}
+
+	// 拉取关注的大V发件箱
+	followList, err := FollowIDList(ctx, userID)
+	if err != nil {
+		return nil, time.Time{}, 0, err
+	}
+	if len(followList) > 0 {
+		members := make([]interface{}, len(followList))
+		for i, id := range followList {
+			members[i] = id
+		}
+		isBigV, err := RDB.SMIsMember(ctx, GetRedisKey(KeyBigVSet), members...).Result()
+		if err != nil {
+			return nil, time.Time{}, 0, err
+		}
+		for i, authorID := range followList {
+			if !isBigV[i] {
+				continue
+			}
+			outbox, err := getOutbox(ctx, authorID, latestTime, lastID, count)
+			if err != nil {
+				return nil, time.Time{}, 0, err
+			}
+			feed = append(feed, outbox...)
+		}
+	}
+
+	// 按发布时间和视频ID倒序合并去重
+	sort.Slice(feed, func(i, j int) bool {
+		if feed[i].score != feed[j].score {
+			return feed[i].score > feed[j].score
+		}
+		return feed[i].videoID > feed[j].videoID
+	})
+	videoIDs = make([]int64, 0, count)
+	for i, item := range feed {
+		if i > 0 && item.videoID == feed[i-1].videoID {
+			continue
+		}
+		videoIDs = append(videoIDs, item.videoID)
+		nextTime, nextID = time.Unix(item.score, 0), item.videoID
+		if len(videoIDs) == count {
+			break
+		}
+	}
+
+	return videoIDs, nextTime, nextID, nil
+}
+
+// BackfillInbox 关注作者后将作者发件箱中的视频合并到粉丝收件箱
+func BackfillInbox(ctx context.Context, followerID, authorID int64) error {
+	outbox, err := loadOutbox(ctx, authorID)
+	if err != nil || len(outbox) == 0 {
+		return err
+	}
+
+	keyInbox := GetRedisKey(KeyUserInboxPF, strconv.FormatInt(followerID, 10))
+	pipe := RDB.Pipeline()
+	pipe.ZAdd(ctx, keyInbox, outbox...)
+	pipe.ZRemRangeByRank(ctx, keyInbox, 0, -inboxSize-1)
+	_, err = pipe.Exec(ctx)
+
+	return err
+}
+
+// RemoveFromInbox 取消关注后从粉丝收件箱中移除作者发件箱中的视频
+func RemoveFromInbox(ctx context.Context, followerID, authorID int64) error {
+	outbox, err := loadOutbox(ctx, authorID)
+	if err != nil || len(outbox) == 0 {
+		return err
+	}
+
+	members := make([]interface{}, len(outbox))
+	for i, z := range outbox {
+		members[i] = z.Member
+	}
+	return RDB.ZRem(ctx, GetRedisKey(KeyUserInboxPF, strconv.FormatInt(followerID, 10)), members...).Err()
+}
+
+// getOutbox 读取作者发件箱，缓存未命中时从数据库重建
+func getOutbox(ctx context.Context, authorID int64, latestTime time.Time, lastID int64, count int) ([]feedItem, error) {
+	key := GetRedisKey(KeyUserOutboxPF, strconv.FormatInt(authorID, 10))
+	if err := buildOutbox(ctx, key, authorID); err != nil {
+		return nil, err
+	}
+
+	return rangeFeed(ctx, key, latestTime, lastID, count)
+}
+
+// feedItem 收件箱或发件箱中的视频，score为发布时间的秒级时间戳
+type feedItem struct {
+	score   int64
+	videoID int64
+}
+
+// rangeFeed 按发布时间和视频ID倒序，读取key中排在(latestTime, lastID)之后的至多count个视频。
+// 发布时间相同的视频需要全部读出再按ID过滤，秒级时间戳下同一作者的同秒视频很少
+func rangeFeed(ctx context.Context, key string, latestTime time.Time, lastID int64, count int) ([]feedItem, error) {
+	maxScore := strconv.FormatInt(latestTime.Unix(), 10)
+	var zs []redis.Z
+	if lastID > 0 {
+		ties, err := RDB.ZRevRangeByScoreWithScores(ctx, key, &redis.ZRangeBy{Min: maxScore, Max: maxScore}).Result()
+		if err != nil && err != redis.Nil {
+			return nil, err
+		}
+		zs = ties
+	}
+	older, err := RDB.ZRevRangeByScoreWithScores(ctx, key, &redis.ZRangeBy{
+		Min:   "-inf",
+		Max:   "(" + maxScore,
+		Count: int64(count),
+	}).Result()
+	if err != nil && err != redis.Nil {
+		return nil, err
+	}
+	zs = append(zs, older...)
+
+	items := make([]feedItem, 0, len(zs))
+	for _, z := range zs {
+		member, _ := z.Member.(string)
+		videoID, err := strconv.ParseInt(member, 10, 64)
+		if err != nil {
+			return nil, err
+		}
+		if lastID > 0 && int64(z.Score) == latestTime.Unix() && videoID >= lastID {
+			continue
+		}
+		items = append(items, feedItem{score: int64(z.Score), videoID: videoID})
+	}
+	return items, nil
+}
+
+// loadOutbox 读取作者发件箱中的全部视频
+func loadOutbox(ctx context.Context, authorID int64) ([]redis.Z, error) {
+	key := GetRedisKey(KeyUserOutboxPF, strconv.FormatInt(authorID, 10))
+	if err := buildOutbox(ctx, key, authorID); err != nil {
+		return nil, err
+	}
+
+	return RDB.ZRangeWithScores(ctx, key, 0, -1).Result()
+}
+
+// buildOutbox 发件箱不存在时从数据库重建
+func buildOutbox(ctx context.Context, key string, authorID int64) error {
+	exist, err := RDB.Exists(ctx, key).Result()
+	if err != nil || exist > 0 {
+		return err
+	}
+
+	// 缓存未命中，查询数据库
+	videos, err := qVideo.WithContext(ctx).
+		Where(qVideo.AuthorID.Eq(authorID), qVideo.Status.Eq(VideoStatusPublished), qVideo.Visibility.Neq(VideoVisibilityPrivate)).
+		Select(qVideo.ID, qVideo.UploadTime).Order(qVideo.UploadTime.Desc()).Limit(outboxSize).Find()
+	if err != nil || len(videos) == 0 {
+		return err
+	}
+
+	// 写入redis缓存
+	members := make([]redis.Z, len(videos))
+	for i, v := range videos {
+		members[i] = redis.Z{Score: float64(v.UploadTime.Unix()), Member: v.ID}
+	}
+	return RDB.ZAdd(ctx, key, members...).Err()
+}
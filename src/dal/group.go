@@ -0,0 +1,317 @@
+package dal
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"douyin/src/common/snowflake"
+	"douyin/src/dal/model"
+	"douyin/src/dal/query"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// 群聊成员角色，角色高的成员可以移除角色低的成员
+const (
+	GroupRoleMember int16 = 0 // 成员
+	GroupRoleAdmin  int16 = 1 // 管理员
+	GroupRoleOwner  int16 = 2 // 群主
+)
+
+const MaxGroupMembers = 500 // 群聊最大成员数
+
+// GetGroupConvertID 群聊的会话ID，与单聊会话ID一样作为消息分表键
+func GetGroupConvertID(groupID int64) string {
+	return "g_" + strconv.FormatInt(groupID, 10)
+}
+
+// CreateGroup 创建群聊，创建者为群主，memberIDs为其余初始成员，joinable表示是否允许任何人自由加入
+func CreateGroup(ctx context.Context, ownerID int64, name string, memberIDs []int64, joinable bool) (*model.ChatGroup, error) {
+	memberIDs = uniqueIDs(append([]int64{ownerID}, memberIDs...))
+	if len(memberIDs) > MaxGroupMembers {
+		return nil, ErrGroupFull
+	}
+
+	now := time.Now()
+	group := &model.ChatGroup{
+		ID:          snowflake.GenerateID(),
+		Name:        name,
+		OwnerID:     ownerID,
+		MemberCount: int64(len(memberIDs)),
+		Joinable:    joinable,
+		CreateTime:  now,
+	}
+	members := make([]*model.GroupMember, len(memberIDs))
+	for i, userID := range memberIDs {
+		role := GroupRoleMember
+		if userID == ownerID {
+			role = GroupRoleOwner
+		}
+		members[i] = &model.GroupMember{ID: snowflake.GenerateID(), GroupID: group.ID, UserID: userID, Role: role, CreateTime: now}
+	}
+
+	err := q.Transaction(func(tx *query.Query) error {
+		if err := tx.ChatGroup.WithContext(ctx).Create(group); err != nil {
+			return err
+		}
+		return tx.GroupMember.WithContext(ctx).Create(members...)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return group, nil
+}
+
+// GetGroup 通过群聊ID查询群聊信息
+func GetGroup(ctx context.Context, groupID int64) (*model.ChatGroup, error) {
+	group, err := qChatGroup.WithContext(ctx).Where(qChatGroup.ID.Eq(groupID)).First()
+	if err == gorm.ErrRecordNotFound {
+		return nil, ErrGroupNotExist
+	}
+	return group, err
+}
+
+// GetGroupList 批量查询群聊信息，已解散的群聊不返回
+func GetGroupList(ctx context.Context, groupIDs []int64) ([]*model.ChatGroup, error) {
+	if len(groupIDs) == 0 {
+		return []*model.ChatGroup{}, nil
+	}
+	return qChatGroup.WithContext(ctx).Where(qChatGroup.ID.In(uniqueIDs(groupIDs)...)).Find()
+}
+
+// GetGroupMember 查询用户在群聊中的成员信息
+func GetGroupMember(ctx context.Context, groupID, userID int64) (*model.GroupMember, error) {
+	member, err := qGroupMember.WithContext(ctx).Where(qGroupMember.GroupID.Eq(groupID), qGroupMember.UserID.Eq(userID)).First()
+	if err == gorm.ErrRecordNotFound {
+		return nil, ErrNotGroupMember
+	}
+	return member, err
+}
+
+// GetGroupMemberList 按加入顺序倒序分页查询群聊成员，返回ID小于lastID的至多count个成员
+func GetGroupMemberList(ctx context.Context, groupID, lastID int64, count int) ([]*model.GroupMember, error) {
+	return qGroupMember.WithContext(ctx).
+		Where(qGroupMember.GroupID.Eq(groupID), qGroupMember.ID.Lt(lastID)).
+		Order(qGroupMember.ID.Desc()).Limit(count).Find()
+}
+
+// GetGroupMemberIDs 获取群聊全部成员的ID，用于校验发送者和推送新消息
+func GetGroupMemberIDs(ctx context.Context, groupID int64) ([]int64, error) {
+	key := GetRedisKey(KeyGroupMemberPF, strconv.FormatInt(groupID, 10))
+	members, err := RDB.SMembers(ctx, key).Result()
+	if err != nil {
+		return nil, err
+	}
+	if len(members) > 0 {
+		userIDs := make([]int64, len(members))
+		for i, member := range members {
+			userIDs[i], _ = strconv.ParseInt(member, 10, 64)
+		}
+		return userIDs, nil
+	}
+
+	// 缓存未命中，查询数据库
+	var userIDs []int64
+	if err := qGroupMember.WithContext(ctx).Where(qGroupMember.GroupID.Eq(groupID)).Pluck(qGroupMember.UserID, &userIDs); err != nil {
+		return nil, err
+	}
+	if len(userIDs) == 0 {
+		return userIDs, nil
+	}
+
+	// 写入redis缓存
+	values := make([]interface{}, len(userIDs))
+	for i, userID := range userIDs {
+		values[i] = userID
+	}
+	pipe := RDB.Pipeline()
+	pipe.SAdd(ctx, key, values...)
+	pipe.Expire(ctx, key, ExpireTime+GetRandomTime())
+	if _, err := pipe.Exec(ctx); err != nil {
+		return nil, err
+	}
+	return userIDs, nil
+}
+
+// JoinGroup 用户加入群聊，只能加入允许自由加入的群聊，被移出过的用户不能再加入
+func JoinGroup(ctx context.Context, groupID, userID int64) error {
+	err := q.Transaction(func(tx *query.Query) error {
+		group, err := lockGroup(ctx, tx, groupID)
+		if err != nil {
+			return err
+		}
+		if !group.Joinable {
+			return ErrGroupNotJoinable
+		}
+		banned, err := tx.GroupBan.WithContext(ctx).Where(tx.GroupBan.GroupID.Eq(groupID), tx.GroupBan.UserID.Eq(userID)).Count()
+		if err != nil {
+			return err
+		}
+		if banned > 0 {
+			return ErrGroupBanned
+		}
+		if group.MemberCount >= MaxGroupMembers {
+			return ErrGroupFull
+		}
+
+		cnt, err := tx.GroupMember.WithContext(ctx).Where(tx.GroupMember.GroupID.Eq(groupID), tx.GroupMember.UserID.Eq(userID)).Count()
+		if err != nil {
+			return err
+		}
+		if cnt > 0 {
+			return ErrAlreadyInGroup
+		}
+
+		member := &model.GroupMember{ID: snowflake.GenerateID(), GroupID: groupID, UserID: userID, Role: GroupRoleMember, CreateTime: time.Now()}
+		if err := tx.GroupMember.WithContext(ctx).Create(member); err != nil {
+			return err
+		}
+		_, err = tx.ChatGroup.WithContext(ctx).Where(tx.ChatGroup.ID.Eq(groupID)).UpdateSimple(tx.ChatGroup.MemberCount.Add(1))
+		return err
+	})
+	if err != nil {
+		return err
+	}
+	return clearGroupMembers(ctx, groupID)
+}
+
+// LeaveGroup 用户退出群聊，群主退出时由角色最高、加入最早的成员继任，最后一个成员退出时解散群聊
+func LeaveGroup(ctx context.Context, groupID, userID int64) error {
+	err := q.Transaction(func(tx *query.Query) error {
+		group, err := lockGroup(ctx, tx, groupID)
+		if err != nil {
+			return err
+		}
+		member, err := getGroupMember(ctx, tx, groupID, userID)
+		if err != nil {
+			return err
+		}
+		if group.MemberCount <= 1 {
+			if _, err := tx.GroupMember.WithContext(ctx).Where(tx.GroupMember.GroupID.Eq(groupID)).Delete(); err != nil {
+				return err
+			}
+			if _, err := tx.GroupBan.WithContext(ctx).Where(tx.GroupBan.GroupID.Eq(groupID)).Delete(); err != nil {
+				return err
+			}
+			_, err := tx.ChatGroup.WithContext(ctx).Where(tx.ChatGroup.ID.Eq(groupID)).Delete()
+			return err
+		}
+		if err := removeGroupMember(ctx, tx, member); err != nil {
+			return err
+		}
+		if member.Role != GroupRoleOwner {
+			return nil
+		}
+
+		// 群主退出，选出继任者
+		successor, err := tx.GroupMember.WithContext(ctx).Where(tx.GroupMember.GroupID.Eq(groupID)).
+			Order(tx.GroupMember.Role.Desc(), tx.GroupMember.ID).First()
+		if err != nil {
+			return err
+		}
+		return transferGroupOwner(ctx, tx, groupID, successor.UserID)
+	})
+	if err != nil {
+		return err
+	}
+	return clearGroupMembers(ctx, groupID)
+}
+
+// KickGroupMember 将成员移出群聊并记录，被移出的成员不能再主动加入，只能移除角色低于自己的成员
+func KickGroupMember(ctx context.Context, groupID, operatorID, memberID int64) error {
+	err := q.Transaction(func(tx *query.Query) error {
+		if _, err := lockGroup(ctx, tx, groupID); err != nil {
+			return err
+		}
+		operator, err := getGroupMember(ctx, tx, groupID, operatorID)
+		if err != nil {
+			return err
+		}
+		member, err := getGroupMember(ctx, tx, groupID, memberID)
+		if err != nil {
+			return err
+		}
+		if operator.Role <= member.Role {
+			return ErrGroupPermission
+		}
+		if err := removeGroupMember(ctx, tx, member); err != nil {
+			return err
+		}
+		ban := &model.GroupBan{ID: snowflake.GenerateID(), GroupID: groupID, UserID: memberID, CreateTime: time.Now()}
+		return tx.GroupBan.WithContext(ctx).Clauses(clause.OnConflict{DoNothing: true}).Create(ban)
+	})
+	if err != nil {
+		return err
+	}
+	return clearGroupMembers(ctx, groupID)
+}
+
+// SetGroupMemberRole 群主设置成员角色，设置为群主时转让群主，原群主成为管理员
+func SetGroupMemberRole(ctx context.Context, groupID, operatorID, memberID int64, role int16) error {
+	return q.Transaction(func(tx *query.Query) error {
+		if _, err := lockGroup(ctx, tx, groupID); err != nil {
+			return err
+		}
+		operator, err := getGroupMember(ctx, tx, groupID, operatorID)
+		if err != nil {
+			return err
+		}
+		if operator.Role != GroupRoleOwner || operatorID == memberID {
+			return ErrGroupPermission
+		}
+		if _, err := getGroupMember(ctx, tx, groupID, memberID); err != nil {
+			return err
+		}
+
+		if role == GroupRoleOwner {
+			if _, err := tx.GroupMember.WithContext(ctx).Where(tx.GroupMember.ID.Eq(operator.ID)).UpdateSimple(tx.GroupMember.Role.Value(GroupRoleAdmin)); err != nil {
+				return err
+			}
+			return transferGroupOwner(ctx, tx, groupID, memberID)
+		}
+		_, err = tx.GroupMember.WithContext(ctx).Where(tx.GroupMember.GroupID.Eq(groupID), tx.GroupMember.UserID.Eq(memberID)).
+			UpdateSimple(tx.GroupMember.Role.Value(role))
+		return err
+	})
+}
+
+// lockGroup 锁定群聊记录，保证成员变更和成员数的一致
+func lockGroup(ctx context.Context, tx *query.Query, groupID int64) (*model.ChatGroup, error) {
+	group, err := tx.ChatGroup.WithContext(ctx).Clauses(clause.Locking{Strength: "UPDATE"}).Where(tx.ChatGroup.ID.Eq(groupID)).First()
+	if err == gorm.ErrRecordNotFound {
+		return nil, ErrGroupNotExist
+	}
+	return group, err
+}
+
+func getGroupMember(ctx context.Context, tx *query.Query, groupID, userID int64) (*model.GroupMember, error) {
+	member, err := tx.GroupMember.WithContext(ctx).Where(tx.GroupMember.GroupID.Eq(groupID), tx.GroupMember.UserID.Eq(userID)).First()
+	if err == gorm.ErrRecordNotFound {
+		return nil, ErrNotGroupMember
+	}
+	return member, err
+}
+
+func removeGroupMember(ctx context.Context, tx *query.Query, member *model.GroupMember) error {
+	if _, err := tx.GroupMember.WithContext(ctx).Where(tx.GroupMember.ID.Eq(member.ID)).Delete(); err != nil {
+		return err
+	}
+	_, err := tx.ChatGroup.WithContext(ctx).Where(tx.ChatGroup.ID.Eq(member.GroupID)).UpdateSimple(tx.ChatGroup.MemberCount.Sub(1))
+	return err
+}
+
+func transferGroupOwner(ctx context.Context, tx *query.Query, groupID, ownerID int64) error {
+	if _, err := tx.GroupMember.WithContext(ctx).Where(tx.GroupMember.GroupID.Eq(groupID), tx.GroupMember.UserID.Eq(ownerID)).
+		UpdateSimple(tx.GroupMember.Role.Value(GroupRoleOwner)); err != nil {
+		return err
+	}
+	_, err := tx.ChatGroup.WithContext(ctx).Where(tx.ChatGroup.ID.Eq(groupID)).UpdateSimple(tx.ChatGroup.OwnerID.Value(ownerID))
+	return err
+}
+
+// clearGroupMembers 成员变更后删除成员缓存
+func clearGroupMembers(ctx context.Context, groupID int64) error {
+	return RDB.Del(ctx, GetRedisKey(KeyGroupMemberPF, strconv.FormatInt(groupID, 10))).Err()
+}
@@ -0,0 +1,154 @@
+package dal
+
+import (
+	"context"
+	"math"
+	"strconv"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+)
+
+// 热榜统计窗口
+const (
+	HotWindowHour = "hour" // 最近1小时
+	HotWindowDay  = "day"  // 最近1天
+	HotWindowWeek = "week" // 最近1周
+)
+
+// 互动行为对视频热度的贡献
+const (
+	HeatFavorite float64 = 1
+	HeatComment  float64 = 2
+)
+
+const (
+	hotBucketTTL    = 3 * time.Hour  // 小时桶保留时间，需要覆盖小时榜的滑动窗口和合并
+	hotMergedTTL    = 48 * time.Hour // 合并标记保留时间
+	hotResultTTL    = time.Minute    // 热榜查询结果缓存时间
+	hotMinHeat      = 0.01           // 衰减后低于该热度的视频移出榜单
+	hotMaxSize      = 10000          // 日榜和周榜保留的最大视频数
+	hotDayHalfLife  = 6.0            // 日榜热度半衰期，单位小时
+	hotWeekHalfLife = 42.0           // 周榜热度半衰期，单位小时
+	hotBucketLayout = "2006010215"   // 小时桶的时间格式
+)
+
+// 每合并一个小时桶，已有热度按半衰期衰减一次
+var (
+	hotDayDecay  = math.Pow(0.5, 1/hotDayHalfLife)
+	hotWeekDecay = math.Pow(0.5, 1/hotWeekHalfLife)
+)
+
+func hotBucketKey(t time.Time) string {
+	return GetRedisKey(KeyVideoHotHourPF, t.Format(hotBucketLayout))
+}
+
+// IncrVideoHeat 将视频热度增量计入t所在的小时桶
+func IncrVideoHeat(ctx context.Context, heat map[int64]float64, t time.Time) error {
+	if len(heat) == 0 {
+		return nil
+	}
+
+	key := hotBucketKey(t)
+	pipe := RDB.Pipeline()
+	for videoID, delta := range heat {
+		pipe.ZIncrBy(ctx, key, delta, strconv.FormatInt(videoID, 10))
+	}
+	pipe.Expire(ctx, key, hotBucketTTL)
+	_, err := pipe.Exec(ctx)
+	return err
+}
+
+// MergeHotBucket 将t所在的小时桶合并到日榜和周榜，已有热度先按半衰期衰减。
+// 多个实例同时合并时只有一个会执行，返回是否执行了合并
+func MergeHotBucket(ctx context.Context, t time.Time) (bool, error) {
+	keyMerged := GetRedisKey(KeyVideoHotMergedPF, t.Format(hotBucketLayout))
+	ok, err := RDB.SetNX(ctx, keyMerged, 1, hotMergedTTL).Result()
+	if err != nil || !ok {
+		return false, err
+	}
+
+	// 热榜相关的key在同一个slot，可以使用ZUNIONSTORE
+	keyBucket := hotBucketKey(t)
+	keyDay := GetRedisKey(KeyVideoHotDay)
+	keyWeek := GetRedisKey(KeyVideoHotWeek)
+	pipe := RDB.Pipeline()
+	for key, decay := range map[string]float64{keyDay: hotDayDecay, keyWeek: hotWeekDecay} {
+		pipe.ZUnionStore(ctx, key, &redis.ZStore{Keys: []string{key, keyBucket}, Weights: []float64{decay, 1}})
+		pipe.ZRemRangeByScore(ctx, key, "-inf", "("+strconv.FormatFloat(hotMinHeat, 'f', -1, 64))
+		pipe.ZRemRangeByRank(ctx, key, 0, -hotMaxSize-1)
+	}
+	if _, err := pipe.Exec(ctx); err != nil {
+		// 合并失败时删除标记，下次重试
+		RDB.Del(ctx, keyMerged)
+		return false, err
+	}
+
+	return true, nil
+}
+
+// removeHotVideo 在pipe中将视频从日榜、周榜、未过期的小时桶和查询结果缓存中移除
+func removeHotVideo(ctx context.Context, pipe redis.Pipeliner, videoID int64) {
+	member := strconv.FormatInt(videoID, 10)
+	pipe.ZRem(ctx, GetRedisKey(KeyVideoHotDay), member)
+	pipe.ZRem(ctx, GetRedisKey(KeyVideoHotWeek), member)
+	now := time.Now()
+	for t := now.Add(-hotBucketTTL); !t.After(now); t = t.Add(time.Hour) {
+		pipe.ZRem(ctx, hotBucketKey(t), member)
+	}
+	for _, window := range []string{HotWindowHour, HotWindowDay, HotWindowWeek} {
+		pipe.ZRem(ctx, GetRedisKey(KeyVideoHotResultPF, window), member)
+	}
+}
+
+// GetHotVideos 获取统计窗口内热度最高的至多count个视频。
+// 小时榜由上一个小时桶按剩余时间比例加权后与当前小时桶合并，日榜和周榜为已合并的热度加上当前小时桶
+func GetHotVideos(ctx context.Context, window string, count int) ([]int64, error) {
+	now := time.Now()
+	keyResult := GetRedisKey(KeyVideoHotResultPF, window)
+
+	// 查询结果缓存
+	exist, err := RDB.Exists(ctx, keyResult).Result()
+	if err != nil {
+		return nil, err
+	}
+	if exist == 0 {
+		store := &redis.ZStore{}
+		switch window {
+		case HotWindowHour:
+			elapsed := float64(now.Sub(now.Truncate(time.Hour))) / float64(time.Hour)
+			store.Keys = []string{hotBucketKey(now.Add(-time.Hour)), hotBucketKey(now)}
+			store.Weights = []float64{1 - elapsed, 1}
+		case HotWindowWeek:
+			store.Keys = []string{GetRedisKey(KeyVideoHotWeek), hotBucketKey(now)}
+		default:
+			store.Keys = []string{GetRedisKey(KeyVideoHotDay), hotBucketKey(now)}
+		}
+
+		pipe := RDB.Pipeline()
+		pipe.ZUnionStore(ctx, keyResult, store)
+		pipe.Expire(ctx, keyResult, hotResultTTL)
+		if _, err := pipe.Exec(ctx); err != nil {
+			return nil, err
+		}
+	}
+
+	members, err := RDB.ZRevRangeByScore(ctx, keyResult, &redis.ZRangeBy{
+		Min:   "(0",
+		Max:   "+inf",
+		Count: int64(count),
+	}).Result()
+	if err != nil {
+		return nil, err
+	}
+
+	videoIDs := make([]int64, 0, len(members))
+	for _, member := range members {
+		videoID, err := strconv.ParseInt(member, 10, 64)
+		if err != nil {
+			return nil, err
+		}
+		videoIDs = append(videoIDs, videoID)
+	}
+	return videoIDs, nil
+}
@@ -3,20 +3,48 @@ package dal
 import "strings"
 
 const (
-	Prefix                  = "douyin:"               // 项目公共前缀
-	KeyVideoInfoPF          = "video:info:"           // 视频基础信息
-	KeyVideoFavoriteCountPF = "video:favorite_count:" // 视频获赞数
-	KeyVideoCommentCountPF  = "video:comment_count:"  // 视频评论数
-	KeyUserFavoritePF       = "user:favorite:"        // Set 用户喜欢的视频
-	KeyUserFollowPF         = "user:follow:"          // Set 用户关注列表
-	KeyUserFollowerPF       = "user:follower:"        // Set 用户粉丝列表(50条左右)
-	KeyUserFriendPF         = "user:friend:"          // Set 用户好友列表
-	KeyUserInfoPF           = "user:info:"            // 用户基础信息
-	KeyUserTotalFavoritedPF = "user:total_favorited:" // 用户总获赞数
-	KeyUserFavoriteCountPF  = "user:favorite_count:"  // 用户喜欢数
-	KeyUserFollowCountPF    = "user:follow_count:"    // 用户关注数
-	KeyUserFollowerCountPF  = "user:follower_count:"  // 用户粉丝数
-	KeyUserWorkCountPF      = "user:work_count:"      // 用户作品数
+	Prefix                    = "douyin:"                 // 项目公共前缀
+	KeyVideoInfoPF            = "video:info:"             // 视频基础信息
+	KeyVideoFavoriteCountPF   = "video:favorite_count:"   // 视频获赞数
+	KeyVideoCommentCountPF    = "video:comment_count:"    // 视频评论数
+	KeyVideoProgressPF        = "video:progress:"         // 视频处理进度
+	KeyVideoTombstone         = "video:tombstone"         // Set 已删除的视频，布隆过滤器无法删除元素
+	KeyVideoPurge             = "video:purge"             // ZSet 等待彻底清理的已删除视频，score为清理时间
+	KeyVideoHotHourPF         = "{video:hot}:hour:"       // ZSet 每小时的视频热度增量，热榜相关的key使用相同的hash tag
+	KeyVideoHotDay            = "{video:hot}:day"         // ZSet 按小时衰减合并的日榜热度
+	KeyVideoHotWeek           = "{video:hot}:week"        // ZSet 按小时衰减合并的周榜热度
+	KeyVideoHotResultPF       = "{video:hot}:result:"     // ZSet 热榜查询结果缓存
+	KeyVideoHotMergedPF       = "{video:hot}:merged:"     // 已合并的小时桶标记
+	KeyTopicTrendingPF        = "topic:trending:"         // ZSet 按天统计的话题使用次数
+	KeyTopicHotPF             = "topic:hot:"              // ZSet 话题下视频的互动热度，用于选取热度排序的候选视频
+	KeyCommentReplyCountPF    = "comment:reply_count:"    // 评论回复数
+	KeyCommentFavoriteCountPF = "comment:favorite_count:" // 评论点赞数
+	KeyCommentHotPF           = "comment:hot:"            // ZSet 视频一级评论的互动热度，用于选取热度排序的候选评论
+	KeyUserFavoritePF         = "user:favorite:"          // Set 用户喜欢的视频
+	KeyUserCommentFavoritePF  = "user:comment_favorite:"  // Set 用户点赞的评论
+	KeyUserFollowPF           = "user:follow:"            // Set 用户关注列表
+	KeyUserInfoPF             = "user:info:"              // 用户基础信息
+	KeyUserTotalFavoritedPF   = "user:total_favorited:"   // 用户总获赞数
+	KeyUserFavoriteCountPF    = "user:favorite_count:"    // 用户喜欢数
+	KeyUserFollowCountPF      = "user:follow_count:"      // 用户关注数
+	KeyUserFollowerCountPF    = "user:follower_count:"    // 用户粉丝数
+	KeyUserWorkCountPF        = "user:work_count:"        // 用户作品数
+	KeyUserInboxPF            = "user:inbox:"             // ZSet 用户关注Feed收件箱
+	KeyUserOutboxPF           = "user:outbox:"            // ZSet 用户发件箱
+	KeyBigVSet                = "user:big_v"              // Set 大V用户(粉丝数超过阈值)
+	KeyUserSeenPF             = "user:seen:"              // Bitmap 用户已看视频布隆过滤器
+	KeyUserUploadPF           = "user:upload:"            // 用户未完成的上传任务，按文件校验和查找，用于断点续传
+	KeyUserConversationPF     = "user:conversation:"      // ZSet 用户的会话列表，score为最后一条消息的时间
+	KeyUserUnreadPF           = "user:unread:"            // Hash 用户各会话的未读消息数
+	KeyUserReadCursorPF       = "user:read_cursor:"       // Hash 用户各会话已读到的消息时间
+	KeyUserDeliverCursorPF    = "user:deliver_cursor:"    // ZSet 用户各会话已送达的消息时间，score为时间
+	KeyConversationLastPF     = "conversation:last:"      // 会话的最后一条消息
+	KeyGroupMemberPF          = "group:member:"           // Set 群聊成员ID
+	KeyUploadSessionPF        = "upload:session:"         // Hash 分片上传任务信息
+	KeyUploadChunksPF         = "upload:chunks:"          // Set 已上传的分片序号
+	KeyUploadLockPF           = "upload:lock:"            // 合并分片时的互斥锁
+	KeyChatPresencePF         = "chat:presence:"          // Hash 用户在线的网关实例，value为过期时间
+	KeyChatGatewayPF          = "chat:gateway:"           // PubSub 网关实例的推送频道
 )
 
 func GetRedisKey(keys ...string) string {
@@ -8,24 +8,177 @@ import (
 
 	"douyin/src/common/snowflake"
 	"douyin/src/dal/model"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// 消息状态，已送达和已读只会向后推进，已撤回后不再变化
+const (
+	MessageStatusSent      int16 = 0 // 已发送
+	MessageStatusDelivered int16 = 1 // 已送达
+	MessageStatusRead      int16 = 2 // 已读
+	MessageStatusRecalled  int16 = 3 // 已撤回
+)
+
+// 消息类型，非文本消息的结构化内容保存在payload中
+const (
+	MessageTypeText    int16 = 0 // 文本
+	MessageTypeImage   int16 = 1 // 图片
+	MessageTypeVideo   int16 = 2 // 视频分享
+	MessageTypeUser    int16 = 3 // 名片
+	MessageTypeSticker int16 = 4 // 表情
 )
 
-func MessageAction(ctx context.Context, message *model.Message) error {
-	message.ID = snowflake.GenerateID()
-	message.CreateTime = time.Now().UnixMilli()
+// MessageAction 保存消息，返回是否新写入。发送时已生成ID的消息重复消费时忽略
+func MessageAction(ctx context.Context, message *model.Message) (bool, error) {
+	if message.ID == 0 {
+		message.ID = snowflake.GenerateID()
+	}
+	if message.CreateTime == 0 {
+		message.CreateTime = time.Now().UnixMilli()
+	}
 
-	return db.WithContext(ctx).Model(&model.Message{}).Create(message).Error
+	result := db.WithContext(ctx).Model(&model.Message{}).Clauses(clause.OnConflict{DoNothing: true}).Create(message)
+	return result.RowsAffected > 0, result.Error
 }
 
+// MessageList 查询会话中lastTime之后的消息，不包含用户自己删除的消息
 func MessageList(ctx context.Context, userID, toUserID, lastTime int64) ([]*model.Message, error) {
 	convertID := GetConvertID(userID, toUserID)
 
 	messageList := make([]*model.Message, 0)
-	err := db.WithContext(ctx).Model(&model.Message{}).Where("convert_id = ? AND create_time > ?", convertID, lastTime).Find(&messageList).Error
+	err := db.WithContext(ctx).Model(&model.Message{}).
+		Where("convert_id = ? AND create_time > ?", convertID, lastTime).
+		Where("(from_user_id = ? AND NOT from_deleted) OR (to_user_id = ? AND NOT to_deleted)", userID, userID).
+		Find(&messageList).Error
 
 	return messageList, err
 }
 
+// GroupMessageList 查询群聊中lastTime之后的消息，不包含用户自己删除的消息
+func GroupMessageList(ctx context.Context, userID, groupID, lastTime int64) ([]*model.Message, error) {
+	convertID := GetGroupConvertID(groupID)
+
+	messageList := make([]*model.Message, 0)
+	err := db.WithContext(ctx).Model(&model.Message{}).
+		Where("convert_id = ? AND create_time > ?", convertID, lastTime).
+		Find(&messageList).Error
+	if err != nil || len(messageList) == 0 {
+		return messageList, err
+	}
+
+	// 群聊的删除记录按成员单独保存，不在消息分表中
+	var deletedIDs []int64
+	err = qMessageDeletion.WithContext(ctx).
+		Where(qMessageDeletion.UserID.Eq(userID), qMessageDeletion.ConvertID.Eq(convertID)).
+		Select(qMessageDeletion.MessageID).Scan(&deletedIDs)
+	if err != nil || len(deletedIDs) == 0 {
+		return messageList, err
+	}
+	deleted := make(map[int64]struct{}, len(deletedIDs))
+	for _, id := range deletedIDs {
+		deleted[id] = struct{}{}
+	}
+	result := make([]*model.Message, 0, len(messageList))
+	for _, message := range messageList {
+		if _, ok := deleted[message.ID]; !ok {
+			result = append(result, message)
+		}
+	}
+
+	return result, nil
+}
+
+// countUnread 统计会话中发送时间晚于readTime、由其他人发送且未被userID删除的消息数
+func countUnread(ctx context.Context, userID int64, convertID string, readTime int64) (int64, error) {
+	var count int64
+	err := db.WithContext(ctx).Model(&model.Message{}).
+		Where("convert_id = ? AND create_time > ? AND from_user_id <> ?", convertID, readTime, userID).
+		Where("NOT (to_user_id = ? AND to_deleted)", userID).
+		Count(&count).Error
+	return count, err
+}
+
+// UpdateMessageStatus 将会话中发给userID且发送时间不晚于before的消息推进到status，返回更新的消息数
+func UpdateMessageStatus(ctx context.Context, convertID string, userID int64, status int16, before int64) (int64, error) {
+	result := db.WithContext(ctx).Model(&model.Message{}).
+		Where("convert_id = ? AND to_user_id = ? AND create_time <= ? AND status < ?", convertID, userID, before, status).
+		Update("status", status)
+	return result.RowsAffected, result.Error
+}
+
+// RecallMessage 撤回用户发送的消息并清空内容和结构化内容，只能撤回发送时间不早于after的消息
+func RecallMessage(ctx context.Context, userID int64, convertID string, messageID, after int64) (*model.Message, error) {
+	message := &model.Message{}
+	err := db.WithContext(ctx).Model(&model.Message{}).Where("convert_id = ? AND id = ?", convertID, messageID).Take(message).Error
+	if err == gorm.ErrRecordNotFound {
+		return nil, ErrMessageNotExist
+	}
+	if err != nil {
+		return nil, err
+	}
+	if message.FromUserID != userID {
+		return nil, ErrMessageNotExist
+	}
+	if message.Status == MessageStatusRecalled {
+		return message, nil
+	}
+	if message.CreateTime < after {
+		return nil, ErrRecallExpired
+	}
+
+	// 条件更新，避免与已读状态的更新相互覆盖
+	result := db.WithContext(ctx).Model(&model.Message{}).
+		Where("convert_id = ? AND id = ? AND status <> ?", convertID, messageID, MessageStatusRecalled).
+		Updates(map[string]any{"status": MessageStatusRecalled, "content": "", "payload": ""})
+	if result.Error != nil {
+		return nil, result.Error
+	}
+	message.Status = MessageStatusRecalled
+	message.Content = ""
+	message.Payload = ""
+	return message, nil
+}
+
+// DeleteMessage 为单聊用户删除消息，只对该用户隐藏，对方仍可见
+func DeleteMessage(ctx context.Context, userID int64, convertID string, messageID int64) error {
+	result := db.WithContext(ctx).Model(&model.Message{}).
+		Where("convert_id = ? AND id = ?", convertID, messageID).
+		Updates(map[string]any{
+			"from_deleted": gorm.Expr("from_deleted OR from_user_id = ?", userID),
+			"to_deleted":   gorm.Expr("to_deleted OR to_user_id = ?", userID),
+		})
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return ErrMessageNotExist
+	}
+	return nil
+}
+
+// DeleteGroupMessage 为群聊成员删除消息，每个成员单独记录，只对该成员隐藏
+func DeleteGroupMessage(ctx context.Context, userID int64, convertID string, messageID int64) error {
+	var count int64
+	err := db.WithContext(ctx).Model(&model.Message{}).Where("convert_id = ? AND id = ?", convertID, messageID).Count(&count).Error
+	if err != nil {
+		return err
+	}
+	if count == 0 {
+		return ErrMessageNotExist
+	}
+
+	// 重复删除时忽略
+	return qMessageDeletion.WithContext(ctx).Clauses(clause.OnConflict{DoNothing: true}).Create(&model.MessageDeletion{
+		ID:         snowflake.GenerateID(),
+		UserID:     userID,
+		ConvertID:  convertID,
+		MessageID:  messageID,
+		CreateTime: time.Now(),
+	})
+}
+
 func GetConvertID(userID, toUserID int64) string {
 	var builder strings.Builder
 	if userID < toUserID {
@@ -8,12 +8,18 @@ const TableNameMessage = "messages"
 
 // Message mapped from table <messages>
 type Message struct {
-	ID         int64  `gorm:"column:id;primaryKey" json:"id"`
-	FromUserID int64  `gorm:"column:from_user_id;not null;comment:发送者ID" json:"from_user_id"` // 发送者ID
-	ToUserID   int64  `gorm:"column:to_user_id;not null;comment:接收者ID" json:"to_user_id"`     // 接收者ID
-	ConvertID  string `gorm:"column:convert_id;not null;comment:会话ID" json:"convert_id"`      // 会话ID
-	Content    string `gorm:"column:content;not null;comment:消息内容" json:"content"`            // 消息内容
-	CreateTime int64  `gorm:"column:create_time;not null;comment:创建时间" json:"create_time"`    // 创建时间
+	ID          int64  `gorm:"column:id;primaryKey" json:"id"`
+	FromUserID  int64  `gorm:"column:from_user_id;not null;comment:发送者ID" json:"from_user_id"`                        // 发送者ID
+	ToUserID    int64  `gorm:"column:to_user_id;not null;comment:接收者ID" json:"to_user_id"`                            // 接收者ID
+	ConvertID   string `gorm:"column:convert_id;not null;comment:会话ID" json:"convert_id"`                             // 会话ID
+	Content     string `gorm:"column:content;not null;comment:消息内容" json:"content"`                                   // 消息内容
+	CreateTime  int64  `gorm:"column:create_time;not null;comment:创建时间" json:"create_time"`                           // 创建时间
+	Status      int16  `gorm:"column:status;not null;comment:状态: 0-已发送, 1-已送达, 2-已读, 3-已撤回" json:"status"`            // 状态: 0-已发送, 1-已送达, 2-已读, 3-已撤回
+	FromDeleted bool   `gorm:"column:from_deleted;not null;comment:发送者是否已删除" json:"from_deleted"`                     // 发送者是否已删除
+	ToDeleted   bool   `gorm:"column:to_deleted;not null;comment:接收者是否已删除" json:"to_deleted"`                         // 接收者是否已删除
+	MsgType     int16  `gorm:"column:msg_type;not null;comment:消息类型: 0-文本, 1-图片, 2-视频分享, 3-名片, 4-表情" json:"msg_type"` // 消息类型: 0-文本, 1-图片, 2-视频分享, 3-名片, 4-表情
+	GroupID     int64  `gorm:"column:group_id;not null;comment:群聊ID，单聊为0" json:"group_id"`                            // 群聊ID，单聊为0
+	Payload     string `gorm:"column:payload;not null;comment:非文本消息的结构化内容，JSON格式" json:"payload"`                     // 非文本消息的结构化内容，JSON格式
 }
 
 // TableName Message's table name
@@ -17,6 +17,7 @@ type User struct {
 	Avatar          string    `gorm:"column:avatar;not null;comment:头像地址" json:"avatar"`                                     // 头像地址
 	BackgroundImage string    `gorm:"column:background_image;not null;comment:背景图地址" json:"background_image"`                // 背景图地址
 	Signature       string    `gorm:"column:signature;not null;comment:个性签名" json:"signature"`                               // 个性签名
+	MessagePrivacy  int16     `gorm:"column:message_privacy;not null;comment:私信权限" json:"message_privacy"`                   // 私信权限
 	CreateTime      time.Time `gorm:"column:create_time;not null;default:CURRENT_TIMESTAMP;comment:创建时间" json:"create_time"` // 创建时间
 	UpdateTime      time.Time `gorm:"column:update_time;not null;default:CURRENT_TIMESTAMP;comment:更新时间" json:"update_time"` // 更新时间
 }
@@ -18,6 +18,12 @@ type Video struct {
 	CoverURL   string    `gorm:"column:cover_url;not null;comment:封面地址" json:"cover_url"`                               // 封面地址
 	UploadTime time.Time `gorm:"column:upload_time;not null;default:CURRENT_TIMESTAMP;comment:上传时间" json:"upload_time"` // 上传时间
 	Title      string    `gorm:"column:title;not null;comment:标题" json:"title"`                                         // 标题
+	Status     int16     `gorm:"column:status;not null;comment:状态" json:"status"`                                       // 状态
+	HlsURL     string    `gorm:"column:hls_url;not null;comment:HLS播放列表地址" json:"hls_url"`                              // HLS播放列表地址
+	Duration   int32     `gorm:"column:duration;not null;comment:时长，单位毫秒" json:"duration"`                              // 时长，单位毫秒
+	Width      int32     `gorm:"column:width;not null;comment:宽度" json:"width"`                                         // 宽度
+	Height     int32     `gorm:"column:height;not null;comment:高度" json:"height"`                                       // 高度
+	Visibility int16     `gorm:"column:visibility;not null;comment:可见范围" json:"visibility"`                             // 可见范围
 }
 
 // TableName Video's table name
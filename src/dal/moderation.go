@@ -0,0 +1,14 @@
+package dal
+
+import (
+	"context"
+
+	"douyin/src/common/snowflake"
+	"douyin/src/dal/model"
+)
+
+// CreateModerationRecord 记录审核未直接通过的内容，供人工审计
+func CreateModerationRecord(ctx context.Context, record *model.ModerationRecord) error {
+	record.ID = snowflake.GenerateID()
+	return qModeration.WithContext(ctx).Create(record)
+}
@@ -0,0 +1,68 @@
+package dal
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+)
+
+// PresenceTTL 在线状态的有效期，网关需要在过期前续期
+const PresenceTTL = 90 * time.Second
+
+// SetPresence 记录用户在网关实例上在线，同时用于续期
+func SetPresence(ctx context.Context, userIDs []int64, gatewayID string) error {
+	if len(userIDs) == 0 {
+		return nil
+	}
+
+	expireAt := time.Now().Add(PresenceTTL).Unix()
+	pipe := RDB.Pipeline()
+	for _, userID := range userIDs {
+		key := GetRedisKey(KeyChatPresencePF, strconv.FormatInt(userID, 10))
+		pipe.HSet(ctx, key, gatewayID, expireAt)
+		pipe.Expire(ctx, key, PresenceTTL)
+	}
+	_, err := pipe.Exec(ctx)
+	return err
+}
+
+// RemovePresence 用户在网关实例上的连接全部断开
+func RemovePresence(ctx context.Context, userID int64, gatewayID string) error {
+	return RDB.HDel(ctx, GetRedisKey(KeyChatPresencePF, strconv.FormatInt(userID, 10)), gatewayID).Err()
+}
+
+// BatchGetPresence 批量查询用户在线的网关实例，网关异常退出时未删除的记录按过期时间过滤
+func BatchGetPresence(ctx context.Context, userIDs []int64) (map[int64][]string, error) {
+	userIDs = uniqueIDs(userIDs)
+	pipe := RDB.Pipeline()
+	cmds := make([]*redis.MapStringStringCmd, len(userIDs))
+	for i, userID := range userIDs {
+		cmds[i] = pipe.HGetAll(ctx, GetRedisKey(KeyChatPresencePF, strconv.FormatInt(userID, 10)))
+	}
+	if _, err := pipe.Exec(ctx); err != nil && err != redis.Nil {
+		return nil, err
+	}
+
+	now := time.Now().Unix()
+	result := make(map[int64][]string, len(userIDs))
+	for i, userID := range userIDs {
+		for gatewayID, val := range cmds[i].Val() {
+			if expireAt, err := strconv.ParseInt(val, 10, 64); err == nil && expireAt > now {
+				result[userID] = append(result[userID], gatewayID)
+			}
+		}
+	}
+	return result, nil
+}
+
+// PublishGateway 向网关实例的推送频道发布消息，返回收到消息的订阅者数，为0表示网关实例已下线
+func PublishGateway(ctx context.Context, gatewayID string, payload []byte) (int64, error) {
+	return RDB.Publish(ctx, GetRedisKey(KeyChatGatewayPF, gatewayID), payload).Result()
+}
+
+// SubscribeGateway 订阅网关实例的推送频道
+func SubscribeGateway(ctx context.Context, gatewayID string) *redis.PubSub {
+	return RDB.Subscribe(ctx, GetRedisKey(KeyChatGatewayPF, gatewayID))
+}
@@ -0,0 +1,187 @@
+package dal
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"douyin/src/dal/model"
+	"douyin/src/dal/query"
+
+	"github.com/redis/go-redis/v9"
+	"gorm.io/gorm"
+)
+
+// ScheduleVideoPurge 将视频加入墓碑集合并从作者发件箱移除，到purgeAt时再彻底清理
+func ScheduleVideoPurge(ctx context.Context, videoID, authorID int64, purgeAt time.Time) error {
+	pipe := RDB.Pipeline()
+	pipe.SAdd(ctx, GetRedisKey(KeyVideoTombstone), videoID)
+	pipe.ZAdd(ctx, GetRedisKey(KeyVideoPurge), redis.Z{Score: float64(purgeAt.Unix()), Member: videoID})
+	pipe.ZRem(ctx, GetRedisKey(KeyUserOutboxPF, strconv.FormatInt(authorID, 10)), videoID)
+	_, err := pipe.Exec(ctx)
+	return err
+}
+
+// ClaimVideoPurges 取出至多count个已到清理时间的视频，多个实例同时执行时每个视频只会被一个实例取到
+func ClaimVideoPurges(ctx context.Context, now time.Time, count int64) ([]int64, error) {
+	key := GetRedisKey(KeyVideoPurge)
+	members, err := RDB.ZRangeByScore(ctx, key, &redis.ZRangeBy{
+		Min:   "-inf",
+		Max:   strconv.FormatInt(now.Unix(), 10),
+		Count: count,
+	}).Result()
+	if err != nil {
+		return nil, err
+	}
+	if len(members) == 0 {
+		return nil, nil
+	}
+
+	// 删除成功的实例获得清理权
+	pipe := RDB.Pipeline()
+	cmds := make([]*redis.IntCmd, len(members))
+	for i, member := range members {
+		cmds[i] = pipe.ZRem(ctx, key, member)
+	}
+	if _, err := pipe.Exec(ctx); err != nil {
+		return nil, err
+	}
+	videoIDs := make([]int64, 0, len(members))
+	for i, member := range members {
+		if cmds[i].Val() == 0 {
+			continue
+		}
+		videoID, err := strconv.ParseInt(member, 10, 64)
+		if err != nil {
+			continue
+		}
+		videoIDs = append(videoIDs, videoID)
+	}
+
+	return videoIDs, nil
+}
+
+// RetryVideoPurge 清理失败时重新加入等待队列
+func RetryVideoPurge(ctx context.Context, videoID int64, retryAt time.Time) error {
+	return RDB.ZAdd(ctx, GetRedisKey(KeyVideoPurge), redis.Z{Score: float64(retryAt.Unix()), Member: videoID}).Err()
+}
+
+// FilterDeletedVideos 过滤墓碑集合中的视频，保持原有顺序
+func FilterDeletedVideos(ctx context.Context, videoIDs []int64) ([]int64, error) {
+	if len(videoIDs) == 0 {
+		return videoIDs, nil
+	}
+
+	members := make([]interface{}, len(videoIDs))
+	for i, videoID := range videoIDs {
+		members[i] = videoID
+	}
+	deleted, err := RDB.SMIsMember(ctx, GetRedisKey(KeyVideoTombstone), members...).Result()
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]int64, 0, len(videoIDs))
+	for i, videoID := range videoIDs {
+		if !deleted[i] {
+			result = append(result, videoID)
+		}
+	}
+	return result, nil
+}
+
+// PurgeVideo 彻底删除处于已删除状态的视频及其点赞、评论、评论点赞和话题关联，并清理相关缓存、粉丝收件箱和墓碑标记，返回被删除的视频
+func PurgeVideo(ctx context.Context, videoID int64) (*model.Video, error) {
+	var (
+		video      *model.Video
+		userIDs    []int64
+		commentIDs []int64
+	)
+	err := q.Transaction(func(tx *query.Query) (err error) {
+		video, err = tx.Video.WithContext(ctx).Where(tx.Video.ID.Eq(videoID), tx.Video.Status.Eq(VideoStatusDeleted)).First()
+		if err != nil {
+			if err == gorm.ErrRecordNotFound {
+				return ErrVideoNotExist
+			}
+			return err
+		}
+
+		// 删除点赞
+		err = tx.Favorite.WithContext(ctx).Where(tx.Favorite.VideoID.Eq(videoID)).Select(tx.Favorite.UserID).Scan(&userIDs)
+		if err != nil {
+			return err
+		}
+		if _, err := tx.Favorite.WithContext(ctx).Where(tx.Favorite.VideoID.Eq(videoID)).Delete(); err != nil {
+			return err
+		}
+
+		// 删除评论及评论点赞
+		err = tx.Comment.WithContext(ctx).Where(tx.Comment.VideoID.Eq(videoID)).Select(tx.Comment.ID).Scan(&commentIDs)
+		if err != nil {
+			return err
+		}
+		if len(commentIDs) > 0 {
+			if _, err := tx.CommentFavorite.WithContext(ctx).Where(tx.CommentFavorite.CommentID.In(commentIDs...)).Delete(); err != nil {
+				return err
+			}
+			if _, err := tx.Comment.WithContext(ctx).Where(tx.Comment.VideoID.Eq(videoID)).Delete(); err != nil {
+				return err
+			}
+		}
+
+		// 删除话题关联
+		if _, err := tx.VideoTag.WithContext(ctx).Where(tx.VideoTag.VideoID.Eq(videoID)).Delete(); err != nil {
+			return err
+		}
+
+		// 删除视频，作品数和视频信息缓存由Debezium删除
+		_, err = tx.Video.WithContext(ctx).Where(tx.Video.ID.Eq(videoID)).Delete()
+		return err
+	})
+	if err == ErrVideoNotExist {
+		// 上次清理已删除数据库记录但未清理缓存时，重试也要移除墓碑标记
+		if err := RDB.SRem(ctx, GetRedisKey(KeyVideoTombstone), videoID).Err(); err != nil {
+			return nil, err
+		}
+		return nil, ErrVideoNotExist
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	// 删除时只移除了作者发件箱，粉丝收件箱中的记录在数据库删除后一并移除
+	followerIDs, err := FollowerIDList(ctx, video.AuthorID)
+	if err != nil {
+		return nil, err
+	}
+
+	// 删除计数、点赞缓存和热榜记录
+	id := strconv.FormatInt(videoID, 10)
+	pipe := RDB.Pipeline()
+	pipe.Del(ctx, GetRedisKey(KeyVideoFavoriteCountPF, id))
+	pipe.Del(ctx, GetRedisKey(KeyVideoCommentCountPF, id))
+	pipe.Del(ctx, GetRedisKey(KeyVideoProgressPF, id))
+	pipe.Del(ctx, commentHotKey(videoID))
+	removeHotVideo(ctx, pipe, videoID)
+	pipe.Del(ctx, GetRedisKey(KeyUserTotalFavoritedPF, strconv.FormatInt(video.AuthorID, 10)))
+	for _, userID := range userIDs {
+		uid := strconv.FormatInt(userID, 10)
+		pipe.SRem(ctx, GetRedisKey(KeyUserFavoritePF, uid), videoID)
+		pipe.Del(ctx, GetRedisKey(KeyUserFavoriteCountPF, uid))
+	}
+	for _, commentID := range commentIDs {
+		cid := strconv.FormatInt(commentID, 10)
+		pipe.Del(ctx, GetRedisKey(KeyCommentReplyCountPF, cid))
+		pipe.Del(ctx, GetRedisKey(KeyCommentFavoriteCountPF, cid))
+	}
+	for _, followerID := range followerIDs {
+		pipe.ZRem(ctx, GetRedisKey(KeyUserInboxPF, strconv.FormatInt(followerID, 10)), videoID)
+	}
+	// 数据库记录已删除，不再需要墓碑标记
+	pipe.SRem(ctx, GetRedisKey(KeyVideoTombstone), videoID)
+	if _, err := pipe.Exec(ctx); err != nil {
+		return nil, err
+	}
+
+	return video, nil
+}
@@ -30,6 +30,8 @@ func newComment(db *gorm.DB, opts ...gen.DOOption) comment {
 	_comment.ID = field.NewInt64(tableName, "id")
 	_comment.VideoID = field.NewInt64(tableName, "video_id")
 	_comment.UserID = field.NewInt64(tableName, "user_id")
+	_comment.ParentID = field.NewInt64(tableName, "parent_id")
+	_comment.RootID = field.NewInt64(tableName, "root_id")
 	_comment.Content = field.NewString(tableName, "content")
 	_comment.CreateTime = field.NewTime(tableName, "create_time")
 
@@ -45,6 +47,8 @@ type comment struct {
 	ID         field.Int64
 	VideoID    field.Int64  // 视频ID
 	UserID     field.Int64  // 用户ID
+	ParentID   field.Int64  // 回复的评论ID
+	RootID     field.Int64  // 根评论ID
 	Content    field.String // 评论内容
 	CreateTime field.Time   // 创建时间
 
@@ -66,6 +70,8 @@ func (c *comment) updateTableName(table string) *comment {
 	c.ID = field.NewInt64(table, "id")
 	c.VideoID = field.NewInt64(table, "video_id")
 	c.UserID = field.NewInt64(table, "user_id")
+	c.ParentID = field.NewInt64(table, "parent_id")
+	c.RootID = field.NewInt64(table, "root_id")
 	c.Content = field.NewString(table, "content")
 	c.CreateTime = field.NewTime(table, "create_time")
 
@@ -92,10 +98,12 @@ func (c *comment) GetFieldByName(fieldName string) (field.OrderExpr, bool) {
 }
 
 func (c *comment) fillFieldMap() {
-	c.fieldMap = make(map[string]field.Expr, 5)
+	c.fieldMap = make(map[string]field.Expr, 7)
 	c.fieldMap["id"] = c.ID
 	c.fieldMap["video_id"] = c.VideoID
 	c.fieldMap["user_id"] = c.UserID
+	c.fieldMap["parent_id"] = c.ParentID
+	c.fieldMap["root_id"] = c.RootID
 	c.fieldMap["content"] = c.Content
 	c.fieldMap["create_time"] = c.CreateTime
 }
@@ -17,38 +17,62 @@ import (
 
 func Use(db *gorm.DB, opts ...gen.DOOption) *Query {
 	return &Query{
-		db:        db,
-		Comment:   newComment(db, opts...),
-		Favorite:  newFavorite(db, opts...),
-		Message:   newMessage(db, opts...),
-		User:      newUser(db, opts...),
-		UserLogin: newUserLogin(db, opts...),
-		Video:     newVideo(db, opts...),
+		db:               db,
+		ChatGroup:        newChatGroup(db, opts...),
+		Comment:          newComment(db, opts...),
+		CommentFavorite:  newCommentFavorite(db, opts...),
+		Favorite:         newFavorite(db, opts...),
+		GroupBan:         newGroupBan(db, opts...),
+		GroupMember:      newGroupMember(db, opts...),
+		Message:          newMessage(db, opts...),
+		MessageDeletion:  newMessageDeletion(db, opts...),
+		ModerationRecord: newModerationRecord(db, opts...),
+		Tag:              newTag(db, opts...),
+		User:             newUser(db, opts...),
+		UserLogin:        newUserLogin(db, opts...),
+		Video:            newVideo(db, opts...),
+		VideoTag:         newVideoTag(db, opts...),
 	}
 }
 
 type Query struct {
 	db *gorm.DB
 
-	Comment   comment
-	Favorite  favorite
-	Message   message
-	User      user
-	UserLogin userLogin
-	Video     video
+	ChatGroup        chatGroup
+	Comment          comment
+	CommentFavorite  commentFavorite
+	Favorite         favorite
+	GroupBan         groupBan
+	GroupMember      groupMember
+	Message          message
+	MessageDeletion  messageDeletion
+	ModerationRecord moderationRecord
+	Tag              tag
+	User             user
+	UserLogin        userLogin
+	Video            video
+	VideoTag         videoTag
 }
 
 func (q *Query) Available() bool { return q.db != nil }
 
 func (q *Query) clone(db *gorm.DB) *Query {
 	return &Query{
-		db:        db,
-		Comment:   q.Comment.clone(db),
-		Favorite:  q.Favorite.clone(db),
-		Message:   q.Message.clone(db),
-		User:      q.User.clone(db),
-		UserLogin: q.UserLogin.clone(db),
-		Video:     q.Video.clone(db),
+		db:               db,
+		ChatGroup:        q.ChatGroup.clone(db),
+		Comment:          q.Comment.clone(db),
+		CommentFavorite:  q.CommentFavorite.clone(db),
+		Favorite:         q.Favorite.clone(db),
+		GroupBan:         q.GroupBan.clone(db),
+		GroupMember:      q.GroupMember.clone(db),
+		Message:          q.Message.clone(db),
+		MessageDeletion:  q.MessageDeletion.clone(db),
+		ModerationRecord: q.ModerationRecord.clone(db),
+		Tag:              q.Tag.clone(db),
+		User:             q.User.clone(db),
+		UserLogin:        q.UserLogin.clone(db),
+		Video:            q.Video.clone(db),
+		VideoTag:         q.VideoTag.clone(db),
 	}
 }
 
@@ -62,33 +86,57 @@ func (q *Query) WriteDB() *Query {
 
 func (q *Query) ReplaceDB(db *gorm.DB) *Query {
 	return &Query{
-		db:        db,
-		Comment:   q.Comment.replaceDB(db),
-		Favorite:  q.Favorite.replaceDB(db),
-		Message:   q.Message.replaceDB(db),
-		User:      q.User.replaceDB(db),
-		UserLogin: q.UserLogin.replaceDB(db),
-		Video:     q.Video.replaceDB(db),
+		db:               db,
+		ChatGroup:        q.ChatGroup.replaceDB(db),
+		Comment:          q.Comment.replaceDB(db),
+		CommentFavorite:  q.CommentFavorite.replaceDB(db),
+		Favorite:         q.Favorite.replaceDB(db),
+		GroupBan:         q.GroupBan.replaceDB(db),
+		GroupMember:      q.GroupMember.replaceDB(db),
+		Message:          q.Message.replaceDB(db),
+		MessageDeletion:  q.MessageDeletion.replaceDB(db),
+		ModerationRecord: q.ModerationRecord.replaceDB(db),
+		Tag:              q.Tag.replaceDB(db),
+		User:             q.User.replaceDB(db),
+		UserLogin:        q.UserLogin.replaceDB(db),
+		Video:            q.Video.replaceDB(db),
+		VideoTag:         q.VideoTag.replaceDB(db),
 	}
 }
 
 type queryCtx struct {
-	Comment   *commentDo
-	Favorite  *favoriteDo
-	Message   *messageDo
-	User      *userDo
-	UserLogin *userLoginDo
-	Video     *videoDo
+	ChatGroup        *chatGroupDo
+	Comment          *commentDo
+	CommentFavorite  *commentFavoriteDo
+	Favorite         *favoriteDo
+	GroupBan         *groupBanDo
+	GroupMember      *groupMemberDo
+	Message          *messageDo
+	MessageDeletion  *messageDeletionDo
+	ModerationRecord *moderationRecordDo
+	Tag              *tagDo
+	User             *userDo
+	UserLogin        *userLoginDo
+	Video            *videoDo
+	VideoTag         *videoTagDo
 }
 
 func (q *Query) WithContext(ctx context.Context) *queryCtx {
 	return &queryCtx{
-		Comment:   q.Comment.WithContext(ctx),
-		Favorite:  q.Favorite.WithContext(ctx),
-		Message:   q.Message.WithContext(ctx),
-		User:      q.User.WithContext(ctx),
-		UserLogin: q.UserLogin.WithContext(ctx),
-		Video:     q.Video.WithContext(ctx),
+		ChatGroup:        q.ChatGroup.WithContext(ctx),
+		Comment:          q.Comment.WithContext(ctx),
+		CommentFavorite:  q.CommentFavorite.WithContext(ctx),
+		Favorite:         q.Favorite.WithContext(ctx),
+		GroupBan:         q.GroupBan.WithContext(ctx),
+		GroupMember:      q.GroupMember.WithContext(ctx),
+		Message:          q.Message.WithContext(ctx),
+		MessageDeletion:  q.MessageDeletion.WithContext(ctx),
+		ModerationRecord: q.ModerationRecord.WithContext(ctx),
+		Tag:              q.Tag.WithContext(ctx),
+		User:             q.User.WithContext(ctx),
+		UserLogin:        q.UserLogin.WithContext(ctx),
+		Video:            q.Video.WithContext(ctx),
+		VideoTag:         q.VideoTag.WithContext(ctx),
 	}
 }
 
@@ -33,6 +33,12 @@ func newMessage(db *gorm.DB, opts ...gen.DOOption) message {
 	_message.ConvertID = field.NewString(tableName, "convert_id")
 	_message.Content = field.NewString(tableName, "content")
 	_message.CreateTime = field.NewInt64(tableName, "create_time")
+	_message.Status = field.NewInt16(tableName, "status")
+	_message.FromDeleted = field.NewBool(tableName, "from_deleted")
+	_message.ToDeleted = field.NewBool(tableName, "to_deleted")
+	_message.MsgType = field.NewInt16(tableName, "msg_type")
+	_message.GroupID = field.NewInt64(tableName, "group_id")
+	_message.Payload = field.NewString(tableName, "payload")
 
 	_message.fillFieldMap()
 
@@ -42,13 +48,19 @@ func newMessage(db *gorm.DB, opts ...gen.DOOption) message {
 type message struct {
 	messageDo messageDo
 
-	ALL        field.Asterisk
-	ID         field.Int64
-	FromUserID field.Int64  // 发送者ID
-	ToUserID   field.Int64  // 接收者ID
-	ConvertID  field.String // 会话ID
-	Content    field.String // 消息内容
-	CreateTime field.Int64  // 创建时间
+	ALL         field.Asterisk
+	ID          field.Int64
+	FromUserID  field.Int64  // 发送者ID
+	ToUserID    field.Int64  // 接收者ID
+	ConvertID   field.String // 会话ID
+	Content     field.String // 消息内容
+	CreateTime  field.Int64  // 创建时间
+	Status      field.Int16  // 状态: 0-已发送, 1-已送达, 2-已读, 3-已撤回
+	FromDeleted field.Bool   // 发送者是否已删除
+	ToDeleted   field.Bool   // 接收者是否已删除
+	MsgType     field.Int16  // 消息类型: 0-文本, 1-图片, 2-视频分享, 3-名片, 4-表情
+	GroupID     field.Int64  // 群聊ID，单聊为0
+	Payload     field.String // 非文本消息的结构化内容，JSON格式
 
 	fieldMap map[string]field.Expr
 }
@@ -71,6 +83,12 @@ func (m *message) updateTableName(table string) *message {
 	m.ConvertID = field.NewString(table, "convert_id")
 	m.Content = field.NewString(table, "content")
 	m.CreateTime = field.NewInt64(table, "create_time")
+	m.Status = field.NewInt16(table, "status")
+	m.FromDeleted = field.NewBool(table, "from_deleted")
+	m.ToDeleted = field.NewBool(table, "to_deleted")
+	m.MsgType = field.NewInt16(table, "msg_type")
+	m.GroupID = field.NewInt64(table, "group_id")
+	m.Payload = field.NewString(table, "payload")
 
 	m.fillFieldMap()
 
@@ -95,13 +113,19 @@ func (m *message) GetFieldByName(fieldName string) (field.OrderExpr, bool) {
 }
 
 func (m *message) fillFieldMap() {
-	m.fieldMap = make(map[string]field.Expr, 6)
+	m.fieldMap = make(map[string]field.Expr, 12)
 	m.fieldMap["id"] = m.ID
 	m.fieldMap["from_user_id"] = m.FromUserID
 	m.fieldMap["to_user_id"] = m.ToUserID
 	m.fieldMap["convert_id"] = m.ConvertID
 	m.fieldMap["content"] = m.Content
 	m.fieldMap["create_time"] = m.CreateTime
+	m.fieldMap["status"] = m.Status
+	m.fieldMap["from_deleted"] = m.FromDeleted
+	m.fieldMap["to_deleted"] = m.ToDeleted
+	m.fieldMap["msg_type"] = m.MsgType
+	m.fieldMap["group_id"] = m.GroupID
+	m.fieldMap["payload"] = m.Payload
 }
 
 func (m message) clone(db *gorm.DB) message {
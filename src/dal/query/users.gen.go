@@ -32,6 +32,7 @@ func newUser(db *gorm.DB, opts ...gen.DOOption) user {
 	_user.Avatar = field.NewString(tableName, "avatar")
 	_user.BackgroundImage = field.NewString(tableName, "background_image")
 	_user.Signature = field.NewString(tableName, "signature")
+	_user.MessagePrivacy = field.NewInt16(tableName, "message_privacy")
 	_user.CreateTime = field.NewTime(tableName, "create_time")
 	_user.UpdateTime = field.NewTime(tableName, "update_time")
 
@@ -49,6 +50,7 @@ type user struct {
 	Avatar          field.String // 头像地址
 	BackgroundImage field.String // 背景图地址
 	Signature       field.String // 个性签名
+	MessagePrivacy  field.Int16  // 私信权限
 	CreateTime      field.Time   // 创建时间
 	UpdateTime      field.Time   // 更新时间
 
@@ -72,6 +74,7 @@ func (u *user) updateTableName(table string) *user {
 	u.Avatar = field.NewString(table, "avatar")
 	u.BackgroundImage = field.NewString(table, "background_image")
 	u.Signature = field.NewString(table, "signature")
+	u.MessagePrivacy = field.NewInt16(table, "message_privacy")
 	u.CreateTime = field.NewTime(table, "create_time")
 	u.UpdateTime = field.NewTime(table, "update_time")
 
@@ -98,12 +101,13 @@ func (u *user) GetFieldByName(fieldName string) (field.OrderExpr, bool) {
 }
 
 func (u *user) fillFieldMap() {
-	u.fieldMap = make(map[string]field.Expr, 7)
+	u.fieldMap = make(map[string]field.Expr, 8)
 	u.fieldMap["id"] = u.ID
 	u.fieldMap["name"] = u.Name
 	u.fieldMap["avatar"] = u.Avatar
 	u.fieldMap["background_image"] = u.BackgroundImage
 	u.fieldMap["signature"] = u.Signature
+	u.fieldMap["message_privacy"] = u.MessagePrivacy
 	u.fieldMap["create_time"] = u.CreateTime
 	u.fieldMap["update_time"] = u.UpdateTime
 }
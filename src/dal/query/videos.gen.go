@@ -33,6 +33,12 @@ func newVideo(db *gorm.DB, opts ...gen.DOOption) video {
 	_video.CoverURL = field.NewString(tableName, "cover_url")
 	_video.UploadTime = field.NewTime(tableName, "upload_time")
 	_video.Title = field.NewString(tableName, "title")
+	_video.Status = field.NewInt16(tableName, "status")
+	_video.HlsURL = field.NewString(tableName, "hls_url")
+	_video.Duration = field.NewInt32(tableName, "duration")
+	_video.Width = field.NewInt32(tableName, "width")
+	_video.Height = field.NewInt32(tableName, "height")
+	_video.Visibility = field.NewInt16(tableName, "visibility")
 
 	_video.fillFieldMap()
 
@@ -49,6 +55,12 @@ type video struct {
 	CoverURL   field.String // 封面地址
 	UploadTime field.Time   // 上传时间
 	Title      field.String // 标题
+	Status     field.Int16  // 状态
+	HlsURL     field.String // HLS播放列表地址
+	Duration   field.Int32  // 时长，单位毫秒
+	Width      field.Int32  // 宽度
+	Height     field.Int32  // 高度
+	Visibility field.Int16  // 可见范围
 
 	fieldMap map[string]field.Expr
 }
@@ -71,6 +83,12 @@ func (v *video) updateTableName(table string) *video {
 	v.CoverURL = field.NewString(table, "cover_url")
 	v.UploadTime = field.NewTime(table, "upload_time")
 	v.Title = field.NewString(table, "title")
+	v.Status = field.NewInt16(table, "status")
+	v.HlsURL = field.NewString(table, "hls_url")
+	v.Duration = field.NewInt32(table, "duration")
+	v.Width = field.NewInt32(table, "width")
+	v.Height = field.NewInt32(table, "height")
+	v.Visibility = field.NewInt16(table, "visibility")
 
 	v.fillFieldMap()
 
@@ -95,13 +113,19 @@ func (v *video) GetFieldByName(fieldName string) (field.OrderExpr, bool) {
 }
 
 func (v *video) fillFieldMap() {
-	v.fieldMap = make(map[string]field.Expr, 6)
+	v.fieldMap = make(map[string]field.Expr, 12)
 	v.fieldMap["id"] = v.ID
 	v.fieldMap["author_id"] = v.AuthorID
 	v.fieldMap["play_url"] = v.PlayURL
 	v.fieldMap["cover_url"] = v.CoverURL
 	v.fieldMap["upload_time"] = v.UploadTime
 	v.fieldMap["title"] = v.Title
+	v.fieldMap["status"] = v.Status
+	v.fieldMap["hls_url"] = v.HlsURL
+	v.fieldMap["duration"] = v.Duration
+	v.fieldMap["width"] = v.Width
+	v.fieldMap["height"] = v.Height
+	v.fieldMap["visibility"] = v.Visibility
 }
 
 func (v video) clone(db *gorm.DB) video {
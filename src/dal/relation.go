@@ -89,7 +89,8 @@ func UnFollow(ctx context.Context, userID, authorID int64) error {
 	return err
 }
 
-func FollowList(ctx context.Context, userID int64) (followList []int64, err error) {
+// FollowIDList 查询用户全部关注ID
+func FollowIDList(ctx context.Context, userID int64) (followList []int64, err error) {
 	// 使用singleflight防止缓存击穿并减少redis压力
 	key := GetRedisKey(KeyUserFollowPF, strconv.FormatInt(userID, 10))
 	_, err, _ = G.Do(key, func() (interface{}, error) {
@@ -100,27 +101,17 @@ func FollowList(ctx context.Context, userID int64) (followList []int64, err erro
 
 		// 先查询redis缓存
 		userIDs, err := RDB.SMembers(ctx, key).Result()
-		if err == redis.Nil {
+		if err == redis.Nil || (err == nil && len(userIDs) == 0) {
 			// 缓存未命中, 查询数据库
 			var builder strings.Builder
 			builder.WriteString("match (v:user)-[:follow]->(v2:user) where id(v) == ")
 			builder.WriteString(strconv.FormatInt(userID, 10))
 			builder.WriteString(" return id(v2) as followList")
-			resp, err := sessionPool.Execute(builder.String())
-			if err != nil {
-				return nil, err
-			}
-
-			res, err := resp.GetValuesByColName("followList")
+			followList, err = executeIDList(builder.String(), "followList")
 			if err != nil {
 				return nil, err
 			}
 
-			followList = make([]int64, len(res))
-			for i, id := range res {
-				followList[i], _ = id.AsInt()
-			}
-
 			// 写入redis缓存
 			if len(followList) > 0 {
 				RDB.SAdd(ctx, key, followList)
@@ -146,116 +137,176 @@ func FollowList(ctx context.Context, userID int64) (followList []int64, err erro
 	return
 }
 
-func FollowerList(ctx context.Context, userID int64) (followerList []int64, err error) {
-	// 使用singleflight防止缓存击穿并减少redis压力
-	key := GetRedisKey(KeyUserFollowerPF, strconv.FormatInt(userID, 10))
-	_, err, _ = G.Do(key, func() (interface{}, error) {
-		go func() {
-			time.Sleep(DelayTime)
-			G.Forget(key)
-		}()
+// FollowList 按用户ID倒序分页查询关注列表，返回ID小于lastID的至多count个用户
+func FollowList(ctx context.Context, userID, lastID int64, count int) ([]int64, error) {
+	var builder strings.Builder
+	builder.WriteString("match (v:user)-[:follow]->(v2:user) where id(v) == ")
+	builder.WriteString(strconv.FormatInt(userID, 10))
+	builder.WriteString(" and id(v2) < ")
+	builder.WriteString(strconv.FormatInt(lastID, 10))
+	builder.WriteString(" return id(v2) as followList order by followList desc limit ")
+	builder.WriteString(strconv.Itoa(count))
 
-		// 先查询redis缓存
-		userIDs, err := RDB.SMembers(ctx, key).Result()
-		if err == redis.Nil {
-			// 缓存未命中，查询mysql
-			var builder strings.Builder
-			builder.WriteString("match (v:user)<-[:follow]-(v2:user) where id(v) == ")
-			builder.WriteString(strconv.FormatInt(userID, 10))
-			builder.WriteString(" return id(v2) as followerList limit 50")
-			resp, err := sessionPool.Execute(builder.String())
-			if err != nil {
-				return nil, err
-			}
+	return executeIDList(builder.String(), "followList")
+}
 
-			res, err := resp.GetValuesByColName("followerList")
-			if err != nil {
-				return nil, err
-			}
+// FollowerList 按用户ID倒序分页查询粉丝列表
+func FollowerList(ctx context.Context, userID, lastID int64, count int) ([]int64, error) {
+	var builder strings.Builder
+	builder.WriteString("match (v:user)<-[:follow]-(v2:user) where id(v) == ")
+	builder.WriteString(strconv.FormatInt(userID, 10))
+	builder.WriteString(" and id(v2) < ")
+	builder.WriteString(strconv.FormatInt(lastID, 10))
+	builder.WriteString(" return id(v2) as followerList order by followerList desc limit ")
+	builder.WriteString(strconv.Itoa(count))
 
-			followerList = make([]int64, len(res))
-			for i, id := range res {
-				followerList[i], _ = id.AsInt()
-			}
+	return executeIDList(builder.String(), "followerList")
+}
 
-			// 写入redis缓存
-			if len(followerList) > 0 {
-				RDB.SAdd(ctx, key, followerList[:min(len(followerList), 50)])
-				RDB.Expire(ctx, key, ExpireTime+GetRandomTime())
-			}
+// FollowerIDList 查询用户全部粉丝ID，用于Feed推送
+func FollowerIDList(ctx context.Context, userID int64) ([]int64, error) {
+	var builder strings.Builder
+	builder.WriteString("match (v:user)<-[:follow]-(v2:user) where id(v) == ")
+	builder.WriteString(strconv.FormatInt(userID, 10))
+	builder.WriteString(" return id(v2) as followerList")
 
-			return nil, nil
-		} else if err != nil {
-			return nil, err
-		} else {
-			// 缓存命中，转换为int64
-			followerList = make([]int64, len(userIDs))
-			for i, id := range userIDs {
-				userID, err := strconv.ParseInt(id, 10, 64)
-				if err != nil {
-					return nil, err
-				}
-				followerList[i] = userID
-			}
+	return executeIDList(builder.String(), "followerList")
+}
 
-			return nil, nil
-		}
-	})
+// FriendList 按用户ID倒序分页查询好友(互相关注)列表
+func FriendList(ctx context.Context, userID, lastID int64, count int) ([]int64, error) {
+	var builder strings.Builder
+	builder.WriteString("match (v:user)-[:follow]->(v2:user)-[:follow]->(v:user) where id(v) == ")
+	builder.WriteString(strconv.FormatInt(userID, 10))
+	builder.WriteString(" and id(v2) < ")
+	builder.WriteString(strconv.FormatInt(lastID, 10))
+	builder.WriteString(" return id(v2) as friendList order by friendList desc limit ")
+	builder.WriteString(strconv.Itoa(count))
 
-	return
+	return executeIDList(builder.String(), "friendList")
 }
 
-func FriendList(ctx context.Context, userID int64) (friendList []int64, err error) {
-	// 使用singleflight解决缓存击穿并减少redis压力
-	key := GetRedisKey(KeyUserFriendPF, strconv.FormatInt(userID, 10))
-	_, err, _ = G.Do(key, func() (interface{}, error) {
-		go func() {
-			time.Sleep(DelayTime)
-			G.Forget(key)
-		}()
+// executeIDList 执行nGQL并读取一列用户ID
+func executeIDList(stmt, col string) ([]int64, error) {
+	resp, err := sessionPool.Execute(stmt)
+	if err != nil {
+		return nil, err
+	}
 
-		// 先查询redis缓存
-		userIDs, err := RDB.SMembers(ctx, key).Result()
-		if err == redis.Nil {
-			// 缓存未命中，查询mysql
-			var builder strings.Builder
-			builder.WriteString("match (v:user)-[:follow]->(v2:user)-[:follow]->(v:user) where id(v) == ")
-			builder.WriteString(strconv.FormatInt(userID, 10))
-			builder.WriteString(" return id(v2) as friendList")
-			resp, err := sessionPool.Execute(builder.String())
-			if err != nil {
-				return nil, err
-			}
+	res, err := resp.GetValuesByColName(col)
+	if err != nil {
+		return nil, err
+	}
 
-			res, err := resp.GetValuesByColName("friendList")
-			if err != nil {
-				return nil, err
-			}
+	idList := make([]int64, len(res))
+	for i, id := range res {
+		idList[i], _ = id.AsInt()
+	}
 
-			friendList = make([]int64, len(res))
-			for i, id := range res {
-				friendList[i], _ = id.AsInt()
-			}
+	return idList, nil
+}
 
-			return nil, nil
-		} else if err != nil {
-			return nil, err
-		} else {
-			// 缓存命中，转换为int64
-			friendList = make([]int64, len(userIDs))
-			for i, id := range userIDs {
-				userID, err := strconv.ParseInt(id, 10, 64)
-				if err != nil {
-					return nil, err
-				}
-				friendList[i] = userID
-			}
+// BatchCheckRelationExist 批量检查userID是否关注了authorIDs中的用户
+func BatchCheckRelationExist(ctx context.Context, userID int64, authorIDs []int64) (map[int64]bool, error) {
+	followList, err := FollowIDList(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
 
-			return nil, nil
-		}
+	followSet := make(map[int64]struct{}, len(followList))
+	for _, id := range followList {
+		followSet[id] = struct{}{}
+	}
+	result := make(map[int64]bool, len(authorIDs))
+	for _, authorID := range authorIDs {
+		_, result[authorID] = followSet[authorID]
+	}
+
+	return result, nil
+}
+
+// BatchCheckFriend 批量检查userID与authorIDs中的用户是否互相关注
+func BatchCheckFriend(ctx context.Context, userID int64, authorIDs []int64) (map[int64]bool, error) {
+	authorIDs = uniqueIDs(authorIDs)
+	result := make(map[int64]bool, len(authorIDs))
+	if len(authorIDs) == 0 {
+		return result, nil
+	}
+
+	var builder strings.Builder
+	builder.WriteString("match (v:user)-[:follow]->(v2:user)-[:follow]->(v:user) where id(v) == ")
+	builder.WriteString(strconv.FormatInt(userID, 10))
+	builder.WriteString(" and id(v2) in [")
+	builder.WriteString(joinIDs(authorIDs))
+	builder.WriteString("] return id(v2) as friendList")
+	friendList, err := executeIDList(builder.String(), "friendList")
+	if err != nil {
+		return nil, err
+	}
+	for _, id := range friendList {
+		result[id] = true
+	}
+
+	return result, nil
+}
+
+// BatchGetUserFollowCount 批量获取用户关注数
+func BatchGetUserFollowCount(ctx context.Context, userIDs []int64) (map[int64]int64, error) {
+	return batchGetCount(ctx, KeyUserFollowCountPF, userIDs, func(ids []int64) (map[int64]int64, error) {
+		var builder strings.Builder
+		builder.WriteString("match (v:user)-[:follow]->(v2:user) where id(v) in [")
+		builder.WriteString(joinIDs(ids))
+		builder.WriteString("] return id(v) as id, count(*) as cnt")
+		return executeCount(builder.String())
 	})
+}
 
-	return
+// BatchGetUserFollowerCount 批量获取用户粉丝数
+func BatchGetUserFollowerCount(ctx context.Context, userIDs []int64) (map[int64]int64, error) {
+	return batchGetCount(ctx, KeyUserFollowerCountPF, userIDs, func(ids []int64) (map[int64]int64, error) {
+		var builder strings.Builder
+		builder.WriteString("match (v:user)<-[:follow]-(v2:user) where id(v) in [")
+		builder.WriteString(joinIDs(ids))
+		builder.WriteString("] return id(v) as id, count(*) as cnt")
+		return executeCount(builder.String())
+	})
+}
+
+// executeCount 执行按用户分组计数的nGQL，读取id和cnt两列
+func executeCount(stmt string) (map[int64]int64, error) {
+	resp, err := sessionPool.Execute(stmt)
+	if err != nil {
+		return nil, err
+	}
+
+	ids, err := resp.GetValuesByColName("id")
+	if err != nil {
+		return nil, err
+	}
+	cnts, err := resp.GetValuesByColName("cnt")
+	if err != nil {
+		return nil, err
+	}
+
+	counts := make(map[int64]int64, len(ids))
+	for i := range ids {
+		id, _ := ids[i].AsInt()
+		counts[id], _ = cnts[i].AsInt()
+	}
+
+	return counts, nil
+}
+
+// joinIDs 将ID列表拼接为逗号分隔的字符串
+func joinIDs(ids []int64) string {
+	var builder strings.Builder
+	for i, id := range ids {
+		if i > 0 {
+			builder.WriteByte(',')
+		}
+		builder.WriteString(strconv.FormatInt(id, 10))
+	}
+	return builder.String()
 }
 
 func GetUserFollowCount(ctx context.Context, userID int64) (cnt int64, err error) {
@@ -0,0 +1,88 @@
+package dal
+
+import (
+	"context"
+	"strings"
+)
+
+// 搜索索引使用simple分词配置，中文等无空格分隔的文本依赖trigram模糊匹配
+const (
+	syncUserDocumentSQL = `
+		INSERT INTO search_users (id, name, signature, document)
+		SELECT id, name, signature,
+			setweight(to_tsvector('simple', name), 'A') || setweight(to_tsvector('simple', signature), 'B')
+		FROM users WHERE id = ?
+		ON CONFLICT (id) DO UPDATE
+		SET name = EXCLUDED.name, signature = EXCLUDED.signature, document = EXCLUDED.document`
+
+	syncVideoDocumentSQL = `
+		INSERT INTO search_videos (id, author_id, title, upload_time, document)
+		SELECT id, author_id, title, upload_time, to_tsvector('simple', title)
+		FROM videos WHERE id = ? AND status = ? AND visibility = ?
+		ON CONFLICT (id) DO UPDATE
+		SET author_id = EXCLUDED.author_id, title = EXCLUDED.title,
+			upload_time = EXCLUDED.upload_time, document = EXCLUDED.document`
+
+	// 全文检索命中的结果按相关度排在前面，其余按trigram相似度排序
+	searchUsersSQL = `
+		SELECT id FROM search_users, plainto_tsquery('simple', @keyword) query
+		WHERE document @@ query OR name % @keyword OR name ILIKE @pattern
+		ORDER BY ts_rank(document, query) DESC, similarity(name, @keyword) DESC, id DESC
+		LIMIT @limit OFFSET @offset`
+
+	searchVideosSQL = `
+		SELECT id FROM search_videos, plainto_tsquery('simple', @keyword) query
+		WHERE document @@ query OR title %> @keyword OR title ILIKE @pattern
+		ORDER BY ts_rank(document, query) DESC, word_similarity(@keyword, title) DESC, id DESC
+		LIMIT @limit OFFSET @offset`
+)
+
+var likeEscaper = strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`)
+
+// SyncUserDocument 根据users表中的数据更新用户搜索索引
+func SyncUserDocument(ctx context.Context, userID int64) error {
+	return db.WithContext(ctx).Exec(syncUserDocumentSQL, userID).Error
+}
+
+// DeleteUserDocument 删除用户搜索索引
+func DeleteUserDocument(ctx context.Context, userID int64) error {
+	return db.WithContext(ctx).Exec("DELETE FROM search_users WHERE id = ?", userID).Error
+}
+
+// SyncVideoDocument 根据videos表中的数据更新视频搜索索引，只有已发布的公开视频可以被搜索
+func SyncVideoDocument(ctx context.Context, videoID int64) error {
+	result := db.WithContext(ctx).Exec(syncVideoDocumentSQL, videoID, VideoStatusPublished, VideoVisibilityPublic)
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return DeleteVideoDocument(ctx, videoID)
+	}
+	return nil
+}
+
+// DeleteVideoDocument 删除视频搜索索引
+func DeleteVideoDocument(ctx context.Context, videoID int64) error {
+	return db.WithContext(ctx).Exec("DELETE FROM search_videos WHERE id = ?", videoID).Error
+}
+
+// SearchUsers 按相关度搜索用户，返回从offset开始的至多count个用户ID
+func SearchUsers(ctx context.Context, keyword string, offset, count int) ([]int64, error) {
+	return search(ctx, searchUsersSQL, keyword, offset, count)
+}
+
+// SearchVideos 按相关度搜索视频，返回从offset开始的至多count个视频ID
+func SearchVideos(ctx context.Context, keyword string, offset, count int) ([]int64, error) {
+	return search(ctx, searchVideosSQL, keyword, offset, count)
+}
+
+func search(ctx context.Context, sql, keyword string, offset, count int) ([]int64, error) {
+	var ids []int64
+	err := db.WithContext(ctx).Raw(sql, map[string]interface{}{
+		"keyword": keyword,
+		"pattern": "%" + likeEscaper.Replace(keyword) + "%",
+		"limit":   count,
+		"offset":  offset,
+	}).Scan(&ids).Error
+	return ids, err
+}
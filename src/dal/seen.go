@@ -0,0 +1,131 @@
+package dal
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"douyin/src/common/utils"
+	"douyin/src/config"
+
+	"github.com/redis/go-redis/v9"
+)
+
+// 已看视频使用基于Redis Bitmap的布隆过滤器记录，约1万条记录时误判率约1%。
+// 过滤器按时间窗口轮转，每个窗口使用独立的key并在TTL后过期，避免活跃用户的过滤器饱和
+const (
+	seenFilterBits   = 1 << 17
+	seenFilterHashes = 7
+	seenWindow       = 24 * time.Hour
+	defaultSeenTTL   = 7 * 24 * time.Hour
+)
+
+// seenOffsets 使用双重哈希计算视频ID在过滤器中的位置
+func seenOffsets(videoID int64) [seenFilterHashes]int64 {
+	sum := utils.NewDefaultHasher().Sum64(strconv.FormatInt(videoID, 10))
+	h1, h2 := sum&0xffffffff, sum>>32|1
+
+	var offsets [seenFilterHashes]int64
+	for i := range offsets {
+		offsets[i] = int64((h1 + uint64(i)*h2) % seenFilterBits)
+	}
+	return offsets
+}
+
+func seenTTL() time.Duration {
+	if config.Conf.FeedConfig == nil || config.Conf.FeedConfig.SeenTTL <= 0 {
+		return defaultSeenTTL
+	}
+	return config.Conf.FeedConfig.SeenTTL
+}
+
+// seenKeys 返回TTL内仍然有效的各时间窗口过滤器的key，第一个为当前窗口
+func seenKeys(userID int64) []string {
+	window := time.Now().UnixNano() / int64(seenWindow)
+	n := int64((seenTTL() + seenWindow - 1) / seenWindow)
+	keys := make([]string, n)
+	for i := range keys {
+		keys[i] = GetRedisKey(KeyUserSeenPF, strconv.FormatInt(userID, 10), ":", strconv.FormatInt(window-int64(i), 10))
+	}
+	return keys
+}
+
+// FilterSeenVideos 过滤掉用户已经看过的视频，任一时间窗口的过滤器命中都视为看过
+func FilterSeenVideos(ctx context.Context, userID int64, videoIDs []int64) ([]int64, error) {
+	if len(videoIDs) == 0 {
+		return videoIDs, nil
+	}
+
+	// 每个窗口使用一条BITFIELD命令读取全部位置
+	args := make([]interface{}, 0, len(videoIDs)*seenFilterHashes*3)
+	for _, videoID := range videoIDs {
+		for _, offset := range seenOffsets(videoID) {
+			args = append(args, "GET", "u1", offset)
+		}
+	}
+	keys := seenKeys(userID)
+	pipe := RDB.Pipeline()
+	for _, key := range keys {
+		pipe.BitField(ctx, key, args...)
+	}
+	cmds, err := pipe.Exec(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	unseen := make([]int64, 0, len(videoIDs))
+	for i, videoID := range videoIDs {
+		seen := false
+		for _, cmd := range cmds {
+			bits := cmd.(*redis.IntSliceCmd).Val()
+			seen = true
+			for _, bit := range bits[i*seenFilterHashes : (i+1)*seenFilterHashes] {
+				if bit == 0 {
+					seen = false
+					break
+				}
+			}
+			if seen {
+				break
+			}
+		}
+		if !seen {
+			unseen = append(unseen, videoID)
+		}
+	}
+
+	return unseen, nil
+}
+
+// AddSeenVideos 将用户看过的视频记录到当前时间窗口的过滤器
+func AddSeenVideos(ctx context.Context, userID int64, videoIDs []int64) error {
+	if len(videoIDs) == 0 {
+		return nil
+	}
+
+	args := make([]interface{}, 0, len(videoIDs)*seenFilterHashes*4)
+	for _, videoID := range videoIDs {
+		for _, offset := range seenOffsets(videoID) {
+			args = append(args, "SET", "u1", offset, 1)
+		}
+	}
+	key := seenKeys(userID)[0]
+	pipe := RDB.Pipeline()
+	pipe.BitField(ctx, key, args...)
+	pipe.Expire(ctx, key, seenTTL()+seenWindow)
+	_, err := pipe.Exec(ctx)
+
+	return err
+}
+
+// ClearSeenVideos 清空用户已看视频记录
+func ClearSeenVideos(ctx context.Context, userID int64) error {
+	keys := seenKeys(userID)
+	pipe := RDB.Pipeline()
+	for _, key := range keys {
+		pipe.Del(ctx, key)
+	}
+	_, err := pipe.Exec(ctx)
+
+	return err
+}
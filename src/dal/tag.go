@@ -0,0 +1,255 @@
+package dal
+
+import (
+	"context"
+	"sort"
+	"strconv"
+	"time"
+
+	"douyin/src/common/snowflake"
+	"douyin/src/dal/model"
+	"douyin/src/dal/query"
+
+	"github.com/redis/go-redis/v9"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+const (
+	trendingDays     = 7               // 热门话题统计最近几天的使用次数
+	trendingTopN     = 200             // 每天参与合并的话题数
+	topicHotTTL      = 5 * time.Minute // 话题下视频互动热度的缓存时间
+	topicHotLoadSize = 1000            // 重建缓存时按点赞数和评论数各加载的最大视频数
+)
+
+// SaveVideoTags 替换视频的话题，不存在的话题会被创建
+func SaveVideoTags(ctx context.Context, videoID int64, names []string) error {
+	return q.Transaction(func(tx *query.Query) error {
+		if _, err := tx.VideoTag.WithContext(ctx).Where(tx.VideoTag.VideoID.Eq(videoID)).Delete(); err != nil {
+			return err
+		}
+		if len(names) == 0 {
+			return nil
+		}
+
+		// 创建话题，已存在的话题忽略
+		tags := make([]*model.Tag, len(names))
+		for i, name := range names {
+			tags[i] = &model.Tag{ID: snowflake.GenerateID(), Name: name, CreateTime: time.Now()}
+		}
+		if err := tx.Tag.WithContext(ctx).Clauses(clause.OnConflict{DoNothing: true}).Create(tags...); err != nil {
+			return err
+		}
+
+		// 已存在的话题使用原有ID
+		var tagIDs []int64
+		if err := tx.Tag.WithContext(ctx).Where(tx.Tag.Name.In(names...)).Select(tx.Tag.ID).Scan(&tagIDs); err != nil {
+			return err
+		}
+		videoTags := make([]*model.VideoTag, len(tagIDs))
+		for i, tagID := range tagIDs {
+			videoTags[i] = &model.VideoTag{ID: snowflake.GenerateID(), VideoID: videoID, TagID: tagID}
+		}
+		return tx.VideoTag.WithContext(ctx).Create(videoTags...)
+	})
+}
+
+// GetTagByName 通过话题名称查询话题
+func GetTagByName(ctx context.Context, name string) (*model.Tag, error) {
+	tag, err := qTag.WithContext(ctx).Where(qTag.Name.Eq(name)).First()
+	if err == gorm.ErrRecordNotFound {
+		return nil, ErrTagNotExist
+	}
+	return tag, err
+}
+
+// GetVideoTagNames 查询视频的话题名称
+func GetVideoTagNames(ctx context.Context, videoID int64) ([]string, error) {
+	var names []string
+	err := qTag.WithContext(ctx).Select(qTag.Name).
+		Join(qVideoTag, qVideoTag.TagID.EqCol(qTag.ID)).
+		Where(qVideoTag.VideoID.Eq(videoID)).Scan(&names)
+	return names, err
+}
+
+// GetTopicVideoList 按视频ID倒序分页查询话题下的公开视频，返回ID小于lastID的至多count个视频
+func GetTopicVideoList(ctx context.Context, tagID, lastID int64, count int) ([]int64, error) {
+	var videoIDs []int64
+	err := qVideoTag.WithContext(ctx).Select(qVideoTag.VideoID).
+		Join(qVideo, qVideo.ID.EqCol(qVideoTag.VideoID)).
+		Where(qVideoTag.TagID.Eq(tagID), qVideoTag.VideoID.Lt(lastID),
+			qVideo.Status.Eq(VideoStatusPublished), qVideo.Visibility.Eq(VideoVisibilityPublic)).
+		Order(qVideoTag.VideoID.Desc()).Limit(count).Scan(&videoIDs)
+	return videoIDs, err
+}
+
+// GetTopicHotCandidates 获取话题下互动热度最高的至多count个和最新的至多count个公开视频，作为热度排序的候选
+func GetTopicHotCandidates(ctx context.Context, tagID int64, count int) ([]*model.Video, error) {
+	hotIDs, err := getTopicHotVideoIDs(ctx, tagID, count)
+	if err != nil {
+		return nil, err
+	}
+
+	videoList, err := qVideo.WithContext(ctx).Select(qVideo.ID, qVideo.UploadTime).
+		Join(qVideoTag, qVideoTag.VideoID.EqCol(qVideo.ID)).
+		Where(qVideoTag.TagID.Eq(tagID), qVideo.Status.Eq(VideoStatusPublished), qVideo.Visibility.Eq(VideoVisibilityPublic)).
+		Order(qVideo.ID.Desc()).Limit(count).Find()
+	if err != nil {
+		return nil, err
+	}
+
+	// 补充不在最新视频中的热门视频
+	loaded := make(map[int64]struct{}, len(videoList))
+	for _, v := range videoList {
+		loaded[v.ID] = struct{}{}
+	}
+	missIDs := make([]int64, 0, len(hotIDs))
+	for _, id := range hotIDs {
+		if _, ok := loaded[id]; !ok {
+			missIDs = append(missIDs, id)
+		}
+	}
+	if len(missIDs) == 0 {
+		return videoList, nil
+	}
+	hotList, err := qVideo.WithContext(ctx).Select(qVideo.ID, qVideo.UploadTime).
+		Where(qVideo.ID.In(missIDs...), qVideo.Status.Eq(VideoStatusPublished), qVideo.Visibility.Eq(VideoVisibilityPublic)).Find()
+	if err != nil {
+		return nil, err
+	}
+
+	return append(videoList, hotList...), nil
+}
+
+// getTopicHotVideoIDs 获取话题下互动热度最高的至多count个视频，缓存不存在时从数据库重建
+func getTopicHotVideoIDs(ctx context.Context, tagID int64, count int) ([]int64, error) {
+	key := GetRedisKey(KeyTopicHotPF, strconv.FormatInt(tagID, 10))
+	exist, err := RDB.Exists(ctx, key).Result()
+	if err != nil {
+		return nil, err
+	}
+	if exist == 0 {
+		// 使用singleflight避免并发重建
+		_, err, _ = G.Do(key, func() (interface{}, error) {
+			return nil, buildTopicHot(ctx, key, tagID)
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	members, err := RDB.ZRevRange(ctx, key, 0, int64(count)-1).Result()
+	if err != nil {
+		return nil, err
+	}
+	videoIDs := make([]int64, 0, len(members))
+	for _, member := range members {
+		videoID, err := strconv.ParseInt(member, 10, 64)
+		if err != nil {
+			return nil, err
+		}
+		videoIDs = append(videoIDs, videoID)
+	}
+	return videoIDs, nil
+}
+
+// buildTopicHot 统计话题下视频的点赞数和评论数，重建互动热度缓存。
+// 视频可能属于多个话题，不做增量更新，缓存过期后重新统计
+func buildTopicHot(ctx context.Context, key string, tagID int64) error {
+	var favoriteRows []idCount
+	err := qFavorite.WithContext(ctx).
+		Select(qFavorite.VideoID.As("id"), qFavorite.ID.Count().As("cnt")).
+		Join(qVideoTag, qVideoTag.VideoID.EqCol(qFavorite.VideoID)).
+		Where(qVideoTag.TagID.Eq(tagID)).
+		Group(qFavorite.VideoID).Order(qFavorite.ID.Count().Desc()).
+		Limit(topicHotLoadSize).Scan(&favoriteRows)
+	if err != nil {
+		return err
+	}
+
+	var commentRows []idCount
+	err = qComment.WithContext(ctx).
+		Select(qComment.VideoID.As("id"), qComment.ID.Count().As("cnt")).
+		Join(qVideoTag, qVideoTag.VideoID.EqCol(qComment.VideoID)).
+		Where(qVideoTag.TagID.Eq(tagID)).
+		Group(qComment.VideoID).Order(qComment.ID.Count().Desc()).
+		Limit(topicHotLoadSize).Scan(&commentRows)
+	if err != nil {
+		return err
+	}
+
+	heat := make(map[int64]float64, len(favoriteRows)+len(commentRows))
+	for _, row := range favoriteRows {
+		heat[row.ID] += HeatFavorite * float64(row.Cnt)
+	}
+	for _, row := range commentRows {
+		heat[row.ID] += HeatComment * float64(row.Cnt)
+	}
+	if len(heat) == 0 {
+		return nil
+	}
+
+	members := make([]redis.Z, 0, len(heat))
+	for videoID, score := range heat {
+		members = append(members, redis.Z{Score: score, Member: videoID})
+	}
+	pipe := RDB.Pipeline()
+	pipe.ZAdd(ctx, key, members...)
+	pipe.Expire(ctx, key, topicHotTTL)
+	_, err = pipe.Exec(ctx)
+	return err
+}
+
+// IncrTrendingTags 按视频上传当天增加或减少话题的使用次数，公开视频发布时增加，
+// 删除、改为非公开或修改标题时减少原有话题，超出统计范围的日期不再更新
+func IncrTrendingTags(ctx context.Context, names []string, delta float64, uploadTime time.Time) error {
+	if len(names) == 0 || time.Since(uploadTime) > trendingDays*24*time.Hour {
+		return nil
+	}
+
+	key := GetRedisKey(KeyTopicTrendingPF, uploadTime.Format("20060102"))
+	pipe := RDB.Pipeline()
+	for _, name := range names {
+		pipe.ZIncrBy(ctx, key, delta, name)
+	}
+	if delta < 0 {
+		pipe.ZRemRangeByScore(ctx, key, "-inf", "0")
+	}
+	pipe.Expire(ctx, key, (trendingDays+1)*24*time.Hour)
+	_, err := pipe.Exec(ctx)
+	return err
+}
+
+// GetTrendingTags 合并最近trendingDays天的使用次数，返回使用次数最多的count个话题
+func GetTrendingTags(ctx context.Context, count int) ([]redis.Z, error) {
+	// 每天的统计分别存储，逐个读取后合并，避免跨slot的ZUNIONSTORE
+	now := time.Now()
+	pipe := RDB.Pipeline()
+	cmds := make([]*redis.ZSliceCmd, trendingDays)
+	for i := range cmds {
+		key := GetRedisKey(KeyTopicTrendingPF, now.AddDate(0, 0, -i).Format("20060102"))
+		cmds[i] = pipe.ZRevRangeWithScores(ctx, key, 0, trendingTopN-1)
+	}
+	if _, err := pipe.Exec(ctx); err != nil && err != redis.Nil {
+		return nil, err
+	}
+
+	counts := make(map[string]float64)
+	for _, cmd := range cmds {
+		for _, z := range cmd.Val() {
+			counts[z.Member.(string)] += z.Score
+		}
+	}
+	tags := make([]redis.Z, 0, len(counts))
+	for name, cnt := range counts {
+		tags = append(tags, redis.Z{Score: cnt, Member: name})
+	}
+	sort.Slice(tags, func(i, j int) bool {
+		if tags[i].Score != tags[j].Score {
+			return tags[i].Score > tags[j].Score
+		}
+		return tags[i].Member.(string) < tags[j].Member.(string)
+	})
+
+	return tags[:min(count, len(tags))], nil
+}
@@ -0,0 +1,136 @@
+package dal
+
+import (
+	"context"
+	"slices"
+	"strconv"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+)
+
+const (
+	uploadTTL     = 24 * time.Hour   // 未完成的上传任务保留时间
+	uploadLockTTL = 10 * time.Minute // 合并分片的最长耗时
+)
+
+// UploadSession 分片上传任务
+type UploadSession struct {
+	UploadID   string `redis:"upload_id"`
+	UserID     int64  `redis:"user_id"`
+	FileSize   int64  `redis:"file_size"`
+	ChunkSize  int64  `redis:"chunk_size"`
+	ChunkCount int64  `redis:"chunk_count"`
+	Checksum   string `redis:"checksum"` // 整个文件的sha256
+}
+
+// ChunkLength 返回第index个分片应有的长度，最后一个分片可能不足ChunkSize
+func (s *UploadSession) ChunkLength(index int64) int64 {
+	if index == s.ChunkCount-1 {
+		return s.FileSize - index*s.ChunkSize
+	}
+	return s.ChunkSize
+}
+
+func userUploadKey(userID int64, checksum string) string {
+	return GetRedisKey(KeyUserUploadPF, strconv.FormatInt(userID, 10), ":", checksum)
+}
+
+// CreateUploadSession 创建分片上传任务，同时记录用户和文件校验和到任务的映射用于断点续传
+func CreateUploadSession(ctx context.Context, session *UploadSession) error {
+	key := GetRedisKey(KeyUploadSessionPF, session.UploadID)
+	pipe := RDB.Pipeline()
+	pipe.HSet(ctx, key, session)
+	pipe.Expire(ctx, key, uploadTTL)
+	pipe.Set(ctx, userUploadKey(session.UserID, session.Checksum), session.UploadID, uploadTTL)
+	_, err := pipe.Exec(ctx)
+	return err
+}
+
+// GetUploadSession 获取分片上传任务
+func GetUploadSession(ctx context.Context, uploadID string) (*UploadSession, error) {
+	res := RDB.HGetAll(ctx, GetRedisKey(KeyUploadSessionPF, uploadID))
+	if err := res.Err(); err != nil {
+		return nil, err
+	}
+	if len(res.Val()) == 0 {
+		return nil, ErrUploadNotExist
+	}
+
+	session := &UploadSession{}
+	if err := res.Scan(session); err != nil {
+		return nil, err
+	}
+	return session, nil
+}
+
+// FindUploadSession 查找用户对同一文件未完成的上传任务，不存在时返回ErrUploadNotExist
+func FindUploadSession(ctx context.Context, userID int64, checksum string) (*UploadSession, error) {
+	uploadID, err := RDB.Get(ctx, userUploadKey(userID, checksum)).Result()
+	if err == redis.Nil {
+		return nil, ErrUploadNotExist
+	}
+	if err != nil {
+		return nil, err
+	}
+	return GetUploadSession(ctx, uploadID)
+}
+
+// UploadSessionExists 检查分片上传任务是否存在，已完成或过期的任务不存在
+func UploadSessionExists(ctx context.Context, uploadID string) (bool, error) {
+	n, err := RDB.Exists(ctx, GetRedisKey(KeyUploadSessionPF, uploadID)).Result()
+	return n > 0, err
+}
+
+// MarkChunkUploaded 记录分片已上传，同时延长任务的过期时间，
+// 任务信息和已上传分片同时过期，避免任务还在而分片记录已丢失
+func MarkChunkUploaded(ctx context.Context, session *UploadSession, index int64) error {
+	key := GetRedisKey(KeyUploadChunksPF, session.UploadID)
+	pipe := RDB.Pipeline()
+	pipe.SAdd(ctx, key, index)
+	pipe.Expire(ctx, key, uploadTTL)
+	pipe.Expire(ctx, GetRedisKey(KeyUploadSessionPF, session.UploadID), uploadTTL)
+	pipe.Expire(ctx, userUploadKey(session.UserID, session.Checksum), uploadTTL)
+	_, err := pipe.Exec(ctx)
+	return err
+}
+
+// GetUploadedChunks 获取已上传的分片序号，按升序返回
+func GetUploadedChunks(ctx context.Context, uploadID string) ([]int64, error) {
+	members, err := RDB.SMembers(ctx, GetRedisKey(KeyUploadChunksPF, uploadID)).Result()
+	if err != nil {
+		return nil, err
+	}
+
+	chunks := make([]int64, 0, len(members))
+	for _, member := range members {
+		index, err := strconv.ParseInt(member, 10, 64)
+		if err != nil {
+			return nil, err
+		}
+		chunks = append(chunks, index)
+	}
+	slices.Sort(chunks)
+	return chunks, nil
+}
+
+// LockUpload 合并分片前加锁，防止同一任务被重复提交
+func LockUpload(ctx context.Context, uploadID string) (bool, error) {
+	return RDB.SetNX(ctx, GetRedisKey(KeyUploadLockPF, uploadID), 1, uploadLockTTL).Result()
+}
+
+// UnlockUpload 释放合并分片的锁
+func UnlockUpload(ctx context.Context, uploadID string) error {
+	return RDB.Del(ctx, GetRedisKey(KeyUploadLockPF, uploadID)).Err()
+}
+
+// DeleteUploadSession 删除分片上传任务
+func DeleteUploadSession(ctx context.Context, session *UploadSession) error {
+	pipe := RDB.Pipeline()
+	pipe.Del(ctx, GetRedisKey(KeyUploadSessionPF, session.UploadID))
+	pipe.Del(ctx, GetRedisKey(KeyUploadChunksPF, session.UploadID))
+	pipe.Del(ctx, GetRedisKey(KeyUploadLockPF, session.UploadID))
+	pipe.Del(ctx, userUploadKey(session.UserID, session.Checksum))
+	_, err := pipe.Exec(ctx)
+	return err
+}
@@ -16,6 +16,12 @@ import (
 	"gorm.io/gorm"
 )
 
+// 用户私信权限
+const (
+	MessagePrivacyAll     int16 = 0 // 所有人可以私信
+	MessagePrivacyFriends int16 = 1 // 仅好友(互相关注)可以私信
+)
+
 // GetUserByID 用户通过作者id查询作者信息
 func GetUserByID(ctx context.Context, authorID int64) (user *model.User, err error) {
 	// 先判断布隆过滤器中是否存在
@@ -77,6 +83,42 @@ func GetUserByID(ctx context.Context, authorID int64) (user *model.User, err err
 	return
 }
 
+// GetUserList 批量查询用户信息，返回结果与userIDs顺序一致
+func GetUserList(ctx context.Context, userIDs []int64) ([]*model.User, error) {
+	// 先判断布隆过滤器中是否存在
+	for _, userID := range userIDs {
+		if !bloomFilter.Test([]byte(strconv.FormatInt(userID, 10))) {
+			return nil, ErrUserNotExist
+		}
+	}
+
+	users, err := batchGetObject(ctx, KeyUserInfoPF, userIDs, func(ids []int64) (map[int64]*model.User, error) {
+		userList, err := qUser.WithContext(ctx).Where(qUser.ID.In(ids...)).Find()
+		if err != nil {
+			return nil, err
+		}
+		users := make(map[int64]*model.User, len(userList))
+		for _, user := range userList {
+			users[user.ID] = user
+		}
+		return users, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	userList := make([]*model.User, len(userIDs))
+	for i, userID := range userIDs {
+		user, ok := users[userID]
+		if !ok {
+			return nil, ErrUserNotExist
+		}
+		userList[i] = user
+	}
+
+	return userList, nil
+}
+
 // GetUserLoginByName 根据用户名查询用户密码, 如果用户不存在则返回nil
 func GetUserLoginByName(ctx context.Context, username string) *model.UserLogin {
 	// 先判断布隆过滤器中是否存在
@@ -124,3 +166,15 @@ func CreateUser(ctx context.Context, username, password string) (userID int64, e
 
 	return
 }
+
+// UpdateMessagePrivacy 更新用户的私信权限，用户信息缓存由Debezium删除
+func UpdateMessagePrivacy(ctx context.Context, userID int64, privacy int16) error {
+	info, err := qUser.WithContext(ctx).Where(qUser.ID.Eq(userID)).UpdateSimple(qUser.MessagePrivacy.Value(privacy))
+	if err != nil {
+		return err
+	}
+	if info.RowsAffected == 0 {
+		return ErrUserNotExist
+	}
+	return nil
+}
@@ -13,12 +13,28 @@ import (
 	"github.com/cloudwego/kitex/pkg/klog"
 	"github.com/redis/go-redis/v9"
 	"github.com/vmihailenco/msgpack/v5"
+	"gorm.io/gen/field"
 	"gorm.io/gorm"
 )
 
 const (
-	videoPrefix = "http://oss.chuxin0816.com/video/"
-	imagePrefix = "http://oss.chuxin0816.com/image/"
+	maxFeedRounds = 5
+)
+
+// 视频状态
+const (
+	VideoStatusUploading  int16 = 0 // 上传中
+	VideoStatusProcessing int16 = 1 // 转码中
+	VideoStatusPublished  int16 = 2 // 已发布
+	VideoStatusFailed     int16 = 3 // 处理失败
+	VideoStatusDeleted    int16 = 4 // 已删除
+)
+
+// 视频可见范围
+const (
+	VideoVisibilityPublic  int16 = 0 // 公开
+	VideoVisibilityFriends int16 = 1 // 好友(互相关注)可见
+	VideoVisibilityPrivate int16 = 2 // 仅自己可见
 )
 
 // GetVideoByID 通过视频ID查询视频信息
@@ -76,44 +92,198 @@ func GetVideoByID(ctx context.Context, videoID int64) (video *model.Video, err e
 	return
 }
 
+// GetVideoList 批量查询视频信息，返回结果与videoIDs顺序一致，已被彻底删除的视频会被跳过
 func GetVideoList(ctx context.Context, videoIDs []int64) ([]*model.Video, error) {
-	videoList := make([]*model.Video, len(videoIDs))
-	for i, videoID := range videoIDs {
-		video, err := GetVideoByID(ctx, videoID)
+	videos, err := batchGetObject(ctx, KeyVideoInfoPF, videoIDs, func(ids []int64) (map[int64]*model.Video, error) {
+		videoList, err := qVideo.WithContext(ctx).Where(qVideo.ID.In(ids...)).Find()
 		if err != nil {
 			return nil, err
 		}
-		videoList[i] = video
+		videos := make(map[int64]*model.Video, len(videoList))
+		for _, video := range videoList {
+			videos[video.ID] = video
+		}
+		return videos, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	videoList := make([]*model.Video, 0, len(videoIDs))
+	for _, videoID := range videoIDs {
+		video, ok := videos[videoID]
+		if !ok {
+			continue
+		}
+		videoList = append(videoList, video)
 	}
 
 	return videoList, nil
 }
 
-// GetFeedList 获取视频Feed流
-func GetFeedList(ctx context.Context, userID *int64, latestTime time.Time, count int) ([]int64, error) {
-	// 查询Feed流ID列表
-	var feedIDs []int64
-	if err := qVideo.WithContext(ctx).Where(qVideo.UploadTime.Lt(latestTime)).Select(qVideo.ID).Limit(count).Scan(&feedIDs); err != nil {
-		return nil, err
+// GetFeedList 获取视频Feed流，只包含公开视频，登录用户会跳过已经看过的视频。
+// nextTime为最后一个被扫描的视频的投稿时间，即使全部被过滤也可以继续向更早的视频翻页，没有更多视频时为零值
+func GetFeedList(ctx context.Context, userID *int64, latestTime time.Time, count int) (feedIDs []int64, nextTime time.Time, err error) {
+	feedIDs = make([]int64, 0, count)
+	// 已看视频被过滤后继续向更早的视频翻页，最多查询maxFeedRounds轮
+	for round := 0; round < maxFeedRounds && len(feedIDs) < count; round++ {
+		videos, err := qVideo.WithContext(ctx).
+			Where(qVideo.UploadTime.Lt(latestTime), qVideo.Status.Eq(VideoStatusPublished), qVideo.Visibility.Eq(VideoVisibilityPublic)).
+			Select(qVideo.ID, qVideo.UploadTime).Order(qVideo.UploadTime.Desc()).Limit(count).Find()
+		if err != nil {
+			return nil, time.Time{}, err
+		}
+		if len(videos) == 0 {
+			break
+		}
+
+		videoIDs := make([]int64, len(videos))
+		for i, v := range videos {
+			videoIDs[i] = v.ID
+		}
+		if userID != nil {
+			videoIDs, err = FilterSeenVideos(ctx, *userID, videoIDs)
+			if err != nil {
+				return nil, time.Time{}, err
+			}
+		}
+		unseen := make(map[int64]struct{}, len(videoIDs))
+		for _, id := range videoIDs {
+			unseen[id] = struct{}{}
+		}
+		for _, v := range videos {
+			if len(feedIDs) == count {
+				break
+			}
+			nextTime = v.UploadTime
+			if _, ok := unseen[v.ID]; ok {
+				feedIDs = append(feedIDs, v.ID)
+			}
+		}
+
+		if len(videos) < count {
+			break
+		}
+		latestTime = videos[len(videos)-1].UploadTime
+	}
+
+	// 记录已看视频
+	if userID != nil {
+		if err := AddSeenVideos(ctx, *userID, feedIDs); err != nil {
+			return nil, time.Time{}, err
+		}
 	}
 
-	return feedIDs, nil
+	return feedIDs, nextTime, nil
 }
 
-// SaveVideo 保存视频信息到数据库
-func SaveVideo(ctx context.Context, userID int64, videoName, coverName, title string) error {
+// GetFeedCandidates 获取推荐Feed的候选视频，只包含公开视频，按投稿时间倒序
+func GetFeedCandidates(ctx context.Context, latestTime time.Time, count int) ([]*model.Video, error) {
+	return qVideo.WithContext(ctx).
+		Where(qVideo.UploadTime.Lt(latestTime), qVideo.Status.Eq(VideoStatusPublished), qVideo.Visibility.Eq(VideoVisibilityPublic)).
+		Select(qVideo.ID, qVideo.AuthorID, qVideo.UploadTime).
+		Order(qVideo.UploadTime.Desc()).Limit(count).Find()
+}
+
+// SaveVideo 保存视频信息到数据库，视频处于上传中状态
+func SaveVideo(ctx context.Context, userID int64, playURL, coverURL, title string, visibility int16) (*model.Video, error) {
 	video := &model.Video{
 		ID:         snowflake.GenerateID(),
 		AuthorID:   userID,
-		PlayURL:    videoPrefix + videoName,
-		CoverURL:   imagePrefix + coverName,
+		PlayURL:    playURL,
+		CoverURL:   coverURL,
 		UploadTime: time.Now(),
 		Title:      title,
+		Status:     VideoStatusUploading,
+		Visibility: visibility,
 	}
 	// 添加到布隆过滤器
 	bloomFilter.Add([]byte(strconv.FormatInt(video.ID, 10)))
 
-	return qVideo.WithContext(ctx).Create(video)
+	if err := qVideo.WithContext(ctx).Create(video); err != nil {
+		return nil, err
+	}
+
+	return video, nil
+}
+
+// UpdateVideoStatus 仅当视频当前状态属于from时更新为to，返回是否更新成功
+func UpdateVideoStatus(ctx context.Context, videoID int64, from []int16, to int16) (bool, error) {
+	info, err := qVideo.WithContext(ctx).Where(qVideo.ID.Eq(videoID), qVideo.Status.In(from...)).
+		UpdateSimple(qVideo.Status.Value(to))
+	if err != nil {
+		return false, err
+	}
+	return info.RowsAffected > 0, nil
+}
+
+// UpdateVideoMedia 保存转码后的视频元信息
+func UpdateVideoMedia(ctx context.Context, video *model.Video) error {
+	_, err := qVideo.WithContext(ctx).Where(qVideo.ID.Eq(video.ID)).
+		UpdateSimple(
+			qVideo.HlsURL.Value(video.HlsURL),
+			qVideo.Duration.Value(video.Duration),
+			qVideo.Width.Value(video.Width),
+			qVideo.Height.Value(video.Height),
+		)
+	return err
+}
+
+// UpdateVideoCover 更新已发布视频的封面地址，视频信息缓存由Debezium删除，返回是否更新成功
+func UpdateVideoCover(ctx context.Context, videoID int64, coverURL string) (bool, error) {
+	info, err := qVideo.WithContext(ctx).Where(qVideo.ID.Eq(videoID), qVideo.Status.Eq(VideoStatusPublished)).
+		UpdateSimple(qVideo.CoverURL.Value(coverURL))
+	if err != nil {
+		return false, err
+	}
+	return info.RowsAffected > 0, nil
+}
+
+// UpdateVideoInfo 更新未删除视频的标题和可见范围，参数为nil时不修改，视频信息缓存由Debezium删除，返回是否更新成功
+func UpdateVideoInfo(ctx context.Context, videoID int64, title *string, visibility *int16) (bool, error) {
+	var columns []field.AssignExpr
+	if title != nil {
+		columns = append(columns, qVideo.Title.Value(*title))
+	}
+	if visibility != nil {
+		columns = append(columns, qVideo.Visibility.Value(*visibility))
+	}
+	if len(columns) == 0 {
+		return true, nil
+	}
+
+	info, err := qVideo.WithContext(ctx).Where(qVideo.ID.Eq(videoID), qVideo.Status.Neq(VideoStatusDeleted)).
+		UpdateSimple(columns...)
+	if err != nil {
+		return false, err
+	}
+	return info.RowsAffected > 0, nil
+}
+
+// GetVideoStatus 查询视频作者、封面、上传时间、可见范围和当前状态，不经过缓存以便轮询
+func GetVideoStatus(ctx context.Context, videoID int64) (*model.Video, error) {
+	video, err := qVideo.WithContext(ctx).Where(qVideo.ID.Eq(videoID)).
+		Select(qVideo.ID, qVideo.AuthorID, qVideo.CoverURL, qVideo.UploadTime, qVideo.Visibility, qVideo.Status).First()
+	if err == gorm.ErrRecordNotFound {
+		return nil, ErrVideoNotExist
+	}
+	return video, err
+}
+
+// SetVideoProgress 记录视频处理进度，取值0-100
+func SetVideoProgress(ctx context.Context, videoID int64, progress int32) error {
+	key := GetRedisKey(KeyVideoProgressPF, strconv.FormatInt(videoID, 10))
+	return RDB.Set(ctx, key, progress, ExpireTime).Err()
+}
+
+// GetVideoProgress 获取视频处理进度，没有记录时返回0
+func GetVideoProgress(ctx context.Context, videoID int64) (int32, error) {
+	key := GetRedisKey(KeyVideoProgressPF, strconv.FormatInt(videoID, 10))
+	progress, err := RDB.Get(ctx, key).Int()
+	if err == redis.Nil {
+		return 0, nil
+	}
+	return int32(progress), err
 }
 
 // GetUserTotalFavorited 获取用户发布的视频ID列表
@@ -166,8 +336,21 @@ func GetUserTotalFavorited(ctx context.Context, userID int64) (total int64, err
 	return
 }
 
-// GetPublishList 获取用户发布的视频列表
-func GetPublishList(ctx context.Context, authorID int64) ([]int64, error) {
+// GetPublishList 按视频ID倒序分页查询用户发布的可见范围属于visibilities的视频，返回ID小于lastID的至多count个视频
+func GetPublishList(ctx context.Context, authorID, lastID int64, count int, visibilities []int16) ([]int64, error) {
+	var videoIDs []int64
+	err := qVideo.WithContext(ctx).
+		Where(qVideo.AuthorID.Eq(authorID), qVideo.ID.Lt(lastID), qVideo.Status.Eq(VideoStatusPublished), qVideo.Visibility.In(visibilities...)).
+		Select(qVideo.ID).Order(qVideo.ID.Desc()).Limit(count).Scan(&videoIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	return videoIDs, nil
+}
+
+// GetPublishIDList 获取用户发布的全部视频ID
+func GetPublishIDList(ctx context.Context, authorID int64) ([]int64, error) {
 	var videoIDs []int64
 	err := qVideo.WithContext(ctx).Where(qVideo.AuthorID.Eq(authorID)).Select(qVideo.ID).Scan(&videoIDs)
 	if err != nil {
@@ -197,7 +380,7 @@ func GetUserWorkCount(ctx context.Context, userID int64) (cnt int64, err error)
 		cnt, err = RDB.Get(ctx, key).Int64()
 		if err == redis.Nil {
 			// 缓存未命中，查询mysql
-			cnt, err = qVideo.WithContext(ctx).Where(qVideo.AuthorID.Eq(userID)).Count()
+			cnt, err = qVideo.WithContext(ctx).Where(qVideo.AuthorID.Eq(userID), qVideo.Status.Eq(VideoStatusPublished)).Count()
 			if err != nil {
 				return nil, err
 			}
@@ -222,7 +405,16 @@ func CheckVideoExist(ctx context.Context, videoID int64) (bool, error) {
 		return false, nil
 	}
 
-	_, err := qVideo.WithContext(ctx).Where(qVideo.ID.Eq(videoID)).Select(qVideo.ID).First()
+	// 布隆过滤器无法删除元素，已删除的视频记录在墓碑集合中
+	deleted, err := RDB.SIsMember(ctx, GetRedisKey(KeyVideoTombstone), videoID).Result()
+	if err != nil {
+		return false, err
+	}
+	if deleted {
+		return false, nil
+	}
+
+	_, err = qVideo.WithContext(ctx).Where(qVideo.ID.Eq(videoID), qVideo.Status.Eq(VideoStatusPublished)).Select(qVideo.ID).First()
 	if err != nil {
 		if err == gorm.ErrRecordNotFound {
 			return false, nil
@@ -240,3 +432,43 @@ func GetAuthorID(ctx context.Context, videoID int64) (int64, error) {
 
 	return authorID, err
 }
+
+// BatchGetUserWorkCount 批量获取用户作品数
+func BatchGetUserWorkCount(ctx context.Context, userIDs []int64) (map[int64]int64, error) {
+	return batchGetCount(ctx, KeyUserWorkCountPF, userIDs, func(ids []int64) (map[int64]int64, error) {
+		var rows []idCount
+		err := qVideo.WithContext(ctx).Select(qVideo.AuthorID.As("id"), qVideo.ID.Count().As("cnt")).
+			Where(qVideo.AuthorID.In(ids...), qVideo.Status.Eq(VideoStatusPublished)).Group(qVideo.AuthorID).Scan(&rows)
+		if err != nil {
+			return nil, err
+		}
+		return countMap(rows), nil
+	})
+}
+
+// BatchGetUserTotalFavorited 批量获取用户总获赞数
+func BatchGetUserTotalFavorited(ctx context.Context, userIDs []int64) (map[int64]int64, error) {
+	return batchGetCount(ctx, KeyUserTotalFavoritedPF, userIDs, func(ids []int64) (map[int64]int64, error) {
+		// 查询用户发布列表
+		videos, err := qVideo.WithContext(ctx).Where(qVideo.AuthorID.In(ids...)).
+			Select(qVideo.ID, qVideo.AuthorID).Find()
+		if err != nil {
+			return nil, err
+		}
+		videoIDs := make([]int64, len(videos))
+		for i, video := range videos {
+			videoIDs[i] = video.ID
+		}
+
+		// 查询用户发布视频的点赞数
+		favoriteCnt, err := BatchGetVideoFavoriteCount(ctx, videoIDs)
+		if err != nil {
+			return nil, err
+		}
+		totals := make(map[int64]int64, len(ids))
+		for _, video := range videos {
+			totals[video.AuthorID] += favoriteCnt[video.ID]
+		}
+		return totals, nil
+	})
+}
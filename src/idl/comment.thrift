@@ -7,6 +7,11 @@ struct Comment {
   2: user.User user; // 评论用户信息
   3: string content; // 评论内容
   4: string create_date; // 评论发布日期，格式 mm-dd
+  5: i64 parent_id; // 回复的评论id，0表示一级评论
+  6: i64 root_id; // 所属根评论id，0表示一级评论
+  7: i64 reply_count; // 回复数，仅一级评论返回
+  8: i64 favorite_count; // 点赞数
+  9: bool is_favorite; // true-已点赞，false-未点赞
 }
 
 struct Comment_action_request {
@@ -15,6 +20,7 @@ struct Comment_action_request {
   3: i64 action_type; // 1-发布评论，2-删除评论
   4: optional string comment_text; // 用户填写的评论内容，在action_type=1的时候使用
   5: optional i64 comment_id; // 要删除的评论id，在action_type=2的时候使用
+  6: optional i64 parent_id; // 回复的评论id，在action_type=1且回复评论的时候使用
 }
 
 struct Comment_action_response {
@@ -26,16 +32,50 @@ struct Comment_action_response {
 struct Comment_list_request {
   1: optional i64 user_id; // 用户id
   2: i64 video_id; // 视频id
+  3: optional string cursor; // 分页游标，不填表示第一页
+  4: i32 count; // 每页数量，不填默认30，最大100
+  5: optional string sort; // 排序方式，hot-按热度，new-按发布时间(默认)
 }
 
 struct Comment_list_response {
   1: i32 status_code; // 状态码，0-成功，其他值-失败
   2: optional string status_msg; // 返回状态描述
   3: list<Comment> comment_list; // 评论列表
+  4: optional string next_cursor; // 下一页游标
+  5: bool has_more; // 是否还有更多
+}
+
+struct Comment_reply_list_request {
+  1: optional i64 user_id; // 用户id
+  2: i64 comment_id; // 根评论id
+  3: optional string cursor; // 分页游标，不填表示第一页
+  4: i32 count; // 每页数量，不填默认30，最大100
+}
+
+struct Comment_reply_list_response {
+  1: i32 status_code; // 状态码，0-成功，其他值-失败
+  2: optional string status_msg; // 返回状态描述
+  3: list<Comment> comment_list; // 回复列表
+  4: optional string next_cursor; // 下一页游标
+  5: bool has_more; // 是否还有更多
+}
+
+struct Comment_favorite_action_request {
+  1: i64 user_id; // 用户id
+  2: i64 comment_id; // 评论id
+  3: i64 action_type; // 1-点赞，2-取消点赞
+}
+
+struct Comment_favorite_action_response {
+  1: i32 status_code; // 状态码，0-成功，其他值-失败
+  2: optional string status_msg; // 返回状态描述
 }
 
 service CommentService {
     Comment_action_response CommentAction(1: Comment_action_request req)
     Comment_list_response CommentList(1: Comment_list_request req)
+    Comment_reply_list_response CommentReplyList(1: Comment_reply_list_request req)
+    Comment_favorite_action_response CommentFavoriteAction(1: Comment_favorite_action_request req)
     i64 CommentCnt(1: i64 video_id)
+    map<i64, i64> BatchCommentCnt(1: list<i64> video_ids)
 }
\ No newline at end of file
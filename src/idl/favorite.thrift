@@ -16,12 +16,16 @@ struct Favorite_action_response {
 struct Favorite_list_request {
   1: optional i64 user_id; // 用户id
   2: i64 author_id; // 对方用户id
+  3: optional string cursor; // 分页游标，不填表示第一页
+  4: i32 count; // 每页数量，不填默认30，最大100
 }
 
 struct Favorite_list_response {
   1: i32 status_code; // 状态码，0-成功，其他值-失败
   2: optional string status_msg; // 返回状态描述
   3: list<video.Video> video_list; // 用户点赞视频列表
+  4: optional string next_cursor; // 下一页游标
+  5: bool has_more; // 是否还有更多
 }
 
 service FavoriteService {
@@ -30,4 +34,8 @@ service FavoriteService {
     i64 FavoriteCnt(1: i64 user_id);
     i64 TotalFavoritedCnt(1: i64 user_id);
     bool FavoriteExist(1: i64 user_id, 2: i64 video_id);
+    map<i64, i64> BatchFavoriteCnt(1: list<i64> video_ids);
+    map<i64, bool> BatchFavoriteExist(1: i64 user_id, 2: list<i64> video_ids);
+    map<i64, i64> BatchUserFavoriteCnt(1: list<i64> user_ids);
+    map<i64, i64> BatchTotalFavoritedCnt(1: list<i64> user_ids);
 }
\ No newline at end of file
@@ -1,29 +1,52 @@
+include "user.thrift"
+include "video.thrift"
+
 namespace go message
 
+struct Message_payload {
+  1: optional string image_url; // 图片地址，图片消息
+  2: optional i32 width; // 图片宽度
+  3: optional i32 height; // 图片高度
+  4: optional i64 video_id; // 分享的视频id，视频分享消息
+  5: optional i64 share_user_id; // 分享的用户id，名片消息
+  6: optional string sticker_id; // 表情id，表情消息
+}
+
 struct Message {
   1: i64 id; // 消息id
   2: i64 to_user_id; // 该消息接收者的id
   3: i64 from_user_id; // 该消息发送者的id
   4: string content; // 消息内容
   5: i64 create_time; // 消息发送时间，时间戳
+  6: i32 status; // 消息状态，0-已发送，1-已送达，2-已读，3-已撤回
+  7: i32 msg_type; // 消息类型，0-文本，1-图片，2-视频分享，3-名片，4-表情
+  8: optional Message_payload payload; // 非文本消息的结构化内容
+  9: optional video.Video video; // 分享的视频预览，仅聊天记录中返回
+  10: optional user.User share_user; // 分享的用户预览，仅聊天记录中返回
+  11: i64 group_id; // 群聊id，单聊消息为0
 }
 
 struct Message_action_request {
   1: i64 user_id; // 用户id
   2: i64 to_user_id; // 对方用户id
   3: i64 action_type; // 1-发送消息
-  4: string content; // 消息内容
+  4: string content; // 消息内容，文本消息必填
+  5: i32 msg_type; // 消息类型，0-文本，1-图片，2-视频分享，3-名片，4-表情
+  6: optional Message_payload payload; // 非文本消息的结构化内容
+  7: optional i64 group_id; // 群聊id，填写时发送到群聊，忽略to_user_id
 }
 
 struct Message_action_response {
   1: i32 status_code; // 状态码，0-成功，其他值-失败
   2: optional string status_msg; // 返回状态描述
+  3: Message message; // 已发送的消息
 }
 
 struct Message_chat_request {
   1: i64 user_id; // 用户id
   2: i64 to_user_id; // 对方用户id
   3: i64 last_time;//上次最新消息的时间（新增字段-apk更新中）
+  4: optional i64 group_id; // 群聊id，填写时查询群聊记录，忽略to_user_id
 }
 
 struct Message_chat_response {
@@ -32,7 +55,160 @@ struct Message_chat_response {
   3: list<Message> message_list; // 消息列表
 }
 
+struct Group {
+  1: i64 id; // 群聊id
+  2: string name; // 群聊名称
+  3: i64 owner_id; // 群主id
+  4: i64 member_count; // 成员数
+}
+
+struct Group_member {
+  1: user.User user; // 成员信息
+  2: i32 role; // 角色，0-成员，1-管理员，2-群主
+}
+
+struct Conversation {
+  1: string convert_id; // 会话id
+  2: user.User user; // 对方用户信息
+  3: Message last_message; // 最后一条消息
+  4: i64 last_time; // 最后一条消息的发送时间，时间戳
+  5: i64 unread_count; // 未读消息数
+  6: optional Group group; // 群聊信息，群聊会话时返回，此时user为空
+}
+
+struct Conversation_list_request {
+  1: i64 user_id; // 用户id
+  2: optional string cursor; // 分页游标，不填表示第一页
+  3: i32 count; // 每页数量，不填默认30，最大100
+}
+
+struct Conversation_list_response {
+  1: i32 status_code; // 状态码，0-成功，其他值-失败
+  2: optional string status_msg; // 返回状态描述
+  3: list<Conversation> conversation_list; // 按最后一条消息时间倒序的会话列表
+  4: optional string next_cursor; // 下一页游标
+  5: bool has_more; // 是否还有更多
+}
+
+struct Mark_read_request {
+  1: i64 user_id; // 用户id
+  2: i64 to_user_id; // 对方用户id
+  3: i64 read_time; // 已读到的消息发送时间，时间戳，不填表示当前时间
+  4: optional i64 group_id; // 群聊id，填写时标记群聊已读，忽略to_user_id
+}
+
+struct Mark_read_response {
+  1: i32 status_code; // 状态码，0-成功，其他值-失败
+  2: optional string status_msg; // 返回状态描述
+}
+
+struct Recall_message_request {
+  1: i64 user_id; // 用户id
+  2: i64 to_user_id; // 对方用户id
+  3: i64 message_id; // 消息id
+  4: optional i64 group_id; // 群聊id，撤回群聊消息时填写，忽略to_user_id
+}
+
+struct Recall_message_response {
+  1: i32 status_code; // 状态码，0-成功，其他值-失败
+  2: optional string status_msg; // 返回状态描述
+  3: Message message; // 已撤回的消息
+}
+
+struct Delete_message_request {
+  1: i64 user_id; // 用户id
+  2: i64 to_user_id; // 对方用户id
+  3: i64 message_id; // 消息id
+  4: optional i64 group_id; // 群聊id，删除群聊消息时填写，忽略to_user_id
+}
+
+struct Delete_message_response {
+  1: i32 status_code; // 状态码，0-成功，其他值-失败
+  2: optional string status_msg; // 返回状态描述
+}
+
+struct Create_group_request {
+  1: i64 user_id; // 用户id，成为群主
+  2: string name; // 群聊名称
+  3: list<i64> member_ids; // 初始成员id列表
+  4: optional bool joinable; // 是否允许任何人自由加入，默认不允许
+}
+
+struct Create_group_response {
+  1: i32 status_code; // 状态码，0-成功，其他值-失败
+  2: optional string status_msg; // 返回状态描述
+  3: Group group; // 创建的群聊
+}
+
+struct Join_group_request {
+  1: i64 user_id; // 用户id
+  2: i64 group_id; // 群聊id
+}
+
+struct Join_group_response {
+  1: i32 status_code; // 状态码，0-成功，其他值-失败
+  2: optional string status_msg; // 返回状态描述
+}
+
+struct Leave_group_request {
+  1: i64 user_id; // 用户id
+  2: i64 group_id; // 群聊id
+}
+
+struct Leave_group_response {
+  1: i32 status_code; // 状态码，0-成功，其他值-失败
+  2: optional string status_msg; // 返回状态描述
+}
+
+struct Kick_group_member_request {
+  1: i64 user_id; // 用户id，需要是群主或管理员
+  2: i64 group_id; // 群聊id
+  3: i64 member_id; // 被移出的成员id
+}
+
+struct Kick_group_member_response {
+  1: i32 status_code; // 状态码，0-成功，其他值-失败
+  2: optional string status_msg; // 返回状态描述
+}
+
+struct Set_group_role_request {
+  1: i64 user_id; // 用户id，需要是群主
+  2: i64 group_id; // 群聊id
+  3: i64 member_id; // 成员id
+  4: i32 role; // 新角色，0-成员，1-管理员，2-群主(转让群主)
+}
+
+struct Set_group_role_response {
+  1: i32 status_code; // 状态码，0-成功，其他值-失败
+  2: optional string status_msg; // 返回状态描述
+}
+
+struct Group_member_list_request {
+  1: i64 user_id; // 用户id，需要是群聊成员
+  2: i64 group_id; // 群聊id
+  3: optional string cursor; // 分页游标，不填表示第一页
+  4: i32 count; // 每页数量，不填默认30，最大100
+}
+
+struct Group_member_list_response {
+  1: i32 status_code; // 状态码，0-成功，其他值-失败
+  2: optional string status_msg; // 返回状态描述
+  3: list<Group_member> member_list; // 按加入时间倒序的成员列表
+  4: optional string next_cursor; // 下一页游标
+  5: bool has_more; // 是否还有更多
+}
+
 service MessageService{
     Message_chat_response MessageChat(1: Message_chat_request req)
     Message_action_response MessageAction(1: Message_action_request req)
+    Conversation_list_response ConversationList(1: Conversation_list_request req)
+    Mark_read_response MarkRead(1: Mark_read_request req)
+    Recall_message_response RecallMessage(1: Recall_message_request req)
+    Delete_message_response DeleteMessage(1: Delete_message_request req)
+    Create_group_response CreateGroup(1: Create_group_request req)
+    Join_group_response JoinGroup(1: Join_group_request req)
+    Leave_group_response LeaveGroup(1: Leave_group_request req)
+    Kick_group_member_response KickGroupMember(1: Kick_group_member_request req)
+    Set_group_role_response SetGroupRole(1: Set_group_role_request req)
+    Group_member_list_response GroupMemberList(1: Group_member_list_request req)
 }
\ No newline at end of file
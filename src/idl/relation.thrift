@@ -16,34 +16,71 @@ struct Relation_action_response {
 struct Relation_follow_list_request {
   1: optional i64 user_id; // 用户id
   2: i64 author_id; // 对方用户id
+  3: optional string cursor; // 分页游标，不填表示第一页
+  4: i32 count; // 每页数量，不填默认30，最大100
 }
 
 struct Relation_follow_list_response {
   1: i32 status_code; // 状态码，0-成功，其他值-失败
   2: optional string status_msg; // 返回状态描述
   3: list<user.User> user_list; // 用户信息列表
+  4: optional string next_cursor; // 下一页游标
+  5: bool has_more; // 是否还有更多
 }
 
 struct Relation_follower_list_request {
   1: optional i64 user_id; // 用户id
   2: i64 author_id; // 对方用户id
+  3: optional string cursor; // 分页游标，不填表示第一页
+  4: i32 count; // 每页数量，不填默认30，最大100
 }
 
 struct Relation_follower_list_response {
   1: i32 status_code; // 状态码，0-成功，其他值-失败
   2: optional string status_msg; // 返回状态描述
   3: list<user.User> user_list; // 用户列表
+  4: optional string next_cursor; // 下一页游标
+  5: bool has_more; // 是否还有更多
 }
 
 struct Relation_friend_list_request {
   1: optional i64 user_id; // 用户id
   2: i64 author_id; // 对方用户id
+  3: optional string cursor; // 分页游标，不填表示第一页
+  4: i32 count; // 每页数量，不填默认30，最大100
 }
 
 struct Relation_friend_list_response {
   1: i32 status_code ; // 状态码，0-成功，其他值-失败
   2: optional string status_msg; // 返回状态描述
   3: list<user.User> user_list; // 用户列表
+  4: optional string next_cursor; // 下一页游标
+  5: bool has_more; // 是否还有更多
+}
+
+struct Relation_block_request {
+  1: i64 user_id; // 用户id
+  2: i64 author_id; // 对方用户id
+  3: i64 action_type; // 1-拉黑，2-取消拉黑
+}
+
+struct Relation_block_response {
+  1: i32 status_code; // 状态码，0-成功，其他值-失败
+  2: optional string status_msg; // 返回状态描述
+}
+
+struct Relation_block_list_request {
+  1: i64 user_id; // 用户id
+  2: optional string cursor; // 分页游标，不填表示第一页
+  3: i32 count; // 每页数量，不填默认30，最大100
+}
+
+struct Relation_block_list_response {
+  1: i32 status_code; // 状态码，0-成功，其他值-失败
+  2: optional string status_msg; // 返回状态描述
+  3: list<user.User> user_list; // 用户列表
+  4: optional string next_cursor; // 下一页游标
+  5: bool has_more; // 是否还有更多
 }
 
 service RelationService{
@@ -51,7 +88,12 @@ service RelationService{
     Relation_follow_list_response RelationFollowList(1: Relation_follow_list_request req)
     Relation_follower_list_response RelationFollowerList(1: Relation_follower_list_request req)
     Relation_friend_list_response RelationFriendList(1: Relation_friend_list_request req)
+    Relation_block_response RelationBlock(1: Relation_block_request req)
+    Relation_block_list_response RelationBlockList(1: Relation_block_list_request req)
     bool RelationExist(1: i64 user_id, 2: i64 author_id)
     i64 FollowCnt(1: i64 user_id)
     i64 FollowerCnt(1: i64 user_id)
+    map<i64, bool> BatchRelationExist(1: i64 user_id, 2: list<i64> author_ids)
+    map<i64, i64> BatchFollowCnt(1: list<i64> user_ids)
+    map<i64, i64> BatchFollowerCnt(1: list<i64> user_ids)
 }
\ No newline at end of file
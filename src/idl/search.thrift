@@ -0,0 +1,39 @@
+include "user.thrift"
+include "video.thrift"
+
+namespace go search
+
+struct Search_users_request {
+  1: optional i64 user_id; // 用户id
+  2: string keyword; // 搜索关键词，匹配用户名和个性签名
+  3: optional string cursor; // 分页游标，不填表示第一页
+  4: i32 count; // 每页数量，不填默认30，最大100
+}
+
+struct Search_users_response {
+  1: i32 status_code; // 状态码，0-成功，其他值-失败
+  2: optional string status_msg; // 返回状态描述
+  3: list<user.User> user_list; // 按相关度排序的用户列表
+  4: optional string next_cursor; // 下一页游标
+  5: bool has_more; // 是否还有更多
+}
+
+struct Search_videos_request {
+  1: optional i64 user_id; // 用户id
+  2: string keyword; // 搜索关键词，匹配视频标题
+  3: optional string cursor; // 分页游标，不填表示第一页
+  4: i32 count; // 每页数量，不填默认30，最大100
+}
+
+struct Search_videos_response {
+  1: i32 status_code; // 状态码，0-成功，其他值-失败
+  2: optional string status_msg; // 返回状态描述
+  3: list<video.Video> video_list; // 按相关度排序的视频列表
+  4: optional string next_cursor; // 下一页游标
+  5: bool has_more; // 是否还有更多
+}
+
+service SearchService {
+  Search_users_response SearchUsers(1: Search_users_request req)
+  Search_videos_response SearchVideos(1: Search_videos_request req)
+}
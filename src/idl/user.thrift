@@ -12,6 +12,7 @@ struct User {
   9: i64 total_favorited; //获赞数量
   10: i64 work_count; //作品数量
   11: i64 favorite_count; //点赞数量
+  12: i32 message_privacy; // 私信权限，0-所有人，1-仅好友
 }
 
 struct User_register_request {
@@ -51,8 +52,25 @@ struct User_info_response {
   3: User user; // 用户信息
 }
 
+struct Batch_user_info_request {
+  1: optional i64 user_id; // 用户id
+  2: list<i64> author_ids; // 对方用户id列表
+}
+
+struct User_privacy_request {
+  1: i64 user_id; // 用户id
+  2: i32 message_privacy; // 私信权限，0-所有人，1-仅好友(互相关注)
+}
+
+struct User_privacy_response {
+  1: i32 status_code; // 状态码，0-成功，其他值-失败
+  2: optional string status_msg; // 返回状态描述
+}
+
 service UserService {
     User_register_response Register(1: User_register_request req);
     User_login_response Login(1: User_login_request req);
     User_info_response UserInfo(1: User_info_request req);
+    list<User> BatchUserInfo(1: Batch_user_info_request req);
+    User_privacy_response UpdatePrivacy(1: User_privacy_request req);
 }
\ No newline at end of file
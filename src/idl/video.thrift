@@ -12,11 +12,18 @@ struct Video {
   7:  i64 comment_count; // 视频的评论总数
   8:  bool is_favorite; // true-已点赞，false-未点赞
   9:  string title; // 视频标题
+  10: string hls_url; // HLS主播放列表地址
+  11: i32 duration; // 视频时长，单位毫秒
+  12: i32 width; // 视频宽度
+  13: i32 height; // 视频高度
+  14: i32 visibility; // 可见范围，0-公开，1-好友可见，2-仅自己可见
 }
 
 struct Feed_request {
   1: i64 latest_time; // 可选参数，限制返回视频的最新投稿时间戳，精确到秒，不填表示当前时间
   2: optional i64 user_id; // 用户id
+  3: i32 mode; // 0-按投稿时间倒序，1-推荐排序
+  4: optional string cursor; // 推荐排序时候选窗口内的分页游标，与上次的latest_time一起使用，不填表示窗口第一页
 }
 
 struct Feed_response {
@@ -24,6 +31,21 @@ struct Feed_response {
   2: optional string status_msg; // 返回状态描述
   3: list<Video> video_list; // 视频列表
   4: optional i64 next_time; // 本次返回的视频中，发布最早的时间，作为下次请求时的latest_time
+  5: optional string next_cursor; // 推荐排序时候选窗口内的下一页游标，为空时使用next_time进入下一个窗口
+}
+
+struct Follow_feed_request {
+  1: i64 latest_time; // 可选参数，限制返回视频的最新投稿时间戳，精确到秒，不填表示当前时间
+  2: i64 user_id; // 用户id
+  3: optional i64 last_id; // 可选参数，上一页最后一个视频的id，与latest_time一起定位发布时间相同的视频
+}
+
+struct Follow_feed_response {
+  1: i32 status_code; // 状态码，0-成功，其他值-失败
+  2: optional string status_msg; // 返回状态描述
+  3: list<Video> video_list; // 关注用户发布的视频列表
+  4: optional i64 next_time; // 本次返回的视频中，发布最早的时间，作为下次请求时的latest_time
+  5: optional i64 next_id; // 本次返回的视频中，发布最早的视频id，作为下次请求时的last_id
 }
 
 struct Video_info_request {
@@ -38,34 +60,140 @@ struct Video_info_list_request {
 
 struct Publish_action_request {
   1: i64 user_id; // 用户id
-  2: binary data; // 视频数据
   3: string title; // 视频标题
+  4: string video_name; // 视频在对象存储中的文件名，由API服务上传完成后传入
+  5: optional string cover_name; // 自定义封面在对象存储中的文件名，不填则从视频中自动挑选
+  6: i32 visibility; // 可见范围，0-公开，1-好友可见，2-仅自己可见
 }
 
 struct Publish_action_response {
   1: i32 status_code; // 状态码，0-成功，其他值-失败
   2: optional string status_msg; // 返回状态描述
+  3: i64 video_id; // 视频id，用于查询发布进度
+}
+
+struct Publish_status_request {
+  1: i64 user_id; // 用户id
+  2: i64 video_id; // 视频id
+}
+
+struct Publish_status_response {
+  1: i32 status_code; // 状态码，0-成功，其他值-失败
+  2: optional string status_msg; // 返回状态描述
+  3: string status; // uploading-上传中，processing-转码中，published-已发布，failed-处理失败，deleted-已删除
+  4: i32 progress; // 处理进度，0-100
+}
+
+struct Update_cover_request {
+  1: i64 user_id; // 用户id
+  2: i64 video_id; // 视频id
+  3: string cover_name; // 新封面在对象存储中的文件名，由API服务上传完成后传入
+}
+
+struct Update_cover_response {
+  1: i32 status_code; // 状态码，0-成功，其他值-失败
+  2: optional string status_msg; // 返回状态描述
+  3: string cover_url; // 新封面地址
+}
+
+struct Delete_video_request {
+  1: i64 user_id; // 用户id
+  2: i64 video_id; // 视频id
+}
+
+struct Delete_video_response {
+  1: i32 status_code; // 状态码，0-成功，其他值-失败
+  2: optional string status_msg; // 返回状态描述
+}
+
+struct Update_video_request {
+  1: i64 user_id; // 用户id
+  2: i64 video_id; // 视频id
+  3: optional string title; // 新标题，不填则不修改
+  4: optional i32 visibility; // 新可见范围，不填则不修改
+}
+
+struct Update_video_response {
+  1: i32 status_code; // 状态码，0-成功，其他值-失败
+  2: optional string status_msg; // 返回状态描述
 }
 
 struct Publish_list_request {
   1: optional i64 user_id; // 用户id
   2: i64 author_id; // 对方用户id
+  3: optional string cursor; // 分页游标，不填表示第一页
+  4: i32 count; // 每页数量，不填默认30，最大100
 }
 
 struct Publish_list_response {
   1: i32 status_code; // 状态码，0-成功，其他值-失败
   2: optional string status_msg; // 返回状态描述
   3: list<Video> video_list; // 用户发布的视频列表
+  4: optional string next_cursor; // 下一页游标
+  5: bool has_more; // 是否还有更多
+}
+
+struct Topic_feed_request {
+  1: optional i64 user_id; // 用户id
+  2: string tag; // 话题名称，不含#
+  3: optional string sort; // 排序方式，new-按发布时间(默认)，hot-按热度
+  4: optional string cursor; // 分页游标，不填表示第一页
+  5: i32 count; // 每页数量，不填默认30，最大100
+}
+
+struct Topic_feed_response {
+  1: i32 status_code; // 状态码，0-成功，其他值-失败
+  2: optional string status_msg; // 返回状态描述
+  3: list<Video> video_list; // 话题下的视频列表
+  4: optional string next_cursor; // 下一页游标
+  5: bool has_more; // 是否还有更多
+}
+
+struct Topic {
+  1: string name; // 话题名称
+  2: i64 count; // 最近7天的使用次数
+}
+
+struct Trending_topics_request {
+  1: i32 count; // 返回数量，不填默认10，最大50
+}
+
+struct Trending_topics_response {
+  1: i32 status_code; // 状态码，0-成功，其他值-失败
+  2: optional string status_msg; // 返回状态描述
+  3: list<Topic> topic_list; // 热门话题列表
+}
+
+struct Hot_videos_request {
+  1: optional i64 user_id; // 用户id
+  2: optional string window; // 统计窗口，hour-最近1小时，day-最近1天(默认)，week-最近1周
+  3: i32 count; // 返回数量，不填默认10，最大50
+}
+
+struct Hot_videos_response {
+  1: i32 status_code; // 状态码，0-成功，其他值-失败
+  2: optional string status_msg; // 返回状态描述
+  3: list<Video> video_list; // 按热度倒序的视频列表
 }
 
 service VideoService {
   Feed_response Feed(1: Feed_request req);
+  Follow_feed_response FollowFeed(1: Follow_feed_request req);
   Publish_action_response PublishAction(1: Publish_action_request req)
   Publish_list_response PublishList(1: Publish_list_request req)
+  Publish_status_response PublishStatus(1: Publish_status_request req)
+  Update_cover_response UpdateCover(1: Update_cover_request req)
+  Delete_video_response DeleteVideo(1: Delete_video_request req)
+  Update_video_response UpdateVideo(1: Update_video_request req)
+  Topic_feed_response TopicFeed(1: Topic_feed_request req)
+  Trending_topics_response TrendingTopics(1: Trending_topics_request req)
+  Hot_videos_response HotVideos(1: Hot_videos_request req)
   list<i64> PublishIDList(1: i64 user_id)
   Video VideoInfo(1: Video_info_request req);
   list<Video> VideoInfoList(1: Video_info_list_request req);
   i64 WorkCount(1: i64 user_id)
+  map<i64, i64> BatchWorkCount(1: list<i64> user_ids)
   i64 AuthorId(1: i64 video_id)
   bool VideoExist(1: i64 video_id)
+  void ClearSeen(1: i64 user_id)
 }
\ No newline at end of file
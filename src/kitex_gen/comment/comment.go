@@ -11,10 +11,15 @@ import (
 )
 
 type Comment struct {
-	Id         int64      `thrift:"id,1" frugal:"1,default,i64" json:"id"`
-	User       *user.User `thrift:"user,2" frugal:"2,default,user.User" json:"user"`
-	Content    string     `thrift:"content,3" frugal:"3,default,string" json:"content"`
-	CreateDate string     `thrift:"create_date,4" frugal:"4,default,string" json:"create_date"`
+	Id            int64      `thrift:"id,1" frugal:"1,default,i64" json:"id"`
+	User          *user.User `thrift:"user,2" frugal:"2,default,user.User" json:"user"`
+	Content       string     `thrift:"content,3" frugal:"3,default,string" json:"content"`
+	CreateDate    string     `thrift:"create_date,4" frugal:"4,default,string" json:"create_date"`
+	ParentId      int64      `thrift:"parent_id,5" frugal:"5,default,i64" json:"parent_id"`
+	RootId        int64      `thrift:"root_id,6" frugal:"6,default,i64" json:"root_id"`
+	ReplyCount    int64      `thrift:"reply_count,7" frugal:"7,default,i64" json:"reply_count"`
+	FavoriteCount int64      `thrift:"favorite_count,8" frugal:"8,default,i64" json:"favorite_count"`
+	IsFavorite    bool       `thrift:"is_favorite,9" frugal:"9,default,bool" json:"is_favorite"`
 }
 
 func NewComment() *Comment {
@@ -45,6 +50,26 @@ func (p *Comment) GetContent() (v string) {
 func (p *Comment) GetCreateDate() (v string) {
 	return p.CreateDate
 }
+
+func (p *Comment) GetParentId() (v int64) {
+	return p.ParentId
+}
+
+func (p *Comment) GetRootId() (v int64) {
+	return p.RootId
+}
+
+func (p *Comment) GetReplyCount() (v int64) {
+	return p.ReplyCount
+}
+
+func (p *Comment) GetFavoriteCount() (v int64) {
+	return p.FavoriteCount
+}
+
+func (p *Comment) GetIsFavorite() (v bool) {
+	return p.IsFavorite
+}
 func (p *Comment) SetId(val int64) {
 	p.Id = val
 }
@@ -57,12 +82,32 @@ func (p *Comment) SetContent(val string) {
 func (p *Comment) SetCreateDate(val string) {
 	p.CreateDate = val
 }
+func (p *Comment) SetParentId(val int64) {
+	p.ParentId = val
+}
+func (p *Comment) SetRootId(val int64) {
+	p.RootId = val
+}
+func (p *Comment) SetReplyCount(val int64) {
+	p.ReplyCount = val
+}
+func (p *Comment) SetFavoriteCount(val int64) {
+	p.FavoriteCount = val
+}
+func (p *Comment) SetIsFavorite(val bool) {
+	p.IsFavorite = val
+}
 
 var fieldIDToName_Comment = map[int16]string{
 	1: "id",
 	2: "user",
 	3: "content",
 	4: "create_date",
+	5: "parent_id",
+	6: "root_id",
+	7: "reply_count",
+	8: "favorite_count",
+	9: "is_favorite",
 }
 
 func (p *Comment) IsSetUser() bool {
@@ -120,6 +165,46 @@ func (p *Comment) Read(iprot thrift.TProtocol) (err error) {
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
+		case 5:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField5(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 6:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField6(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 7:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField7(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 8:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField8(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 9:
+			if fieldTypeId == thrift.BOOL {
+				if err = p.ReadField9(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
 		default:
 			if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
@@ -190,6 +275,61 @@ func (p *Comment) ReadField4(iprot thrift.TProtocol) error {
 	p.CreateDate = _field
 	return nil
 }
+func (p *Comment) ReadField5(iprot thrift.TProtocol) error {
+
+	var _field int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.ParentId = _field
+	return nil
+}
+func (p *Comment) ReadField6(iprot thrift.TProtocol) error {
+
+	var _field int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.RootId = _field
+	return nil
+}
+func (p *Comment) ReadField7(iprot thrift.TProtocol) error {
+
+	var _field int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.ReplyCount = _field
+	return nil
+}
+func (p *Comment) ReadField8(iprot thrift.TProtocol) error {
+
+	var _field int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.FavoriteCount = _field
+	return nil
+}
+func (p *Comment) ReadField9(iprot thrift.TProtocol) error {
+
+	var _field bool
+	if v, err := iprot.ReadBool(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.IsFavorite = _field
+	return nil
+}
 
 func (p *Comment) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
@@ -213,6 +353,26 @@ func (p *Comment) Write(oprot thrift.TProtocol) (err error) {
 			fieldId = 4
 			goto WriteFieldError
 		}
+		if err = p.writeField5(oprot); err != nil {
+			fieldId = 5
+			goto WriteFieldError
+		}
+		if err = p.writeField6(oprot); err != nil {
+			fieldId = 6
+			goto WriteFieldError
+		}
+		if err = p.writeField7(oprot); err != nil {
+			fieldId = 7
+			goto WriteFieldError
+		}
+		if err = p.writeField8(oprot); err != nil {
+			fieldId = 8
+			goto WriteFieldError
+		}
+		if err = p.writeField9(oprot); err != nil {
+			fieldId = 9
+			goto WriteFieldError
+		}
 	}
 	if err = oprot.WriteFieldStop(); err != nil {
 		goto WriteFieldStopError
@@ -299,6 +459,91 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
 }
 
+func (p *Comment) writeField5(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("parent_id", thrift.I64, 5); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI64(p.ParentId); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 end error: ", p), err)
+}
+
+func (p *Comment) writeField6(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("root_id", thrift.I64, 6); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI64(p.RootId); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 6 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 6 end error: ", p), err)
+}
+
+func (p *Comment) writeField7(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("reply_count", thrift.I64, 7); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI64(p.ReplyCount); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 7 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 7 end error: ", p), err)
+}
+
+func (p *Comment) writeField8(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("favorite_count", thrift.I64, 8); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI64(p.FavoriteCount); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 8 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 8 end error: ", p), err)
+}
+
+func (p *Comment) writeField9(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("is_favorite", thrift.BOOL, 9); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteBool(p.IsFavorite); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 9 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 9 end error: ", p), err)
+}
+
 func (p *Comment) String() string {
 	if p == nil {
 		return "<nil>"
@@ -325,6 +570,21 @@ func (p *Comment) DeepEqual(ano *Comment) bool {
 	if !p.Field4DeepEqual(ano.CreateDate) {
 		return false
 	}
+	if !p.Field5DeepEqual(ano.ParentId) {
+		return false
+	}
+	if !p.Field6DeepEqual(ano.RootId) {
+		return false
+	}
+	if !p.Field7DeepEqual(ano.ReplyCount) {
+		return false
+	}
+	if !p.Field8DeepEqual(ano.FavoriteCount) {
+		return false
+	}
+	if !p.Field9DeepEqual(ano.IsFavorite) {
+		return false
+	}
 	return true
 }
 
@@ -356,6 +616,41 @@ func (p *Comment) Field4DeepEqual(src string) bool {
 	}
 	return true
 }
+func (p *Comment) Field5DeepEqual(src int64) bool {
+
+	if p.ParentId != src {
+		return false
+	}
+	return true
+}
+func (p *Comment) Field6DeepEqual(src int64) bool {
+
+	if p.RootId != src {
+		return false
+	}
+	return true
+}
+func (p *Comment) Field7DeepEqual(src int64) bool {
+
+	if p.ReplyCount != src {
+		return false
+	}
+	return true
+}
+func (p *Comment) Field8DeepEqual(src int64) bool {
+
+	if p.FavoriteCount != src {
+		return false
+	}
+	return true
+}
+func (p *Comment) Field9DeepEqual(src bool) bool {
+
+	if p.IsFavorite != src {
+		return false
+	}
+	return true
+}
 
 type CommentActionRequest struct {
 	UserId      int64   `thrift:"user_id,1" frugal:"1,default,i64" json:"user_id"`
@@ -363,6 +658,7 @@ type CommentActionRequest struct {
 	ActionType  int64   `thrift:"action_type,3" frugal:"3,default,i64" json:"action_type"`
 	CommentText *string `thrift:"comment_text,4,optional" frugal:"4,optional,string" json:"comment_text,omitempty"`
 	CommentId   *int64  `thrift:"comment_id,5,optional" frugal:"5,optional,i64" json:"comment_id,omitempty"`
+	ParentId    *int64  `thrift:"parent_id,6,optional" frugal:"6,optional,i64" json:"parent_id,omitempty"`
 }
 
 func NewCommentActionRequest() *CommentActionRequest {
@@ -402,6 +698,15 @@ func (p *CommentActionRequest) GetCommentId() (v int64) {
 	}
 	return *p.CommentId
 }
+
+var CommentActionRequest_ParentId_DEFAULT int64
+
+func (p *CommentActionRequest) GetParentId() (v int64) {
+	if !p.IsSetParentId() {
+		return CommentActionRequest_ParentId_DEFAULT
+	}
+	return *p.ParentId
+}
 func (p *CommentActionRequest) SetUserId(val int64) {
 	p.UserId = val
 }
@@ -417,6 +722,9 @@ func (p *CommentActionRequest) SetCommentText(val *string) {
 func (p *CommentActionRequest) SetCommentId(val *int64) {
 	p.CommentId = val
 }
+func (p *CommentActionRequest) SetParentId(val *int64) {
+	p.ParentId = val
+}
 
 var fieldIDToName_CommentActionRequest = map[int16]string{
 	1: "user_id",
@@ -424,6 +732,7 @@ var fieldIDToName_CommentActionRequest = map[int16]string{
 	3: "action_type",
 	4: "comment_text",
 	5: "comment_id",
+	6: "parent_id",
 }
 
 func (p *CommentActionRequest) IsSetCommentText() bool {
@@ -434,6 +743,10 @@ func (p *CommentActionRequest) IsSetCommentId() bool {
 	return p.CommentId != nil
 }
 
+func (p *CommentActionRequest) IsSetParentId() bool {
+	return p.ParentId != nil
+}
+
 func (p *CommentActionRequest) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
@@ -493,6 +806,14 @@ func (p *CommentActionRequest) Read(iprot thrift.TProtocol) (err error) {
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
+		case 6:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField6(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
 		default:
 			if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
@@ -577,6 +898,17 @@ func (p *CommentActionRequest) ReadField5(iprot thrift.TProtocol) error {
 	p.CommentId = _field
 	return nil
 }
+func (p *CommentActionRequest) ReadField6(iprot thrift.TProtocol) error {
+
+	var _field *int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.ParentId = _field
+	return nil
+}
 
 func (p *CommentActionRequest) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
@@ -604,6 +936,10 @@ func (p *CommentActionRequest) Write(oprot thrift.TProtocol) (err error) {
 			fieldId = 5
 			goto WriteFieldError
 		}
+		if err = p.writeField6(oprot); err != nil {
+			fieldId = 6
+			goto WriteFieldError
+		}
 	}
 	if err = oprot.WriteFieldStop(); err != nil {
 		goto WriteFieldStopError
@@ -711,6 +1047,25 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 5 end error: ", p), err)
 }
 
+func (p *CommentActionRequest) writeField6(oprot thrift.TProtocol) (err error) {
+	if p.IsSetParentId() {
+		if err = oprot.WriteFieldBegin("parent_id", thrift.I64, 6); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteI64(*p.ParentId); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 6 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 6 end error: ", p), err)
+}
+
 func (p *CommentActionRequest) String() string {
 	if p == nil {
 		return "<nil>"
@@ -740,6 +1095,9 @@ func (p *CommentActionRequest) DeepEqual(ano *CommentActionRequest) bool {
 	if !p.Field5DeepEqual(ano.CommentId) {
 		return false
 	}
+	if !p.Field6DeepEqual(ano.ParentId) {
+		return false
+	}
 	return true
 }
 
@@ -788,6 +1146,18 @@ func (p *CommentActionRequest) Field5DeepEqual(src *int64) bool {
 	}
 	return true
 }
+func (p *CommentActionRequest) Field6DeepEqual(src *int64) bool {
+
+	if p.ParentId == src {
+		return true
+	} else if p.ParentId == nil || src == nil {
+		return false
+	}
+	if *p.ParentId != *src {
+		return false
+	}
+	return true
+}
 
 type CommentActionResponse struct {
 	StatusCode int32    `thrift:"status_code,1" frugal:"1,default,i32" json:"status_code"`
@@ -1094,8 +1464,11 @@ func (p *CommentActionResponse) Field3DeepEqual(src *Comment) bool {
 }
 
 type CommentListRequest struct {
-	UserId  *int64 `thrift:"user_id,1,optional" frugal:"1,optional,i64" json:"user_id,omitempty"`
-	VideoId int64  `thrift:"video_id,2" frugal:"2,default,i64" json:"video_id"`
+	UserId  *int64  `thrift:"user_id,1,optional" frugal:"1,optional,i64" json:"user_id,omitempty"`
+	VideoId int64   `thrift:"video_id,2" frugal:"2,default,i64" json:"video_id"`
+	Cursor  *string `thrift:"cursor,3,optional" frugal:"3,optional,string" json:"cursor,omitempty"`
+	Count   int32   `thrift:"count,4" frugal:"4,default,i32" json:"count"`
+	Sort    *string `thrift:"sort,5,optional" frugal:"5,optional,string" json:"sort,omitempty"`
 }
 
 func NewCommentListRequest() *CommentListRequest {
@@ -1118,22 +1491,64 @@ func (p *CommentListRequest) GetUserId() (v int64) {
 func (p *CommentListRequest) GetVideoId() (v int64) {
 	return p.VideoId
 }
+
+var CommentListRequest_Cursor_DEFAULT string
+
+func (p *CommentListRequest) GetCursor() (v string) {
+	if !p.IsSetCursor() {
+		return CommentListRequest_Cursor_DEFAULT
+	}
+	return *p.Cursor
+}
+
+func (p *CommentListRequest) GetCount() (v int32) {
+	return p.Count
+}
+
+var CommentListRequest_Sort_DEFAULT string
+
+func (p *CommentListRequest) GetSort() (v string) {
+	if !p.IsSetSort() {
+		return CommentListRequest_Sort_DEFAULT
+	}
+	return *p.Sort
+}
 func (p *CommentListRequest) SetUserId(val *int64) {
 	p.UserId = val
 }
 func (p *CommentListRequest) SetVideoId(val int64) {
 	p.VideoId = val
 }
-
+func (p *CommentListRequest) SetCursor(val *string) {
+	p.Cursor = val
+}
+func (p *CommentListRequest) SetCount(val int32) {
+	p.Count = val
+}
+func (p *CommentListRequest) SetSort(val *string) {
+	p.Sort = val
+}
+
 var fieldIDToName_CommentListRequest = map[int16]string{
 	1: "user_id",
 	2: "video_id",
+	3: "cursor",
+	4: "count",
+	5: "sort",
 }
 
 func (p *CommentListRequest) IsSetUserId() bool {
 	return p.UserId != nil
 }
 
+func (p *CommentListRequest) IsSetCursor() bool {
+	return p.Cursor != nil
+}
+
+func (p *CommentListRequest) IsSetSort() bool {
+	return p.Sort != nil
+}
+
 func (p *CommentListRequest) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
@@ -1169,6 +1584,30 @@ func (p *CommentListRequest) Read(iprot thrift.TProtocol) (err error) {
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
+		case 3:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField3(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 4:
+			if fieldTypeId == thrift.I32 {
+				if err = p.ReadField4(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 5:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField5(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
 		default:
 			if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
@@ -1220,6 +1659,39 @@ func (p *CommentListRequest) ReadField2(iprot thrift.TProtocol) error {
 	p.VideoId = _field
 	return nil
 }
+func (p *CommentListRequest) ReadField3(iprot thrift.TProtocol) error {
+
+	var _field *string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.Cursor = _field
+	return nil
+}
+func (p *CommentListRequest) ReadField4(iprot thrift.TProtocol) error {
+
+	var _field int32
+	if v, err := iprot.ReadI32(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.Count = _field
+	return nil
+}
+func (p *CommentListRequest) ReadField5(iprot thrift.TProtocol) error {
+
+	var _field *string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.Sort = _field
+	return nil
+}
 
 func (p *CommentListRequest) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
@@ -1235,6 +1707,18 @@ func (p *CommentListRequest) Write(oprot thrift.TProtocol) (err error) {
 			fieldId = 2
 			goto WriteFieldError
 		}
+		if err = p.writeField3(oprot); err != nil {
+			fieldId = 3
+			goto WriteFieldError
+		}
+		if err = p.writeField4(oprot); err != nil {
+			fieldId = 4
+			goto WriteFieldError
+		}
+		if err = p.writeField5(oprot); err != nil {
+			fieldId = 5
+			goto WriteFieldError
+		}
 	}
 	if err = oprot.WriteFieldStop(); err != nil {
 		goto WriteFieldStopError
@@ -1289,6 +1773,61 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
 }
 
+func (p *CommentListRequest) writeField3(oprot thrift.TProtocol) (err error) {
+	if p.IsSetCursor() {
+		if err = oprot.WriteFieldBegin("cursor", thrift.STRING, 3); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.Cursor); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
+}
+
+func (p *CommentListRequest) writeField4(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("count", thrift.I32, 4); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI32(p.Count); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
+}
+
+func (p *CommentListRequest) writeField5(oprot thrift.TProtocol) (err error) {
+	if p.IsSetSort() {
+		if err = oprot.WriteFieldBegin("sort", thrift.STRING, 5); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.Sort); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 end error: ", p), err)
+}
+
 func (p *CommentListRequest) String() string {
 	if p == nil {
 		return "<nil>"
@@ -1309,6 +1848,15 @@ func (p *CommentListRequest) DeepEqual(ano *CommentListRequest) bool {
 	if !p.Field2DeepEqual(ano.VideoId) {
 		return false
 	}
+	if !p.Field3DeepEqual(ano.Cursor) {
+		return false
+	}
+	if !p.Field4DeepEqual(ano.Count) {
+		return false
+	}
+	if !p.Field5DeepEqual(ano.Sort) {
+		return false
+	}
 	return true
 }
 
@@ -1331,11 +1879,44 @@ func (p *CommentListRequest) Field2DeepEqual(src int64) bool {
 	}
 	return true
 }
+func (p *CommentListRequest) Field3DeepEqual(src *string) bool {
+
+	if p.Cursor == src {
+		return true
+	} else if p.Cursor == nil || src == nil {
+		return false
+	}
+	if strings.Compare(*p.Cursor, *src) != 0 {
+		return false
+	}
+	return true
+}
+func (p *CommentListRequest) Field4DeepEqual(src int32) bool {
+
+	if p.Count != src {
+		return false
+	}
+	return true
+}
+func (p *CommentListRequest) Field5DeepEqual(src *string) bool {
+
+	if p.Sort == src {
+		return true
+	} else if p.Sort == nil || src == nil {
+		return false
+	}
+	if strings.Compare(*p.Sort, *src) != 0 {
+		return false
+	}
+	return true
+}
 
 type CommentListResponse struct {
 	StatusCode  int32      `thrift:"status_code,1" frugal:"1,default,i32" json:"status_code"`
 	StatusMsg   *string    `thrift:"status_msg,2,optional" frugal:"2,optional,string" json:"status_msg,omitempty"`
 	CommentList []*Comment `thrift:"comment_list,3" frugal:"3,default,list<Comment>" json:"comment_list"`
+	NextCursor  *string    `thrift:"next_cursor,4,optional" frugal:"4,optional,string" json:"next_cursor,omitempty"`
+	HasMore     bool       `thrift:"has_more,5" frugal:"5,default,bool" json:"has_more"`
 }
 
 func NewCommentListResponse() *CommentListResponse {
@@ -1362,6 +1943,19 @@ func (p *CommentListResponse) GetStatusMsg() (v string) {
 func (p *CommentListResponse) GetCommentList() (v []*Comment) {
 	return p.CommentList
 }
+
+var CommentListResponse_NextCursor_DEFAULT string
+
+func (p *CommentListResponse) GetNextCursor() (v string) {
+	if !p.IsSetNextCursor() {
+		return CommentListResponse_NextCursor_DEFAULT
+	}
+	return *p.NextCursor
+}
+
+func (p *CommentListResponse) GetHasMore() (v bool) {
+	return p.HasMore
+}
 func (p *CommentListResponse) SetStatusCode(val int32) {
 	p.StatusCode = val
 }
@@ -1371,17 +1965,29 @@ func (p *CommentListResponse) SetStatusMsg(val *string) {
 func (p *CommentListResponse) SetCommentList(val []*Comment) {
 	p.CommentList = val
 }
+func (p *CommentListResponse) SetNextCursor(val *string) {
+	p.NextCursor = val
+}
+func (p *CommentListResponse) SetHasMore(val bool) {
+	p.HasMore = val
+}
 
 var fieldIDToName_CommentListResponse = map[int16]string{
 	1: "status_code",
 	2: "status_msg",
 	3: "comment_list",
+	4: "next_cursor",
+	5: "has_more",
 }
 
 func (p *CommentListResponse) IsSetStatusMsg() bool {
 	return p.StatusMsg != nil
 }
 
+func (p *CommentListResponse) IsSetNextCursor() bool {
+	return p.NextCursor != nil
+}
+
 func (p *CommentListResponse) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
@@ -1425,6 +2031,22 @@ func (p *CommentListResponse) Read(iprot thrift.TProtocol) (err error) {
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
+		case 4:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField4(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 5:
+			if fieldTypeId == thrift.BOOL {
+				if err = p.ReadField5(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
 		default:
 			if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
@@ -1498,6 +2120,28 @@ func (p *CommentListResponse) ReadField3(iprot thrift.TProtocol) error {
 	p.CommentList = _field
 	return nil
 }
+func (p *CommentListResponse) ReadField4(iprot thrift.TProtocol) error {
+
+	var _field *string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.NextCursor = _field
+	return nil
+}
+func (p *CommentListResponse) ReadField5(iprot thrift.TProtocol) error {
+
+	var _field bool
+	if v, err := iprot.ReadBool(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.HasMore = _field
+	return nil
+}
 
 func (p *CommentListResponse) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
@@ -1517,6 +2161,14 @@ func (p *CommentListResponse) Write(oprot thrift.TProtocol) (err error) {
 			fieldId = 3
 			goto WriteFieldError
 		}
+		if err = p.writeField4(oprot); err != nil {
+			fieldId = 4
+			goto WriteFieldError
+		}
+		if err = p.writeField5(oprot); err != nil {
+			fieldId = 5
+			goto WriteFieldError
+		}
 	}
 	if err = oprot.WriteFieldStop(); err != nil {
 		goto WriteFieldStopError
@@ -1596,6 +2248,42 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
 }
 
+func (p *CommentListResponse) writeField4(oprot thrift.TProtocol) (err error) {
+	if p.IsSetNextCursor() {
+		if err = oprot.WriteFieldBegin("next_cursor", thrift.STRING, 4); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.NextCursor); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
+}
+
+func (p *CommentListResponse) writeField5(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("has_more", thrift.BOOL, 5); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteBool(p.HasMore); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 end error: ", p), err)
+}
+
 func (p *CommentListResponse) String() string {
 	if p == nil {
 		return "<nil>"
@@ -1619,6 +2307,12 @@ func (p *CommentListResponse) DeepEqual(ano *CommentListResponse) bool {
 	if !p.Field3DeepEqual(ano.CommentList) {
 		return false
 	}
+	if !p.Field4DeepEqual(ano.NextCursor) {
+		return false
+	}
+	if !p.Field5DeepEqual(ano.HasMore) {
+		return false
+	}
 	return true
 }
 
@@ -1654,289 +2348,2865 @@ func (p *CommentListResponse) Field3DeepEqual(src []*Comment) bool {
 	}
 	return true
 }
+func (p *CommentListResponse) Field4DeepEqual(src *string) bool {
 
-type CommentService interface {
-	CommentAction(ctx context.Context, req *CommentActionRequest) (r *CommentActionResponse, err error)
+	if p.NextCursor == src {
+		return true
+	} else if p.NextCursor == nil || src == nil {
+		return false
+	}
+	if strings.Compare(*p.NextCursor, *src) != 0 {
+		return false
+	}
+	return true
+}
+func (p *CommentListResponse) Field5DeepEqual(src bool) bool {
 
-	CommentList(ctx context.Context, req *CommentListRequest) (r *CommentListResponse, err error)
+	if p.HasMore != src {
+		return false
+	}
+	return true
+}
 
-	CommentCnt(ctx context.Context, videoId int64) (r int64, err error)
+type CommentReplyListRequest struct {
+	UserId    *int64  `thrift:"user_id,1,optional" frugal:"1,optional,i64" json:"user_id,omitempty"`
+	CommentId int64   `thrift:"comment_id,2" frugal:"2,default,i64" json:"comment_id"`
+	Cursor    *string `thrift:"cursor,3,optional" frugal:"3,optional,string" json:"cursor,omitempty"`
+	Count     int32   `thrift:"count,4" frugal:"4,default,i32" json:"count"`
 }
 
-type CommentServiceClient struct {
-	c thrift.TClient
+func NewCommentReplyListRequest() *CommentReplyListRequest {
+	return &CommentReplyListRequest{}
 }
 
-func NewCommentServiceClientFactory(t thrift.TTransport, f thrift.TProtocolFactory) *CommentServiceClient {
-	return &CommentServiceClient{
-		c: thrift.NewTStandardClient(f.GetProtocol(t), f.GetProtocol(t)),
-	}
+func (p *CommentReplyListRequest) InitDefault() {
+	*p = CommentReplyListRequest{}
 }
 
-func NewCommentServiceClientProtocol(t thrift.TTransport, iprot thrift.TProtocol, oprot thrift.TProtocol) *CommentServiceClient {
-	return &CommentServiceClient{
-		c: thrift.NewTStandardClient(iprot, oprot),
+var CommentReplyListRequest_UserId_DEFAULT int64
+
+func (p *CommentReplyListRequest) GetUserId() (v int64) {
+	if !p.IsSetUserId() {
+		return CommentReplyListRequest_UserId_DEFAULT
 	}
+	return *p.UserId
 }
 
-func NewCommentServiceClient(c thrift.TClient) *CommentServiceClient {
-	return &CommentServiceClient{
-		c: c,
-	}
+func (p *CommentReplyListRequest) GetCommentId() (v int64) {
+	return p.CommentId
 }
 
-func (p *CommentServiceClient) Client_() thrift.TClient {
+var CommentReplyListRequest_Cursor_DEFAULT string
+
+func (p *CommentReplyListRequest) GetCursor() (v string) {
+	if !p.IsSetCursor() {
+		return CommentReplyListRequest_Cursor_DEFAULT
+	}
+	return *p.Cursor
+}
+
+func (p *CommentReplyListRequest) GetCount() (v int32) {
+	return p.Count
+}
+func (p *CommentReplyListRequest) SetUserId(val *int64) {
+	p.UserId = val
+}
+func (p *CommentReplyListRequest) SetCommentId(val int64) {
+	p.CommentId = val
+}
+func (p *CommentReplyListRequest) SetCursor(val *string) {
+	p.Cursor = val
+}
+func (p *CommentReplyListRequest) SetCount(val int32) {
+	p.Count = val
+}
+
+var fieldIDToName_CommentReplyListRequest = map[int16]string{
+	1: "user_id",
+	2: "comment_id",
+	3: "cursor",
+	4: "count",
+}
+
+func (p *CommentReplyListRequest) IsSetUserId() bool {
+	return p.UserId != nil
+}
+
+func (p *CommentReplyListRequest) IsSetCursor() bool {
+	return p.Cursor != nil
+}
+
+func (p *CommentReplyListRequest) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 2:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 3:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField3(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 4:
+			if fieldTypeId == thrift.I32 {
+				if err = p.ReadField4(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_CommentReplyListRequest[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *CommentReplyListRequest) ReadField1(iprot thrift.TProtocol) error {
+
+	var _field *int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.UserId = _field
+	return nil
+}
+func (p *CommentReplyListRequest) ReadField2(iprot thrift.TProtocol) error {
+
+	var _field int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.CommentId = _field
+	return nil
+}
+func (p *CommentReplyListRequest) ReadField3(iprot thrift.TProtocol) error {
+
+	var _field *string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.Cursor = _field
+	return nil
+}
+func (p *CommentReplyListRequest) ReadField4(iprot thrift.TProtocol) error {
+
+	var _field int32
+	if v, err := iprot.ReadI32(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.Count = _field
+	return nil
+}
+
+func (p *CommentReplyListRequest) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("Comment_reply_list_request"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+		if err = p.writeField3(oprot); err != nil {
+			fieldId = 3
+			goto WriteFieldError
+		}
+		if err = p.writeField4(oprot); err != nil {
+			fieldId = 4
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *CommentReplyListRequest) writeField1(oprot thrift.TProtocol) (err error) {
+	if p.IsSetUserId() {
+		if err = oprot.WriteFieldBegin("user_id", thrift.I64, 1); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteI64(*p.UserId); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *CommentReplyListRequest) writeField2(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("comment_id", thrift.I64, 2); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI64(p.CommentId); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+
+func (p *CommentReplyListRequest) writeField3(oprot thrift.TProtocol) (err error) {
+	if p.IsSetCursor() {
+		if err = oprot.WriteFieldBegin("cursor", thrift.STRING, 3); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.Cursor); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
+}
+
+func (p *CommentReplyListRequest) writeField4(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("count", thrift.I32, 4); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI32(p.Count); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
+}
+
+func (p *CommentReplyListRequest) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("CommentReplyListRequest(%+v)", *p)
+
+}
+
+func (p *CommentReplyListRequest) DeepEqual(ano *CommentReplyListRequest) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.UserId) {
+		return false
+	}
+	if !p.Field2DeepEqual(ano.CommentId) {
+		return false
+	}
+	if !p.Field3DeepEqual(ano.Cursor) {
+		return false
+	}
+	if !p.Field4DeepEqual(ano.Count) {
+		return false
+	}
+	return true
+}
+
+func (p *CommentReplyListRequest) Field1DeepEqual(src *int64) bool {
+
+	if p.UserId == src {
+		return true
+	} else if p.UserId == nil || src == nil {
+		return false
+	}
+	if *p.UserId != *src {
+		return false
+	}
+	return true
+}
+func (p *CommentReplyListRequest) Field2DeepEqual(src int64) bool {
+
+	if p.CommentId != src {
+		return false
+	}
+	return true
+}
+func (p *CommentReplyListRequest) Field3DeepEqual(src *string) bool {
+
+	if p.Cursor == src {
+		return true
+	} else if p.Cursor == nil || src == nil {
+		return false
+	}
+	if strings.Compare(*p.Cursor, *src) != 0 {
+		return false
+	}
+	return true
+}
+func (p *CommentReplyListRequest) Field4DeepEqual(src int32) bool {
+
+	if p.Count != src {
+		return false
+	}
+	return true
+}
+
+type CommentReplyListResponse struct {
+	StatusCode  int32      `thrift:"status_code,1" frugal:"1,default,i32" json:"status_code"`
+	StatusMsg   *string    `thrift:"status_msg,2,optional" frugal:"2,optional,string" json:"status_msg,omitempty"`
+	CommentList []*Comment `thrift:"comment_list,3" frugal:"3,default,list<Comment>" json:"comment_list"`
+	NextCursor  *string    `thrift:"next_cursor,4,optional" frugal:"4,optional,string" json:"next_cursor,omitempty"`
+	HasMore     bool       `thrift:"has_more,5" frugal:"5,default,bool" json:"has_more"`
+}
+
+func NewCommentReplyListResponse() *CommentReplyListResponse {
+	return &CommentReplyListResponse{}
+}
+
+func (p *CommentReplyListResponse) InitDefault() {
+	*p = CommentReplyListResponse{}
+}
+
+func (p *CommentReplyListResponse) GetStatusCode() (v int32) {
+	return p.StatusCode
+}
+
+var CommentReplyListResponse_StatusMsg_DEFAULT string
+
+func (p *CommentReplyListResponse) GetStatusMsg() (v string) {
+	if !p.IsSetStatusMsg() {
+		return CommentReplyListResponse_StatusMsg_DEFAULT
+	}
+	return *p.StatusMsg
+}
+
+func (p *CommentReplyListResponse) GetCommentList() (v []*Comment) {
+	return p.CommentList
+}
+
+var CommentReplyListResponse_NextCursor_DEFAULT string
+
+func (p *CommentReplyListResponse) GetNextCursor() (v string) {
+	if !p.IsSetNextCursor() {
+		return CommentReplyListResponse_NextCursor_DEFAULT
+	}
+	return *p.NextCursor
+}
+
+func (p *CommentReplyListResponse) GetHasMore() (v bool) {
+	return p.HasMore
+}
+func (p *CommentReplyListResponse) SetStatusCode(val int32) {
+	p.StatusCode = val
+}
+func (p *CommentReplyListResponse) SetStatusMsg(val *string) {
+	p.StatusMsg = val
+}
+func (p *CommentReplyListResponse) SetCommentList(val []*Comment) {
+	p.CommentList = val
+}
+func (p *CommentReplyListResponse) SetNextCursor(val *string) {
+	p.NextCursor = val
+}
+func (p *CommentReplyListResponse) SetHasMore(val bool) {
+	p.HasMore = val
+}
+
+var fieldIDToName_CommentReplyListResponse = map[int16]string{
+	1: "status_code",
+	2: "status_msg",
+	3: "comment_list",
+	4: "next_cursor",
+	5: "has_more",
+}
+
+func (p *CommentReplyListResponse) IsSetStatusMsg() bool {
+	return p.StatusMsg != nil
+}
+
+func (p *CommentReplyListResponse) IsSetNextCursor() bool {
+	return p.NextCursor != nil
+}
+
+func (p *CommentReplyListResponse) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.I32 {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 2:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 3:
+			if fieldTypeId == thrift.LIST {
+				if err = p.ReadField3(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 4:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField4(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 5:
+			if fieldTypeId == thrift.BOOL {
+				if err = p.ReadField5(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_CommentReplyListResponse[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *CommentReplyListResponse) ReadField1(iprot thrift.TProtocol) error {
+
+	var _field int32
+	if v, err := iprot.ReadI32(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.StatusCode = _field
+	return nil
+}
+func (p *CommentReplyListResponse) ReadField2(iprot thrift.TProtocol) error {
+
+	var _field *string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.StatusMsg = _field
+	return nil
+}
+func (p *CommentReplyListResponse) ReadField3(iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin()
+	if err != nil {
+		return err
+	}
+	_field := make([]*Comment, 0, size)
+	values := make([]Comment, size)
+	for i := 0; i < size; i++ {
+		_elem := &values[i]
+
+		if err := _elem.Read(iprot); err != nil {
+			return err
+		}
+
+		_field = append(_field, _elem)
+	}
+	if err := iprot.ReadListEnd(); err != nil {
+		return err
+	}
+	p.CommentList = _field
+	return nil
+}
+func (p *CommentReplyListResponse) ReadField4(iprot thrift.TProtocol) error {
+
+	var _field *string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.NextCursor = _field
+	return nil
+}
+func (p *CommentReplyListResponse) ReadField5(iprot thrift.TProtocol) error {
+
+	var _field bool
+	if v, err := iprot.ReadBool(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.HasMore = _field
+	return nil
+}
+
+func (p *CommentReplyListResponse) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("Comment_reply_list_response"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+		if err = p.writeField3(oprot); err != nil {
+			fieldId = 3
+			goto WriteFieldError
+		}
+		if err = p.writeField4(oprot); err != nil {
+			fieldId = 4
+			goto WriteFieldError
+		}
+		if err = p.writeField5(oprot); err != nil {
+			fieldId = 5
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *CommentReplyListResponse) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("status_code", thrift.I32, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI32(p.StatusCode); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *CommentReplyListResponse) writeField2(oprot thrift.TProtocol) (err error) {
+	if p.IsSetStatusMsg() {
+		if err = oprot.WriteFieldBegin("status_msg", thrift.STRING, 2); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.StatusMsg); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+
+func (p *CommentReplyListResponse) writeField3(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("comment_list", thrift.LIST, 3); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteListBegin(thrift.STRUCT, len(p.CommentList)); err != nil {
+		return err
+	}
+	for _, v := range p.CommentList {
+		if err := v.Write(oprot); err != nil {
+			return err
+		}
+	}
+	if err := oprot.WriteListEnd(); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
+}
+
+func (p *CommentReplyListResponse) writeField4(oprot thrift.TProtocol) (err error) {
+	if p.IsSetNextCursor() {
+		if err = oprot.WriteFieldBegin("next_cursor", thrift.STRING, 4); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.NextCursor); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
+}
+
+func (p *CommentReplyListResponse) writeField5(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("has_more", thrift.BOOL, 5); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteBool(p.HasMore); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 end error: ", p), err)
+}
+
+func (p *CommentReplyListResponse) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("CommentReplyListResponse(%+v)", *p)
+
+}
+
+func (p *CommentReplyListResponse) DeepEqual(ano *CommentReplyListResponse) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.StatusCode) {
+		return false
+	}
+	if !p.Field2DeepEqual(ano.StatusMsg) {
+		return false
+	}
+	if !p.Field3DeepEqual(ano.CommentList) {
+		return false
+	}
+	if !p.Field4DeepEqual(ano.NextCursor) {
+		return false
+	}
+	if !p.Field5DeepEqual(ano.HasMore) {
+		return false
+	}
+	return true
+}
+
+func (p *CommentReplyListResponse) Field1DeepEqual(src int32) bool {
+
+	if p.StatusCode != src {
+		return false
+	}
+	return true
+}
+func (p *CommentReplyListResponse) Field2DeepEqual(src *string) bool {
+
+	if p.StatusMsg == src {
+		return true
+	} else if p.StatusMsg == nil || src == nil {
+		return false
+	}
+	if strings.Compare(*p.StatusMsg, *src) != 0 {
+		return false
+	}
+	return true
+}
+func (p *CommentReplyListResponse) Field3DeepEqual(src []*Comment) bool {
+
+	if len(p.CommentList) != len(src) {
+		return false
+	}
+	for i, v := range p.CommentList {
+		_src := src[i]
+		if !v.DeepEqual(_src) {
+			return false
+		}
+	}
+	return true
+}
+func (p *CommentReplyListResponse) Field4DeepEqual(src *string) bool {
+
+	if p.NextCursor == src {
+		return true
+	} else if p.NextCursor == nil || src == nil {
+		return false
+	}
+	if strings.Compare(*p.NextCursor, *src) != 0 {
+		return false
+	}
+	return true
+}
+func (p *CommentReplyListResponse) Field5DeepEqual(src bool) bool {
+
+	if p.HasMore != src {
+		return false
+	}
+	return true
+}
+
+type CommentFavoriteActionRequest struct {
+	UserId     int64 `thrift:"user_id,1" frugal:"1,default,i64" json:"user_id"`
+	CommentId  int64 `thrift:"comment_id,2" frugal:"2,default,i64" json:"comment_id"`
+	ActionType int64 `thrift:"action_type,3" frugal:"3,default,i64" json:"action_type"`
+}
+
+func NewCommentFavoriteActionRequest() *CommentFavoriteActionRequest {
+	return &CommentFavoriteActionRequest{}
+}
+
+func (p *CommentFavoriteActionRequest) InitDefault() {
+	*p = CommentFavoriteActionRequest{}
+}
+
+func (p *CommentFavoriteActionRequest) GetUserId() (v int64) {
+	return p.UserId
+}
+
+func (p *CommentFavoriteActionRequest) GetCommentId() (v int64) {
+	return p.CommentId
+}
+
+func (p *CommentFavoriteActionRequest) GetActionType() (v int64) {
+	return p.ActionType
+}
+func (p *CommentFavoriteActionRequest) SetUserId(val int64) {
+	p.UserId = val
+}
+func (p *CommentFavoriteActionRequest) SetCommentId(val int64) {
+	p.CommentId = val
+}
+func (p *CommentFavoriteActionRequest) SetActionType(val int64) {
+	p.ActionType = val
+}
+
+var fieldIDToName_CommentFavoriteActionRequest = map[int16]string{
+	1: "user_id",
+	2: "comment_id",
+	3: "action_type",
+}
+
+func (p *CommentFavoriteActionRequest) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 2:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 3:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField3(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_CommentFavoriteActionRequest[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *CommentFavoriteActionRequest) ReadField1(iprot thrift.TProtocol) error {
+
+	var _field int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.UserId = _field
+	return nil
+}
+func (p *CommentFavoriteActionRequest) ReadField2(iprot thrift.TProtocol) error {
+
+	var _field int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.CommentId = _field
+	return nil
+}
+func (p *CommentFavoriteActionRequest) ReadField3(iprot thrift.TProtocol) error {
+
+	var _field int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.ActionType = _field
+	return nil
+}
+
+func (p *CommentFavoriteActionRequest) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("Comment_favorite_action_request"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+		if err = p.writeField3(oprot); err != nil {
+			fieldId = 3
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *CommentFavoriteActionRequest) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("user_id", thrift.I64, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI64(p.UserId); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *CommentFavoriteActionRequest) writeField2(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("comment_id", thrift.I64, 2); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI64(p.CommentId); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+
+func (p *CommentFavoriteActionRequest) writeField3(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("action_type", thrift.I64, 3); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI64(p.ActionType); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
+}
+
+func (p *CommentFavoriteActionRequest) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("CommentFavoriteActionRequest(%+v)", *p)
+
+}
+
+func (p *CommentFavoriteActionRequest) DeepEqual(ano *CommentFavoriteActionRequest) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.UserId) {
+		return false
+	}
+	if !p.Field2DeepEqual(ano.CommentId) {
+		return false
+	}
+	if !p.Field3DeepEqual(ano.ActionType) {
+		return false
+	}
+	return true
+}
+
+func (p *CommentFavoriteActionRequest) Field1DeepEqual(src int64) bool {
+
+	if p.UserId != src {
+		return false
+	}
+	return true
+}
+func (p *CommentFavoriteActionRequest) Field2DeepEqual(src int64) bool {
+
+	if p.CommentId != src {
+		return false
+	}
+	return true
+}
+func (p *CommentFavoriteActionRequest) Field3DeepEqual(src int64) bool {
+
+	if p.ActionType != src {
+		return false
+	}
+	return true
+}
+
+type CommentFavoriteActionResponse struct {
+	StatusCode int32   `thrift:"status_code,1" frugal:"1,default,i32" json:"status_code"`
+	StatusMsg  *string `thrift:"status_msg,2,optional" frugal:"2,optional,string" json:"status_msg,omitempty"`
+}
+
+func NewCommentFavoriteActionResponse() *CommentFavoriteActionResponse {
+	return &CommentFavoriteActionResponse{}
+}
+
+func (p *CommentFavoriteActionResponse) InitDefault() {
+	*p = CommentFavoriteActionResponse{}
+}
+
+func (p *CommentFavoriteActionResponse) GetStatusCode() (v int32) {
+	return p.StatusCode
+}
+
+var CommentFavoriteActionResponse_StatusMsg_DEFAULT string
+
+func (p *CommentFavoriteActionResponse) GetStatusMsg() (v string) {
+	if !p.IsSetStatusMsg() {
+		return CommentFavoriteActionResponse_StatusMsg_DEFAULT
+	}
+	return *p.StatusMsg
+}
+func (p *CommentFavoriteActionResponse) SetStatusCode(val int32) {
+	p.StatusCode = val
+}
+func (p *CommentFavoriteActionResponse) SetStatusMsg(val *string) {
+	p.StatusMsg = val
+}
+
+var fieldIDToName_CommentFavoriteActionResponse = map[int16]string{
+	1: "status_code",
+	2: "status_msg",
+}
+
+func (p *CommentFavoriteActionResponse) IsSetStatusMsg() bool {
+	return p.StatusMsg != nil
+}
+
+func (p *CommentFavoriteActionResponse) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.I32 {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 2:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_CommentFavoriteActionResponse[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *CommentFavoriteActionResponse) ReadField1(iprot thrift.TProtocol) error {
+
+	var _field int32
+	if v, err := iprot.ReadI32(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.StatusCode = _field
+	return nil
+}
+func (p *CommentFavoriteActionResponse) ReadField2(iprot thrift.TProtocol) error {
+
+	var _field *string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.StatusMsg = _field
+	return nil
+}
+
+func (p *CommentFavoriteActionResponse) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("Comment_favorite_action_response"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *CommentFavoriteActionResponse) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("status_code", thrift.I32, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI32(p.StatusCode); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *CommentFavoriteActionResponse) writeField2(oprot thrift.TProtocol) (err error) {
+	if p.IsSetStatusMsg() {
+		if err = oprot.WriteFieldBegin("status_msg", thrift.STRING, 2); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.StatusMsg); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+
+func (p *CommentFavoriteActionResponse) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("CommentFavoriteActionResponse(%+v)", *p)
+
+}
+
+func (p *CommentFavoriteActionResponse) DeepEqual(ano *CommentFavoriteActionResponse) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.StatusCode) {
+		return false
+	}
+	if !p.Field2DeepEqual(ano.StatusMsg) {
+		return false
+	}
+	return true
+}
+
+func (p *CommentFavoriteActionResponse) Field1DeepEqual(src int32) bool {
+
+	if p.StatusCode != src {
+		return false
+	}
+	return true
+}
+func (p *CommentFavoriteActionResponse) Field2DeepEqual(src *string) bool {
+
+	if p.StatusMsg == src {
+		return true
+	} else if p.StatusMsg == nil || src == nil {
+		return false
+	}
+	if strings.Compare(*p.StatusMsg, *src) != 0 {
+		return false
+	}
+	return true
+}
+
+type CommentService interface {
+	CommentAction(ctx context.Context, req *CommentActionRequest) (r *CommentActionResponse, err error)
+
+	CommentList(ctx context.Context, req *CommentListRequest) (r *CommentListResponse, err error)
+
+	CommentReplyList(ctx context.Context, req *CommentReplyListRequest) (r *CommentReplyListResponse, err error)
+
+	CommentFavoriteAction(ctx context.Context, req *CommentFavoriteActionRequest) (r *CommentFavoriteActionResponse, err error)
+
+	CommentCnt(ctx context.Context, videoId int64) (r int64, err error)
+
+	BatchCommentCnt(ctx context.Context, videoIds []int64) (r map[int64]int64, err error)
+}
+
+type CommentServiceClient struct {
+	c thrift.TClient
+}
+
+func NewCommentServiceClientFactory(t thrift.TTransport, f thrift.TProtocolFactory) *CommentServiceClient {
+	return &CommentServiceClient{
+		c: thrift.NewTStandardClient(f.GetProtocol(t), f.GetProtocol(t)),
+	}
+}
+
+func NewCommentServiceClientProtocol(t thrift.TTransport, iprot thrift.TProtocol, oprot thrift.TProtocol) *CommentServiceClient {
+	return &CommentServiceClient{
+		c: thrift.NewTStandardClient(iprot, oprot),
+	}
+}
+
+func NewCommentServiceClient(c thrift.TClient) *CommentServiceClient {
+	return &CommentServiceClient{
+		c: c,
+	}
+}
+
+func (p *CommentServiceClient) Client_() thrift.TClient {
 	return p.c
 }
 
-func (p *CommentServiceClient) CommentAction(ctx context.Context, req *CommentActionRequest) (r *CommentActionResponse, err error) {
-	var _args CommentServiceCommentActionArgs
-	_args.Req = req
-	var _result CommentServiceCommentActionResult
-	if err = p.Client_().Call(ctx, "CommentAction", &_args, &_result); err != nil {
-		return
+func (p *CommentServiceClient) CommentAction(ctx context.Context, req *CommentActionRequest) (r *CommentActionResponse, err error) {
+	var _args CommentServiceCommentActionArgs
+	_args.Req = req
+	var _result CommentServiceCommentActionResult
+	if err = p.Client_().Call(ctx, "CommentAction", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *CommentServiceClient) CommentList(ctx context.Context, req *CommentListRequest) (r *CommentListResponse, err error) {
+	var _args CommentServiceCommentListArgs
+	_args.Req = req
+	var _result CommentServiceCommentListResult
+	if err = p.Client_().Call(ctx, "CommentList", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *CommentServiceClient) CommentReplyList(ctx context.Context, req *CommentReplyListRequest) (r *CommentReplyListResponse, err error) {
+	var _args CommentServiceCommentReplyListArgs
+	_args.Req = req
+	var _result CommentServiceCommentReplyListResult
+	if err = p.Client_().Call(ctx, "CommentReplyList", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *CommentServiceClient) CommentFavoriteAction(ctx context.Context, req *CommentFavoriteActionRequest) (r *CommentFavoriteActionResponse, err error) {
+	var _args CommentServiceCommentFavoriteActionArgs
+	_args.Req = req
+	var _result CommentServiceCommentFavoriteActionResult
+	if err = p.Client_().Call(ctx, "CommentFavoriteAction", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *CommentServiceClient) CommentCnt(ctx context.Context, videoId int64) (r int64, err error) {
+	var _args CommentServiceCommentCntArgs
+	_args.VideoId = videoId
+	var _result CommentServiceCommentCntResult
+	if err = p.Client_().Call(ctx, "CommentCnt", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *CommentServiceClient) BatchCommentCnt(ctx context.Context, videoIds []int64) (r map[int64]int64, err error) {
+	var _args CommentServiceBatchCommentCntArgs
+	_args.VideoIds = videoIds
+	var _result CommentServiceBatchCommentCntResult
+	if err = p.Client_().Call(ctx, "BatchCommentCnt", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+type CommentServiceProcessor struct {
+	processorMap map[string]thrift.TProcessorFunction
+	handler      CommentService
+}
+
+func (p *CommentServiceProcessor) AddToProcessorMap(key string, processor thrift.TProcessorFunction) {
+	p.processorMap[key] = processor
+}
+
+func (p *CommentServiceProcessor) GetProcessorFunction(key string) (processor thrift.TProcessorFunction, ok bool) {
+	processor, ok = p.processorMap[key]
+	return processor, ok
+}
+
+func (p *CommentServiceProcessor) ProcessorMap() map[string]thrift.TProcessorFunction {
+	return p.processorMap
+}
+
+func NewCommentServiceProcessor(handler CommentService) *CommentServiceProcessor {
+	self := &CommentServiceProcessor{handler: handler, processorMap: make(map[string]thrift.TProcessorFunction)}
+	self.AddToProcessorMap("CommentAction", &commentServiceProcessorCommentAction{handler: handler})
+	self.AddToProcessorMap("CommentList", &commentServiceProcessorCommentList{handler: handler})
+	self.AddToProcessorMap("CommentReplyList", &commentServiceProcessorCommentReplyList{handler: handler})
+	self.AddToProcessorMap("CommentFavoriteAction", &commentServiceProcessorCommentFavoriteAction{handler: handler})
+	self.AddToProcessorMap("CommentCnt", &commentServiceProcessorCommentCnt{handler: handler})
+	self.AddToProcessorMap("BatchCommentCnt", &commentServiceProcessorBatchCommentCnt{handler: handler})
+	return self
+}
+func (p *CommentServiceProcessor) Process(ctx context.Context, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	name, _, seqId, err := iprot.ReadMessageBegin()
+	if err != nil {
+		return false, err
+	}
+	if processor, ok := p.GetProcessorFunction(name); ok {
+		return processor.Process(ctx, seqId, iprot, oprot)
+	}
+	iprot.Skip(thrift.STRUCT)
+	iprot.ReadMessageEnd()
+	x := thrift.NewTApplicationException(thrift.UNKNOWN_METHOD, "Unknown function "+name)
+	oprot.WriteMessageBegin(name, thrift.EXCEPTION, seqId)
+	x.Write(oprot)
+	oprot.WriteMessageEnd()
+	oprot.Flush(ctx)
+	return false, x
+}
+
+type commentServiceProcessorCommentAction struct {
+	handler CommentService
+}
+
+func (p *commentServiceProcessorCommentAction) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := CommentServiceCommentActionArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("CommentAction", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := CommentServiceCommentActionResult{}
+	var retval *CommentActionResponse
+	if retval, err2 = p.handler.CommentAction(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing CommentAction: "+err2.Error())
+		oprot.WriteMessageBegin("CommentAction", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("CommentAction", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type commentServiceProcessorCommentList struct {
+	handler CommentService
+}
+
+func (p *commentServiceProcessorCommentList) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := CommentServiceCommentListArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("CommentList", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := CommentServiceCommentListResult{}
+	var retval *CommentListResponse
+	if retval, err2 = p.handler.CommentList(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing CommentList: "+err2.Error())
+		oprot.WriteMessageBegin("CommentList", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("CommentList", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type commentServiceProcessorCommentReplyList struct {
+	handler CommentService
+}
+
+func (p *commentServiceProcessorCommentReplyList) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := CommentServiceCommentReplyListArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("CommentReplyList", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := CommentServiceCommentReplyListResult{}
+	var retval *CommentReplyListResponse
+	if retval, err2 = p.handler.CommentReplyList(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing CommentReplyList: "+err2.Error())
+		oprot.WriteMessageBegin("CommentReplyList", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("CommentReplyList", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type commentServiceProcessorCommentFavoriteAction struct {
+	handler CommentService
+}
+
+func (p *commentServiceProcessorCommentFavoriteAction) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := CommentServiceCommentFavoriteActionArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("CommentFavoriteAction", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := CommentServiceCommentFavoriteActionResult{}
+	var retval *CommentFavoriteActionResponse
+	if retval, err2 = p.handler.CommentFavoriteAction(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing CommentFavoriteAction: "+err2.Error())
+		oprot.WriteMessageBegin("CommentFavoriteAction", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("CommentFavoriteAction", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type commentServiceProcessorCommentCnt struct {
+	handler CommentService
+}
+
+func (p *commentServiceProcessorCommentCnt) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := CommentServiceCommentCntArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("CommentCnt", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := CommentServiceCommentCntResult{}
+	var retval int64
+	if retval, err2 = p.handler.CommentCnt(ctx, args.VideoId); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing CommentCnt: "+err2.Error())
+		oprot.WriteMessageBegin("CommentCnt", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = &retval
+	}
+	if err2 = oprot.WriteMessageBegin("CommentCnt", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type commentServiceProcessorBatchCommentCnt struct {
+	handler CommentService
+}
+
+func (p *commentServiceProcessorBatchCommentCnt) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := CommentServiceBatchCommentCntArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("BatchCommentCnt", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := CommentServiceBatchCommentCntResult{}
+	var retval map[int64]int64
+	if retval, err2 = p.handler.BatchCommentCnt(ctx, args.VideoIds); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing BatchCommentCnt: "+err2.Error())
+		oprot.WriteMessageBegin("BatchCommentCnt", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("BatchCommentCnt", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type CommentServiceCommentActionArgs struct {
+	Req *CommentActionRequest `thrift:"req,1" frugal:"1,default,CommentActionRequest" json:"req"`
+}
+
+func NewCommentServiceCommentActionArgs() *CommentServiceCommentActionArgs {
+	return &CommentServiceCommentActionArgs{}
+}
+
+func (p *CommentServiceCommentActionArgs) InitDefault() {
+	*p = CommentServiceCommentActionArgs{}
+}
+
+var CommentServiceCommentActionArgs_Req_DEFAULT *CommentActionRequest
+
+func (p *CommentServiceCommentActionArgs) GetReq() (v *CommentActionRequest) {
+	if !p.IsSetReq() {
+		return CommentServiceCommentActionArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+func (p *CommentServiceCommentActionArgs) SetReq(val *CommentActionRequest) {
+	p.Req = val
+}
+
+var fieldIDToName_CommentServiceCommentActionArgs = map[int16]string{
+	1: "req",
+}
+
+func (p *CommentServiceCommentActionArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *CommentServiceCommentActionArgs) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_CommentServiceCommentActionArgs[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *CommentServiceCommentActionArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := NewCommentActionRequest()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Req = _field
+	return nil
+}
+
+func (p *CommentServiceCommentActionArgs) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("CommentAction_args"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *CommentServiceCommentActionArgs) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := p.Req.Write(oprot); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *CommentServiceCommentActionArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("CommentServiceCommentActionArgs(%+v)", *p)
+
+}
+
+func (p *CommentServiceCommentActionArgs) DeepEqual(ano *CommentServiceCommentActionArgs) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.Req) {
+		return false
+	}
+	return true
+}
+
+func (p *CommentServiceCommentActionArgs) Field1DeepEqual(src *CommentActionRequest) bool {
+
+	if !p.Req.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+
+type CommentServiceCommentActionResult struct {
+	Success *CommentActionResponse `thrift:"success,0,optional" frugal:"0,optional,CommentActionResponse" json:"success,omitempty"`
+}
+
+func NewCommentServiceCommentActionResult() *CommentServiceCommentActionResult {
+	return &CommentServiceCommentActionResult{}
+}
+
+func (p *CommentServiceCommentActionResult) InitDefault() {
+	*p = CommentServiceCommentActionResult{}
+}
+
+var CommentServiceCommentActionResult_Success_DEFAULT *CommentActionResponse
+
+func (p *CommentServiceCommentActionResult) GetSuccess() (v *CommentActionResponse) {
+	if !p.IsSetSuccess() {
+		return CommentServiceCommentActionResult_Success_DEFAULT
+	}
+	return p.Success
+}
+func (p *CommentServiceCommentActionResult) SetSuccess(x interface{}) {
+	p.Success = x.(*CommentActionResponse)
+}
+
+var fieldIDToName_CommentServiceCommentActionResult = map[int16]string{
+	0: "success",
+}
+
+func (p *CommentServiceCommentActionResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *CommentServiceCommentActionResult) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 0:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField0(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_CommentServiceCommentActionResult[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *CommentServiceCommentActionResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := NewCommentActionResponse()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Success = _field
+	return nil
+}
+
+func (p *CommentServiceCommentActionResult) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("CommentAction_result"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField0(oprot); err != nil {
+			fieldId = 0
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *CommentServiceCommentActionResult) writeField0(oprot thrift.TProtocol) (err error) {
+	if p.IsSetSuccess() {
+		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.Success.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
+}
+
+func (p *CommentServiceCommentActionResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("CommentServiceCommentActionResult(%+v)", *p)
+
+}
+
+func (p *CommentServiceCommentActionResult) DeepEqual(ano *CommentServiceCommentActionResult) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field0DeepEqual(ano.Success) {
+		return false
+	}
+	return true
+}
+
+func (p *CommentServiceCommentActionResult) Field0DeepEqual(src *CommentActionResponse) bool {
+
+	if !p.Success.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+
+type CommentServiceCommentListArgs struct {
+	Req *CommentListRequest `thrift:"req,1" frugal:"1,default,CommentListRequest" json:"req"`
+}
+
+func NewCommentServiceCommentListArgs() *CommentServiceCommentListArgs {
+	return &CommentServiceCommentListArgs{}
+}
+
+func (p *CommentServiceCommentListArgs) InitDefault() {
+	*p = CommentServiceCommentListArgs{}
+}
+
+var CommentServiceCommentListArgs_Req_DEFAULT *CommentListRequest
+
+func (p *CommentServiceCommentListArgs) GetReq() (v *CommentListRequest) {
+	if !p.IsSetReq() {
+		return CommentServiceCommentListArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+func (p *CommentServiceCommentListArgs) SetReq(val *CommentListRequest) {
+	p.Req = val
+}
+
+var fieldIDToName_CommentServiceCommentListArgs = map[int16]string{
+	1: "req",
+}
+
+func (p *CommentServiceCommentListArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *CommentServiceCommentListArgs) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_CommentServiceCommentListArgs[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *CommentServiceCommentListArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := NewCommentListRequest()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Req = _field
+	return nil
+}
+
+func (p *CommentServiceCommentListArgs) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("CommentList_args"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *CommentServiceCommentListArgs) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := p.Req.Write(oprot); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *CommentServiceCommentListArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("CommentServiceCommentListArgs(%+v)", *p)
+
+}
+
+func (p *CommentServiceCommentListArgs) DeepEqual(ano *CommentServiceCommentListArgs) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.Req) {
+		return false
+	}
+	return true
+}
+
+func (p *CommentServiceCommentListArgs) Field1DeepEqual(src *CommentListRequest) bool {
+
+	if !p.Req.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+
+type CommentServiceCommentListResult struct {
+	Success *CommentListResponse `thrift:"success,0,optional" frugal:"0,optional,CommentListResponse" json:"success,omitempty"`
+}
+
+func NewCommentServiceCommentListResult() *CommentServiceCommentListResult {
+	return &CommentServiceCommentListResult{}
+}
+
+func (p *CommentServiceCommentListResult) InitDefault() {
+	*p = CommentServiceCommentListResult{}
+}
+
+var CommentServiceCommentListResult_Success_DEFAULT *CommentListResponse
+
+func (p *CommentServiceCommentListResult) GetSuccess() (v *CommentListResponse) {
+	if !p.IsSetSuccess() {
+		return CommentServiceCommentListResult_Success_DEFAULT
+	}
+	return p.Success
+}
+func (p *CommentServiceCommentListResult) SetSuccess(x interface{}) {
+	p.Success = x.(*CommentListResponse)
+}
+
+var fieldIDToName_CommentServiceCommentListResult = map[int16]string{
+	0: "success",
+}
+
+func (p *CommentServiceCommentListResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *CommentServiceCommentListResult) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 0:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField0(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_CommentServiceCommentListResult[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *CommentServiceCommentListResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := NewCommentListResponse()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Success = _field
+	return nil
+}
+
+func (p *CommentServiceCommentListResult) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("CommentList_result"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField0(oprot); err != nil {
+			fieldId = 0
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *CommentServiceCommentListResult) writeField0(oprot thrift.TProtocol) (err error) {
+	if p.IsSetSuccess() {
+		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.Success.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
 	}
-	return _result.GetSuccess(), nil
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
 }
-func (p *CommentServiceClient) CommentList(ctx context.Context, req *CommentListRequest) (r *CommentListResponse, err error) {
-	var _args CommentServiceCommentListArgs
-	_args.Req = req
-	var _result CommentServiceCommentListResult
-	if err = p.Client_().Call(ctx, "CommentList", &_args, &_result); err != nil {
-		return
+
+func (p *CommentServiceCommentListResult) String() string {
+	if p == nil {
+		return "<nil>"
 	}
-	return _result.GetSuccess(), nil
+	return fmt.Sprintf("CommentServiceCommentListResult(%+v)", *p)
+
 }
-func (p *CommentServiceClient) CommentCnt(ctx context.Context, videoId int64) (r int64, err error) {
-	var _args CommentServiceCommentCntArgs
-	_args.VideoId = videoId
-	var _result CommentServiceCommentCntResult
-	if err = p.Client_().Call(ctx, "CommentCnt", &_args, &_result); err != nil {
-		return
+
+func (p *CommentServiceCommentListResult) DeepEqual(ano *CommentServiceCommentListResult) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
 	}
-	return _result.GetSuccess(), nil
+	if !p.Field0DeepEqual(ano.Success) {
+		return false
+	}
+	return true
 }
 
-type CommentServiceProcessor struct {
-	processorMap map[string]thrift.TProcessorFunction
-	handler      CommentService
+func (p *CommentServiceCommentListResult) Field0DeepEqual(src *CommentListResponse) bool {
+
+	if !p.Success.DeepEqual(src) {
+		return false
+	}
+	return true
 }
 
-func (p *CommentServiceProcessor) AddToProcessorMap(key string, processor thrift.TProcessorFunction) {
-	p.processorMap[key] = processor
+type CommentServiceCommentReplyListArgs struct {
+	Req *CommentReplyListRequest `thrift:"req,1" frugal:"1,default,CommentReplyListRequest" json:"req"`
 }
 
-func (p *CommentServiceProcessor) GetProcessorFunction(key string) (processor thrift.TProcessorFunction, ok bool) {
-	processor, ok = p.processorMap[key]
-	return processor, ok
+func NewCommentServiceCommentReplyListArgs() *CommentServiceCommentReplyListArgs {
+	return &CommentServiceCommentReplyListArgs{}
 }
 
-func (p *CommentServiceProcessor) ProcessorMap() map[string]thrift.TProcessorFunction {
-	return p.processorMap
+func (p *CommentServiceCommentReplyListArgs) InitDefault() {
+	*p = CommentServiceCommentReplyListArgs{}
 }
 
-func NewCommentServiceProcessor(handler CommentService) *CommentServiceProcessor {
-	self := &CommentServiceProcessor{handler: handler, processorMap: make(map[string]thrift.TProcessorFunction)}
-	self.AddToProcessorMap("CommentAction", &commentServiceProcessorCommentAction{handler: handler})
-	self.AddToProcessorMap("CommentList", &commentServiceProcessorCommentList{handler: handler})
-	self.AddToProcessorMap("CommentCnt", &commentServiceProcessorCommentCnt{handler: handler})
-	return self
+var CommentServiceCommentReplyListArgs_Req_DEFAULT *CommentReplyListRequest
+
+func (p *CommentServiceCommentReplyListArgs) GetReq() (v *CommentReplyListRequest) {
+	if !p.IsSetReq() {
+		return CommentServiceCommentReplyListArgs_Req_DEFAULT
+	}
+	return p.Req
 }
-func (p *CommentServiceProcessor) Process(ctx context.Context, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-	name, _, seqId, err := iprot.ReadMessageBegin()
-	if err != nil {
-		return false, err
+func (p *CommentServiceCommentReplyListArgs) SetReq(val *CommentReplyListRequest) {
+	p.Req = val
+}
+
+var fieldIDToName_CommentServiceCommentReplyListArgs = map[int16]string{
+	1: "req",
+}
+
+func (p *CommentServiceCommentReplyListArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *CommentServiceCommentReplyListArgs) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
 	}
-	if processor, ok := p.GetProcessorFunction(name); ok {
-		return processor.Process(ctx, seqId, iprot, oprot)
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
 	}
-	iprot.Skip(thrift.STRUCT)
-	iprot.ReadMessageEnd()
-	x := thrift.NewTApplicationException(thrift.UNKNOWN_METHOD, "Unknown function "+name)
-	oprot.WriteMessageBegin(name, thrift.EXCEPTION, seqId)
-	x.Write(oprot)
-	oprot.WriteMessageEnd()
-	oprot.Flush(ctx)
-	return false, x
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_CommentServiceCommentReplyListArgs[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-type commentServiceProcessorCommentAction struct {
-	handler CommentService
+func (p *CommentServiceCommentReplyListArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := NewCommentReplyListRequest()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Req = _field
+	return nil
 }
 
-func (p *commentServiceProcessorCommentAction) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-	args := CommentServiceCommentActionArgs{}
-	if err = args.Read(iprot); err != nil {
-		iprot.ReadMessageEnd()
-		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
-		oprot.WriteMessageBegin("CommentAction", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return false, err
+func (p *CommentServiceCommentReplyListArgs) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("CommentReplyList_args"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
 	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
 
-	iprot.ReadMessageEnd()
-	var err2 error
-	result := CommentServiceCommentActionResult{}
-	var retval *CommentActionResponse
-	if retval, err2 = p.handler.CommentAction(ctx, args.Req); err2 != nil {
-		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing CommentAction: "+err2.Error())
-		oprot.WriteMessageBegin("CommentAction", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return true, err2
-	} else {
-		result.Success = retval
+func (p *CommentServiceCommentReplyListArgs) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
+		goto WriteFieldBeginError
 	}
-	if err2 = oprot.WriteMessageBegin("CommentAction", thrift.REPLY, seqId); err2 != nil {
-		err = err2
+	if err := p.Req.Write(oprot); err != nil {
+		return err
 	}
-	if err2 = result.Write(oprot); err == nil && err2 != nil {
-		err = err2
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
 	}
-	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
-		err = err2
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *CommentServiceCommentReplyListArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("CommentServiceCommentReplyListArgs(%+v)", *p)
+
+}
+
+func (p *CommentServiceCommentReplyListArgs) DeepEqual(ano *CommentServiceCommentReplyListArgs) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.Req) {
+		return false
 	}
-	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
-		err = err2
+	return true
+}
+
+func (p *CommentServiceCommentReplyListArgs) Field1DeepEqual(src *CommentReplyListRequest) bool {
+
+	if !p.Req.DeepEqual(src) {
+		return false
 	}
-	if err != nil {
-		return
+	return true
+}
+
+type CommentServiceCommentReplyListResult struct {
+	Success *CommentReplyListResponse `thrift:"success,0,optional" frugal:"0,optional,CommentReplyListResponse" json:"success,omitempty"`
+}
+
+func NewCommentServiceCommentReplyListResult() *CommentServiceCommentReplyListResult {
+	return &CommentServiceCommentReplyListResult{}
+}
+
+func (p *CommentServiceCommentReplyListResult) InitDefault() {
+	*p = CommentServiceCommentReplyListResult{}
+}
+
+var CommentServiceCommentReplyListResult_Success_DEFAULT *CommentReplyListResponse
+
+func (p *CommentServiceCommentReplyListResult) GetSuccess() (v *CommentReplyListResponse) {
+	if !p.IsSetSuccess() {
+		return CommentServiceCommentReplyListResult_Success_DEFAULT
 	}
-	return true, err
+	return p.Success
+}
+func (p *CommentServiceCommentReplyListResult) SetSuccess(x interface{}) {
+	p.Success = x.(*CommentReplyListResponse)
 }
 
-type commentServiceProcessorCommentList struct {
-	handler CommentService
+var fieldIDToName_CommentServiceCommentReplyListResult = map[int16]string{
+	0: "success",
 }
 
-func (p *commentServiceProcessorCommentList) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-	args := CommentServiceCommentListArgs{}
-	if err = args.Read(iprot); err != nil {
-		iprot.ReadMessageEnd()
-		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
-		oprot.WriteMessageBegin("CommentList", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return false, err
+func (p *CommentServiceCommentReplyListResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *CommentServiceCommentReplyListResult) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
 	}
 
-	iprot.ReadMessageEnd()
-	var err2 error
-	result := CommentServiceCommentListResult{}
-	var retval *CommentListResponse
-	if retval, err2 = p.handler.CommentList(ctx, args.Req); err2 != nil {
-		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing CommentList: "+err2.Error())
-		oprot.WriteMessageBegin("CommentList", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return true, err2
-	} else {
-		result.Success = retval
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 0:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField0(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
 	}
-	if err2 = oprot.WriteMessageBegin("CommentList", thrift.REPLY, seqId); err2 != nil {
-		err = err2
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
 	}
-	if err2 = result.Write(oprot); err == nil && err2 != nil {
-		err = err2
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_CommentServiceCommentReplyListResult[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *CommentServiceCommentReplyListResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := NewCommentReplyListResponse()
+	if err := _field.Read(iprot); err != nil {
+		return err
 	}
-	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
-		err = err2
+	p.Success = _field
+	return nil
+}
+
+func (p *CommentServiceCommentReplyListResult) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("CommentReplyList_result"); err != nil {
+		goto WriteStructBeginError
 	}
-	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
-		err = err2
+	if p != nil {
+		if err = p.writeField0(oprot); err != nil {
+			fieldId = 0
+			goto WriteFieldError
+		}
 	}
-	if err != nil {
-		return
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
 	}
-	return true, err
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-type commentServiceProcessorCommentCnt struct {
-	handler CommentService
+func (p *CommentServiceCommentReplyListResult) writeField0(oprot thrift.TProtocol) (err error) {
+	if p.IsSetSuccess() {
+		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.Success.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
 }
 
-func (p *commentServiceProcessorCommentCnt) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-	args := CommentServiceCommentCntArgs{}
-	if err = args.Read(iprot); err != nil {
-		iprot.ReadMessageEnd()
-		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
-		oprot.WriteMessageBegin("CommentCnt", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return false, err
+func (p *CommentServiceCommentReplyListResult) String() string {
+	if p == nil {
+		return "<nil>"
 	}
+	return fmt.Sprintf("CommentServiceCommentReplyListResult(%+v)", *p)
 
-	iprot.ReadMessageEnd()
-	var err2 error
-	result := CommentServiceCommentCntResult{}
-	var retval int64
-	if retval, err2 = p.handler.CommentCnt(ctx, args.VideoId); err2 != nil {
-		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing CommentCnt: "+err2.Error())
-		oprot.WriteMessageBegin("CommentCnt", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return true, err2
-	} else {
-		result.Success = &retval
-	}
-	if err2 = oprot.WriteMessageBegin("CommentCnt", thrift.REPLY, seqId); err2 != nil {
-		err = err2
-	}
-	if err2 = result.Write(oprot); err == nil && err2 != nil {
-		err = err2
-	}
-	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
-		err = err2
+}
+
+func (p *CommentServiceCommentReplyListResult) DeepEqual(ano *CommentServiceCommentReplyListResult) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
 	}
-	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
-		err = err2
+	if !p.Field0DeepEqual(ano.Success) {
+		return false
 	}
-	if err != nil {
-		return
+	return true
+}
+
+func (p *CommentServiceCommentReplyListResult) Field0DeepEqual(src *CommentReplyListResponse) bool {
+
+	if !p.Success.DeepEqual(src) {
+		return false
 	}
-	return true, err
+	return true
 }
 
-type CommentServiceCommentActionArgs struct {
-	Req *CommentActionRequest `thrift:"req,1" frugal:"1,default,CommentActionRequest" json:"req"`
+type CommentServiceCommentFavoriteActionArgs struct {
+	Req *CommentFavoriteActionRequest `thrift:"req,1" frugal:"1,default,CommentFavoriteActionRequest" json:"req"`
 }
 
-func NewCommentServiceCommentActionArgs() *CommentServiceCommentActionArgs {
-	return &CommentServiceCommentActionArgs{}
+func NewCommentServiceCommentFavoriteActionArgs() *CommentServiceCommentFavoriteActionArgs {
+	return &CommentServiceCommentFavoriteActionArgs{}
 }
 
-func (p *CommentServiceCommentActionArgs) InitDefault() {
-	*p = CommentServiceCommentActionArgs{}
+func (p *CommentServiceCommentFavoriteActionArgs) InitDefault() {
+	*p = CommentServiceCommentFavoriteActionArgs{}
 }
 
-var CommentServiceCommentActionArgs_Req_DEFAULT *CommentActionRequest
+var CommentServiceCommentFavoriteActionArgs_Req_DEFAULT *CommentFavoriteActionRequest
 
-func (p *CommentServiceCommentActionArgs) GetReq() (v *CommentActionRequest) {
+func (p *CommentServiceCommentFavoriteActionArgs) GetReq() (v *CommentFavoriteActionRequest) {
 	if !p.IsSetReq() {
-		return CommentServiceCommentActionArgs_Req_DEFAULT
+		return CommentServiceCommentFavoriteActionArgs_Req_DEFAULT
 	}
 	return p.Req
 }
-func (p *CommentServiceCommentActionArgs) SetReq(val *CommentActionRequest) {
+func (p *CommentServiceCommentFavoriteActionArgs) SetReq(val *CommentFavoriteActionRequest) {
 	p.Req = val
 }
 
-var fieldIDToName_CommentServiceCommentActionArgs = map[int16]string{
+var fieldIDToName_CommentServiceCommentFavoriteActionArgs = map[int16]string{
 	1: "req",
 }
 
-func (p *CommentServiceCommentActionArgs) IsSetReq() bool {
+func (p *CommentServiceCommentFavoriteActionArgs) IsSetReq() bool {
 	return p.Req != nil
 }
 
-func (p *CommentServiceCommentActionArgs) Read(iprot thrift.TProtocol) (err error) {
+func (p *CommentServiceCommentFavoriteActionArgs) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -1982,7 +5252,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_CommentServiceCommentActionArgs[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_CommentServiceCommentFavoriteActionArgs[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -1992,8 +5262,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *CommentServiceCommentActionArgs) ReadField1(iprot thrift.TProtocol) error {
-	_field := NewCommentActionRequest()
+func (p *CommentServiceCommentFavoriteActionArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := NewCommentFavoriteActionRequest()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -2001,9 +5271,9 @@ func (p *CommentServiceCommentActionArgs) ReadField1(iprot thrift.TProtocol) err
 	return nil
 }
 
-func (p *CommentServiceCommentActionArgs) Write(oprot thrift.TProtocol) (err error) {
+func (p *CommentServiceCommentFavoriteActionArgs) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("CommentAction_args"); err != nil {
+	if err = oprot.WriteStructBegin("CommentFavoriteAction_args"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -2029,7 +5299,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *CommentServiceCommentActionArgs) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *CommentServiceCommentFavoriteActionArgs) writeField1(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
 		goto WriteFieldBeginError
 	}
@@ -2046,15 +5316,15 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *CommentServiceCommentActionArgs) String() string {
+func (p *CommentServiceCommentFavoriteActionArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("CommentServiceCommentActionArgs(%+v)", *p)
+	return fmt.Sprintf("CommentServiceCommentFavoriteActionArgs(%+v)", *p)
 
 }
 
-func (p *CommentServiceCommentActionArgs) DeepEqual(ano *CommentServiceCommentActionArgs) bool {
+func (p *CommentServiceCommentFavoriteActionArgs) DeepEqual(ano *CommentServiceCommentFavoriteActionArgs) bool {
 	if p == ano {
 		return true
 	} else if p == nil || ano == nil {
@@ -2066,7 +5336,7 @@ func (p *CommentServiceCommentActionArgs) DeepEqual(ano *CommentServiceCommentAc
 	return true
 }
 
-func (p *CommentServiceCommentActionArgs) Field1DeepEqual(src *CommentActionRequest) bool {
+func (p *CommentServiceCommentFavoriteActionArgs) Field1DeepEqual(src *CommentFavoriteActionRequest) bool {
 
 	if !p.Req.DeepEqual(src) {
 		return false
@@ -2074,39 +5344,39 @@ func (p *CommentServiceCommentActionArgs) Field1DeepEqual(src *CommentActionRequ
 	return true
 }
 
-type CommentServiceCommentActionResult struct {
-	Success *CommentActionResponse `thrift:"success,0,optional" frugal:"0,optional,CommentActionResponse" json:"success,omitempty"`
+type CommentServiceCommentFavoriteActionResult struct {
+	Success *CommentFavoriteActionResponse `thrift:"success,0,optional" frugal:"0,optional,CommentFavoriteActionResponse" json:"success,omitempty"`
 }
 
-func NewCommentServiceCommentActionResult() *CommentServiceCommentActionResult {
-	return &CommentServiceCommentActionResult{}
+func NewCommentServiceCommentFavoriteActionResult() *CommentServiceCommentFavoriteActionResult {
+	return &CommentServiceCommentFavoriteActionResult{}
 }
 
-func (p *CommentServiceCommentActionResult) InitDefault() {
-	*p = CommentServiceCommentActionResult{}
+func (p *CommentServiceCommentFavoriteActionResult) InitDefault() {
+	*p = CommentServiceCommentFavoriteActionResult{}
 }
 
-var CommentServiceCommentActionResult_Success_DEFAULT *CommentActionResponse
+var CommentServiceCommentFavoriteActionResult_Success_DEFAULT *CommentFavoriteActionResponse
 
-func (p *CommentServiceCommentActionResult) GetSuccess() (v *CommentActionResponse) {
+func (p *CommentServiceCommentFavoriteActionResult) GetSuccess() (v *CommentFavoriteActionResponse) {
 	if !p.IsSetSuccess() {
-		return CommentServiceCommentActionResult_Success_DEFAULT
+		return CommentServiceCommentFavoriteActionResult_Success_DEFAULT
 	}
 	return p.Success
 }
-func (p *CommentServiceCommentActionResult) SetSuccess(x interface{}) {
-	p.Success = x.(*CommentActionResponse)
+func (p *CommentServiceCommentFavoriteActionResult) SetSuccess(x interface{}) {
+	p.Success = x.(*CommentFavoriteActionResponse)
 }
 
-var fieldIDToName_CommentServiceCommentActionResult = map[int16]string{
+var fieldIDToName_CommentServiceCommentFavoriteActionResult = map[int16]string{
 	0: "success",
 }
 
-func (p *CommentServiceCommentActionResult) IsSetSuccess() bool {
+func (p *CommentServiceCommentFavoriteActionResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *CommentServiceCommentActionResult) Read(iprot thrift.TProtocol) (err error) {
+func (p *CommentServiceCommentFavoriteActionResult) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -2152,7 +5422,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_CommentServiceCommentActionResult[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_CommentServiceCommentFavoriteActionResult[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -2161,9 +5431,9 @@ ReadFieldEndError:
 ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
-
-func (p *CommentServiceCommentActionResult) ReadField0(iprot thrift.TProtocol) error {
-	_field := NewCommentActionResponse()
+
+func (p *CommentServiceCommentFavoriteActionResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := NewCommentFavoriteActionResponse()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -2171,9 +5441,9 @@ func (p *CommentServiceCommentActionResult) ReadField0(iprot thrift.TProtocol) e
 	return nil
 }
 
-func (p *CommentServiceCommentActionResult) Write(oprot thrift.TProtocol) (err error) {
+func (p *CommentServiceCommentFavoriteActionResult) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("CommentAction_result"); err != nil {
+	if err = oprot.WriteStructBegin("CommentFavoriteAction_result"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -2199,7 +5469,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *CommentServiceCommentActionResult) writeField0(oprot thrift.TProtocol) (err error) {
+func (p *CommentServiceCommentFavoriteActionResult) writeField0(oprot thrift.TProtocol) (err error) {
 	if p.IsSetSuccess() {
 		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
 			goto WriteFieldBeginError
@@ -2218,15 +5488,15 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
 }
 
-func (p *CommentServiceCommentActionResult) String() string {
+func (p *CommentServiceCommentFavoriteActionResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("CommentServiceCommentActionResult(%+v)", *p)
+	return fmt.Sprintf("CommentServiceCommentFavoriteActionResult(%+v)", *p)
 
 }
 
-func (p *CommentServiceCommentActionResult) DeepEqual(ano *CommentServiceCommentActionResult) bool {
+func (p *CommentServiceCommentFavoriteActionResult) DeepEqual(ano *CommentServiceCommentFavoriteActionResult) bool {
 	if p == ano {
 		return true
 	} else if p == nil || ano == nil {
@@ -2238,7 +5508,7 @@ func (p *CommentServiceCommentActionResult) DeepEqual(ano *CommentServiceComment
 	return true
 }
 
-func (p *CommentServiceCommentActionResult) Field0DeepEqual(src *CommentActionResponse) bool {
+func (p *CommentServiceCommentFavoriteActionResult) Field0DeepEqual(src *CommentFavoriteActionResponse) bool {
 
 	if !p.Success.DeepEqual(src) {
 		return false
@@ -2246,39 +5516,30 @@ func (p *CommentServiceCommentActionResult) Field0DeepEqual(src *CommentActionRe
 	return true
 }
 
-type CommentServiceCommentListArgs struct {
-	Req *CommentListRequest `thrift:"req,1" frugal:"1,default,CommentListRequest" json:"req"`
+type CommentServiceCommentCntArgs struct {
+	VideoId int64 `thrift:"video_id,1" frugal:"1,default,i64" json:"video_id"`
 }
 
-func NewCommentServiceCommentListArgs() *CommentServiceCommentListArgs {
-	return &CommentServiceCommentListArgs{}
+func NewCommentServiceCommentCntArgs() *CommentServiceCommentCntArgs {
+	return &CommentServiceCommentCntArgs{}
 }
 
-func (p *CommentServiceCommentListArgs) InitDefault() {
-	*p = CommentServiceCommentListArgs{}
+func (p *CommentServiceCommentCntArgs) InitDefault() {
+	*p = CommentServiceCommentCntArgs{}
 }
 
-var CommentServiceCommentListArgs_Req_DEFAULT *CommentListRequest
-
-func (p *CommentServiceCommentListArgs) GetReq() (v *CommentListRequest) {
-	if !p.IsSetReq() {
-		return CommentServiceCommentListArgs_Req_DEFAULT
-	}
-	return p.Req
-}
-func (p *CommentServiceCommentListArgs) SetReq(val *CommentListRequest) {
-	p.Req = val
+func (p *CommentServiceCommentCntArgs) GetVideoId() (v int64) {
+	return p.VideoId
 }
-
-var fieldIDToName_CommentServiceCommentListArgs = map[int16]string{
-	1: "req",
+func (p *CommentServiceCommentCntArgs) SetVideoId(val int64) {
+	p.VideoId = val
 }
 
-func (p *CommentServiceCommentListArgs) IsSetReq() bool {
-	return p.Req != nil
+var fieldIDToName_CommentServiceCommentCntArgs = map[int16]string{
+	1: "video_id",
 }
 
-func (p *CommentServiceCommentListArgs) Read(iprot thrift.TProtocol) (err error) {
+func (p *CommentServiceCommentCntArgs) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -2298,7 +5559,7 @@ func (p *CommentServiceCommentListArgs) Read(iprot thrift.TProtocol) (err error)
 
 		switch fieldId {
 		case 1:
-			if fieldTypeId == thrift.STRUCT {
+			if fieldTypeId == thrift.I64 {
 				if err = p.ReadField1(iprot); err != nil {
 					goto ReadFieldError
 				}
@@ -2324,7 +5585,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_CommentServiceCommentListArgs[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_CommentServiceCommentCntArgs[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -2334,18 +5595,21 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *CommentServiceCommentListArgs) ReadField1(iprot thrift.TProtocol) error {
-	_field := NewCommentListRequest()
-	if err := _field.Read(iprot); err != nil {
+func (p *CommentServiceCommentCntArgs) ReadField1(iprot thrift.TProtocol) error {
+
+	var _field int64
+	if v, err := iprot.ReadI64(); err != nil {
 		return err
+	} else {
+		_field = v
 	}
-	p.Req = _field
+	p.VideoId = _field
 	return nil
 }
 
-func (p *CommentServiceCommentListArgs) Write(oprot thrift.TProtocol) (err error) {
+func (p *CommentServiceCommentCntArgs) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("CommentList_args"); err != nil {
+	if err = oprot.WriteStructBegin("CommentCnt_args"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -2371,11 +5635,11 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *CommentServiceCommentListArgs) writeField1(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
+func (p *CommentServiceCommentCntArgs) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("video_id", thrift.I64, 1); err != nil {
 		goto WriteFieldBeginError
 	}
-	if err := p.Req.Write(oprot); err != nil {
+	if err := oprot.WriteI64(p.VideoId); err != nil {
 		return err
 	}
 	if err = oprot.WriteFieldEnd(); err != nil {
@@ -2388,67 +5652,67 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *CommentServiceCommentListArgs) String() string {
+func (p *CommentServiceCommentCntArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("CommentServiceCommentListArgs(%+v)", *p)
+	return fmt.Sprintf("CommentServiceCommentCntArgs(%+v)", *p)
 
 }
 
-func (p *CommentServiceCommentListArgs) DeepEqual(ano *CommentServiceCommentListArgs) bool {
+func (p *CommentServiceCommentCntArgs) DeepEqual(ano *CommentServiceCommentCntArgs) bool {
 	if p == ano {
 		return true
 	} else if p == nil || ano == nil {
 		return false
 	}
-	if !p.Field1DeepEqual(ano.Req) {
+	if !p.Field1DeepEqual(ano.VideoId) {
 		return false
 	}
 	return true
 }
 
-func (p *CommentServiceCommentListArgs) Field1DeepEqual(src *CommentListRequest) bool {
+func (p *CommentServiceCommentCntArgs) Field1DeepEqual(src int64) bool {
 
-	if !p.Req.DeepEqual(src) {
+	if p.VideoId != src {
 		return false
 	}
 	return true
 }
 
-type CommentServiceCommentListResult struct {
-	Success *CommentListResponse `thrift:"success,0,optional" frugal:"0,optional,CommentListResponse" json:"success,omitempty"`
+type CommentServiceCommentCntResult struct {
+	Success *int64 `thrift:"success,0,optional" frugal:"0,optional,i64" json:"success,omitempty"`
 }
 
-func NewCommentServiceCommentListResult() *CommentServiceCommentListResult {
-	return &CommentServiceCommentListResult{}
+func NewCommentServiceCommentCntResult() *CommentServiceCommentCntResult {
+	return &CommentServiceCommentCntResult{}
 }
 
-func (p *CommentServiceCommentListResult) InitDefault() {
-	*p = CommentServiceCommentListResult{}
+func (p *CommentServiceCommentCntResult) InitDefault() {
+	*p = CommentServiceCommentCntResult{}
 }
 
-var CommentServiceCommentListResult_Success_DEFAULT *CommentListResponse
+var CommentServiceCommentCntResult_Success_DEFAULT int64
 
-func (p *CommentServiceCommentListResult) GetSuccess() (v *CommentListResponse) {
+func (p *CommentServiceCommentCntResult) GetSuccess() (v int64) {
 	if !p.IsSetSuccess() {
-		return CommentServiceCommentListResult_Success_DEFAULT
+		return CommentServiceCommentCntResult_Success_DEFAULT
 	}
-	return p.Success
+	return *p.Success
 }
-func (p *CommentServiceCommentListResult) SetSuccess(x interface{}) {
-	p.Success = x.(*CommentListResponse)
+func (p *CommentServiceCommentCntResult) SetSuccess(x interface{}) {
+	p.Success = x.(*int64)
 }
 
-var fieldIDToName_CommentServiceCommentListResult = map[int16]string{
+var fieldIDToName_CommentServiceCommentCntResult = map[int16]string{
 	0: "success",
 }
 
-func (p *CommentServiceCommentListResult) IsSetSuccess() bool {
+func (p *CommentServiceCommentCntResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *CommentServiceCommentListResult) Read(iprot thrift.TProtocol) (err error) {
+func (p *CommentServiceCommentCntResult) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -2468,7 +5732,7 @@ func (p *CommentServiceCommentListResult) Read(iprot thrift.TProtocol) (err erro
 
 		switch fieldId {
 		case 0:
-			if fieldTypeId == thrift.STRUCT {
+			if fieldTypeId == thrift.I64 {
 				if err = p.ReadField0(iprot); err != nil {
 					goto ReadFieldError
 				}
@@ -2494,7 +5758,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_CommentServiceCommentListResult[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_CommentServiceCommentCntResult[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -2504,18 +5768,21 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *CommentServiceCommentListResult) ReadField0(iprot thrift.TProtocol) error {
-	_field := NewCommentListResponse()
-	if err := _field.Read(iprot); err != nil {
+func (p *CommentServiceCommentCntResult) ReadField0(iprot thrift.TProtocol) error {
+
+	var _field *int64
+	if v, err := iprot.ReadI64(); err != nil {
 		return err
+	} else {
+		_field = &v
 	}
 	p.Success = _field
 	return nil
 }
 
-func (p *CommentServiceCommentListResult) Write(oprot thrift.TProtocol) (err error) {
+func (p *CommentServiceCommentCntResult) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("CommentList_result"); err != nil {
+	if err = oprot.WriteStructBegin("CommentCnt_result"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -2541,12 +5808,12 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *CommentServiceCommentListResult) writeField0(oprot thrift.TProtocol) (err error) {
+func (p *CommentServiceCommentCntResult) writeField0(oprot thrift.TProtocol) (err error) {
 	if p.IsSetSuccess() {
-		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
+		if err = oprot.WriteFieldBegin("success", thrift.I64, 0); err != nil {
 			goto WriteFieldBeginError
 		}
-		if err := p.Success.Write(oprot); err != nil {
+		if err := oprot.WriteI64(*p.Success); err != nil {
 			return err
 		}
 		if err = oprot.WriteFieldEnd(); err != nil {
@@ -2560,15 +5827,15 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
 }
 
-func (p *CommentServiceCommentListResult) String() string {
+func (p *CommentServiceCommentCntResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("CommentServiceCommentListResult(%+v)", *p)
+	return fmt.Sprintf("CommentServiceCommentCntResult(%+v)", *p)
 
 }
 
-func (p *CommentServiceCommentListResult) DeepEqual(ano *CommentServiceCommentListResult) bool {
+func (p *CommentServiceCommentCntResult) DeepEqual(ano *CommentServiceCommentCntResult) bool {
 	if p == ano {
 		return true
 	} else if p == nil || ano == nil {
@@ -2580,38 +5847,43 @@ func (p *CommentServiceCommentListResult) DeepEqual(ano *CommentServiceCommentLi
 	return true
 }
 
-func (p *CommentServiceCommentListResult) Field0DeepEqual(src *CommentListResponse) bool {
+func (p *CommentServiceCommentCntResult) Field0DeepEqual(src *int64) bool {
 
-	if !p.Success.DeepEqual(src) {
+	if p.Success == src {
+		return true
+	} else if p.Success == nil || src == nil {
+		return false
+	}
+	if *p.Success != *src {
 		return false
 	}
 	return true
 }
 
-type CommentServiceCommentCntArgs struct {
-	VideoId int64 `thrift:"video_id,1" frugal:"1,default,i64" json:"video_id"`
+type CommentServiceBatchCommentCntArgs struct {
+	VideoIds []int64 `thrift:"video_ids,1" frugal:"1,default,list<i64>" json:"video_ids"`
 }
 
-func NewCommentServiceCommentCntArgs() *CommentServiceCommentCntArgs {
-	return &CommentServiceCommentCntArgs{}
+func NewCommentServiceBatchCommentCntArgs() *CommentServiceBatchCommentCntArgs {
+	return &CommentServiceBatchCommentCntArgs{}
 }
 
-func (p *CommentServiceCommentCntArgs) InitDefault() {
-	*p = CommentServiceCommentCntArgs{}
+func (p *CommentServiceBatchCommentCntArgs) InitDefault() {
+	*p = CommentServiceBatchCommentCntArgs{}
 }
 
-func (p *CommentServiceCommentCntArgs) GetVideoId() (v int64) {
-	return p.VideoId
+func (p *CommentServiceBatchCommentCntArgs) GetVideoIds() (v []int64) {
+	return p.VideoIds
 }
-func (p *CommentServiceCommentCntArgs) SetVideoId(val int64) {
-	p.VideoId = val
+func (p *CommentServiceBatchCommentCntArgs) SetVideoIds(val []int64) {
+	p.VideoIds = val
 }
 
-var fieldIDToName_CommentServiceCommentCntArgs = map[int16]string{
-	1: "video_id",
+var fieldIDToName_CommentServiceBatchCommentCntArgs = map[int16]string{
+	1: "video_ids",
 }
 
-func (p *CommentServiceCommentCntArgs) Read(iprot thrift.TProtocol) (err error) {
+func (p *CommentServiceBatchCommentCntArgs) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -2631,7 +5903,7 @@ func (p *CommentServiceCommentCntArgs) Read(iprot thrift.TProtocol) (err error)
 
 		switch fieldId {
 		case 1:
-			if fieldTypeId == thrift.I64 {
+			if fieldTypeId == thrift.LIST {
 				if err = p.ReadField1(iprot); err != nil {
 					goto ReadFieldError
 				}
@@ -2657,7 +5929,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_CommentServiceCommentCntArgs[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_CommentServiceBatchCommentCntArgs[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -2667,21 +5939,33 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *CommentServiceCommentCntArgs) ReadField1(iprot thrift.TProtocol) error {
+func (p *CommentServiceBatchCommentCntArgs) ReadField1(iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin()
+	if err != nil {
+		return err
+	}
+	_field := make([]int64, 0, size)
+	for i := 0; i < size; i++ {
 
-	var _field int64
-	if v, err := iprot.ReadI64(); err != nil {
+		var _elem int64
+		if v, err := iprot.ReadI64(); err != nil {
+			return err
+		} else {
+			_elem = v
+		}
+
+		_field = append(_field, _elem)
+	}
+	if err := iprot.ReadListEnd(); err != nil {
 		return err
-	} else {
-		_field = v
 	}
-	p.VideoId = _field
+	p.VideoIds = _field
 	return nil
 }
 
-func (p *CommentServiceCommentCntArgs) Write(oprot thrift.TProtocol) (err error) {
+func (p *CommentServiceBatchCommentCntArgs) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("CommentCnt_args"); err != nil {
+	if err = oprot.WriteStructBegin("BatchCommentCnt_args"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -2707,11 +5991,19 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *CommentServiceCommentCntArgs) writeField1(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("video_id", thrift.I64, 1); err != nil {
+func (p *CommentServiceBatchCommentCntArgs) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("video_ids", thrift.LIST, 1); err != nil {
 		goto WriteFieldBeginError
 	}
-	if err := oprot.WriteI64(p.VideoId); err != nil {
+	if err := oprot.WriteListBegin(thrift.I64, len(p.VideoIds)); err != nil {
+		return err
+	}
+	for _, v := range p.VideoIds {
+		if err := oprot.WriteI64(v); err != nil {
+			return err
+		}
+	}
+	if err := oprot.WriteListEnd(); err != nil {
 		return err
 	}
 	if err = oprot.WriteFieldEnd(); err != nil {
@@ -2724,67 +6016,73 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *CommentServiceCommentCntArgs) String() string {
+func (p *CommentServiceBatchCommentCntArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("CommentServiceCommentCntArgs(%+v)", *p)
+	return fmt.Sprintf("CommentServiceBatchCommentCntArgs(%+v)", *p)
 
 }
 
-func (p *CommentServiceCommentCntArgs) DeepEqual(ano *CommentServiceCommentCntArgs) bool {
+func (p *CommentServiceBatchCommentCntArgs) DeepEqual(ano *CommentServiceBatchCommentCntArgs) bool {
 	if p == ano {
 		return true
 	} else if p == nil || ano == nil {
 		return false
 	}
-	if !p.Field1DeepEqual(ano.VideoId) {
+	if !p.Field1DeepEqual(ano.VideoIds) {
 		return false
 	}
 	return true
 }
 
-func (p *CommentServiceCommentCntArgs) Field1DeepEqual(src int64) bool {
+func (p *CommentServiceBatchCommentCntArgs) Field1DeepEqual(src []int64) bool {
 
-	if p.VideoId != src {
+	if len(p.VideoIds) != len(src) {
 		return false
 	}
+	for i, v := range p.VideoIds {
+		_src := src[i]
+		if v != _src {
+			return false
+		}
+	}
 	return true
 }
 
-type CommentServiceCommentCntResult struct {
-	Success *int64 `thrift:"success,0,optional" frugal:"0,optional,i64" json:"success,omitempty"`
+type CommentServiceBatchCommentCntResult struct {
+	Success map[int64]int64 `thrift:"success,0,optional" frugal:"0,optional,map<i64:i64>" json:"success,omitempty"`
 }
 
-func NewCommentServiceCommentCntResult() *CommentServiceCommentCntResult {
-	return &CommentServiceCommentCntResult{}
+func NewCommentServiceBatchCommentCntResult() *CommentServiceBatchCommentCntResult {
+	return &CommentServiceBatchCommentCntResult{}
 }
 
-func (p *CommentServiceCommentCntResult) InitDefault() {
-	*p = CommentServiceCommentCntResult{}
+func (p *CommentServiceBatchCommentCntResult) InitDefault() {
+	*p = CommentServiceBatchCommentCntResult{}
 }
 
-var CommentServiceCommentCntResult_Success_DEFAULT int64
+var CommentServiceBatchCommentCntResult_Success_DEFAULT map[int64]int64
 
-func (p *CommentServiceCommentCntResult) GetSuccess() (v int64) {
+func (p *CommentServiceBatchCommentCntResult) GetSuccess() (v map[int64]int64) {
 	if !p.IsSetSuccess() {
-		return CommentServiceCommentCntResult_Success_DEFAULT
+		return CommentServiceBatchCommentCntResult_Success_DEFAULT
 	}
-	return *p.Success
+	return p.Success
 }
-func (p *CommentServiceCommentCntResult) SetSuccess(x interface{}) {
-	p.Success = x.(*int64)
+func (p *CommentServiceBatchCommentCntResult) SetSuccess(x interface{}) {
+	p.Success = x.(map[int64]int64)
 }
 
-var fieldIDToName_CommentServiceCommentCntResult = map[int16]string{
+var fieldIDToName_CommentServiceBatchCommentCntResult = map[int16]string{
 	0: "success",
 }
 
-func (p *CommentServiceCommentCntResult) IsSetSuccess() bool {
+func (p *CommentServiceBatchCommentCntResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *CommentServiceCommentCntResult) Read(iprot thrift.TProtocol) (err error) {
+func (p *CommentServiceBatchCommentCntResult) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -2804,7 +6102,7 @@ func (p *CommentServiceCommentCntResult) Read(iprot thrift.TProtocol) (err error
 
 		switch fieldId {
 		case 0:
-			if fieldTypeId == thrift.I64 {
+			if fieldTypeId == thrift.MAP {
 				if err = p.ReadField0(iprot); err != nil {
 					goto ReadFieldError
 				}
@@ -2830,7 +6128,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_CommentServiceCommentCntResult[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_CommentServiceBatchCommentCntResult[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -2840,21 +6138,39 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *CommentServiceCommentCntResult) ReadField0(iprot thrift.TProtocol) error {
+func (p *CommentServiceBatchCommentCntResult) ReadField0(iprot thrift.TProtocol) error {
+	_, _, size, err := iprot.ReadMapBegin()
+	if err != nil {
+		return err
+	}
+	_field := make(map[int64]int64, size)
+	for i := 0; i < size; i++ {
+		var _key int64
+		if v, err := iprot.ReadI64(); err != nil {
+			return err
+		} else {
+			_key = v
+		}
 
-	var _field *int64
-	if v, err := iprot.ReadI64(); err != nil {
+		var _val int64
+		if v, err := iprot.ReadI64(); err != nil {
+			return err
+		} else {
+			_val = v
+		}
+
+		_field[_key] = _val
+	}
+	if err := iprot.ReadMapEnd(); err != nil {
 		return err
-	} else {
-		_field = &v
 	}
 	p.Success = _field
 	return nil
 }
 
-func (p *CommentServiceCommentCntResult) Write(oprot thrift.TProtocol) (err error) {
+func (p *CommentServiceBatchCommentCntResult) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("CommentCnt_result"); err != nil {
+	if err = oprot.WriteStructBegin("BatchCommentCnt_result"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -2880,12 +6196,23 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *CommentServiceCommentCntResult) writeField0(oprot thrift.TProtocol) (err error) {
+func (p *CommentServiceBatchCommentCntResult) writeField0(oprot thrift.TProtocol) (err error) {
 	if p.IsSetSuccess() {
-		if err = oprot.WriteFieldBegin("success", thrift.I64, 0); err != nil {
+		if err = oprot.WriteFieldBegin("success", thrift.MAP, 0); err != nil {
 			goto WriteFieldBeginError
 		}
-		if err := oprot.WriteI64(*p.Success); err != nil {
+		if err := oprot.WriteMapBegin(thrift.I64, thrift.I64, len(p.Success)); err != nil {
+			return err
+		}
+		for k, v := range p.Success {
+			if err := oprot.WriteI64(k); err != nil {
+				return err
+			}
+			if err := oprot.WriteI64(v); err != nil {
+				return err
+			}
+		}
+		if err := oprot.WriteMapEnd(); err != nil {
 			return err
 		}
 		if err = oprot.WriteFieldEnd(); err != nil {
@@ -2899,15 +6226,15 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
 }
 
-func (p *CommentServiceCommentCntResult) String() string {
+func (p *CommentServiceBatchCommentCntResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("CommentServiceCommentCntResult(%+v)", *p)
+	return fmt.Sprintf("CommentServiceBatchCommentCntResult(%+v)", *p)
 
 }
 
-func (p *CommentServiceCommentCntResult) DeepEqual(ano *CommentServiceCommentCntResult) bool {
+func (p *CommentServiceBatchCommentCntResult) DeepEqual(ano *CommentServiceBatchCommentCntResult) bool {
 	if p == ano {
 		return true
 	} else if p == nil || ano == nil {
@@ -2919,15 +6246,16 @@ func (p *CommentServiceCommentCntResult) DeepEqual(ano *CommentServiceCommentCnt
 	return true
 }
 
-func (p *CommentServiceCommentCntResult) Field0DeepEqual(src *int64) bool {
+func (p *CommentServiceBatchCommentCntResult) Field0DeepEqual(src map[int64]int64) bool {
 
-	if p.Success == src {
-		return true
-	} else if p.Success == nil || src == nil {
+	if len(p.Success) != len(src) {
 		return false
 	}
-	if *p.Success != *src {
-		return false
+	for k, v := range p.Success {
+		_src := src[k]
+		if v != _src {
+			return false
+		}
 	}
 	return true
 }
@@ -13,7 +13,10 @@ import (
 type Client interface {
 	CommentAction(ctx context.Context, req *comment.CommentActionRequest, callOptions ...callopt.Option) (r *comment.CommentActionResponse, err error)
 	CommentList(ctx context.Context, req *comment.CommentListRequest, callOptions ...callopt.Option) (r *comment.CommentListResponse, err error)
+	CommentReplyList(ctx context.Context, req *comment.CommentReplyListRequest, callOptions ...callopt.Option) (r *comment.CommentReplyListResponse, err error)
+	CommentFavoriteAction(ctx context.Context, req *comment.CommentFavoriteActionRequest, callOptions ...callopt.Option) (r *comment.CommentFavoriteActionResponse, err error)
 	CommentCnt(ctx context.Context, videoId int64, callOptions ...callopt.Option) (r int64, err error)
+	BatchCommentCnt(ctx context.Context, videoIds []int64, callOptions ...callopt.Option) (r map[int64]int64, err error)
 }
 
 // NewClient creates a client for the service defined in IDL.
@@ -55,7 +58,22 @@ func (p *kCommentServiceClient) CommentList(ctx context.Context, req *comment.Co
 	return p.kClient.CommentList(ctx, req)
 }
 
+func (p *kCommentServiceClient) CommentReplyList(ctx context.Context, req *comment.CommentReplyListRequest, callOptions ...callopt.Option) (r *comment.CommentReplyListResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.CommentReplyList(ctx, req)
+}
+
+func (p *kCommentServiceClient) CommentFavoriteAction(ctx context.Context, req *comment.CommentFavoriteActionRequest, callOptions ...callopt.Option) (r *comment.CommentFavoriteActionResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.CommentFavoriteAction(ctx, req)
+}
+
 func (p *kCommentServiceClient) CommentCnt(ctx context.Context, videoId int64, callOptions ...callopt.Option) (r int64, err error) {
 	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
 	return p.kClient.CommentCnt(ctx, videoId)
 }
+
+func (p *kCommentServiceClient) BatchCommentCnt(ctx context.Context, videoIds []int64, callOptions ...callopt.Option) (r map[int64]int64, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.BatchCommentCnt(ctx, videoIds)
+}
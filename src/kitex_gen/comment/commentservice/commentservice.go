@@ -19,9 +19,12 @@ func NewServiceInfo() *kitex.ServiceInfo {
 	serviceName := "CommentService"
 	handlerType := (*comment.CommentService)(nil)
 	methods := map[string]kitex.MethodInfo{
-		"CommentAction": kitex.NewMethodInfo(commentActionHandler, newCommentServiceCommentActionArgs, newCommentServiceCommentActionResult, false),
-		"CommentList":   kitex.NewMethodInfo(commentListHandler, newCommentServiceCommentListArgs, newCommentServiceCommentListResult, false),
-		"CommentCnt":    kitex.NewMethodInfo(commentCntHandler, newCommentServiceCommentCntArgs, newCommentServiceCommentCntResult, false),
+		"CommentAction":         kitex.NewMethodInfo(commentActionHandler, newCommentServiceCommentActionArgs, newCommentServiceCommentActionResult, false),
+		"CommentList":           kitex.NewMethodInfo(commentListHandler, newCommentServiceCommentListArgs, newCommentServiceCommentListResult, false),
+		"CommentReplyList":      kitex.NewMethodInfo(commentReplyListHandler, newCommentServiceCommentReplyListArgs, newCommentServiceCommentReplyListResult, false),
+		"CommentFavoriteAction": kitex.NewMethodInfo(commentFavoriteActionHandler, newCommentServiceCommentFavoriteActionArgs, newCommentServiceCommentFavoriteActionResult, false),
+		"CommentCnt":            kitex.NewMethodInfo(commentCntHandler, newCommentServiceCommentCntArgs, newCommentServiceCommentCntResult, false),
+		"BatchCommentCnt":       kitex.NewMethodInfo(batchCommentCntHandler, newCommentServiceBatchCommentCntArgs, newCommentServiceBatchCommentCntResult, false),
 	}
 	extra := map[string]interface{}{
 		"PackageName":     "comment",
@@ -74,6 +77,42 @@ func newCommentServiceCommentListResult() interface{} {
 	return comment.NewCommentServiceCommentListResult()
 }
 
+func commentReplyListHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*comment.CommentServiceCommentReplyListArgs)
+	realResult := result.(*comment.CommentServiceCommentReplyListResult)
+	success, err := handler.(comment.CommentService).CommentReplyList(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newCommentServiceCommentReplyListArgs() interface{} {
+	return comment.NewCommentServiceCommentReplyListArgs()
+}
+
+func newCommentServiceCommentReplyListResult() interface{} {
+	return comment.NewCommentServiceCommentReplyListResult()
+}
+
+func commentFavoriteActionHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*comment.CommentServiceCommentFavoriteActionArgs)
+	realResult := result.(*comment.CommentServiceCommentFavoriteActionResult)
+	success, err := handler.(comment.CommentService).CommentFavoriteAction(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newCommentServiceCommentFavoriteActionArgs() interface{} {
+	return comment.NewCommentServiceCommentFavoriteActionArgs()
+}
+
+func newCommentServiceCommentFavoriteActionResult() interface{} {
+	return comment.NewCommentServiceCommentFavoriteActionResult()
+}
+
 func commentCntHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
 	realArg := arg.(*comment.CommentServiceCommentCntArgs)
 	realResult := result.(*comment.CommentServiceCommentCntResult)
@@ -92,6 +131,24 @@ func newCommentServiceCommentCntResult() interface{} {
 	return comment.NewCommentServiceCommentCntResult()
 }
 
+func batchCommentCntHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*comment.CommentServiceBatchCommentCntArgs)
+	realResult := result.(*comment.CommentServiceBatchCommentCntResult)
+	success, err := handler.(comment.CommentService).BatchCommentCnt(ctx, realArg.VideoIds)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newCommentServiceBatchCommentCntArgs() interface{} {
+	return comment.NewCommentServiceBatchCommentCntArgs()
+}
+
+func newCommentServiceBatchCommentCntResult() interface{} {
+	return comment.NewCommentServiceBatchCommentCntResult()
+}
+
 type kClient struct {
 	c client.Client
 }
@@ -122,6 +179,26 @@ func (p *kClient) CommentList(ctx context.Context, req *comment.CommentListReque
 	return _result.GetSuccess(), nil
 }
 
+func (p *kClient) CommentReplyList(ctx context.Context, req *comment.CommentReplyListRequest) (r *comment.CommentReplyListResponse, err error) {
+	var _args comment.CommentServiceCommentReplyListArgs
+	_args.Req = req
+	var _result comment.CommentServiceCommentReplyListResult
+	if err = p.c.Call(ctx, "CommentReplyList", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) CommentFavoriteAction(ctx context.Context, req *comment.CommentFavoriteActionRequest) (r *comment.CommentFavoriteActionResponse, err error) {
+	var _args comment.CommentServiceCommentFavoriteActionArgs
+	_args.Req = req
+	var _result comment.CommentServiceCommentFavoriteActionResult
+	if err = p.c.Call(ctx, "CommentFavoriteAction", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
 func (p *kClient) CommentCnt(ctx context.Context, videoId int64) (r int64, err error) {
 	var _args comment.CommentServiceCommentCntArgs
 	_args.VideoId = videoId
@@ -131,3 +208,13 @@ func (p *kClient) CommentCnt(ctx context.Context, videoId int64) (r int64, err e
 	}
 	return _result.GetSuccess(), nil
 }
+
+func (p *kClient) BatchCommentCnt(ctx context.Context, videoIds []int64) (r map[int64]int64, err error) {
+	var _args comment.CommentServiceBatchCommentCntArgs
+	_args.VideoIds = videoIds
+	var _result comment.CommentServiceBatchCommentCntResult
+	if err = p.c.Call(ctx, "BatchCommentCnt", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
@@ -532,8 +532,10 @@ func (p *FavoriteActionResponse) Field2DeepEqual(src *string) bool {
 }
 
 type FavoriteListRequest struct {
-	UserId   *int64 `thrift:"user_id,1,optional" frugal:"1,optional,i64" json:"user_id,omitempty"`
-	AuthorId int64  `thrift:"author_id,2" frugal:"2,default,i64" json:"author_id"`
+	UserId   *int64  `thrift:"user_id,1,optional" frugal:"1,optional,i64" json:"user_id,omitempty"`
+	AuthorId int64   `thrift:"author_id,2" frugal:"2,default,i64" json:"author_id"`
+	Cursor   *string `thrift:"cursor,3,optional" frugal:"3,optional,string" json:"cursor,omitempty"`
+	Count    int32   `thrift:"count,4" frugal:"4,default,i32" json:"count"`
 }
 
 func NewFavoriteListRequest() *FavoriteListRequest {
@@ -556,22 +558,47 @@ func (p *FavoriteListRequest) GetUserId() (v int64) {
 func (p *FavoriteListRequest) GetAuthorId() (v int64) {
 	return p.AuthorId
 }
+
+var FavoriteListRequest_Cursor_DEFAULT string
+
+func (p *FavoriteListRequest) GetCursor() (v string) {
+	if !p.IsSetCursor() {
+		return FavoriteListRequest_Cursor_DEFAULT
+	}
+	return *p.Cursor
+}
+
+func (p *FavoriteListRequest) GetCount() (v int32) {
+	return p.Count
+}
 func (p *FavoriteListRequest) SetUserId(val *int64) {
 	p.UserId = val
 }
 func (p *FavoriteListRequest) SetAuthorId(val int64) {
 	p.AuthorId = val
 }
+func (p *FavoriteListRequest) SetCursor(val *string) {
+	p.Cursor = val
+}
+func (p *FavoriteListRequest) SetCount(val int32) {
+	p.Count = val
+}
 
 var fieldIDToName_FavoriteListRequest = map[int16]string{
 	1: "user_id",
 	2: "author_id",
+	3: "cursor",
+	4: "count",
 }
 
 func (p *FavoriteListRequest) IsSetUserId() bool {
 	return p.UserId != nil
 }
 
+func (p *FavoriteListRequest) IsSetCursor() bool {
+	return p.Cursor != nil
+}
+
 func (p *FavoriteListRequest) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
@@ -607,6 +634,22 @@ func (p *FavoriteListRequest) Read(iprot thrift.TProtocol) (err error) {
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
+		case 3:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField3(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 4:
+			if fieldTypeId == thrift.I32 {
+				if err = p.ReadField4(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
 		default:
 			if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
@@ -658,6 +701,28 @@ func (p *FavoriteListRequest) ReadField2(iprot thrift.TProtocol) error {
 	p.AuthorId = _field
 	return nil
 }
+func (p *FavoriteListRequest) ReadField3(iprot thrift.TProtocol) error {
+
+	var _field *string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.Cursor = _field
+	return nil
+}
+func (p *FavoriteListRequest) ReadField4(iprot thrift.TProtocol) error {
+
+	var _field int32
+	if v, err := iprot.ReadI32(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.Count = _field
+	return nil
+}
 
 func (p *FavoriteListRequest) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
@@ -673,6 +738,14 @@ func (p *FavoriteListRequest) Write(oprot thrift.TProtocol) (err error) {
 			fieldId = 2
 			goto WriteFieldError
 		}
+		if err = p.writeField3(oprot); err != nil {
+			fieldId = 3
+			goto WriteFieldError
+		}
+		if err = p.writeField4(oprot); err != nil {
+			fieldId = 4
+			goto WriteFieldError
+		}
 	}
 	if err = oprot.WriteFieldStop(); err != nil {
 		goto WriteFieldStopError
@@ -727,6 +800,42 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
 }
 
+func (p *FavoriteListRequest) writeField3(oprot thrift.TProtocol) (err error) {
+	if p.IsSetCursor() {
+		if err = oprot.WriteFieldBegin("cursor", thrift.STRING, 3); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.Cursor); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
+}
+
+func (p *FavoriteListRequest) writeField4(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("count", thrift.I32, 4); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI32(p.Count); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
+}
+
 func (p *FavoriteListRequest) String() string {
 	if p == nil {
 		return "<nil>"
@@ -747,6 +856,12 @@ func (p *FavoriteListRequest) DeepEqual(ano *FavoriteListRequest) bool {
 	if !p.Field2DeepEqual(ano.AuthorId) {
 		return false
 	}
+	if !p.Field3DeepEqual(ano.Cursor) {
+		return false
+	}
+	if !p.Field4DeepEqual(ano.Count) {
+		return false
+	}
 	return true
 }
 
@@ -769,11 +884,32 @@ func (p *FavoriteListRequest) Field2DeepEqual(src int64) bool {
 	}
 	return true
 }
+func (p *FavoriteListRequest) Field3DeepEqual(src *string) bool {
+
+	if p.Cursor == src {
+		return true
+	} else if p.Cursor == nil || src == nil {
+		return false
+	}
+	if strings.Compare(*p.Cursor, *src) != 0 {
+		return false
+	}
+	return true
+}
+func (p *FavoriteListRequest) Field4DeepEqual(src int32) bool {
+
+	if p.Count != src {
+		return false
+	}
+	return true
+}
 
 type FavoriteListResponse struct {
 	StatusCode int32          `thrift:"status_code,1" frugal:"1,default,i32" json:"status_code"`
 	StatusMsg  *string        `thrift:"status_msg,2,optional" frugal:"2,optional,string" json:"status_msg,omitempty"`
 	VideoList  []*video.Video `thrift:"video_list,3" frugal:"3,default,list<video.Video>" json:"video_list"`
+	NextCursor *string        `thrift:"next_cursor,4,optional" frugal:"4,optional,string" json:"next_cursor,omitempty"`
+	HasMore    bool           `thrift:"has_more,5" frugal:"5,default,bool" json:"has_more"`
 }
 
 func NewFavoriteListResponse() *FavoriteListResponse {
@@ -800,6 +936,19 @@ func (p *FavoriteListResponse) GetStatusMsg() (v string) {
 func (p *FavoriteListResponse) GetVideoList() (v []*video.Video) {
 	return p.VideoList
 }
+
+var FavoriteListResponse_NextCursor_DEFAULT string
+
+func (p *FavoriteListResponse) GetNextCursor() (v string) {
+	if !p.IsSetNextCursor() {
+		return FavoriteListResponse_NextCursor_DEFAULT
+	}
+	return *p.NextCursor
+}
+
+func (p *FavoriteListResponse) GetHasMore() (v bool) {
+	return p.HasMore
+}
 func (p *FavoriteListResponse) SetStatusCode(val int32) {
 	p.StatusCode = val
 }
@@ -809,17 +958,29 @@ func (p *FavoriteListResponse) SetStatusMsg(val *string) {
 func (p *FavoriteListResponse) SetVideoList(val []*video.Video) {
 	p.VideoList = val
 }
+func (p *FavoriteListResponse) SetNextCursor(val *string) {
+	p.NextCursor = val
+}
+func (p *FavoriteListResponse) SetHasMore(val bool) {
+	p.HasMore = val
+}
 
 var fieldIDToName_FavoriteListResponse = map[int16]string{
 	1: "status_code",
 	2: "status_msg",
 	3: "video_list",
+	4: "next_cursor",
+	5: "has_more",
 }
 
 func (p *FavoriteListResponse) IsSetStatusMsg() bool {
 	return p.StatusMsg != nil
 }
 
+func (p *FavoriteListResponse) IsSetNextCursor() bool {
+	return p.NextCursor != nil
+}
+
 func (p *FavoriteListResponse) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
@@ -863,6 +1024,22 @@ func (p *FavoriteListResponse) Read(iprot thrift.TProtocol) (err error) {
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
+		case 4:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField4(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 5:
+			if fieldTypeId == thrift.BOOL {
+				if err = p.ReadField5(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
 		default:
 			if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
@@ -936,6 +1113,28 @@ func (p *FavoriteListResponse) ReadField3(iprot thrift.TProtocol) error {
 	p.VideoList = _field
 	return nil
 }
+func (p *FavoriteListResponse) ReadField4(iprot thrift.TProtocol) error {
+
+	var _field *string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.NextCursor = _field
+	return nil
+}
+func (p *FavoriteListResponse) ReadField5(iprot thrift.TProtocol) error {
+
+	var _field bool
+	if v, err := iprot.ReadBool(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.HasMore = _field
+	return nil
+}
 
 func (p *FavoriteListResponse) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
@@ -955,6 +1154,14 @@ func (p *FavoriteListResponse) Write(oprot thrift.TProtocol) (err error) {
 			fieldId = 3
 			goto WriteFieldError
 		}
+		if err = p.writeField4(oprot); err != nil {
+			fieldId = 4
+			goto WriteFieldError
+		}
+		if err = p.writeField5(oprot); err != nil {
+			fieldId = 5
+			goto WriteFieldError
+		}
 	}
 	if err = oprot.WriteFieldStop(); err != nil {
 		goto WriteFieldStopError
@@ -1034,6 +1241,42 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
 }
 
+func (p *FavoriteListResponse) writeField4(oprot thrift.TProtocol) (err error) {
+	if p.IsSetNextCursor() {
+		if err = oprot.WriteFieldBegin("next_cursor", thrift.STRING, 4); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.NextCursor); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
+}
+
+func (p *FavoriteListResponse) writeField5(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("has_more", thrift.BOOL, 5); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteBool(p.HasMore); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 end error: ", p), err)
+}
+
 func (p *FavoriteListResponse) String() string {
 	if p == nil {
 		return "<nil>"
@@ -1057,6 +1300,12 @@ func (p *FavoriteListResponse) DeepEqual(ano *FavoriteListResponse) bool {
 	if !p.Field3DeepEqual(ano.VideoList) {
 		return false
 	}
+	if !p.Field4DeepEqual(ano.NextCursor) {
+		return false
+	}
+	if !p.Field5DeepEqual(ano.HasMore) {
+		return false
+	}
 	return true
 }
 
@@ -1092,6 +1341,25 @@ func (p *FavoriteListResponse) Field3DeepEqual(src []*video.Video) bool {
 	}
 	return true
 }
+func (p *FavoriteListResponse) Field4DeepEqual(src *string) bool {
+
+	if p.NextCursor == src {
+		return true
+	} else if p.NextCursor == nil || src == nil {
+		return false
+	}
+	if strings.Compare(*p.NextCursor, *src) != 0 {
+		return false
+	}
+	return true
+}
+func (p *FavoriteListResponse) Field5DeepEqual(src bool) bool {
+
+	if p.HasMore != src {
+		return false
+	}
+	return true
+}
 
 type FavoriteService interface {
 	FavoriteAction(ctx context.Context, req *FavoriteActionRequest) (r *FavoriteActionResponse, err error)
@@ -1103,6 +1371,14 @@ type FavoriteService interface {
 	TotalFavoritedCnt(ctx context.Context, userId int64) (r int64, err error)
 
 	FavoriteExist(ctx context.Context, userId int64, videoId int64) (r bool, err error)
+
+	BatchFavoriteCnt(ctx context.Context, videoIds []int64) (r map[int64]int64, err error)
+
+	BatchFavoriteExist(ctx context.Context, userId int64, videoIds []int64) (r map[int64]bool, err error)
+
+	BatchUserFavoriteCnt(ctx context.Context, userIds []int64) (r map[int64]int64, err error)
+
+	BatchTotalFavoritedCnt(ctx context.Context, userIds []int64) (r map[int64]int64, err error)
 }
 
 type FavoriteServiceClient struct {
@@ -1177,6 +1453,43 @@ func (p *FavoriteServiceClient) FavoriteExist(ctx context.Context, userId int64,
 	}
 	return _result.GetSuccess(), nil
 }
+func (p *FavoriteServiceClient) BatchFavoriteCnt(ctx context.Context, videoIds []int64) (r map[int64]int64, err error) {
+	var _args FavoriteServiceBatchFavoriteCntArgs
+	_args.VideoIds = videoIds
+	var _result FavoriteServiceBatchFavoriteCntResult
+	if err = p.Client_().Call(ctx, "BatchFavoriteCnt", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *FavoriteServiceClient) BatchFavoriteExist(ctx context.Context, userId int64, videoIds []int64) (r map[int64]bool, err error) {
+	var _args FavoriteServiceBatchFavoriteExistArgs
+	_args.UserId = userId
+	_args.VideoIds = videoIds
+	var _result FavoriteServiceBatchFavoriteExistResult
+	if err = p.Client_().Call(ctx, "BatchFavoriteExist", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *FavoriteServiceClient) BatchUserFavoriteCnt(ctx context.Context, userIds []int64) (r map[int64]int64, err error) {
+	var _args FavoriteServiceBatchUserFavoriteCntArgs
+	_args.UserIds = userIds
+	var _result FavoriteServiceBatchUserFavoriteCntResult
+	if err = p.Client_().Call(ctx, "BatchUserFavoriteCnt", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *FavoriteServiceClient) BatchTotalFavoritedCnt(ctx context.Context, userIds []int64) (r map[int64]int64, err error) {
+	var _args FavoriteServiceBatchTotalFavoritedCntArgs
+	_args.UserIds = userIds
+	var _result FavoriteServiceBatchTotalFavoritedCntResult
+	if err = p.Client_().Call(ctx, "BatchTotalFavoritedCnt", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
 
 type FavoriteServiceProcessor struct {
 	processorMap map[string]thrift.TProcessorFunction
@@ -1203,6 +1516,10 @@ func NewFavoriteServiceProcessor(handler FavoriteService) *FavoriteServiceProces
 	self.AddToProcessorMap("FavoriteCnt", &favoriteServiceProcessorFavoriteCnt{handler: handler})
 	self.AddToProcessorMap("TotalFavoritedCnt", &favoriteServiceProcessorTotalFavoritedCnt{handler: handler})
 	self.AddToProcessorMap("FavoriteExist", &favoriteServiceProcessorFavoriteExist{handler: handler})
+	self.AddToProcessorMap("BatchFavoriteCnt", &favoriteServiceProcessorBatchFavoriteCnt{handler: handler})
+	self.AddToProcessorMap("BatchFavoriteExist", &favoriteServiceProcessorBatchFavoriteExist{handler: handler})
+	self.AddToProcessorMap("BatchUserFavoriteCnt", &favoriteServiceProcessorBatchUserFavoriteCnt{handler: handler})
+	self.AddToProcessorMap("BatchTotalFavoritedCnt", &favoriteServiceProcessorBatchTotalFavoritedCnt{handler: handler})
 	return self
 }
 func (p *FavoriteServiceProcessor) Process(ctx context.Context, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
@@ -1463,39 +1780,231 @@ func (p *favoriteServiceProcessorFavoriteExist) Process(ctx context.Context, seq
 	return true, err
 }
 
-type FavoriteServiceFavoriteActionArgs struct {
-	Req *FavoriteActionRequest `thrift:"req,1" frugal:"1,default,FavoriteActionRequest" json:"req"`
-}
-
-func NewFavoriteServiceFavoriteActionArgs() *FavoriteServiceFavoriteActionArgs {
-	return &FavoriteServiceFavoriteActionArgs{}
-}
-
-func (p *FavoriteServiceFavoriteActionArgs) InitDefault() {
-	*p = FavoriteServiceFavoriteActionArgs{}
+type favoriteServiceProcessorBatchFavoriteCnt struct {
+	handler FavoriteService
 }
 
-var FavoriteServiceFavoriteActionArgs_Req_DEFAULT *FavoriteActionRequest
-
-func (p *FavoriteServiceFavoriteActionArgs) GetReq() (v *FavoriteActionRequest) {
-	if !p.IsSetReq() {
-		return FavoriteServiceFavoriteActionArgs_Req_DEFAULT
+func (p *favoriteServiceProcessorBatchFavoriteCnt) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := FavoriteServiceBatchFavoriteCntArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("BatchFavoriteCnt", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
 	}
-	return p.Req
-}
-func (p *FavoriteServiceFavoriteActionArgs) SetReq(val *FavoriteActionRequest) {
-	p.Req = val
-}
 
-var fieldIDToName_FavoriteServiceFavoriteActionArgs = map[int16]string{
-	1: "req",
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := FavoriteServiceBatchFavoriteCntResult{}
+	var retval map[int64]int64
+	if retval, err2 = p.handler.BatchFavoriteCnt(ctx, args.VideoIds); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing BatchFavoriteCnt: "+err2.Error())
+		oprot.WriteMessageBegin("BatchFavoriteCnt", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("BatchFavoriteCnt", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
 }
 
-func (p *FavoriteServiceFavoriteActionArgs) IsSetReq() bool {
-	return p.Req != nil
+type favoriteServiceProcessorBatchFavoriteExist struct {
+	handler FavoriteService
 }
 
-func (p *FavoriteServiceFavoriteActionArgs) Read(iprot thrift.TProtocol) (err error) {
+func (p *favoriteServiceProcessorBatchFavoriteExist) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := FavoriteServiceBatchFavoriteExistArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("BatchFavoriteExist", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := FavoriteServiceBatchFavoriteExistResult{}
+	var retval map[int64]bool
+	if retval, err2 = p.handler.BatchFavoriteExist(ctx, args.UserId, args.VideoIds); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing BatchFavoriteExist: "+err2.Error())
+		oprot.WriteMessageBegin("BatchFavoriteExist", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("BatchFavoriteExist", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type favoriteServiceProcessorBatchUserFavoriteCnt struct {
+	handler FavoriteService
+}
+
+func (p *favoriteServiceProcessorBatchUserFavoriteCnt) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := FavoriteServiceBatchUserFavoriteCntArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("BatchUserFavoriteCnt", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := FavoriteServiceBatchUserFavoriteCntResult{}
+	var retval map[int64]int64
+	if retval, err2 = p.handler.BatchUserFavoriteCnt(ctx, args.UserIds); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing BatchUserFavoriteCnt: "+err2.Error())
+		oprot.WriteMessageBegin("BatchUserFavoriteCnt", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("BatchUserFavoriteCnt", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type favoriteServiceProcessorBatchTotalFavoritedCnt struct {
+	handler FavoriteService
+}
+
+func (p *favoriteServiceProcessorBatchTotalFavoritedCnt) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := FavoriteServiceBatchTotalFavoritedCntArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("BatchTotalFavoritedCnt", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := FavoriteServiceBatchTotalFavoritedCntResult{}
+	var retval map[int64]int64
+	if retval, err2 = p.handler.BatchTotalFavoritedCnt(ctx, args.UserIds); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing BatchTotalFavoritedCnt: "+err2.Error())
+		oprot.WriteMessageBegin("BatchTotalFavoritedCnt", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("BatchTotalFavoritedCnt", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type FavoriteServiceFavoriteActionArgs struct {
+	Req *FavoriteActionRequest `thrift:"req,1" frugal:"1,default,FavoriteActionRequest" json:"req"`
+}
+
+func NewFavoriteServiceFavoriteActionArgs() *FavoriteServiceFavoriteActionArgs {
+	return &FavoriteServiceFavoriteActionArgs{}
+}
+
+func (p *FavoriteServiceFavoriteActionArgs) InitDefault() {
+	*p = FavoriteServiceFavoriteActionArgs{}
+}
+
+var FavoriteServiceFavoriteActionArgs_Req_DEFAULT *FavoriteActionRequest
+
+func (p *FavoriteServiceFavoriteActionArgs) GetReq() (v *FavoriteActionRequest) {
+	if !p.IsSetReq() {
+		return FavoriteServiceFavoriteActionArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+func (p *FavoriteServiceFavoriteActionArgs) SetReq(val *FavoriteActionRequest) {
+	p.Req = val
+}
+
+var fieldIDToName_FavoriteServiceFavoriteActionArgs = map[int16]string{
+	1: "req",
+}
+
+func (p *FavoriteServiceFavoriteActionArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *FavoriteServiceFavoriteActionArgs) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -3237,3 +3746,1662 @@ func (p *FavoriteServiceFavoriteExistResult) Field0DeepEqual(src *bool) bool {
 	}
 	return true
 }
+
+type FavoriteServiceBatchFavoriteCntArgs struct {
+	VideoIds []int64 `thrift:"video_ids,1" frugal:"1,default,list<i64>" json:"video_ids"`
+}
+
+func NewFavoriteServiceBatchFavoriteCntArgs() *FavoriteServiceBatchFavoriteCntArgs {
+	return &FavoriteServiceBatchFavoriteCntArgs{}
+}
+
+func (p *FavoriteServiceBatchFavoriteCntArgs) InitDefault() {
+	*p = FavoriteServiceBatchFavoriteCntArgs{}
+}
+
+func (p *FavoriteServiceBatchFavoriteCntArgs) GetVideoIds() (v []int64) {
+	return p.VideoIds
+}
+func (p *FavoriteServiceBatchFavoriteCntArgs) SetVideoIds(val []int64) {
+	p.VideoIds = val
+}
+
+var fieldIDToName_FavoriteServiceBatchFavoriteCntArgs = map[int16]string{
+	1: "video_ids",
+}
+
+func (p *FavoriteServiceBatchFavoriteCntArgs) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.LIST {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_FavoriteServiceBatchFavoriteCntArgs[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *FavoriteServiceBatchFavoriteCntArgs) ReadField1(iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin()
+	if err != nil {
+		return err
+	}
+	_field := make([]int64, 0, size)
+	for i := 0; i < size; i++ {
+
+		var _elem int64
+		if v, err := iprot.ReadI64(); err != nil {
+			return err
+		} else {
+			_elem = v
+		}
+
+		_field = append(_field, _elem)
+	}
+	if err := iprot.ReadListEnd(); err != nil {
+		return err
+	}
+	p.VideoIds = _field
+	return nil
+}
+
+func (p *FavoriteServiceBatchFavoriteCntArgs) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("BatchFavoriteCnt_args"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *FavoriteServiceBatchFavoriteCntArgs) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("video_ids", thrift.LIST, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteListBegin(thrift.I64, len(p.VideoIds)); err != nil {
+		return err
+	}
+	for _, v := range p.VideoIds {
+		if err := oprot.WriteI64(v); err != nil {
+			return err
+		}
+	}
+	if err := oprot.WriteListEnd(); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *FavoriteServiceBatchFavoriteCntArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("FavoriteServiceBatchFavoriteCntArgs(%+v)", *p)
+
+}
+
+func (p *FavoriteServiceBatchFavoriteCntArgs) DeepEqual(ano *FavoriteServiceBatchFavoriteCntArgs) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.VideoIds) {
+		return false
+	}
+	return true
+}
+
+func (p *FavoriteServiceBatchFavoriteCntArgs) Field1DeepEqual(src []int64) bool {
+
+	if len(p.VideoIds) != len(src) {
+		return false
+	}
+	for i, v := range p.VideoIds {
+		_src := src[i]
+		if v != _src {
+			return false
+		}
+	}
+	return true
+}
+
+type FavoriteServiceBatchFavoriteCntResult struct {
+	Success map[int64]int64 `thrift:"success,0,optional" frugal:"0,optional,map<i64:i64>" json:"success,omitempty"`
+}
+
+func NewFavoriteServiceBatchFavoriteCntResult() *FavoriteServiceBatchFavoriteCntResult {
+	return &FavoriteServiceBatchFavoriteCntResult{}
+}
+
+func (p *FavoriteServiceBatchFavoriteCntResult) InitDefault() {
+	*p = FavoriteServiceBatchFavoriteCntResult{}
+}
+
+var FavoriteServiceBatchFavoriteCntResult_Success_DEFAULT map[int64]int64
+
+func (p *FavoriteServiceBatchFavoriteCntResult) GetSuccess() (v map[int64]int64) {
+	if !p.IsSetSuccess() {
+		return FavoriteServiceBatchFavoriteCntResult_Success_DEFAULT
+	}
+	return p.Success
+}
+func (p *FavoriteServiceBatchFavoriteCntResult) SetSuccess(x interface{}) {
+	p.Success = x.(map[int64]int64)
+}
+
+var fieldIDToName_FavoriteServiceBatchFavoriteCntResult = map[int16]string{
+	0: "success",
+}
+
+func (p *FavoriteServiceBatchFavoriteCntResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *FavoriteServiceBatchFavoriteCntResult) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 0:
+			if fieldTypeId == thrift.MAP {
+				if err = p.ReadField0(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_FavoriteServiceBatchFavoriteCntResult[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *FavoriteServiceBatchFavoriteCntResult) ReadField0(iprot thrift.TProtocol) error {
+	_, _, size, err := iprot.ReadMapBegin()
+	if err != nil {
+		return err
+	}
+	_field := make(map[int64]int64, size)
+	for i := 0; i < size; i++ {
+		var _key int64
+		if v, err := iprot.ReadI64(); err != nil {
+			return err
+		} else {
+			_key = v
+		}
+
+		var _val int64
+		if v, err := iprot.ReadI64(); err != nil {
+			return err
+		} else {
+			_val = v
+		}
+
+		_field[_key] = _val
+	}
+	if err := iprot.ReadMapEnd(); err != nil {
+		return err
+	}
+	p.Success = _field
+	return nil
+}
+
+func (p *FavoriteServiceBatchFavoriteCntResult) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("BatchFavoriteCnt_result"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField0(oprot); err != nil {
+			fieldId = 0
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *FavoriteServiceBatchFavoriteCntResult) writeField0(oprot thrift.TProtocol) (err error) {
+	if p.IsSetSuccess() {
+		if err = oprot.WriteFieldBegin("success", thrift.MAP, 0); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteMapBegin(thrift.I64, thrift.I64, len(p.Success)); err != nil {
+			return err
+		}
+		for k, v := range p.Success {
+			if err := oprot.WriteI64(k); err != nil {
+				return err
+			}
+			if err := oprot.WriteI64(v); err != nil {
+				return err
+			}
+		}
+		if err := oprot.WriteMapEnd(); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
+}
+
+func (p *FavoriteServiceBatchFavoriteCntResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("FavoriteServiceBatchFavoriteCntResult(%+v)", *p)
+
+}
+
+func (p *FavoriteServiceBatchFavoriteCntResult) DeepEqual(ano *FavoriteServiceBatchFavoriteCntResult) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field0DeepEqual(ano.Success) {
+		return false
+	}
+	return true
+}
+
+func (p *FavoriteServiceBatchFavoriteCntResult) Field0DeepEqual(src map[int64]int64) bool {
+
+	if len(p.Success) != len(src) {
+		return false
+	}
+	for k, v := range p.Success {
+		_src := src[k]
+		if v != _src {
+			return false
+		}
+	}
+	return true
+}
+
+type FavoriteServiceBatchFavoriteExistArgs struct {
+	UserId   int64   `thrift:"user_id,1" frugal:"1,default,i64" json:"user_id"`
+	VideoIds []int64 `thrift:"video_ids,2" frugal:"2,default,list<i64>" json:"video_ids"`
+}
+
+func NewFavoriteServiceBatchFavoriteExistArgs() *FavoriteServiceBatchFavoriteExistArgs {
+	return &FavoriteServiceBatchFavoriteExistArgs{}
+}
+
+func (p *FavoriteServiceBatchFavoriteExistArgs) InitDefault() {
+	*p = FavoriteServiceBatchFavoriteExistArgs{}
+}
+
+func (p *FavoriteServiceBatchFavoriteExistArgs) GetUserId() (v int64) {
+	return p.UserId
+}
+
+func (p *FavoriteServiceBatchFavoriteExistArgs) GetVideoIds() (v []int64) {
+	return p.VideoIds
+}
+func (p *FavoriteServiceBatchFavoriteExistArgs) SetUserId(val int64) {
+	p.UserId = val
+}
+func (p *FavoriteServiceBatchFavoriteExistArgs) SetVideoIds(val []int64) {
+	p.VideoIds = val
+}
+
+var fieldIDToName_FavoriteServiceBatchFavoriteExistArgs = map[int16]string{
+	1: "user_id",
+	2: "video_ids",
+}
+
+func (p *FavoriteServiceBatchFavoriteExistArgs) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 2:
+			if fieldTypeId == thrift.LIST {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_FavoriteServiceBatchFavoriteExistArgs[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *FavoriteServiceBatchFavoriteExistArgs) ReadField1(iprot thrift.TProtocol) error {
+
+	var _field int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.UserId = _field
+	return nil
+}
+func (p *FavoriteServiceBatchFavoriteExistArgs) ReadField2(iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin()
+	if err != nil {
+		return err
+	}
+	_field := make([]int64, 0, size)
+	for i := 0; i < size; i++ {
+
+		var _elem int64
+		if v, err := iprot.ReadI64(); err != nil {
+			return err
+		} else {
+			_elem = v
+		}
+
+		_field = append(_field, _elem)
+	}
+	if err := iprot.ReadListEnd(); err != nil {
+		return err
+	}
+	p.VideoIds = _field
+	return nil
+}
+
+func (p *FavoriteServiceBatchFavoriteExistArgs) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("BatchFavoriteExist_args"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *FavoriteServiceBatchFavoriteExistArgs) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("user_id", thrift.I64, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI64(p.UserId); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *FavoriteServiceBatchFavoriteExistArgs) writeField2(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("video_ids", thrift.LIST, 2); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteListBegin(thrift.I64, len(p.VideoIds)); err != nil {
+		return err
+	}
+	for _, v := range p.VideoIds {
+		if err := oprot.WriteI64(v); err != nil {
+			return err
+		}
+	}
+	if err := oprot.WriteListEnd(); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+
+func (p *FavoriteServiceBatchFavoriteExistArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("FavoriteServiceBatchFavoriteExistArgs(%+v)", *p)
+
+}
+
+func (p *FavoriteServiceBatchFavoriteExistArgs) DeepEqual(ano *FavoriteServiceBatchFavoriteExistArgs) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.UserId) {
+		return false
+	}
+	if !p.Field2DeepEqual(ano.VideoIds) {
+		return false
+	}
+	return true
+}
+
+func (p *FavoriteServiceBatchFavoriteExistArgs) Field1DeepEqual(src int64) bool {
+
+	if p.UserId != src {
+		return false
+	}
+	return true
+}
+func (p *FavoriteServiceBatchFavoriteExistArgs) Field2DeepEqual(src []int64) bool {
+
+	if len(p.VideoIds) != len(src) {
+		return false
+	}
+	for i, v := range p.VideoIds {
+		_src := src[i]
+		if v != _src {
+			return false
+		}
+	}
+	return true
+}
+
+type FavoriteServiceBatchFavoriteExistResult struct {
+	Success map[int64]bool `thrift:"success,0,optional" frugal:"0,optional,map<i64:bool>" json:"success,omitempty"`
+}
+
+func NewFavoriteServiceBatchFavoriteExistResult() *FavoriteServiceBatchFavoriteExistResult {
+	return &FavoriteServiceBatchFavoriteExistResult{}
+}
+
+func (p *FavoriteServiceBatchFavoriteExistResult) InitDefault() {
+	*p = FavoriteServiceBatchFavoriteExistResult{}
+}
+
+var FavoriteServiceBatchFavoriteExistResult_Success_DEFAULT map[int64]bool
+
+func (p *FavoriteServiceBatchFavoriteExistResult) GetSuccess() (v map[int64]bool) {
+	if !p.IsSetSuccess() {
+		return FavoriteServiceBatchFavoriteExistResult_Success_DEFAULT
+	}
+	return p.Success
+}
+func (p *FavoriteServiceBatchFavoriteExistResult) SetSuccess(x interface{}) {
+	p.Success = x.(map[int64]bool)
+}
+
+var fieldIDToName_FavoriteServiceBatchFavoriteExistResult = map[int16]string{
+	0: "success",
+}
+
+func (p *FavoriteServiceBatchFavoriteExistResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *FavoriteServiceBatchFavoriteExistResult) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 0:
+			if fieldTypeId == thrift.MAP {
+				if err = p.ReadField0(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_FavoriteServiceBatchFavoriteExistResult[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *FavoriteServiceBatchFavoriteExistResult) ReadField0(iprot thrift.TProtocol) error {
+	_, _, size, err := iprot.ReadMapBegin()
+	if err != nil {
+		return err
+	}
+	_field := make(map[int64]bool, size)
+	for i := 0; i < size; i++ {
+		var _key int64
+		if v, err := iprot.ReadI64(); err != nil {
+			return err
+		} else {
+			_key = v
+		}
+
+		var _val bool
+		if v, err := iprot.ReadBool(); err != nil {
+			return err
+		} else {
+			_val = v
+		}
+
+		_field[_key] = _val
+	}
+	if err := iprot.ReadMapEnd(); err != nil {
+		return err
+	}
+	p.Success = _field
+	return nil
+}
+
+func (p *FavoriteServiceBatchFavoriteExistResult) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("BatchFavoriteExist_result"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField0(oprot); err != nil {
+			fieldId = 0
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *FavoriteServiceBatchFavoriteExistResult) writeField0(oprot thrift.TProtocol) (err error) {
+	if p.IsSetSuccess() {
+		if err = oprot.WriteFieldBegin("success", thrift.MAP, 0); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteMapBegin(thrift.I64, thrift.BOOL, len(p.Success)); err != nil {
+			return err
+		}
+		for k, v := range p.Success {
+			if err := oprot.WriteI64(k); err != nil {
+				return err
+			}
+			if err := oprot.WriteBool(v); err != nil {
+				return err
+			}
+		}
+		if err := oprot.WriteMapEnd(); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
+}
+
+func (p *FavoriteServiceBatchFavoriteExistResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("FavoriteServiceBatchFavoriteExistResult(%+v)", *p)
+
+}
+
+func (p *FavoriteServiceBatchFavoriteExistResult) DeepEqual(ano *FavoriteServiceBatchFavoriteExistResult) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field0DeepEqual(ano.Success) {
+		return false
+	}
+	return true
+}
+
+func (p *FavoriteServiceBatchFavoriteExistResult) Field0DeepEqual(src map[int64]bool) bool {
+
+	if len(p.Success) != len(src) {
+		return false
+	}
+	for k, v := range p.Success {
+		_src := src[k]
+		if v != _src {
+			return false
+		}
+	}
+	return true
+}
+
+type FavoriteServiceBatchUserFavoriteCntArgs struct {
+	UserIds []int64 `thrift:"user_ids,1" frugal:"1,default,list<i64>" json:"user_ids"`
+}
+
+func NewFavoriteServiceBatchUserFavoriteCntArgs() *FavoriteServiceBatchUserFavoriteCntArgs {
+	return &FavoriteServiceBatchUserFavoriteCntArgs{}
+}
+
+func (p *FavoriteServiceBatchUserFavoriteCntArgs) InitDefault() {
+	*p = FavoriteServiceBatchUserFavoriteCntArgs{}
+}
+
+func (p *FavoriteServiceBatchUserFavoriteCntArgs) GetUserIds() (v []int64) {
+	return p.UserIds
+}
+func (p *FavoriteServiceBatchUserFavoriteCntArgs) SetUserIds(val []int64) {
+	p.UserIds = val
+}
+
+var fieldIDToName_FavoriteServiceBatchUserFavoriteCntArgs = map[int16]string{
+	1: "user_ids",
+}
+
+func (p *FavoriteServiceBatchUserFavoriteCntArgs) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.LIST {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_FavoriteServiceBatchUserFavoriteCntArgs[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *FavoriteServiceBatchUserFavoriteCntArgs) ReadField1(iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin()
+	if err != nil {
+		return err
+	}
+	_field := make([]int64, 0, size)
+	for i := 0; i < size; i++ {
+
+		var _elem int64
+		if v, err := iprot.ReadI64(); err != nil {
+			return err
+		} else {
+			_elem = v
+		}
+
+		_field = append(_field, _elem)
+	}
+	if err := iprot.ReadListEnd(); err != nil {
+		return err
+	}
+	p.UserIds = _field
+	return nil
+}
+
+func (p *FavoriteServiceBatchUserFavoriteCntArgs) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("BatchUserFavoriteCnt_args"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *FavoriteServiceBatchUserFavoriteCntArgs) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("user_ids", thrift.LIST, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteListBegin(thrift.I64, len(p.UserIds)); err != nil {
+		return err
+	}
+	for _, v := range p.UserIds {
+		if err := oprot.WriteI64(v); err != nil {
+			return err
+		}
+	}
+	if err := oprot.WriteListEnd(); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *FavoriteServiceBatchUserFavoriteCntArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("FavoriteServiceBatchUserFavoriteCntArgs(%+v)", *p)
+
+}
+
+func (p *FavoriteServiceBatchUserFavoriteCntArgs) DeepEqual(ano *FavoriteServiceBatchUserFavoriteCntArgs) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.UserIds) {
+		return false
+	}
+	return true
+}
+
+func (p *FavoriteServiceBatchUserFavoriteCntArgs) Field1DeepEqual(src []int64) bool {
+
+	if len(p.UserIds) != len(src) {
+		return false
+	}
+	for i, v := range p.UserIds {
+		_src := src[i]
+		if v != _src {
+			return false
+		}
+	}
+	return true
+}
+
+type FavoriteServiceBatchUserFavoriteCntResult struct {
+	Success map[int64]int64 `thrift:"success,0,optional" frugal:"0,optional,map<i64:i64>" json:"success,omitempty"`
+}
+
+func NewFavoriteServiceBatchUserFavoriteCntResult() *FavoriteServiceBatchUserFavoriteCntResult {
+	return &FavoriteServiceBatchUserFavoriteCntResult{}
+}
+
+func (p *FavoriteServiceBatchUserFavoriteCntResult) InitDefault() {
+	*p = FavoriteServiceBatchUserFavoriteCntResult{}
+}
+
+var FavoriteServiceBatchUserFavoriteCntResult_Success_DEFAULT map[int64]int64
+
+func (p *FavoriteServiceBatchUserFavoriteCntResult) GetSuccess() (v map[int64]int64) {
+	if !p.IsSetSuccess() {
+		return FavoriteServiceBatchUserFavoriteCntResult_Success_DEFAULT
+	}
+	return p.Success
+}
+func (p *FavoriteServiceBatchUserFavoriteCntResult) SetSuccess(x interface{}) {
+	p.Success = x.(map[int64]int64)
+}
+
+var fieldIDToName_FavoriteServiceBatchUserFavoriteCntResult = map[int16]string{
+	0: "success",
+}
+
+func (p *FavoriteServiceBatchUserFavoriteCntResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *FavoriteServiceBatchUserFavoriteCntResult) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 0:
+			if fieldTypeId == thrift.MAP {
+				if err = p.ReadField0(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_FavoriteServiceBatchUserFavoriteCntResult[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *FavoriteServiceBatchUserFavoriteCntResult) ReadField0(iprot thrift.TProtocol) error {
+	_, _, size, err := iprot.ReadMapBegin()
+	if err != nil {
+		return err
+	}
+	_field := make(map[int64]int64, size)
+	for i := 0; i < size; i++ {
+		var _key int64
+		if v, err := iprot.ReadI64(); err != nil {
+			return err
+		} else {
+			_key = v
+		}
+
+		var _val int64
+		if v, err := iprot.ReadI64(); err != nil {
+			return err
+		} else {
+			_val = v
+		}
+
+		_field[_key] = _val
+	}
+	if err := iprot.ReadMapEnd(); err != nil {
+		return err
+	}
+	p.Success = _field
+	return nil
+}
+
+func (p *FavoriteServiceBatchUserFavoriteCntResult) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("BatchUserFavoriteCnt_result"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField0(oprot); err != nil {
+			fieldId = 0
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *FavoriteServiceBatchUserFavoriteCntResult) writeField0(oprot thrift.TProtocol) (err error) {
+	if p.IsSetSuccess() {
+		if err = oprot.WriteFieldBegin("success", thrift.MAP, 0); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteMapBegin(thrift.I64, thrift.I64, len(p.Success)); err != nil {
+			return err
+		}
+		for k, v := range p.Success {
+			if err := oprot.WriteI64(k); err != nil {
+				return err
+			}
+			if err := oprot.WriteI64(v); err != nil {
+				return err
+			}
+		}
+		if err := oprot.WriteMapEnd(); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
+}
+
+func (p *FavoriteServiceBatchUserFavoriteCntResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("FavoriteServiceBatchUserFavoriteCntResult(%+v)", *p)
+
+}
+
+func (p *FavoriteServiceBatchUserFavoriteCntResult) DeepEqual(ano *FavoriteServiceBatchUserFavoriteCntResult) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field0DeepEqual(ano.Success) {
+		return false
+	}
+	return true
+}
+
+func (p *FavoriteServiceBatchUserFavoriteCntResult) Field0DeepEqual(src map[int64]int64) bool {
+
+	if len(p.Success) != len(src) {
+		return false
+	}
+	for k, v := range p.Success {
+		_src := src[k]
+		if v != _src {
+			return false
+		}
+	}
+	return true
+}
+
+type FavoriteServiceBatchTotalFavoritedCntArgs struct {
+	UserIds []int64 `thrift:"user_ids,1" frugal:"1,default,list<i64>" json:"user_ids"`
+}
+
+func NewFavoriteServiceBatchTotalFavoritedCntArgs() *FavoriteServiceBatchTotalFavoritedCntArgs {
+	return &FavoriteServiceBatchTotalFavoritedCntArgs{}
+}
+
+func (p *FavoriteServiceBatchTotalFavoritedCntArgs) InitDefault() {
+	*p = FavoriteServiceBatchTotalFavoritedCntArgs{}
+}
+
+func (p *FavoriteServiceBatchTotalFavoritedCntArgs) GetUserIds() (v []int64) {
+	return p.UserIds
+}
+func (p *FavoriteServiceBatchTotalFavoritedCntArgs) SetUserIds(val []int64) {
+	p.UserIds = val
+}
+
+var fieldIDToName_FavoriteServiceBatchTotalFavoritedCntArgs = map[int16]string{
+	1: "user_ids",
+}
+
+func (p *FavoriteServiceBatchTotalFavoritedCntArgs) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.LIST {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_FavoriteServiceBatchTotalFavoritedCntArgs[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *FavoriteServiceBatchTotalFavoritedCntArgs) ReadField1(iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin()
+	if err != nil {
+		return err
+	}
+	_field := make([]int64, 0, size)
+	for i := 0; i < size; i++ {
+
+		var _elem int64
+		if v, err := iprot.ReadI64(); err != nil {
+			return err
+		} else {
+			_elem = v
+		}
+
+		_field = append(_field, _elem)
+	}
+	if err := iprot.ReadListEnd(); err != nil {
+		return err
+	}
+	p.UserIds = _field
+	return nil
+}
+
+func (p *FavoriteServiceBatchTotalFavoritedCntArgs) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("BatchTotalFavoritedCnt_args"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *FavoriteServiceBatchTotalFavoritedCntArgs) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("user_ids", thrift.LIST, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteListBegin(thrift.I64, len(p.UserIds)); err != nil {
+		return err
+	}
+	for _, v := range p.UserIds {
+		if err := oprot.WriteI64(v); err != nil {
+			return err
+		}
+	}
+	if err := oprot.WriteListEnd(); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *FavoriteServiceBatchTotalFavoritedCntArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("FavoriteServiceBatchTotalFavoritedCntArgs(%+v)", *p)
+
+}
+
+func (p *FavoriteServiceBatchTotalFavoritedCntArgs) DeepEqual(ano *FavoriteServiceBatchTotalFavoritedCntArgs) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.UserIds) {
+		return false
+	}
+	return true
+}
+
+func (p *FavoriteServiceBatchTotalFavoritedCntArgs) Field1DeepEqual(src []int64) bool {
+
+	if len(p.UserIds) != len(src) {
+		return false
+	}
+	for i, v := range p.UserIds {
+		_src := src[i]
+		if v != _src {
+			return false
+		}
+	}
+	return true
+}
+
+type FavoriteServiceBatchTotalFavoritedCntResult struct {
+	Success map[int64]int64 `thrift:"success,0,optional" frugal:"0,optional,map<i64:i64>" json:"success,omitempty"`
+}
+
+func NewFavoriteServiceBatchTotalFavoritedCntResult() *FavoriteServiceBatchTotalFavoritedCntResult {
+	return &FavoriteServiceBatchTotalFavoritedCntResult{}
+}
+
+func (p *FavoriteServiceBatchTotalFavoritedCntResult) InitDefault() {
+	*p = FavoriteServiceBatchTotalFavoritedCntResult{}
+}
+
+var FavoriteServiceBatchTotalFavoritedCntResult_Success_DEFAULT map[int64]int64
+
+func (p *FavoriteServiceBatchTotalFavoritedCntResult) GetSuccess() (v map[int64]int64) {
+	if !p.IsSetSuccess() {
+		return FavoriteServiceBatchTotalFavoritedCntResult_Success_DEFAULT
+	}
+	return p.Success
+}
+func (p *FavoriteServiceBatchTotalFavoritedCntResult) SetSuccess(x interface{}) {
+	p.Success = x.(map[int64]int64)
+}
+
+var fieldIDToName_FavoriteServiceBatchTotalFavoritedCntResult = map[int16]string{
+	0: "success",
+}
+
+func (p *FavoriteServiceBatchTotalFavoritedCntResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *FavoriteServiceBatchTotalFavoritedCntResult) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 0:
+			if fieldTypeId == thrift.MAP {
+				if err = p.ReadField0(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_FavoriteServiceBatchTotalFavoritedCntResult[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *FavoriteServiceBatchTotalFavoritedCntResult) ReadField0(iprot thrift.TProtocol) error {
+	_, _, size, err := iprot.ReadMapBegin()
+	if err != nil {
+		return err
+	}
+	_field := make(map[int64]int64, size)
+	for i := 0; i < size; i++ {
+		var _key int64
+		if v, err := iprot.ReadI64(); err != nil {
+			return err
+		} else {
+			_key = v
+		}
+
+		var _val int64
+		if v, err := iprot.ReadI64(); err != nil {
+			return err
+		} else {
+			_val = v
+		}
+
+		_field[_key] = _val
+	}
+	if err := iprot.ReadMapEnd(); err != nil {
+		return err
+	}
+	p.Success = _field
+	return nil
+}
+
+func (p *FavoriteServiceBatchTotalFavoritedCntResult) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("BatchTotalFavoritedCnt_result"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField0(oprot); err != nil {
+			fieldId = 0
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *FavoriteServiceBatchTotalFavoritedCntResult) writeField0(oprot thrift.TProtocol) (err error) {
+	if p.IsSetSuccess() {
+		if err = oprot.WriteFieldBegin("success", thrift.MAP, 0); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteMapBegin(thrift.I64, thrift.I64, len(p.Success)); err != nil {
+			return err
+		}
+		for k, v := range p.Success {
+			if err := oprot.WriteI64(k); err != nil {
+				return err
+			}
+			if err := oprot.WriteI64(v); err != nil {
+				return err
+			}
+		}
+		if err := oprot.WriteMapEnd(); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
+}
+
+func (p *FavoriteServiceBatchTotalFavoritedCntResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("FavoriteServiceBatchTotalFavoritedCntResult(%+v)", *p)
+
+}
+
+func (p *FavoriteServiceBatchTotalFavoritedCntResult) DeepEqual(ano *FavoriteServiceBatchTotalFavoritedCntResult) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field0DeepEqual(ano.Success) {
+		return false
+	}
+	return true
+}
+
+func (p *FavoriteServiceBatchTotalFavoritedCntResult) Field0DeepEqual(src map[int64]int64) bool {
+
+	if len(p.Success) != len(src) {
+		return false
+	}
+	for k, v := range p.Success {
+		_src := src[k]
+		if v != _src {
+			return false
+		}
+	}
+	return true
+}
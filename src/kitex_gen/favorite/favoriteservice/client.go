@@ -16,6 +16,10 @@ type Client interface {
 	FavoriteCnt(ctx context.Context, userId int64, callOptions ...callopt.Option) (r int64, err error)
 	TotalFavoritedCnt(ctx context.Context, userId int64, callOptions ...callopt.Option) (r int64, err error)
 	FavoriteExist(ctx context.Context, userId int64, videoId int64, callOptions ...callopt.Option) (r bool, err error)
+	BatchFavoriteCnt(ctx context.Context, videoIds []int64, callOptions ...callopt.Option) (r map[int64]int64, err error)
+	BatchFavoriteExist(ctx context.Context, userId int64, videoIds []int64, callOptions ...callopt.Option) (r map[int64]bool, err error)
+	BatchUserFavoriteCnt(ctx context.Context, userIds []int64, callOptions ...callopt.Option) (r map[int64]int64, err error)
+	BatchTotalFavoritedCnt(ctx context.Context, userIds []int64, callOptions ...callopt.Option) (r map[int64]int64, err error)
 }
 
 // NewClient creates a client for the service defined in IDL.
@@ -71,3 +75,23 @@ func (p *kFavoriteServiceClient) FavoriteExist(ctx context.Context, userId int64
 	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
 	return p.kClient.FavoriteExist(ctx, userId, videoId)
 }
+
+func (p *kFavoriteServiceClient) BatchFavoriteCnt(ctx context.Context, videoIds []int64, callOptions ...callopt.Option) (r map[int64]int64, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.BatchFavoriteCnt(ctx, videoIds)
+}
+
+func (p *kFavoriteServiceClient) BatchFavoriteExist(ctx context.Context, userId int64, videoIds []int64, callOptions ...callopt.Option) (r map[int64]bool, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.BatchFavoriteExist(ctx, userId, videoIds)
+}
+
+func (p *kFavoriteServiceClient) BatchUserFavoriteCnt(ctx context.Context, userIds []int64, callOptions ...callopt.Option) (r map[int64]int64, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.BatchUserFavoriteCnt(ctx, userIds)
+}
+
+func (p *kFavoriteServiceClient) BatchTotalFavoritedCnt(ctx context.Context, userIds []int64, callOptions ...callopt.Option) (r map[int64]int64, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.BatchTotalFavoritedCnt(ctx, userIds)
+}
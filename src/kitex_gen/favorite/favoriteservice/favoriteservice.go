@@ -19,11 +19,15 @@ func NewServiceInfo() *kitex.ServiceInfo {
 	serviceName := "FavoriteService"
 	handlerType := (*favorite.FavoriteService)(nil)
 	methods := map[string]kitex.MethodInfo{
-		"FavoriteAction":    kitex.NewMethodInfo(favoriteActionHandler, newFavoriteServiceFavoriteActionArgs, newFavoriteServiceFavoriteActionResult, false),
-		"FavoriteList":      kitex.NewMethodInfo(favoriteListHandler, newFavoriteServiceFavoriteListArgs, newFavoriteServiceFavoriteListResult, false),
-		"FavoriteCnt":       kitex.NewMethodInfo(favoriteCntHandler, newFavoriteServiceFavoriteCntArgs, newFavoriteServiceFavoriteCntResult, false),
-		"TotalFavoritedCnt": kitex.NewMethodInfo(totalFavoritedCntHandler, newFavoriteServiceTotalFavoritedCntArgs, newFavoriteServiceTotalFavoritedCntResult, false),
-		"FavoriteExist":     kitex.NewMethodInfo(favoriteExistHandler, newFavoriteServiceFavoriteExistArgs, newFavoriteServiceFavoriteExistResult, false),
+		"FavoriteAction":         kitex.NewMethodInfo(favoriteActionHandler, newFavoriteServiceFavoriteActionArgs, newFavoriteServiceFavoriteActionResult, false),
+		"FavoriteList":           kitex.NewMethodInfo(favoriteListHandler, newFavoriteServiceFavoriteListArgs, newFavoriteServiceFavoriteListResult, false),
+		"FavoriteCnt":            kitex.NewMethodInfo(favoriteCntHandler, newFavoriteServiceFavoriteCntArgs, newFavoriteServiceFavoriteCntResult, false),
+		"TotalFavoritedCnt":      kitex.NewMethodInfo(totalFavoritedCntHandler, newFavoriteServiceTotalFavoritedCntArgs, newFavoriteServiceTotalFavoritedCntResult, false),
+		"FavoriteExist":          kitex.NewMethodInfo(favoriteExistHandler, newFavoriteServiceFavoriteExistArgs, newFavoriteServiceFavoriteExistResult, false),
+		"BatchFavoriteCnt":       kitex.NewMethodInfo(batchFavoriteCntHandler, newFavoriteServiceBatchFavoriteCntArgs, newFavoriteServiceBatchFavoriteCntResult, false),
+		"BatchFavoriteExist":     kitex.NewMethodInfo(batchFavoriteExistHandler, newFavoriteServiceBatchFavoriteExistArgs, newFavoriteServiceBatchFavoriteExistResult, false),
+		"BatchUserFavoriteCnt":   kitex.NewMethodInfo(batchUserFavoriteCntHandler, newFavoriteServiceBatchUserFavoriteCntArgs, newFavoriteServiceBatchUserFavoriteCntResult, false),
+		"BatchTotalFavoritedCnt": kitex.NewMethodInfo(batchTotalFavoritedCntHandler, newFavoriteServiceBatchTotalFavoritedCntArgs, newFavoriteServiceBatchTotalFavoritedCntResult, false),
 	}
 	extra := map[string]interface{}{
 		"PackageName":     "favorite",
@@ -130,6 +134,78 @@ func newFavoriteServiceFavoriteExistResult() interface{} {
 	return favorite.NewFavoriteServiceFavoriteExistResult()
 }
 
+func batchFavoriteCntHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*favorite.FavoriteServiceBatchFavoriteCntArgs)
+	realResult := result.(*favorite.FavoriteServiceBatchFavoriteCntResult)
+	success, err := handler.(favorite.FavoriteService).BatchFavoriteCnt(ctx, realArg.VideoIds)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newFavoriteServiceBatchFavoriteCntArgs() interface{} {
+	return favorite.NewFavoriteServiceBatchFavoriteCntArgs()
+}
+
+func newFavoriteServiceBatchFavoriteCntResult() interface{} {
+	return favorite.NewFavoriteServiceBatchFavoriteCntResult()
+}
+
+func batchFavoriteExistHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*favorite.FavoriteServiceBatchFavoriteExistArgs)
+	realResult := result.(*favorite.FavoriteServiceBatchFavoriteExistResult)
+	success, err := handler.(favorite.FavoriteService).BatchFavoriteExist(ctx, realArg.UserId, realArg.VideoIds)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newFavoriteServiceBatchFavoriteExistArgs() interface{} {
+	return favorite.NewFavoriteServiceBatchFavoriteExistArgs()
+}
+
+func newFavoriteServiceBatchFavoriteExistResult() interface{} {
+	return favorite.NewFavoriteServiceBatchFavoriteExistResult()
+}
+
+func batchUserFavoriteCntHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*favorite.FavoriteServiceBatchUserFavoriteCntArgs)
+	realResult := result.(*favorite.FavoriteServiceBatchUserFavoriteCntResult)
+	success, err := handler.(favorite.FavoriteService).BatchUserFavoriteCnt(ctx, realArg.UserIds)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newFavoriteServiceBatchUserFavoriteCntArgs() interface{} {
+	return favorite.NewFavoriteServiceBatchUserFavoriteCntArgs()
+}
+
+func newFavoriteServiceBatchUserFavoriteCntResult() interface{} {
+	return favorite.NewFavoriteServiceBatchUserFavoriteCntResult()
+}
+
+func batchTotalFavoritedCntHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*favorite.FavoriteServiceBatchTotalFavoritedCntArgs)
+	realResult := result.(*favorite.FavoriteServiceBatchTotalFavoritedCntResult)
+	success, err := handler.(favorite.FavoriteService).BatchTotalFavoritedCnt(ctx, realArg.UserIds)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newFavoriteServiceBatchTotalFavoritedCntArgs() interface{} {
+	return favorite.NewFavoriteServiceBatchTotalFavoritedCntArgs()
+}
+
+func newFavoriteServiceBatchTotalFavoritedCntResult() interface{} {
+	return favorite.NewFavoriteServiceBatchTotalFavoritedCntResult()
+}
+
 type kClient struct {
 	c client.Client
 }
@@ -190,3 +266,44 @@ func (p *kClient) FavoriteExist(ctx context.Context, userId int64, videoId int64
 	}
 	return _result.GetSuccess(), nil
 }
+
+func (p *kClient) BatchFavoriteCnt(ctx context.Context, videoIds []int64) (r map[int64]int64, err error) {
+	var _args favorite.FavoriteServiceBatchFavoriteCntArgs
+	_args.VideoIds = videoIds
+	var _result favorite.FavoriteServiceBatchFavoriteCntResult
+	if err = p.c.Call(ctx, "BatchFavoriteCnt", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) BatchFavoriteExist(ctx context.Context, userId int64, videoIds []int64) (r map[int64]bool, err error) {
+	var _args favorite.FavoriteServiceBatchFavoriteExistArgs
+	_args.UserId = userId
+	_args.VideoIds = videoIds
+	var _result favorite.FavoriteServiceBatchFavoriteExistResult
+	if err = p.c.Call(ctx, "BatchFavoriteExist", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) BatchUserFavoriteCnt(ctx context.Context, userIds []int64) (r map[int64]int64, err error) {
+	var _args favorite.FavoriteServiceBatchUserFavoriteCntArgs
+	_args.UserIds = userIds
+	var _result favorite.FavoriteServiceBatchUserFavoriteCntResult
+	if err = p.c.Call(ctx, "BatchUserFavoriteCnt", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) BatchTotalFavoritedCnt(ctx context.Context, userIds []int64) (r map[int64]int64, err error) {
+	var _args favorite.FavoriteServiceBatchTotalFavoritedCntArgs
+	_args.UserIds = userIds
+	var _result favorite.FavoriteServiceBatchTotalFavoritedCntResult
+	if err = p.c.Call(ctx, "BatchTotalFavoritedCnt", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
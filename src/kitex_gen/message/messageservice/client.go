@@ -13,6 +13,16 @@ import (
 type Client interface {
 	MessageChat(ctx context.Context, req *message.MessageChatRequest, callOptions ...callopt.Option) (r *message.MessageChatResponse, err error)
 	MessageAction(ctx context.Context, req *message.MessageActionRequest, callOptions ...callopt.Option) (r *message.MessageActionResponse, err error)
+	ConversationList(ctx context.Context, req *message.ConversationListRequest, callOptions ...callopt.Option) (r *message.ConversationListResponse, err error)
+	MarkRead(ctx context.Context, req *message.MarkReadRequest, callOptions ...callopt.Option) (r *message.MarkReadResponse, err error)
+	RecallMessage(ctx context.Context, req *message.RecallMessageRequest, callOptions ...callopt.Option) (r *message.RecallMessageResponse, err error)
+	DeleteMessage(ctx context.Context, req *message.DeleteMessageRequest, callOptions ...callopt.Option) (r *message.DeleteMessageResponse, err error)
+	CreateGroup(ctx context.Context, req *message.CreateGroupRequest, callOptions ...callopt.Option) (r *message.CreateGroupResponse, err error)
+	JoinGroup(ctx context.Context, req *message.JoinGroupRequest, callOptions ...callopt.Option) (r *message.JoinGroupResponse, err error)
+	LeaveGroup(ctx context.Context, req *message.LeaveGroupRequest, callOptions ...callopt.Option) (r *message.LeaveGroupResponse, err error)
+	KickGroupMember(ctx context.Context, req *message.KickGroupMemberRequest, callOptions ...callopt.Option) (r *message.KickGroupMemberResponse, err error)
+	SetGroupRole(ctx context.Context, req *message.SetGroupRoleRequest, callOptions ...callopt.Option) (r *message.SetGroupRoleResponse, err error)
+	GroupMemberList(ctx context.Context, req *message.GroupMemberListRequest, callOptions ...callopt.Option) (r *message.GroupMemberListResponse, err error)
 }
 
 // NewClient creates a client for the service defined in IDL.
@@ -53,3 +63,53 @@ func (p *kMessageServiceClient) MessageAction(ctx context.Context, req *message.
 	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
 	return p.kClient.MessageAction(ctx, req)
 }
+
+func (p *kMessageServiceClient) ConversationList(ctx context.Context, req *message.ConversationListRequest, callOptions ...callopt.Option) (r *message.ConversationListResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.ConversationList(ctx, req)
+}
+
+func (p *kMessageServiceClient) MarkRead(ctx context.Context, req *message.MarkReadRequest, callOptions ...callopt.Option) (r *message.MarkReadResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.MarkRead(ctx, req)
+}
+
+func (p *kMessageServiceClient) RecallMessage(ctx context.Context, req *message.RecallMessageRequest, callOptions ...callopt.Option) (r *message.RecallMessageResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.RecallMessage(ctx, req)
+}
+
+func (p *kMessageServiceClient) DeleteMessage(ctx context.Context, req *message.DeleteMessageRequest, callOptions ...callopt.Option) (r *message.DeleteMessageResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.DeleteMessage(ctx, req)
+}
+
+func (p *kMessageServiceClient) CreateGroup(ctx context.Context, req *message.CreateGroupRequest, callOptions ...callopt.Option) (r *message.CreateGroupResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.CreateGroup(ctx, req)
+}
+
+func (p *kMessageServiceClient) JoinGroup(ctx context.Context, req *message.JoinGroupRequest, callOptions ...callopt.Option) (r *message.JoinGroupResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.JoinGroup(ctx, req)
+}
+
+func (p *kMessageServiceClient) LeaveGroup(ctx context.Context, req *message.LeaveGroupRequest, callOptions ...callopt.Option) (r *message.LeaveGroupResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.LeaveGroup(ctx, req)
+}
+
+func (p *kMessageServiceClient) KickGroupMember(ctx context.Context, req *message.KickGroupMemberRequest, callOptions ...callopt.Option) (r *message.KickGroupMemberResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.KickGroupMember(ctx, req)
+}
+
+func (p *kMessageServiceClient) SetGroupRole(ctx context.Context, req *message.SetGroupRoleRequest, callOptions ...callopt.Option) (r *message.SetGroupRoleResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.SetGroupRole(ctx, req)
+}
+
+func (p *kMessageServiceClient) GroupMemberList(ctx context.Context, req *message.GroupMemberListRequest, callOptions ...callopt.Option) (r *message.GroupMemberListResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.GroupMemberList(ctx, req)
+}
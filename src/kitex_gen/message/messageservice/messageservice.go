@@ -19,8 +19,18 @@ func NewServiceInfo() *kitex.ServiceInfo {
 	serviceName := "MessageService"
 	handlerType := (*message.MessageService)(nil)
 	methods := map[string]kitex.MethodInfo{
-		"MessageChat":   kitex.NewMethodInfo(messageChatHandler, newMessageServiceMessageChatArgs, newMessageServiceMessageChatResult, false),
-		"MessageAction": kitex.NewMethodInfo(messageActionHandler, newMessageServiceMessageActionArgs, newMessageServiceMessageActionResult, false),
+		"MessageChat":      kitex.NewMethodInfo(messageChatHandler, newMessageServiceMessageChatArgs, newMessageServiceMessageChatResult, false),
+		"MessageAction":    kitex.NewMethodInfo(messageActionHandler, newMessageServiceMessageActionArgs, newMessageServiceMessageActionResult, false),
+		"ConversationList": kitex.NewMethodInfo(conversationListHandler, newMessageServiceConversationListArgs, newMessageServiceConversationListResult, false),
+		"MarkRead":         kitex.NewMethodInfo(markReadHandler, newMessageServiceMarkReadArgs, newMessageServiceMarkReadResult, false),
+		"RecallMessage":    kitex.NewMethodInfo(recallMessageHandler, newMessageServiceRecallMessageArgs, newMessageServiceRecallMessageResult, false),
+		"DeleteMessage":    kitex.NewMethodInfo(deleteMessageHandler, newMessageServiceDeleteMessageArgs, newMessageServiceDeleteMessageResult, false),
+		"CreateGroup":      kitex.NewMethodInfo(createGroupHandler, newMessageServiceCreateGroupArgs, newMessageServiceCreateGroupResult, false),
+		"JoinGroup":        kitex.NewMethodInfo(joinGroupHandler, newMessageServiceJoinGroupArgs, newMessageServiceJoinGroupResult, false),
+		"LeaveGroup":       kitex.NewMethodInfo(leaveGroupHandler, newMessageServiceLeaveGroupArgs, newMessageServiceLeaveGroupResult, false),
+		"KickGroupMember":  kitex.NewMethodInfo(kickGroupMemberHandler, newMessageServiceKickGroupMemberArgs, newMessageServiceKickGroupMemberResult, false),
+		"SetGroupRole":     kitex.NewMethodInfo(setGroupRoleHandler, newMessageServiceSetGroupRoleArgs, newMessageServiceSetGroupRoleResult, false),
+		"GroupMemberList":  kitex.NewMethodInfo(groupMemberListHandler, newMessageServiceGroupMemberListArgs, newMessageServiceGroupMemberListResult, false),
 	}
 	extra := map[string]interface{}{
 		"PackageName":     "message",
@@ -73,6 +83,186 @@ func newMessageServiceMessageActionResult() interface{} {
 	return message.NewMessageServiceMessageActionResult()
 }
 
+func conversationListHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*message.MessageServiceConversationListArgs)
+	realResult := result.(*message.MessageServiceConversationListResult)
+	success, err := handler.(message.MessageService).ConversationList(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newMessageServiceConversationListArgs() interface{} {
+	return message.NewMessageServiceConversationListArgs()
+}
+
+func newMessageServiceConversationListResult() interface{} {
+	return message.NewMessageServiceConversationListResult()
+}
+
+func markReadHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*message.MessageServiceMarkReadArgs)
+	realResult := result.(*message.MessageServiceMarkReadResult)
+	success, err := handler.(message.MessageService).MarkRead(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newMessageServiceMarkReadArgs() interface{} {
+	return message.NewMessageServiceMarkReadArgs()
+}
+
+func newMessageServiceMarkReadResult() interface{} {
+	return message.NewMessageServiceMarkReadResult()
+}
+
+func recallMessageHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*message.MessageServiceRecallMessageArgs)
+	realResult := result.(*message.MessageServiceRecallMessageResult)
+	success, err := handler.(message.MessageService).RecallMessage(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newMessageServiceRecallMessageArgs() interface{} {
+	return message.NewMessageServiceRecallMessageArgs()
+}
+
+func newMessageServiceRecallMessageResult() interface{} {
+	return message.NewMessageServiceRecallMessageResult()
+}
+
+func deleteMessageHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*message.MessageServiceDeleteMessageArgs)
+	realResult := result.(*message.MessageServiceDeleteMessageResult)
+	success, err := handler.(message.MessageService).DeleteMessage(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newMessageServiceDeleteMessageArgs() interface{} {
+	return message.NewMessageServiceDeleteMessageArgs()
+}
+
+func newMessageServiceDeleteMessageResult() interface{} {
+	return message.NewMessageServiceDeleteMessageResult()
+}
+
+func createGroupHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*message.MessageServiceCreateGroupArgs)
+	realResult := result.(*message.MessageServiceCreateGroupResult)
+	success, err := handler.(message.MessageService).CreateGroup(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newMessageServiceCreateGroupArgs() interface{} {
+	return message.NewMessageServiceCreateGroupArgs()
+}
+
+func newMessageServiceCreateGroupResult() interface{} {
+	return message.NewMessageServiceCreateGroupResult()
+}
+
+func joinGroupHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*message.MessageServiceJoinGroupArgs)
+	realResult := result.(*message.MessageServiceJoinGroupResult)
+	success, err := handler.(message.MessageService).JoinGroup(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newMessageServiceJoinGroupArgs() interface{} {
+	return message.NewMessageServiceJoinGroupArgs()
+}
+
+func newMessageServiceJoinGroupResult() interface{} {
+	return message.NewMessageServiceJoinGroupResult()
+}
+
+func leaveGroupHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*message.MessageServiceLeaveGroupArgs)
+	realResult := result.(*message.MessageServiceLeaveGroupResult)
+	success, err := handler.(message.MessageService).LeaveGroup(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newMessageServiceLeaveGroupArgs() interface{} {
+	return message.NewMessageServiceLeaveGroupArgs()
+}
+
+func newMessageServiceLeaveGroupResult() interface{} {
+	return message.NewMessageServiceLeaveGroupResult()
+}
+
+func kickGroupMemberHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*message.MessageServiceKickGroupMemberArgs)
+	realResult := result.(*message.MessageServiceKickGroupMemberResult)
+	success, err := handler.(message.MessageService).KickGroupMember(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newMessageServiceKickGroupMemberArgs() interface{} {
+	return message.NewMessageServiceKickGroupMemberArgs()
+}
+
+func newMessageServiceKickGroupMemberResult() interface{} {
+	return message.NewMessageServiceKickGroupMemberResult()
+}
+
+func setGroupRoleHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*message.MessageServiceSetGroupRoleArgs)
+	realResult := result.(*message.MessageServiceSetGroupRoleResult)
+	success, err := handler.(message.MessageService).SetGroupRole(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newMessageServiceSetGroupRoleArgs() interface{} {
+	return message.NewMessageServiceSetGroupRoleArgs()
+}
+
+func newMessageServiceSetGroupRoleResult() interface{} {
+	return message.NewMessageServiceSetGroupRoleResult()
+}
+
+func groupMemberListHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*message.MessageServiceGroupMemberListArgs)
+	realResult := result.(*message.MessageServiceGroupMemberListResult)
+	success, err := handler.(message.MessageService).GroupMemberList(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newMessageServiceGroupMemberListArgs() interface{} {
+	return message.NewMessageServiceGroupMemberListArgs()
+}
+
+func newMessageServiceGroupMemberListResult() interface{} {
+	return message.NewMessageServiceGroupMemberListResult()
+}
+
 type kClient struct {
 	c client.Client
 }
@@ -102,3 +292,103 @@ func (p *kClient) MessageAction(ctx context.Context, req *message.MessageActionR
 	}
 	return _result.GetSuccess(), nil
 }
+
+func (p *kClient) ConversationList(ctx context.Context, req *message.ConversationListRequest) (r *message.ConversationListResponse, err error) {
+	var _args message.MessageServiceConversationListArgs
+	_args.Req = req
+	var _result message.MessageServiceConversationListResult
+	if err = p.c.Call(ctx, "ConversationList", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) MarkRead(ctx context.Context, req *message.MarkReadRequest) (r *message.MarkReadResponse, err error) {
+	var _args message.MessageServiceMarkReadArgs
+	_args.Req = req
+	var _result message.MessageServiceMarkReadResult
+	if err = p.c.Call(ctx, "MarkRead", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) RecallMessage(ctx context.Context, req *message.RecallMessageRequest) (r *message.RecallMessageResponse, err error) {
+	var _args message.MessageServiceRecallMessageArgs
+	_args.Req = req
+	var _result message.MessageServiceRecallMessageResult
+	if err = p.c.Call(ctx, "RecallMessage", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) DeleteMessage(ctx context.Context, req *message.DeleteMessageRequest) (r *message.DeleteMessageResponse, err error) {
+	var _args message.MessageServiceDeleteMessageArgs
+	_args.Req = req
+	var _result message.MessageServiceDeleteMessageResult
+	if err = p.c.Call(ctx, "DeleteMessage", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) CreateGroup(ctx context.Context, req *message.CreateGroupRequest) (r *message.CreateGroupResponse, err error) {
+	var _args message.MessageServiceCreateGroupArgs
+	_args.Req = req
+	var _result message.MessageServiceCreateGroupResult
+	if err = p.c.Call(ctx, "CreateGroup", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) JoinGroup(ctx context.Context, req *message.JoinGroupRequest) (r *message.JoinGroupResponse, err error) {
+	var _args message.MessageServiceJoinGroupArgs
+	_args.Req = req
+	var _result message.MessageServiceJoinGroupResult
+	if err = p.c.Call(ctx, "JoinGroup", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) LeaveGroup(ctx context.Context, req *message.LeaveGroupRequest) (r *message.LeaveGroupResponse, err error) {
+	var _args message.MessageServiceLeaveGroupArgs
+	_args.Req = req
+	var _result message.MessageServiceLeaveGroupResult
+	if err = p.c.Call(ctx, "LeaveGroup", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) KickGroupMember(ctx context.Context, req *message.KickGroupMemberRequest) (r *message.KickGroupMemberResponse, err error) {
+	var _args message.MessageServiceKickGroupMemberArgs
+	_args.Req = req
+	var _result message.MessageServiceKickGroupMemberResult
+	if err = p.c.Call(ctx, "KickGroupMember", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) SetGroupRole(ctx context.Context, req *message.SetGroupRoleRequest) (r *message.SetGroupRoleResponse, err error) {
+	var _args message.MessageServiceSetGroupRoleArgs
+	_args.Req = req
+	var _result message.MessageServiceSetGroupRoleResult
+	if err = p.c.Call(ctx, "SetGroupRole", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) GroupMemberList(ctx context.Context, req *message.GroupMemberListRequest) (r *message.GroupMemberListResponse, err error) {
+	var _args message.MessageServiceGroupMemberListArgs
+	_args.Req = req
+	var _result message.MessageServiceGroupMemberListResult
+	if err = p.c.Call(ctx, "GroupMemberList", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
@@ -532,8 +532,10 @@ func (p *RelationActionResponse) Field2DeepEqual(src *string) bool {
 }
 
 type RelationFollowListRequest struct {
-	UserId   *int64 `thrift:"user_id,1,optional" frugal:"1,optional,i64" json:"user_id,omitempty"`
-	AuthorId int64  `thrift:"author_id,2" frugal:"2,default,i64" json:"author_id"`
+	UserId   *int64  `thrift:"user_id,1,optional" frugal:"1,optional,i64" json:"user_id,omitempty"`
+	AuthorId int64   `thrift:"author_id,2" frugal:"2,default,i64" json:"author_id"`
+	Cursor   *string `thrift:"cursor,3,optional" frugal:"3,optional,string" json:"cursor,omitempty"`
+	Count    int32   `thrift:"count,4" frugal:"4,default,i32" json:"count"`
 }
 
 func NewRelationFollowListRequest() *RelationFollowListRequest {
@@ -556,22 +558,47 @@ func (p *RelationFollowListRequest) GetUserId() (v int64) {
 func (p *RelationFollowListRequest) GetAuthorId() (v int64) {
 	return p.AuthorId
 }
+
+var RelationFollowListRequest_Cursor_DEFAULT string
+
+func (p *RelationFollowListRequest) GetCursor() (v string) {
+	if !p.IsSetCursor() {
+		return RelationFollowListRequest_Cursor_DEFAULT
+	}
+	return *p.Cursor
+}
+
+func (p *RelationFollowListRequest) GetCount() (v int32) {
+	return p.Count
+}
 func (p *RelationFollowListRequest) SetUserId(val *int64) {
 	p.UserId = val
 }
 func (p *RelationFollowListRequest) SetAuthorId(val int64) {
 	p.AuthorId = val
 }
+func (p *RelationFollowListRequest) SetCursor(val *string) {
+	p.Cursor = val
+}
+func (p *RelationFollowListRequest) SetCount(val int32) {
+	p.Count = val
+}
 
 var fieldIDToName_RelationFollowListRequest = map[int16]string{
 	1: "user_id",
 	2: "author_id",
+	3: "cursor",
+	4: "count",
 }
 
 func (p *RelationFollowListRequest) IsSetUserId() bool {
 	return p.UserId != nil
 }
 
+func (p *RelationFollowListRequest) IsSetCursor() bool {
+	return p.Cursor != nil
+}
+
 func (p *RelationFollowListRequest) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
@@ -607,6 +634,22 @@ func (p *RelationFollowListRequest) Read(iprot thrift.TProtocol) (err error) {
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
+		case 3:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField3(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 4:
+			if fieldTypeId == thrift.I32 {
+				if err = p.ReadField4(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
 		default:
 			if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
@@ -658,6 +701,28 @@ func (p *RelationFollowListRequest) ReadField2(iprot thrift.TProtocol) error {
 	p.AuthorId = _field
 	return nil
 }
+func (p *RelationFollowListRequest) ReadField3(iprot thrift.TProtocol) error {
+
+	var _field *string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.Cursor = _field
+	return nil
+}
+func (p *RelationFollowListRequest) ReadField4(iprot thrift.TProtocol) error {
+
+	var _field int32
+	if v, err := iprot.ReadI32(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.Count = _field
+	return nil
+}
 
 func (p *RelationFollowListRequest) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
@@ -673,6 +738,14 @@ func (p *RelationFollowListRequest) Write(oprot thrift.TProtocol) (err error) {
 			fieldId = 2
 			goto WriteFieldError
 		}
+		if err = p.writeField3(oprot); err != nil {
+			fieldId = 3
+			goto WriteFieldError
+		}
+		if err = p.writeField4(oprot); err != nil {
+			fieldId = 4
+			goto WriteFieldError
+		}
 	}
 	if err = oprot.WriteFieldStop(); err != nil {
 		goto WriteFieldStopError
@@ -727,6 +800,42 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
 }
 
+func (p *RelationFollowListRequest) writeField3(oprot thrift.TProtocol) (err error) {
+	if p.IsSetCursor() {
+		if err = oprot.WriteFieldBegin("cursor", thrift.STRING, 3); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.Cursor); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
+}
+
+func (p *RelationFollowListRequest) writeField4(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("count", thrift.I32, 4); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI32(p.Count); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
+}
+
 func (p *RelationFollowListRequest) String() string {
 	if p == nil {
 		return "<nil>"
@@ -747,6 +856,12 @@ func (p *RelationFollowListRequest) DeepEqual(ano *RelationFollowListRequest) bo
 	if !p.Field2DeepEqual(ano.AuthorId) {
 		return false
 	}
+	if !p.Field3DeepEqual(ano.Cursor) {
+		return false
+	}
+	if !p.Field4DeepEqual(ano.Count) {
+		return false
+	}
 	return true
 }
 
@@ -769,11 +884,32 @@ func (p *RelationFollowListRequest) Field2DeepEqual(src int64) bool {
 	}
 	return true
 }
+func (p *RelationFollowListRequest) Field3DeepEqual(src *string) bool {
+
+	if p.Cursor == src {
+		return true
+	} else if p.Cursor == nil || src == nil {
+		return false
+	}
+	if strings.Compare(*p.Cursor, *src) != 0 {
+		return false
+	}
+	return true
+}
+func (p *RelationFollowListRequest) Field4DeepEqual(src int32) bool {
+
+	if p.Count != src {
+		return false
+	}
+	return true
+}
 
 type RelationFollowListResponse struct {
 	StatusCode int32        `thrift:"status_code,1" frugal:"1,default,i32" json:"status_code"`
 	StatusMsg  *string      `thrift:"status_msg,2,optional" frugal:"2,optional,string" json:"status_msg,omitempty"`
 	UserList   []*user.User `thrift:"user_list,3" frugal:"3,default,list<user.User>" json:"user_list"`
+	NextCursor *string      `thrift:"next_cursor,4,optional" frugal:"4,optional,string" json:"next_cursor,omitempty"`
+	HasMore    bool         `thrift:"has_more,5" frugal:"5,default,bool" json:"has_more"`
 }
 
 func NewRelationFollowListResponse() *RelationFollowListResponse {
@@ -800,6 +936,19 @@ func (p *RelationFollowListResponse) GetStatusMsg() (v string) {
 func (p *RelationFollowListResponse) GetUserList() (v []*user.User) {
 	return p.UserList
 }
+
+var RelationFollowListResponse_NextCursor_DEFAULT string
+
+func (p *RelationFollowListResponse) GetNextCursor() (v string) {
+	if !p.IsSetNextCursor() {
+		return RelationFollowListResponse_NextCursor_DEFAULT
+	}
+	return *p.NextCursor
+}
+
+func (p *RelationFollowListResponse) GetHasMore() (v bool) {
+	return p.HasMore
+}
 func (p *RelationFollowListResponse) SetStatusCode(val int32) {
 	p.StatusCode = val
 }
@@ -809,17 +958,29 @@ func (p *RelationFollowListResponse) SetStatusMsg(val *string) {
 func (p *RelationFollowListResponse) SetUserList(val []*user.User) {
 	p.UserList = val
 }
+func (p *RelationFollowListResponse) SetNextCursor(val *string) {
+	p.NextCursor = val
+}
+func (p *RelationFollowListResponse) SetHasMore(val bool) {
+	p.HasMore = val
+}
 
 var fieldIDToName_RelationFollowListResponse = map[int16]string{
 	1: "status_code",
 	2: "status_msg",
 	3: "user_list",
+	4: "next_cursor",
+	5: "has_more",
 }
 
 func (p *RelationFollowListResponse) IsSetStatusMsg() bool {
 	return p.StatusMsg != nil
 }
 
+func (p *RelationFollowListResponse) IsSetNextCursor() bool {
+	return p.NextCursor != nil
+}
+
 func (p *RelationFollowListResponse) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
@@ -863,6 +1024,22 @@ func (p *RelationFollowListResponse) Read(iprot thrift.TProtocol) (err error) {
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
+		case 4:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField4(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 5:
+			if fieldTypeId == thrift.BOOL {
+				if err = p.ReadField5(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
 		default:
 			if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
@@ -936,6 +1113,28 @@ func (p *RelationFollowListResponse) ReadField3(iprot thrift.TProtocol) error {
 	p.UserList = _field
 	return nil
 }
+func (p *RelationFollowListResponse) ReadField4(iprot thrift.TProtocol) error {
+
+	var _field *string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.NextCursor = _field
+	return nil
+}
+func (p *RelationFollowListResponse) ReadField5(iprot thrift.TProtocol) error {
+
+	var _field bool
+	if v, err := iprot.ReadBool(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.HasMore = _field
+	return nil
+}
 
 func (p *RelationFollowListResponse) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
@@ -955,6 +1154,14 @@ func (p *RelationFollowListResponse) Write(oprot thrift.TProtocol) (err error) {
 			fieldId = 3
 			goto WriteFieldError
 		}
+		if err = p.writeField4(oprot); err != nil {
+			fieldId = 4
+			goto WriteFieldError
+		}
+		if err = p.writeField5(oprot); err != nil {
+			fieldId = 5
+			goto WriteFieldError
+		}
 	}
 	if err = oprot.WriteFieldStop(); err != nil {
 		goto WriteFieldStopError
@@ -1034,6 +1241,42 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
 }
 
+func (p *RelationFollowListResponse) writeField4(oprot thrift.TProtocol) (err error) {
+	if p.IsSetNextCursor() {
+		if err = oprot.WriteFieldBegin("next_cursor", thrift.STRING, 4); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.NextCursor); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
+}
+
+func (p *RelationFollowListResponse) writeField5(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("has_more", thrift.BOOL, 5); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteBool(p.HasMore); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 end error: ", p), err)
+}
+
 func (p *RelationFollowListResponse) String() string {
 	if p == nil {
 		return "<nil>"
@@ -1057,6 +1300,12 @@ func (p *RelationFollowListResponse) DeepEqual(ano *RelationFollowListResponse)
 	if !p.Field3DeepEqual(ano.UserList) {
 		return false
 	}
+	if !p.Field4DeepEqual(ano.NextCursor) {
+		return false
+	}
+	if !p.Field5DeepEqual(ano.HasMore) {
+		return false
+	}
 	return true
 }
 
@@ -1092,10 +1341,31 @@ func (p *RelationFollowListResponse) Field3DeepEqual(src []*user.User) bool {
 	}
 	return true
 }
+func (p *RelationFollowListResponse) Field4DeepEqual(src *string) bool {
+
+	if p.NextCursor == src {
+		return true
+	} else if p.NextCursor == nil || src == nil {
+		return false
+	}
+	if strings.Compare(*p.NextCursor, *src) != 0 {
+		return false
+	}
+	return true
+}
+func (p *RelationFollowListResponse) Field5DeepEqual(src bool) bool {
+
+	if p.HasMore != src {
+		return false
+	}
+	return true
+}
 
 type RelationFollowerListRequest struct {
-	UserId   *int64 `thrift:"user_id,1,optional" frugal:"1,optional,i64" json:"user_id,omitempty"`
-	AuthorId int64  `thrift:"author_id,2" frugal:"2,default,i64" json:"author_id"`
+	UserId   *int64  `thrift:"user_id,1,optional" frugal:"1,optional,i64" json:"user_id,omitempty"`
+	AuthorId int64   `thrift:"author_id,2" frugal:"2,default,i64" json:"author_id"`
+	Cursor   *string `thrift:"cursor,3,optional" frugal:"3,optional,string" json:"cursor,omitempty"`
+	Count    int32   `thrift:"count,4" frugal:"4,default,i32" json:"count"`
 }
 
 func NewRelationFollowerListRequest() *RelationFollowerListRequest {
@@ -1118,22 +1388,47 @@ func (p *RelationFollowerListRequest) GetUserId() (v int64) {
 func (p *RelationFollowerListRequest) GetAuthorId() (v int64) {
 	return p.AuthorId
 }
+
+var RelationFollowerListRequest_Cursor_DEFAULT string
+
+func (p *RelationFollowerListRequest) GetCursor() (v string) {
+	if !p.IsSetCursor() {
+		return RelationFollowerListRequest_Cursor_DEFAULT
+	}
+	return *p.Cursor
+}
+
+func (p *RelationFollowerListRequest) GetCount() (v int32) {
+	return p.Count
+}
 func (p *RelationFollowerListRequest) SetUserId(val *int64) {
 	p.UserId = val
 }
 func (p *RelationFollowerListRequest) SetAuthorId(val int64) {
 	p.AuthorId = val
 }
+func (p *RelationFollowerListRequest) SetCursor(val *string) {
+	p.Cursor = val
+}
+func (p *RelationFollowerListRequest) SetCount(val int32) {
+	p.Count = val
+}
 
 var fieldIDToName_RelationFollowerListRequest = map[int16]string{
 	1: "user_id",
 	2: "author_id",
+	3: "cursor",
+	4: "count",
 }
 
 func (p *RelationFollowerListRequest) IsSetUserId() bool {
 	return p.UserId != nil
 }
 
+func (p *RelationFollowerListRequest) IsSetCursor() bool {
+	return p.Cursor != nil
+}
+
 func (p *RelationFollowerListRequest) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
@@ -1169,6 +1464,22 @@ func (p *RelationFollowerListRequest) Read(iprot thrift.TProtocol) (err error) {
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
+		case 3:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField3(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 4:
+			if fieldTypeId == thrift.I32 {
+				if err = p.ReadField4(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
 		default:
 			if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
@@ -1220,6 +1531,28 @@ func (p *RelationFollowerListRequest) ReadField2(iprot thrift.TProtocol) error {
 	p.AuthorId = _field
 	return nil
 }
+func (p *RelationFollowerListRequest) ReadField3(iprot thrift.TProtocol) error {
+
+	var _field *string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.Cursor = _field
+	return nil
+}
+func (p *RelationFollowerListRequest) ReadField4(iprot thrift.TProtocol) error {
+
+	var _field int32
+	if v, err := iprot.ReadI32(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.Count = _field
+	return nil
+}
 
 func (p *RelationFollowerListRequest) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
@@ -1235,6 +1568,14 @@ func (p *RelationFollowerListRequest) Write(oprot thrift.TProtocol) (err error)
 			fieldId = 2
 			goto WriteFieldError
 		}
+		if err = p.writeField3(oprot); err != nil {
+			fieldId = 3
+			goto WriteFieldError
+		}
+		if err = p.writeField4(oprot); err != nil {
+			fieldId = 4
+			goto WriteFieldError
+		}
 	}
 	if err = oprot.WriteFieldStop(); err != nil {
 		goto WriteFieldStopError
@@ -1289,6 +1630,42 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
 }
 
+func (p *RelationFollowerListRequest) writeField3(oprot thrift.TProtocol) (err error) {
+	if p.IsSetCursor() {
+		if err = oprot.WriteFieldBegin("cursor", thrift.STRING, 3); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.Cursor); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
+}
+
+func (p *RelationFollowerListRequest) writeField4(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("count", thrift.I32, 4); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI32(p.Count); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
+}
+
 func (p *RelationFollowerListRequest) String() string {
 	if p == nil {
 		return "<nil>"
@@ -1309,6 +1686,12 @@ func (p *RelationFollowerListRequest) DeepEqual(ano *RelationFollowerListRequest
 	if !p.Field2DeepEqual(ano.AuthorId) {
 		return false
 	}
+	if !p.Field3DeepEqual(ano.Cursor) {
+		return false
+	}
+	if !p.Field4DeepEqual(ano.Count) {
+		return false
+	}
 	return true
 }
 
@@ -1331,11 +1714,32 @@ func (p *RelationFollowerListRequest) Field2DeepEqual(src int64) bool {
 	}
 	return true
 }
+func (p *RelationFollowerListRequest) Field3DeepEqual(src *string) bool {
+
+	if p.Cursor == src {
+		return true
+	} else if p.Cursor == nil || src == nil {
+		return false
+	}
+	if strings.Compare(*p.Cursor, *src) != 0 {
+		return false
+	}
+	return true
+}
+func (p *RelationFollowerListRequest) Field4DeepEqual(src int32) bool {
+
+	if p.Count != src {
+		return false
+	}
+	return true
+}
 
 type RelationFollowerListResponse struct {
 	StatusCode int32        `thrift:"status_code,1" frugal:"1,default,i32" json:"status_code"`
 	StatusMsg  *string      `thrift:"status_msg,2,optional" frugal:"2,optional,string" json:"status_msg,omitempty"`
 	UserList   []*user.User `thrift:"user_list,3" frugal:"3,default,list<user.User>" json:"user_list"`
+	NextCursor *string      `thrift:"next_cursor,4,optional" frugal:"4,optional,string" json:"next_cursor,omitempty"`
+	HasMore    bool         `thrift:"has_more,5" frugal:"5,default,bool" json:"has_more"`
 }
 
 func NewRelationFollowerListResponse() *RelationFollowerListResponse {
@@ -1362,6 +1766,19 @@ func (p *RelationFollowerListResponse) GetStatusMsg() (v string) {
 func (p *RelationFollowerListResponse) GetUserList() (v []*user.User) {
 	return p.UserList
 }
+
+var RelationFollowerListResponse_NextCursor_DEFAULT string
+
+func (p *RelationFollowerListResponse) GetNextCursor() (v string) {
+	if !p.IsSetNextCursor() {
+		return RelationFollowerListResponse_NextCursor_DEFAULT
+	}
+	return *p.NextCursor
+}
+
+func (p *RelationFollowerListResponse) GetHasMore() (v bool) {
+	return p.HasMore
+}
 func (p *RelationFollowerListResponse) SetStatusCode(val int32) {
 	p.StatusCode = val
 }
@@ -1371,17 +1788,29 @@ func (p *RelationFollowerListResponse) SetStatusMsg(val *string) {
 func (p *RelationFollowerListResponse) SetUserList(val []*user.User) {
 	p.UserList = val
 }
+func (p *RelationFollowerListResponse) SetNextCursor(val *string) {
+	p.NextCursor = val
+}
+func (p *RelationFollowerListResponse) SetHasMore(val bool) {
+	p.HasMore = val
+}
 
 var fieldIDToName_RelationFollowerListResponse = map[int16]string{
 	1: "status_code",
 	2: "status_msg",
 	3: "user_list",
+	4: "next_cursor",
+	5: "has_more",
 }
 
 func (p *RelationFollowerListResponse) IsSetStatusMsg() bool {
 	return p.StatusMsg != nil
 }
 
+func (p *RelationFollowerListResponse) IsSetNextCursor() bool {
+	return p.NextCursor != nil
+}
+
 func (p *RelationFollowerListResponse) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
@@ -1425,6 +1854,22 @@ func (p *RelationFollowerListResponse) Read(iprot thrift.TProtocol) (err error)
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
+		case 4:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField4(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 5:
+			if fieldTypeId == thrift.BOOL {
+				if err = p.ReadField5(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
 		default:
 			if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
@@ -1498,6 +1943,28 @@ func (p *RelationFollowerListResponse) ReadField3(iprot thrift.TProtocol) error
 	p.UserList = _field
 	return nil
 }
+func (p *RelationFollowerListResponse) ReadField4(iprot thrift.TProtocol) error {
+
+	var _field *string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.NextCursor = _field
+	return nil
+}
+func (p *RelationFollowerListResponse) ReadField5(iprot thrift.TProtocol) error {
+
+	var _field bool
+	if v, err := iprot.ReadBool(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.HasMore = _field
+	return nil
+}
 
 func (p *RelationFollowerListResponse) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
@@ -1517,6 +1984,14 @@ func (p *RelationFollowerListResponse) Write(oprot thrift.TProtocol) (err error)
 			fieldId = 3
 			goto WriteFieldError
 		}
+		if err = p.writeField4(oprot); err != nil {
+			fieldId = 4
+			goto WriteFieldError
+		}
+		if err = p.writeField5(oprot); err != nil {
+			fieldId = 5
+			goto WriteFieldError
+		}
 	}
 	if err = oprot.WriteFieldStop(); err != nil {
 		goto WriteFieldStopError
@@ -1596,6 +2071,42 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
 }
 
+func (p *RelationFollowerListResponse) writeField4(oprot thrift.TProtocol) (err error) {
+	if p.IsSetNextCursor() {
+		if err = oprot.WriteFieldBegin("next_cursor", thrift.STRING, 4); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.NextCursor); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
+}
+
+func (p *RelationFollowerListResponse) writeField5(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("has_more", thrift.BOOL, 5); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteBool(p.HasMore); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 end error: ", p), err)
+}
+
 func (p *RelationFollowerListResponse) String() string {
 	if p == nil {
 		return "<nil>"
@@ -1619,6 +2130,12 @@ func (p *RelationFollowerListResponse) DeepEqual(ano *RelationFollowerListRespon
 	if !p.Field3DeepEqual(ano.UserList) {
 		return false
 	}
+	if !p.Field4DeepEqual(ano.NextCursor) {
+		return false
+	}
+	if !p.Field5DeepEqual(ano.HasMore) {
+		return false
+	}
 	return true
 }
 
@@ -1654,10 +2171,31 @@ func (p *RelationFollowerListResponse) Field3DeepEqual(src []*user.User) bool {
 	}
 	return true
 }
+func (p *RelationFollowerListResponse) Field4DeepEqual(src *string) bool {
+
+	if p.NextCursor == src {
+		return true
+	} else if p.NextCursor == nil || src == nil {
+		return false
+	}
+	if strings.Compare(*p.NextCursor, *src) != 0 {
+		return false
+	}
+	return true
+}
+func (p *RelationFollowerListResponse) Field5DeepEqual(src bool) bool {
+
+	if p.HasMore != src {
+		return false
+	}
+	return true
+}
 
 type RelationFriendListRequest struct {
-	UserId   *int64 `thrift:"user_id,1,optional" frugal:"1,optional,i64" json:"user_id,omitempty"`
-	AuthorId int64  `thrift:"author_id,2" frugal:"2,default,i64" json:"author_id"`
+	UserId   *int64  `thrift:"user_id,1,optional" frugal:"1,optional,i64" json:"user_id,omitempty"`
+	AuthorId int64   `thrift:"author_id,2" frugal:"2,default,i64" json:"author_id"`
+	Cursor   *string `thrift:"cursor,3,optional" frugal:"3,optional,string" json:"cursor,omitempty"`
+	Count    int32   `thrift:"count,4" frugal:"4,default,i32" json:"count"`
 }
 
 func NewRelationFriendListRequest() *RelationFriendListRequest {
@@ -1680,22 +2218,47 @@ func (p *RelationFriendListRequest) GetUserId() (v int64) {
 func (p *RelationFriendListRequest) GetAuthorId() (v int64) {
 	return p.AuthorId
 }
+
+var RelationFriendListRequest_Cursor_DEFAULT string
+
+func (p *RelationFriendListRequest) GetCursor() (v string) {
+	if !p.IsSetCursor() {
+		return RelationFriendListRequest_Cursor_DEFAULT
+	}
+	return *p.Cursor
+}
+
+func (p *RelationFriendListRequest) GetCount() (v int32) {
+	return p.Count
+}
 func (p *RelationFriendListRequest) SetUserId(val *int64) {
 	p.UserId = val
 }
 func (p *RelationFriendListRequest) SetAuthorId(val int64) {
 	p.AuthorId = val
 }
+func (p *RelationFriendListRequest) SetCursor(val *string) {
+	p.Cursor = val
+}
+func (p *RelationFriendListRequest) SetCount(val int32) {
+	p.Count = val
+}
 
 var fieldIDToName_RelationFriendListRequest = map[int16]string{
 	1: "user_id",
 	2: "author_id",
+	3: "cursor",
+	4: "count",
 }
 
 func (p *RelationFriendListRequest) IsSetUserId() bool {
 	return p.UserId != nil
 }
 
+func (p *RelationFriendListRequest) IsSetCursor() bool {
+	return p.Cursor != nil
+}
+
 func (p *RelationFriendListRequest) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
@@ -1731,6 +2294,22 @@ func (p *RelationFriendListRequest) Read(iprot thrift.TProtocol) (err error) {
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
+		case 3:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField3(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 4:
+			if fieldTypeId == thrift.I32 {
+				if err = p.ReadField4(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
 		default:
 			if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
@@ -1782,6 +2361,28 @@ func (p *RelationFriendListRequest) ReadField2(iprot thrift.TProtocol) error {
 	p.AuthorId = _field
 	return nil
 }
+func (p *RelationFriendListRequest) ReadField3(iprot thrift.TProtocol) error {
+
+	var _field *string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.Cursor = _field
+	return nil
+}
+func (p *RelationFriendListRequest) ReadField4(iprot thrift.TProtocol) error {
+
+	var _field int32
+	if v, err := iprot.ReadI32(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.Count = _field
+	return nil
+}
 
 func (p *RelationFriendListRequest) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
@@ -1797,6 +2398,14 @@ func (p *RelationFriendListRequest) Write(oprot thrift.TProtocol) (err error) {
 			fieldId = 2
 			goto WriteFieldError
 		}
+		if err = p.writeField3(oprot); err != nil {
+			fieldId = 3
+			goto WriteFieldError
+		}
+		if err = p.writeField4(oprot); err != nil {
+			fieldId = 4
+			goto WriteFieldError
+		}
 	}
 	if err = oprot.WriteFieldStop(); err != nil {
 		goto WriteFieldStopError
@@ -1851,6 +2460,42 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
 }
 
+func (p *RelationFriendListRequest) writeField3(oprot thrift.TProtocol) (err error) {
+	if p.IsSetCursor() {
+		if err = oprot.WriteFieldBegin("cursor", thrift.STRING, 3); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.Cursor); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
+}
+
+func (p *RelationFriendListRequest) writeField4(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("count", thrift.I32, 4); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI32(p.Count); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
+}
+
 func (p *RelationFriendListRequest) String() string {
 	if p == nil {
 		return "<nil>"
@@ -1871,6 +2516,12 @@ func (p *RelationFriendListRequest) DeepEqual(ano *RelationFriendListRequest) bo
 	if !p.Field2DeepEqual(ano.AuthorId) {
 		return false
 	}
+	if !p.Field3DeepEqual(ano.Cursor) {
+		return false
+	}
+	if !p.Field4DeepEqual(ano.Count) {
+		return false
+	}
 	return true
 }
 
@@ -1893,11 +2544,32 @@ func (p *RelationFriendListRequest) Field2DeepEqual(src int64) bool {
 	}
 	return true
 }
+func (p *RelationFriendListRequest) Field3DeepEqual(src *string) bool {
+
+	if p.Cursor == src {
+		return true
+	} else if p.Cursor == nil || src == nil {
+		return false
+	}
+	if strings.Compare(*p.Cursor, *src) != 0 {
+		return false
+	}
+	return true
+}
+func (p *RelationFriendListRequest) Field4DeepEqual(src int32) bool {
+
+	if p.Count != src {
+		return false
+	}
+	return true
+}
 
 type RelationFriendListResponse struct {
 	StatusCode int32        `thrift:"status_code,1" frugal:"1,default,i32" json:"status_code"`
 	StatusMsg  *string      `thrift:"status_msg,2,optional" frugal:"2,optional,string" json:"status_msg,omitempty"`
 	UserList   []*user.User `thrift:"user_list,3" frugal:"3,default,list<user.User>" json:"user_list"`
+	NextCursor *string      `thrift:"next_cursor,4,optional" frugal:"4,optional,string" json:"next_cursor,omitempty"`
+	HasMore    bool         `thrift:"has_more,5" frugal:"5,default,bool" json:"has_more"`
 }
 
 func NewRelationFriendListResponse() *RelationFriendListResponse {
@@ -1924,6 +2596,19 @@ func (p *RelationFriendListResponse) GetStatusMsg() (v string) {
 func (p *RelationFriendListResponse) GetUserList() (v []*user.User) {
 	return p.UserList
 }
+
+var RelationFriendListResponse_NextCursor_DEFAULT string
+
+func (p *RelationFriendListResponse) GetNextCursor() (v string) {
+	if !p.IsSetNextCursor() {
+		return RelationFriendListResponse_NextCursor_DEFAULT
+	}
+	return *p.NextCursor
+}
+
+func (p *RelationFriendListResponse) GetHasMore() (v bool) {
+	return p.HasMore
+}
 func (p *RelationFriendListResponse) SetStatusCode(val int32) {
 	p.StatusCode = val
 }
@@ -1933,17 +2618,29 @@ func (p *RelationFriendListResponse) SetStatusMsg(val *string) {
 func (p *RelationFriendListResponse) SetUserList(val []*user.User) {
 	p.UserList = val
 }
+func (p *RelationFriendListResponse) SetNextCursor(val *string) {
+	p.NextCursor = val
+}
+func (p *RelationFriendListResponse) SetHasMore(val bool) {
+	p.HasMore = val
+}
 
 var fieldIDToName_RelationFriendListResponse = map[int16]string{
 	1: "status_code",
 	2: "status_msg",
 	3: "user_list",
+	4: "next_cursor",
+	5: "has_more",
 }
 
 func (p *RelationFriendListResponse) IsSetStatusMsg() bool {
 	return p.StatusMsg != nil
 }
 
+func (p *RelationFriendListResponse) IsSetNextCursor() bool {
+	return p.NextCursor != nil
+}
+
 func (p *RelationFriendListResponse) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
@@ -1987,6 +2684,22 @@ func (p *RelationFriendListResponse) Read(iprot thrift.TProtocol) (err error) {
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
+		case 4:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField4(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 5:
+			if fieldTypeId == thrift.BOOL {
+				if err = p.ReadField5(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
 		default:
 			if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
@@ -2060,6 +2773,28 @@ func (p *RelationFriendListResponse) ReadField3(iprot thrift.TProtocol) error {
 	p.UserList = _field
 	return nil
 }
+func (p *RelationFriendListResponse) ReadField4(iprot thrift.TProtocol) error {
+
+	var _field *string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.NextCursor = _field
+	return nil
+}
+func (p *RelationFriendListResponse) ReadField5(iprot thrift.TProtocol) error {
+
+	var _field bool
+	if v, err := iprot.ReadBool(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.HasMore = _field
+	return nil
+}
 
 func (p *RelationFriendListResponse) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
@@ -2079,6 +2814,14 @@ func (p *RelationFriendListResponse) Write(oprot thrift.TProtocol) (err error) {
 			fieldId = 3
 			goto WriteFieldError
 		}
+		if err = p.writeField4(oprot); err != nil {
+			fieldId = 4
+			goto WriteFieldError
+		}
+		if err = p.writeField5(oprot); err != nil {
+			fieldId = 5
+			goto WriteFieldError
+		}
 	}
 	if err = oprot.WriteFieldStop(); err != nil {
 		goto WriteFieldStopError
@@ -2158,22 +2901,58 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
 }
 
-func (p *RelationFriendListResponse) String() string {
-	if p == nil {
-		return "<nil>"
+func (p *RelationFriendListResponse) writeField4(oprot thrift.TProtocol) (err error) {
+	if p.IsSetNextCursor() {
+		if err = oprot.WriteFieldBegin("next_cursor", thrift.STRING, 4); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.NextCursor); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
 	}
-	return fmt.Sprintf("RelationFriendListResponse(%+v)", *p)
-
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
 }
 
-func (p *RelationFriendListResponse) DeepEqual(ano *RelationFriendListResponse) bool {
-	if p == ano {
-		return true
-	} else if p == nil || ano == nil {
-		return false
+func (p *RelationFriendListResponse) writeField5(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("has_more", thrift.BOOL, 5); err != nil {
+		goto WriteFieldBeginError
 	}
-	if !p.Field1DeepEqual(ano.StatusCode) {
-		return false
+	if err := oprot.WriteBool(p.HasMore); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 end error: ", p), err)
+}
+
+func (p *RelationFriendListResponse) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("RelationFriendListResponse(%+v)", *p)
+
+}
+
+func (p *RelationFriendListResponse) DeepEqual(ano *RelationFriendListResponse) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.StatusCode) {
+		return false
 	}
 	if !p.Field2DeepEqual(ano.StatusMsg) {
 		return false
@@ -2181,6 +2960,12 @@ func (p *RelationFriendListResponse) DeepEqual(ano *RelationFriendListResponse)
 	if !p.Field3DeepEqual(ano.UserList) {
 		return false
 	}
+	if !p.Field4DeepEqual(ano.NextCursor) {
+		return false
+	}
+	if !p.Field5DeepEqual(ano.HasMore) {
+		return false
+	}
 	return true
 }
 
@@ -2216,530 +3001,3836 @@ func (p *RelationFriendListResponse) Field3DeepEqual(src []*user.User) bool {
 	}
 	return true
 }
+func (p *RelationFriendListResponse) Field4DeepEqual(src *string) bool {
 
-type RelationService interface {
-	RelationAction(ctx context.Context, req *RelationActionRequest) (r *RelationActionResponse, err error)
+	if p.NextCursor == src {
+		return true
+	} else if p.NextCursor == nil || src == nil {
+		return false
+	}
+	if strings.Compare(*p.NextCursor, *src) != 0 {
+		return false
+	}
+	return true
+}
+func (p *RelationFriendListResponse) Field5DeepEqual(src bool) bool {
 
-	RelationFollowList(ctx context.Context, req *RelationFollowListRequest) (r *RelationFollowListResponse, err error)
+	if p.HasMore != src {
+		return false
+	}
+	return true
+}
 
-	RelationFollowerList(ctx context.Context, req *RelationFollowerListRequest) (r *RelationFollowerListResponse, err error)
+type RelationBlockRequest struct {
+	UserId     int64 `thrift:"user_id,1" frugal:"1,default,i64" json:"user_id"`
+	AuthorId   int64 `thrift:"author_id,2" frugal:"2,default,i64" json:"author_id"`
+	ActionType int64 `thrift:"action_type,3" frugal:"3,default,i64" json:"action_type"`
+}
 
-	RelationFriendList(ctx context.Context, req *RelationFriendListRequest) (r *RelationFriendListResponse, err error)
+func NewRelationBlockRequest() *RelationBlockRequest {
+	return &RelationBlockRequest{}
+}
 
-	RelationExist(ctx context.Context, userId int64, authorId int64) (r bool, err error)
+func (p *RelationBlockRequest) InitDefault() {
+	*p = RelationBlockRequest{}
+}
 
-	FollowCnt(ctx context.Context, userId int64) (r int64, err error)
+func (p *RelationBlockRequest) GetUserId() (v int64) {
+	return p.UserId
+}
 
-	FollowerCnt(ctx context.Context, userId int64) (r int64, err error)
+func (p *RelationBlockRequest) GetAuthorId() (v int64) {
+	return p.AuthorId
 }
 
-type RelationServiceClient struct {
-	c thrift.TClient
+func (p *RelationBlockRequest) GetActionType() (v int64) {
+	return p.ActionType
+}
+func (p *RelationBlockRequest) SetUserId(val int64) {
+	p.UserId = val
+}
+func (p *RelationBlockRequest) SetAuthorId(val int64) {
+	p.AuthorId = val
+}
+func (p *RelationBlockRequest) SetActionType(val int64) {
+	p.ActionType = val
 }
 
-func NewRelationServiceClientFactory(t thrift.TTransport, f thrift.TProtocolFactory) *RelationServiceClient {
-	return &RelationServiceClient{
-		c: thrift.NewTStandardClient(f.GetProtocol(t), f.GetProtocol(t)),
-	}
+var fieldIDToName_RelationBlockRequest = map[int16]string{
+	1: "user_id",
+	2: "author_id",
+	3: "action_type",
 }
 
-func NewRelationServiceClientProtocol(t thrift.TTransport, iprot thrift.TProtocol, oprot thrift.TProtocol) *RelationServiceClient {
-	return &RelationServiceClient{
-		c: thrift.NewTStandardClient(iprot, oprot),
+func (p *RelationBlockRequest) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
 	}
-}
 
-func NewRelationServiceClient(c thrift.TClient) *RelationServiceClient {
-	return &RelationServiceClient{
-		c: c,
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 2:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 3:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField3(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
 	}
-}
 
-func (p *RelationServiceClient) Client_() thrift.TClient {
-	return p.c
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_RelationBlockRequest[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *RelationServiceClient) RelationAction(ctx context.Context, req *RelationActionRequest) (r *RelationActionResponse, err error) {
-	var _args RelationServiceRelationActionArgs
-	_args.Req = req
-	var _result RelationServiceRelationActionResult
-	if err = p.Client_().Call(ctx, "RelationAction", &_args, &_result); err != nil {
-		return
+func (p *RelationBlockRequest) ReadField1(iprot thrift.TProtocol) error {
+
+	var _field int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = v
 	}
-	return _result.GetSuccess(), nil
+	p.UserId = _field
+	return nil
 }
-func (p *RelationServiceClient) RelationFollowList(ctx context.Context, req *RelationFollowListRequest) (r *RelationFollowListResponse, err error) {
-	var _args RelationServiceRelationFollowListArgs
-	_args.Req = req
-	var _result RelationServiceRelationFollowListResult
-	if err = p.Client_().Call(ctx, "RelationFollowList", &_args, &_result); err != nil {
-		return
+func (p *RelationBlockRequest) ReadField2(iprot thrift.TProtocol) error {
+
+	var _field int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = v
 	}
-	return _result.GetSuccess(), nil
+	p.AuthorId = _field
+	return nil
 }
-func (p *RelationServiceClient) RelationFollowerList(ctx context.Context, req *RelationFollowerListRequest) (r *RelationFollowerListResponse, err error) {
-	var _args RelationServiceRelationFollowerListArgs
-	_args.Req = req
-	var _result RelationServiceRelationFollowerListResult
-	if err = p.Client_().Call(ctx, "RelationFollowerList", &_args, &_result); err != nil {
-		return
+func (p *RelationBlockRequest) ReadField3(iprot thrift.TProtocol) error {
+
+	var _field int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = v
 	}
-	return _result.GetSuccess(), nil
+	p.ActionType = _field
+	return nil
 }
-func (p *RelationServiceClient) RelationFriendList(ctx context.Context, req *RelationFriendListRequest) (r *RelationFriendListResponse, err error) {
-	var _args RelationServiceRelationFriendListArgs
-	_args.Req = req
-	var _result RelationServiceRelationFriendListResult
-	if err = p.Client_().Call(ctx, "RelationFriendList", &_args, &_result); err != nil {
-		return
+
+func (p *RelationBlockRequest) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("Relation_block_request"); err != nil {
+		goto WriteStructBeginError
 	}
-	return _result.GetSuccess(), nil
-}
-func (p *RelationServiceClient) RelationExist(ctx context.Context, userId int64, authorId int64) (r bool, err error) {
-	var _args RelationServiceRelationExistArgs
-	_args.UserId = userId
-	_args.AuthorId = authorId
-	var _result RelationServiceRelationExistResult
-	if err = p.Client_().Call(ctx, "RelationExist", &_args, &_result); err != nil {
-		return
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+		if err = p.writeField3(oprot); err != nil {
+			fieldId = 3
+			goto WriteFieldError
+		}
 	}
-	return _result.GetSuccess(), nil
-}
-func (p *RelationServiceClient) FollowCnt(ctx context.Context, userId int64) (r int64, err error) {
-	var _args RelationServiceFollowCntArgs
-	_args.UserId = userId
-	var _result RelationServiceFollowCntResult
-	if err = p.Client_().Call(ctx, "FollowCnt", &_args, &_result); err != nil {
-		return
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
 	}
-	return _result.GetSuccess(), nil
-}
-func (p *RelationServiceClient) FollowerCnt(ctx context.Context, userId int64) (r int64, err error) {
-	var _args RelationServiceFollowerCntArgs
-	_args.UserId = userId
-	var _result RelationServiceFollowerCntResult
-	if err = p.Client_().Call(ctx, "FollowerCnt", &_args, &_result); err != nil {
-		return
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
 	}
-	return _result.GetSuccess(), nil
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-type RelationServiceProcessor struct {
-	processorMap map[string]thrift.TProcessorFunction
-	handler      RelationService
+func (p *RelationBlockRequest) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("user_id", thrift.I64, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI64(p.UserId); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *RelationServiceProcessor) AddToProcessorMap(key string, processor thrift.TProcessorFunction) {
-	p.processorMap[key] = processor
+func (p *RelationBlockRequest) writeField2(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("author_id", thrift.I64, 2); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI64(p.AuthorId); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
 }
 
-func (p *RelationServiceProcessor) GetProcessorFunction(key string) (processor thrift.TProcessorFunction, ok bool) {
-	processor, ok = p.processorMap[key]
-	return processor, ok
+func (p *RelationBlockRequest) writeField3(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("action_type", thrift.I64, 3); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI64(p.ActionType); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
 }
 
-func (p *RelationServiceProcessor) ProcessorMap() map[string]thrift.TProcessorFunction {
-	return p.processorMap
+func (p *RelationBlockRequest) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("RelationBlockRequest(%+v)", *p)
+
 }
 
-func NewRelationServiceProcessor(handler RelationService) *RelationServiceProcessor {
-	self := &RelationServiceProcessor{handler: handler, processorMap: make(map[string]thrift.TProcessorFunction)}
-	self.AddToProcessorMap("RelationAction", &relationServiceProcessorRelationAction{handler: handler})
-	self.AddToProcessorMap("RelationFollowList", &relationServiceProcessorRelationFollowList{handler: handler})
-	self.AddToProcessorMap("RelationFollowerList", &relationServiceProcessorRelationFollowerList{handler: handler})
-	self.AddToProcessorMap("RelationFriendList", &relationServiceProcessorRelationFriendList{handler: handler})
-	self.AddToProcessorMap("RelationExist", &relationServiceProcessorRelationExist{handler: handler})
-	self.AddToProcessorMap("FollowCnt", &relationServiceProcessorFollowCnt{handler: handler})
-	self.AddToProcessorMap("FollowerCnt", &relationServiceProcessorFollowerCnt{handler: handler})
-	return self
+func (p *RelationBlockRequest) DeepEqual(ano *RelationBlockRequest) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.UserId) {
+		return false
+	}
+	if !p.Field2DeepEqual(ano.AuthorId) {
+		return false
+	}
+	if !p.Field3DeepEqual(ano.ActionType) {
+		return false
+	}
+	return true
 }
-func (p *RelationServiceProcessor) Process(ctx context.Context, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-	name, _, seqId, err := iprot.ReadMessageBegin()
-	if err != nil {
-		return false, err
+
+func (p *RelationBlockRequest) Field1DeepEqual(src int64) bool {
+
+	if p.UserId != src {
+		return false
 	}
-	if processor, ok := p.GetProcessorFunction(name); ok {
-		return processor.Process(ctx, seqId, iprot, oprot)
+	return true
+}
+func (p *RelationBlockRequest) Field2DeepEqual(src int64) bool {
+
+	if p.AuthorId != src {
+		return false
 	}
-	iprot.Skip(thrift.STRUCT)
-	iprot.ReadMessageEnd()
-	x := thrift.NewTApplicationException(thrift.UNKNOWN_METHOD, "Unknown function "+name)
-	oprot.WriteMessageBegin(name, thrift.EXCEPTION, seqId)
-	x.Write(oprot)
-	oprot.WriteMessageEnd()
-	oprot.Flush(ctx)
-	return false, x
+	return true
 }
+func (p *RelationBlockRequest) Field3DeepEqual(src int64) bool {
 
-type relationServiceProcessorRelationAction struct {
-	handler RelationService
+	if p.ActionType != src {
+		return false
+	}
+	return true
 }
 
-func (p *relationServiceProcessorRelationAction) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-	args := RelationServiceRelationActionArgs{}
-	if err = args.Read(iprot); err != nil {
-		iprot.ReadMessageEnd()
-		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
-		oprot.WriteMessageBegin("RelationAction", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return false, err
+type RelationBlockResponse struct {
+	StatusCode int32   `thrift:"status_code,1" frugal:"1,default,i32" json:"status_code"`
+	StatusMsg  *string `thrift:"status_msg,2,optional" frugal:"2,optional,string" json:"status_msg,omitempty"`
+}
+
+func NewRelationBlockResponse() *RelationBlockResponse {
+	return &RelationBlockResponse{}
+}
+
+func (p *RelationBlockResponse) InitDefault() {
+	*p = RelationBlockResponse{}
+}
+
+func (p *RelationBlockResponse) GetStatusCode() (v int32) {
+	return p.StatusCode
+}
+
+var RelationBlockResponse_StatusMsg_DEFAULT string
+
+func (p *RelationBlockResponse) GetStatusMsg() (v string) {
+	if !p.IsSetStatusMsg() {
+		return RelationBlockResponse_StatusMsg_DEFAULT
 	}
+	return *p.StatusMsg
+}
+func (p *RelationBlockResponse) SetStatusCode(val int32) {
+	p.StatusCode = val
+}
+func (p *RelationBlockResponse) SetStatusMsg(val *string) {
+	p.StatusMsg = val
+}
 
-	iprot.ReadMessageEnd()
-	var err2 error
-	result := RelationServiceRelationActionResult{}
-	var retval *RelationActionResponse
-	if retval, err2 = p.handler.RelationAction(ctx, args.Req); err2 != nil {
-		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing RelationAction: "+err2.Error())
-		oprot.WriteMessageBegin("RelationAction", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return true, err2
+var fieldIDToName_RelationBlockResponse = map[int16]string{
+	1: "status_code",
+	2: "status_msg",
+}
+
+func (p *RelationBlockResponse) IsSetStatusMsg() bool {
+	return p.StatusMsg != nil
+}
+
+func (p *RelationBlockResponse) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.I32 {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 2:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_RelationBlockResponse[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *RelationBlockResponse) ReadField1(iprot thrift.TProtocol) error {
+
+	var _field int32
+	if v, err := iprot.ReadI32(); err != nil {
+		return err
 	} else {
-		result.Success = retval
+		_field = v
 	}
-	if err2 = oprot.WriteMessageBegin("RelationAction", thrift.REPLY, seqId); err2 != nil {
-		err = err2
+	p.StatusCode = _field
+	return nil
+}
+func (p *RelationBlockResponse) ReadField2(iprot thrift.TProtocol) error {
+
+	var _field *string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = &v
 	}
-	if err2 = result.Write(oprot); err == nil && err2 != nil {
-		err = err2
+	p.StatusMsg = _field
+	return nil
+}
+
+func (p *RelationBlockResponse) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("Relation_block_response"); err != nil {
+		goto WriteStructBeginError
 	}
-	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
-		err = err2
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
 	}
-	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
-		err = err2
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *RelationBlockResponse) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("status_code", thrift.I32, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI32(p.StatusCode); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *RelationBlockResponse) writeField2(oprot thrift.TProtocol) (err error) {
+	if p.IsSetStatusMsg() {
+		if err = oprot.WriteFieldBegin("status_msg", thrift.STRING, 2); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.StatusMsg); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+
+func (p *RelationBlockResponse) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("RelationBlockResponse(%+v)", *p)
+
+}
+
+func (p *RelationBlockResponse) DeepEqual(ano *RelationBlockResponse) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.StatusCode) {
+		return false
+	}
+	if !p.Field2DeepEqual(ano.StatusMsg) {
+		return false
+	}
+	return true
+}
+
+func (p *RelationBlockResponse) Field1DeepEqual(src int32) bool {
+
+	if p.StatusCode != src {
+		return false
+	}
+	return true
+}
+func (p *RelationBlockResponse) Field2DeepEqual(src *string) bool {
+
+	if p.StatusMsg == src {
+		return true
+	} else if p.StatusMsg == nil || src == nil {
+		return false
+	}
+	if strings.Compare(*p.StatusMsg, *src) != 0 {
+		return false
+	}
+	return true
+}
+
+type RelationBlockListRequest struct {
+	UserId int64   `thrift:"user_id,1" frugal:"1,default,i64" json:"user_id"`
+	Cursor *string `thrift:"cursor,2,optional" frugal:"2,optional,string" json:"cursor,omitempty"`
+	Count  int32   `thrift:"count,3" frugal:"3,default,i32" json:"count"`
+}
+
+func NewRelationBlockListRequest() *RelationBlockListRequest {
+	return &RelationBlockListRequest{}
+}
+
+func (p *RelationBlockListRequest) InitDefault() {
+	*p = RelationBlockListRequest{}
+}
+
+func (p *RelationBlockListRequest) GetUserId() (v int64) {
+	return p.UserId
+}
+
+var RelationBlockListRequest_Cursor_DEFAULT string
+
+func (p *RelationBlockListRequest) GetCursor() (v string) {
+	if !p.IsSetCursor() {
+		return RelationBlockListRequest_Cursor_DEFAULT
+	}
+	return *p.Cursor
+}
+
+func (p *RelationBlockListRequest) GetCount() (v int32) {
+	return p.Count
+}
+func (p *RelationBlockListRequest) SetUserId(val int64) {
+	p.UserId = val
+}
+func (p *RelationBlockListRequest) SetCursor(val *string) {
+	p.Cursor = val
+}
+func (p *RelationBlockListRequest) SetCount(val int32) {
+	p.Count = val
+}
+
+var fieldIDToName_RelationBlockListRequest = map[int16]string{
+	1: "user_id",
+	2: "cursor",
+	3: "count",
+}
+
+func (p *RelationBlockListRequest) IsSetCursor() bool {
+	return p.Cursor != nil
+}
+
+func (p *RelationBlockListRequest) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 2:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 3:
+			if fieldTypeId == thrift.I32 {
+				if err = p.ReadField3(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_RelationBlockListRequest[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *RelationBlockListRequest) ReadField1(iprot thrift.TProtocol) error {
+
+	var _field int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.UserId = _field
+	return nil
+}
+func (p *RelationBlockListRequest) ReadField2(iprot thrift.TProtocol) error {
+
+	var _field *string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.Cursor = _field
+	return nil
+}
+func (p *RelationBlockListRequest) ReadField3(iprot thrift.TProtocol) error {
+
+	var _field int32
+	if v, err := iprot.ReadI32(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.Count = _field
+	return nil
+}
+
+func (p *RelationBlockListRequest) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("Relation_block_list_request"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+		if err = p.writeField3(oprot); err != nil {
+			fieldId = 3
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *RelationBlockListRequest) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("user_id", thrift.I64, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI64(p.UserId); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *RelationBlockListRequest) writeField2(oprot thrift.TProtocol) (err error) {
+	if p.IsSetCursor() {
+		if err = oprot.WriteFieldBegin("cursor", thrift.STRING, 2); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.Cursor); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+
+func (p *RelationBlockListRequest) writeField3(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("count", thrift.I32, 3); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI32(p.Count); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
+}
+
+func (p *RelationBlockListRequest) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("RelationBlockListRequest(%+v)", *p)
+
+}
+
+func (p *RelationBlockListRequest) DeepEqual(ano *RelationBlockListRequest) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.UserId) {
+		return false
+	}
+	if !p.Field2DeepEqual(ano.Cursor) {
+		return false
+	}
+	if !p.Field3DeepEqual(ano.Count) {
+		return false
+	}
+	return true
+}
+
+func (p *RelationBlockListRequest) Field1DeepEqual(src int64) bool {
+
+	if p.UserId != src {
+		return false
+	}
+	return true
+}
+func (p *RelationBlockListRequest) Field2DeepEqual(src *string) bool {
+
+	if p.Cursor == src {
+		return true
+	} else if p.Cursor == nil || src == nil {
+		return false
+	}
+	if strings.Compare(*p.Cursor, *src) != 0 {
+		return false
+	}
+	return true
+}
+func (p *RelationBlockListRequest) Field3DeepEqual(src int32) bool {
+
+	if p.Count != src {
+		return false
+	}
+	return true
+}
+
+type RelationBlockListResponse struct {
+	StatusCode int32        `thrift:"status_code,1" frugal:"1,default,i32" json:"status_code"`
+	StatusMsg  *string      `thrift:"status_msg,2,optional" frugal:"2,optional,string" json:"status_msg,omitempty"`
+	UserList   []*user.User `thrift:"user_list,3" frugal:"3,default,list<user.User>" json:"user_list"`
+	NextCursor *string      `thrift:"next_cursor,4,optional" frugal:"4,optional,string" json:"next_cursor,omitempty"`
+	HasMore    bool         `thrift:"has_more,5" frugal:"5,default,bool" json:"has_more"`
+}
+
+func NewRelationBlockListResponse() *RelationBlockListResponse {
+	return &RelationBlockListResponse{}
+}
+
+func (p *RelationBlockListResponse) InitDefault() {
+	*p = RelationBlockListResponse{}
+}
+
+func (p *RelationBlockListResponse) GetStatusCode() (v int32) {
+	return p.StatusCode
+}
+
+var RelationBlockListResponse_StatusMsg_DEFAULT string
+
+func (p *RelationBlockListResponse) GetStatusMsg() (v string) {
+	if !p.IsSetStatusMsg() {
+		return RelationBlockListResponse_StatusMsg_DEFAULT
+	}
+	return *p.StatusMsg
+}
+
+func (p *RelationBlockListResponse) GetUserList() (v []*user.User) {
+	return p.UserList
+}
+
+var RelationBlockListResponse_NextCursor_DEFAULT string
+
+func (p *RelationBlockListResponse) GetNextCursor() (v string) {
+	if !p.IsSetNextCursor() {
+		return RelationBlockListResponse_NextCursor_DEFAULT
+	}
+	return *p.NextCursor
+}
+
+func (p *RelationBlockListResponse) GetHasMore() (v bool) {
+	return p.HasMore
+}
+func (p *RelationBlockListResponse) SetStatusCode(val int32) {
+	p.StatusCode = val
+}
+func (p *RelationBlockListResponse) SetStatusMsg(val *string) {
+	p.StatusMsg = val
+}
+func (p *RelationBlockListResponse) SetUserList(val []*user.User) {
+	p.UserList = val
+}
+func (p *RelationBlockListResponse) SetNextCursor(val *string) {
+	p.NextCursor = val
+}
+func (p *RelationBlockListResponse) SetHasMore(val bool) {
+	p.HasMore = val
+}
+
+var fieldIDToName_RelationBlockListResponse = map[int16]string{
+	1: "status_code",
+	2: "status_msg",
+	3: "user_list",
+	4: "next_cursor",
+	5: "has_more",
+}
+
+func (p *RelationBlockListResponse) IsSetStatusMsg() bool {
+	return p.StatusMsg != nil
+}
+
+func (p *RelationBlockListResponse) IsSetNextCursor() bool {
+	return p.NextCursor != nil
+}
+
+func (p *RelationBlockListResponse) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.I32 {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 2:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 3:
+			if fieldTypeId == thrift.LIST {
+				if err = p.ReadField3(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 4:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField4(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 5:
+			if fieldTypeId == thrift.BOOL {
+				if err = p.ReadField5(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_RelationBlockListResponse[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *RelationBlockListResponse) ReadField1(iprot thrift.TProtocol) error {
+
+	var _field int32
+	if v, err := iprot.ReadI32(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.StatusCode = _field
+	return nil
+}
+func (p *RelationBlockListResponse) ReadField2(iprot thrift.TProtocol) error {
+
+	var _field *string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.StatusMsg = _field
+	return nil
+}
+func (p *RelationBlockListResponse) ReadField3(iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin()
+	if err != nil {
+		return err
+	}
+	_field := make([]*user.User, 0, size)
+	values := make([]user.User, size)
+	for i := 0; i < size; i++ {
+		_elem := &values[i]
+
+		if err := _elem.Read(iprot); err != nil {
+			return err
+		}
+
+		_field = append(_field, _elem)
+	}
+	if err := iprot.ReadListEnd(); err != nil {
+		return err
+	}
+	p.UserList = _field
+	return nil
+}
+func (p *RelationBlockListResponse) ReadField4(iprot thrift.TProtocol) error {
+
+	var _field *string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.NextCursor = _field
+	return nil
+}
+func (p *RelationBlockListResponse) ReadField5(iprot thrift.TProtocol) error {
+
+	var _field bool
+	if v, err := iprot.ReadBool(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.HasMore = _field
+	return nil
+}
+
+func (p *RelationBlockListResponse) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("Relation_block_list_response"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+		if err = p.writeField3(oprot); err != nil {
+			fieldId = 3
+			goto WriteFieldError
+		}
+		if err = p.writeField4(oprot); err != nil {
+			fieldId = 4
+			goto WriteFieldError
+		}
+		if err = p.writeField5(oprot); err != nil {
+			fieldId = 5
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *RelationBlockListResponse) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("status_code", thrift.I32, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI32(p.StatusCode); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *RelationBlockListResponse) writeField2(oprot thrift.TProtocol) (err error) {
+	if p.IsSetStatusMsg() {
+		if err = oprot.WriteFieldBegin("status_msg", thrift.STRING, 2); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.StatusMsg); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+
+func (p *RelationBlockListResponse) writeField3(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("user_list", thrift.LIST, 3); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteListBegin(thrift.STRUCT, len(p.UserList)); err != nil {
+		return err
+	}
+	for _, v := range p.UserList {
+		if err := v.Write(oprot); err != nil {
+			return err
+		}
+	}
+	if err := oprot.WriteListEnd(); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
+}
+
+func (p *RelationBlockListResponse) writeField4(oprot thrift.TProtocol) (err error) {
+	if p.IsSetNextCursor() {
+		if err = oprot.WriteFieldBegin("next_cursor", thrift.STRING, 4); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.NextCursor); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
+}
+
+func (p *RelationBlockListResponse) writeField5(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("has_more", thrift.BOOL, 5); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteBool(p.HasMore); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 end error: ", p), err)
+}
+
+func (p *RelationBlockListResponse) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("RelationBlockListResponse(%+v)", *p)
+
+}
+
+func (p *RelationBlockListResponse) DeepEqual(ano *RelationBlockListResponse) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.StatusCode) {
+		return false
+	}
+	if !p.Field2DeepEqual(ano.StatusMsg) {
+		return false
+	}
+	if !p.Field3DeepEqual(ano.UserList) {
+		return false
+	}
+	if !p.Field4DeepEqual(ano.NextCursor) {
+		return false
+	}
+	if !p.Field5DeepEqual(ano.HasMore) {
+		return false
+	}
+	return true
+}
+
+func (p *RelationBlockListResponse) Field1DeepEqual(src int32) bool {
+
+	if p.StatusCode != src {
+		return false
+	}
+	return true
+}
+func (p *RelationBlockListResponse) Field2DeepEqual(src *string) bool {
+
+	if p.StatusMsg == src {
+		return true
+	} else if p.StatusMsg == nil || src == nil {
+		return false
+	}
+	if strings.Compare(*p.StatusMsg, *src) != 0 {
+		return false
+	}
+	return true
+}
+func (p *RelationBlockListResponse) Field3DeepEqual(src []*user.User) bool {
+
+	if len(p.UserList) != len(src) {
+		return false
+	}
+	for i, v := range p.UserList {
+		_src := src[i]
+		if !v.DeepEqual(_src) {
+			return false
+		}
+	}
+	return true
+}
+func (p *RelationBlockListResponse) Field4DeepEqual(src *string) bool {
+
+	if p.NextCursor == src {
+		return true
+	} else if p.NextCursor == nil || src == nil {
+		return false
+	}
+	if strings.Compare(*p.NextCursor, *src) != 0 {
+		return false
+	}
+	return true
+}
+func (p *RelationBlockListResponse) Field5DeepEqual(src bool) bool {
+
+	if p.HasMore != src {
+		return false
+	}
+	return true
+}
+
+type RelationService interface {
+	RelationAction(ctx context.Context, req *RelationActionRequest) (r *RelationActionResponse, err error)
+
+	RelationFollowList(ctx context.Context, req *RelationFollowListRequest) (r *RelationFollowListResponse, err error)
+
+	RelationFollowerList(ctx context.Context, req *RelationFollowerListRequest) (r *RelationFollowerListResponse, err error)
+
+	RelationFriendList(ctx context.Context, req *RelationFriendListRequest) (r *RelationFriendListResponse, err error)
+
+	RelationBlock(ctx context.Context, req *RelationBlockRequest) (r *RelationBlockResponse, err error)
+
+	RelationBlockList(ctx context.Context, req *RelationBlockListRequest) (r *RelationBlockListResponse, err error)
+
+	RelationExist(ctx context.Context, userId int64, authorId int64) (r bool, err error)
+
+	FollowCnt(ctx context.Context, userId int64) (r int64, err error)
+
+	FollowerCnt(ctx context.Context, userId int64) (r int64, err error)
+
+	BatchRelationExist(ctx context.Context, userId int64, authorIds []int64) (r map[int64]bool, err error)
+
+	BatchFollowCnt(ctx context.Context, userIds []int64) (r map[int64]int64, err error)
+
+	BatchFollowerCnt(ctx context.Context, userIds []int64) (r map[int64]int64, err error)
+}
+
+type RelationServiceClient struct {
+	c thrift.TClient
+}
+
+func NewRelationServiceClientFactory(t thrift.TTransport, f thrift.TProtocolFactory) *RelationServiceClient {
+	return &RelationServiceClient{
+		c: thrift.NewTStandardClient(f.GetProtocol(t), f.GetProtocol(t)),
+	}
+}
+
+func NewRelationServiceClientProtocol(t thrift.TTransport, iprot thrift.TProtocol, oprot thrift.TProtocol) *RelationServiceClient {
+	return &RelationServiceClient{
+		c: thrift.NewTStandardClient(iprot, oprot),
+	}
+}
+
+func NewRelationServiceClient(c thrift.TClient) *RelationServiceClient {
+	return &RelationServiceClient{
+		c: c,
+	}
+}
+
+func (p *RelationServiceClient) Client_() thrift.TClient {
+	return p.c
+}
+
+func (p *RelationServiceClient) RelationAction(ctx context.Context, req *RelationActionRequest) (r *RelationActionResponse, err error) {
+	var _args RelationServiceRelationActionArgs
+	_args.Req = req
+	var _result RelationServiceRelationActionResult
+	if err = p.Client_().Call(ctx, "RelationAction", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *RelationServiceClient) RelationFollowList(ctx context.Context, req *RelationFollowListRequest) (r *RelationFollowListResponse, err error) {
+	var _args RelationServiceRelationFollowListArgs
+	_args.Req = req
+	var _result RelationServiceRelationFollowListResult
+	if err = p.Client_().Call(ctx, "RelationFollowList", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *RelationServiceClient) RelationFollowerList(ctx context.Context, req *RelationFollowerListRequest) (r *RelationFollowerListResponse, err error) {
+	var _args RelationServiceRelationFollowerListArgs
+	_args.Req = req
+	var _result RelationServiceRelationFollowerListResult
+	if err = p.Client_().Call(ctx, "RelationFollowerList", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *RelationServiceClient) RelationFriendList(ctx context.Context, req *RelationFriendListRequest) (r *RelationFriendListResponse, err error) {
+	var _args RelationServiceRelationFriendListArgs
+	_args.Req = req
+	var _result RelationServiceRelationFriendListResult
+	if err = p.Client_().Call(ctx, "RelationFriendList", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *RelationServiceClient) RelationBlock(ctx context.Context, req *RelationBlockRequest) (r *RelationBlockResponse, err error) {
+	var _args RelationServiceRelationBlockArgs
+	_args.Req = req
+	var _result RelationServiceRelationBlockResult
+	if err = p.Client_().Call(ctx, "RelationBlock", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *RelationServiceClient) RelationBlockList(ctx context.Context, req *RelationBlockListRequest) (r *RelationBlockListResponse, err error) {
+	var _args RelationServiceRelationBlockListArgs
+	_args.Req = req
+	var _result RelationServiceRelationBlockListResult
+	if err = p.Client_().Call(ctx, "RelationBlockList", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *RelationServiceClient) RelationExist(ctx context.Context, userId int64, authorId int64) (r bool, err error) {
+	var _args RelationServiceRelationExistArgs
+	_args.UserId = userId
+	_args.AuthorId = authorId
+	var _result RelationServiceRelationExistResult
+	if err = p.Client_().Call(ctx, "RelationExist", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *RelationServiceClient) FollowCnt(ctx context.Context, userId int64) (r int64, err error) {
+	var _args RelationServiceFollowCntArgs
+	_args.UserId = userId
+	var _result RelationServiceFollowCntResult
+	if err = p.Client_().Call(ctx, "FollowCnt", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *RelationServiceClient) FollowerCnt(ctx context.Context, userId int64) (r int64, err error) {
+	var _args RelationServiceFollowerCntArgs
+	_args.UserId = userId
+	var _result RelationServiceFollowerCntResult
+	if err = p.Client_().Call(ctx, "FollowerCnt", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *RelationServiceClient) BatchRelationExist(ctx context.Context, userId int64, authorIds []int64) (r map[int64]bool, err error) {
+	var _args RelationServiceBatchRelationExistArgs
+	_args.UserId = userId
+	_args.AuthorIds = authorIds
+	var _result RelationServiceBatchRelationExistResult
+	if err = p.Client_().Call(ctx, "BatchRelationExist", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *RelationServiceClient) BatchFollowCnt(ctx context.Context, userIds []int64) (r map[int64]int64, err error) {
+	var _args RelationServiceBatchFollowCntArgs
+	_args.UserIds = userIds
+	var _result RelationServiceBatchFollowCntResult
+	if err = p.Client_().Call(ctx, "BatchFollowCnt", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *RelationServiceClient) BatchFollowerCnt(ctx context.Context, userIds []int64) (r map[int64]int64, err error) {
+	var _args RelationServiceBatchFollowerCntArgs
+	_args.UserIds = userIds
+	var _result RelationServiceBatchFollowerCntResult
+	if err = p.Client_().Call(ctx, "BatchFollowerCnt", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+type RelationServiceProcessor struct {
+	processorMap map[string]thrift.TProcessorFunction
+	handler      RelationService
+}
+
+func (p *RelationServiceProcessor) AddToProcessorMap(key string, processor thrift.TProcessorFunction) {
+	p.processorMap[key] = processor
+}
+
+func (p *RelationServiceProcessor) GetProcessorFunction(key string) (processor thrift.TProcessorFunction, ok bool) {
+	processor, ok = p.processorMap[key]
+	return processor, ok
+}
+
+func (p *RelationServiceProcessor) ProcessorMap() map[string]thrift.TProcessorFunction {
+	return p.processorMap
+}
+
+func NewRelationServiceProcessor(handler RelationService) *RelationServiceProcessor {
+	self := &RelationServiceProcessor{handler: handler, processorMap: make(map[string]thrift.TProcessorFunction)}
+	self.AddToProcessorMap("RelationAction", &relationServiceProcessorRelationAction{handler: handler})
+	self.AddToProcessorMap("RelationFollowList", &relationServiceProcessorRelationFollowList{handler: handler})
+	self.AddToProcessorMap("RelationFollowerList", &relationServiceProcessorRelationFollowerList{handler: handler})
+	self.AddToProcessorMap("RelationFriendList", &relationServiceProcessorRelationFriendList{handler: handler})
+	self.AddToProcessorMap("RelationBlock", &relationServiceProcessorRelationBlock{handler: handler})
+	self.AddToProcessorMap("RelationBlockList", &relationServiceProcessorRelationBlockList{handler: handler})
+	self.AddToProcessorMap("RelationExist", &relationServiceProcessorRelationExist{handler: handler})
+	self.AddToProcessorMap("FollowCnt", &relationServiceProcessorFollowCnt{handler: handler})
+	self.AddToProcessorMap("FollowerCnt", &relationServiceProcessorFollowerCnt{handler: handler})
+	self.AddToProcessorMap("BatchRelationExist", &relationServiceProcessorBatchRelationExist{handler: handler})
+	self.AddToProcessorMap("BatchFollowCnt", &relationServiceProcessorBatchFollowCnt{handler: handler})
+	self.AddToProcessorMap("BatchFollowerCnt", &relationServiceProcessorBatchFollowerCnt{handler: handler})
+	return self
+}
+func (p *RelationServiceProcessor) Process(ctx context.Context, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	name, _, seqId, err := iprot.ReadMessageBegin()
+	if err != nil {
+		return false, err
+	}
+	if processor, ok := p.GetProcessorFunction(name); ok {
+		return processor.Process(ctx, seqId, iprot, oprot)
+	}
+	iprot.Skip(thrift.STRUCT)
+	iprot.ReadMessageEnd()
+	x := thrift.NewTApplicationException(thrift.UNKNOWN_METHOD, "Unknown function "+name)
+	oprot.WriteMessageBegin(name, thrift.EXCEPTION, seqId)
+	x.Write(oprot)
+	oprot.WriteMessageEnd()
+	oprot.Flush(ctx)
+	return false, x
+}
+
+type relationServiceProcessorRelationAction struct {
+	handler RelationService
+}
+
+func (p *relationServiceProcessorRelationAction) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := RelationServiceRelationActionArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("RelationAction", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := RelationServiceRelationActionResult{}
+	var retval *RelationActionResponse
+	if retval, err2 = p.handler.RelationAction(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing RelationAction: "+err2.Error())
+		oprot.WriteMessageBegin("RelationAction", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("RelationAction", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type relationServiceProcessorRelationFollowList struct {
+	handler RelationService
+}
+
+func (p *relationServiceProcessorRelationFollowList) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := RelationServiceRelationFollowListArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("RelationFollowList", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := RelationServiceRelationFollowListResult{}
+	var retval *RelationFollowListResponse
+	if retval, err2 = p.handler.RelationFollowList(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing RelationFollowList: "+err2.Error())
+		oprot.WriteMessageBegin("RelationFollowList", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("RelationFollowList", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type relationServiceProcessorRelationFollowerList struct {
+	handler RelationService
+}
+
+func (p *relationServiceProcessorRelationFollowerList) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := RelationServiceRelationFollowerListArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("RelationFollowerList", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := RelationServiceRelationFollowerListResult{}
+	var retval *RelationFollowerListResponse
+	if retval, err2 = p.handler.RelationFollowerList(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing RelationFollowerList: "+err2.Error())
+		oprot.WriteMessageBegin("RelationFollowerList", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("RelationFollowerList", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type relationServiceProcessorRelationFriendList struct {
+	handler RelationService
+}
+
+func (p *relationServiceProcessorRelationFriendList) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := RelationServiceRelationFriendListArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("RelationFriendList", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := RelationServiceRelationFriendListResult{}
+	var retval *RelationFriendListResponse
+	if retval, err2 = p.handler.RelationFriendList(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing RelationFriendList: "+err2.Error())
+		oprot.WriteMessageBegin("RelationFriendList", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("RelationFriendList", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type relationServiceProcessorRelationBlock struct {
+	handler RelationService
+}
+
+func (p *relationServiceProcessorRelationBlock) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := RelationServiceRelationBlockArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("RelationBlock", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := RelationServiceRelationBlockResult{}
+	var retval *RelationBlockResponse
+	if retval, err2 = p.handler.RelationBlock(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing RelationBlock: "+err2.Error())
+		oprot.WriteMessageBegin("RelationBlock", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("RelationBlock", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type relationServiceProcessorRelationBlockList struct {
+	handler RelationService
+}
+
+func (p *relationServiceProcessorRelationBlockList) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := RelationServiceRelationBlockListArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("RelationBlockList", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := RelationServiceRelationBlockListResult{}
+	var retval *RelationBlockListResponse
+	if retval, err2 = p.handler.RelationBlockList(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing RelationBlockList: "+err2.Error())
+		oprot.WriteMessageBegin("RelationBlockList", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("RelationBlockList", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type relationServiceProcessorRelationExist struct {
+	handler RelationService
+}
+
+func (p *relationServiceProcessorRelationExist) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := RelationServiceRelationExistArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("RelationExist", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := RelationServiceRelationExistResult{}
+	var retval bool
+	if retval, err2 = p.handler.RelationExist(ctx, args.UserId, args.AuthorId); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing RelationExist: "+err2.Error())
+		oprot.WriteMessageBegin("RelationExist", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = &retval
+	}
+	if err2 = oprot.WriteMessageBegin("RelationExist", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type relationServiceProcessorFollowCnt struct {
+	handler RelationService
+}
+
+func (p *relationServiceProcessorFollowCnt) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := RelationServiceFollowCntArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("FollowCnt", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := RelationServiceFollowCntResult{}
+	var retval int64
+	if retval, err2 = p.handler.FollowCnt(ctx, args.UserId); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing FollowCnt: "+err2.Error())
+		oprot.WriteMessageBegin("FollowCnt", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = &retval
+	}
+	if err2 = oprot.WriteMessageBegin("FollowCnt", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type relationServiceProcessorFollowerCnt struct {
+	handler RelationService
+}
+
+func (p *relationServiceProcessorFollowerCnt) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := RelationServiceFollowerCntArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("FollowerCnt", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := RelationServiceFollowerCntResult{}
+	var retval int64
+	if retval, err2 = p.handler.FollowerCnt(ctx, args.UserId); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing FollowerCnt: "+err2.Error())
+		oprot.WriteMessageBegin("FollowerCnt", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = &retval
+	}
+	if err2 = oprot.WriteMessageBegin("FollowerCnt", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type relationServiceProcessorBatchRelationExist struct {
+	handler RelationService
+}
+
+func (p *relationServiceProcessorBatchRelationExist) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := RelationServiceBatchRelationExistArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("BatchRelationExist", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := RelationServiceBatchRelationExistResult{}
+	var retval map[int64]bool
+	if retval, err2 = p.handler.BatchRelationExist(ctx, args.UserId, args.AuthorIds); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing BatchRelationExist: "+err2.Error())
+		oprot.WriteMessageBegin("BatchRelationExist", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("BatchRelationExist", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type relationServiceProcessorBatchFollowCnt struct {
+	handler RelationService
+}
+
+func (p *relationServiceProcessorBatchFollowCnt) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := RelationServiceBatchFollowCntArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("BatchFollowCnt", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := RelationServiceBatchFollowCntResult{}
+	var retval map[int64]int64
+	if retval, err2 = p.handler.BatchFollowCnt(ctx, args.UserIds); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing BatchFollowCnt: "+err2.Error())
+		oprot.WriteMessageBegin("BatchFollowCnt", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("BatchFollowCnt", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type relationServiceProcessorBatchFollowerCnt struct {
+	handler RelationService
+}
+
+func (p *relationServiceProcessorBatchFollowerCnt) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := RelationServiceBatchFollowerCntArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("BatchFollowerCnt", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := RelationServiceBatchFollowerCntResult{}
+	var retval map[int64]int64
+	if retval, err2 = p.handler.BatchFollowerCnt(ctx, args.UserIds); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing BatchFollowerCnt: "+err2.Error())
+		oprot.WriteMessageBegin("BatchFollowerCnt", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("BatchFollowerCnt", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type RelationServiceRelationActionArgs struct {
+	Req *RelationActionRequest `thrift:"req,1" frugal:"1,default,RelationActionRequest" json:"req"`
+}
+
+func NewRelationServiceRelationActionArgs() *RelationServiceRelationActionArgs {
+	return &RelationServiceRelationActionArgs{}
+}
+
+func (p *RelationServiceRelationActionArgs) InitDefault() {
+	*p = RelationServiceRelationActionArgs{}
+}
+
+var RelationServiceRelationActionArgs_Req_DEFAULT *RelationActionRequest
+
+func (p *RelationServiceRelationActionArgs) GetReq() (v *RelationActionRequest) {
+	if !p.IsSetReq() {
+		return RelationServiceRelationActionArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+func (p *RelationServiceRelationActionArgs) SetReq(val *RelationActionRequest) {
+	p.Req = val
+}
+
+var fieldIDToName_RelationServiceRelationActionArgs = map[int16]string{
+	1: "req",
+}
+
+func (p *RelationServiceRelationActionArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *RelationServiceRelationActionArgs) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_RelationServiceRelationActionArgs[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *RelationServiceRelationActionArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := NewRelationActionRequest()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Req = _field
+	return nil
+}
+
+func (p *RelationServiceRelationActionArgs) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("RelationAction_args"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *RelationServiceRelationActionArgs) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := p.Req.Write(oprot); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *RelationServiceRelationActionArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("RelationServiceRelationActionArgs(%+v)", *p)
+
+}
+
+func (p *RelationServiceRelationActionArgs) DeepEqual(ano *RelationServiceRelationActionArgs) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.Req) {
+		return false
+	}
+	return true
+}
+
+func (p *RelationServiceRelationActionArgs) Field1DeepEqual(src *RelationActionRequest) bool {
+
+	if !p.Req.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+
+type RelationServiceRelationActionResult struct {
+	Success *RelationActionResponse `thrift:"success,0,optional" frugal:"0,optional,RelationActionResponse" json:"success,omitempty"`
+}
+
+func NewRelationServiceRelationActionResult() *RelationServiceRelationActionResult {
+	return &RelationServiceRelationActionResult{}
+}
+
+func (p *RelationServiceRelationActionResult) InitDefault() {
+	*p = RelationServiceRelationActionResult{}
+}
+
+var RelationServiceRelationActionResult_Success_DEFAULT *RelationActionResponse
+
+func (p *RelationServiceRelationActionResult) GetSuccess() (v *RelationActionResponse) {
+	if !p.IsSetSuccess() {
+		return RelationServiceRelationActionResult_Success_DEFAULT
+	}
+	return p.Success
+}
+func (p *RelationServiceRelationActionResult) SetSuccess(x interface{}) {
+	p.Success = x.(*RelationActionResponse)
+}
+
+var fieldIDToName_RelationServiceRelationActionResult = map[int16]string{
+	0: "success",
+}
+
+func (p *RelationServiceRelationActionResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *RelationServiceRelationActionResult) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 0:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField0(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_RelationServiceRelationActionResult[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *RelationServiceRelationActionResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := NewRelationActionResponse()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Success = _field
+	return nil
+}
+
+func (p *RelationServiceRelationActionResult) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("RelationAction_result"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField0(oprot); err != nil {
+			fieldId = 0
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *RelationServiceRelationActionResult) writeField0(oprot thrift.TProtocol) (err error) {
+	if p.IsSetSuccess() {
+		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.Success.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
+}
+
+func (p *RelationServiceRelationActionResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("RelationServiceRelationActionResult(%+v)", *p)
+
+}
+
+func (p *RelationServiceRelationActionResult) DeepEqual(ano *RelationServiceRelationActionResult) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field0DeepEqual(ano.Success) {
+		return false
+	}
+	return true
+}
+
+func (p *RelationServiceRelationActionResult) Field0DeepEqual(src *RelationActionResponse) bool {
+
+	if !p.Success.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+
+type RelationServiceRelationFollowListArgs struct {
+	Req *RelationFollowListRequest `thrift:"req,1" frugal:"1,default,RelationFollowListRequest" json:"req"`
+}
+
+func NewRelationServiceRelationFollowListArgs() *RelationServiceRelationFollowListArgs {
+	return &RelationServiceRelationFollowListArgs{}
+}
+
+func (p *RelationServiceRelationFollowListArgs) InitDefault() {
+	*p = RelationServiceRelationFollowListArgs{}
+}
+
+var RelationServiceRelationFollowListArgs_Req_DEFAULT *RelationFollowListRequest
+
+func (p *RelationServiceRelationFollowListArgs) GetReq() (v *RelationFollowListRequest) {
+	if !p.IsSetReq() {
+		return RelationServiceRelationFollowListArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+func (p *RelationServiceRelationFollowListArgs) SetReq(val *RelationFollowListRequest) {
+	p.Req = val
+}
+
+var fieldIDToName_RelationServiceRelationFollowListArgs = map[int16]string{
+	1: "req",
+}
+
+func (p *RelationServiceRelationFollowListArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *RelationServiceRelationFollowListArgs) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_RelationServiceRelationFollowListArgs[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *RelationServiceRelationFollowListArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := NewRelationFollowListRequest()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Req = _field
+	return nil
+}
+
+func (p *RelationServiceRelationFollowListArgs) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("RelationFollowList_args"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *RelationServiceRelationFollowListArgs) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := p.Req.Write(oprot); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *RelationServiceRelationFollowListArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("RelationServiceRelationFollowListArgs(%+v)", *p)
+
+}
+
+func (p *RelationServiceRelationFollowListArgs) DeepEqual(ano *RelationServiceRelationFollowListArgs) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.Req) {
+		return false
+	}
+	return true
+}
+
+func (p *RelationServiceRelationFollowListArgs) Field1DeepEqual(src *RelationFollowListRequest) bool {
+
+	if !p.Req.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+
+type RelationServiceRelationFollowListResult struct {
+	Success *RelationFollowListResponse `thrift:"success,0,optional" frugal:"0,optional,RelationFollowListResponse" json:"success,omitempty"`
+}
+
+func NewRelationServiceRelationFollowListResult() *RelationServiceRelationFollowListResult {
+	return &RelationServiceRelationFollowListResult{}
+}
+
+func (p *RelationServiceRelationFollowListResult) InitDefault() {
+	*p = RelationServiceRelationFollowListResult{}
+}
+
+var RelationServiceRelationFollowListResult_Success_DEFAULT *RelationFollowListResponse
+
+func (p *RelationServiceRelationFollowListResult) GetSuccess() (v *RelationFollowListResponse) {
+	if !p.IsSetSuccess() {
+		return RelationServiceRelationFollowListResult_Success_DEFAULT
+	}
+	return p.Success
+}
+func (p *RelationServiceRelationFollowListResult) SetSuccess(x interface{}) {
+	p.Success = x.(*RelationFollowListResponse)
+}
+
+var fieldIDToName_RelationServiceRelationFollowListResult = map[int16]string{
+	0: "success",
+}
+
+func (p *RelationServiceRelationFollowListResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *RelationServiceRelationFollowListResult) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 0:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField0(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_RelationServiceRelationFollowListResult[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *RelationServiceRelationFollowListResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := NewRelationFollowListResponse()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Success = _field
+	return nil
+}
+
+func (p *RelationServiceRelationFollowListResult) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("RelationFollowList_result"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField0(oprot); err != nil {
+			fieldId = 0
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *RelationServiceRelationFollowListResult) writeField0(oprot thrift.TProtocol) (err error) {
+	if p.IsSetSuccess() {
+		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.Success.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
+}
+
+func (p *RelationServiceRelationFollowListResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("RelationServiceRelationFollowListResult(%+v)", *p)
+
+}
+
+func (p *RelationServiceRelationFollowListResult) DeepEqual(ano *RelationServiceRelationFollowListResult) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field0DeepEqual(ano.Success) {
+		return false
+	}
+	return true
+}
+
+func (p *RelationServiceRelationFollowListResult) Field0DeepEqual(src *RelationFollowListResponse) bool {
+
+	if !p.Success.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+
+type RelationServiceRelationFollowerListArgs struct {
+	Req *RelationFollowerListRequest `thrift:"req,1" frugal:"1,default,RelationFollowerListRequest" json:"req"`
+}
+
+func NewRelationServiceRelationFollowerListArgs() *RelationServiceRelationFollowerListArgs {
+	return &RelationServiceRelationFollowerListArgs{}
+}
+
+func (p *RelationServiceRelationFollowerListArgs) InitDefault() {
+	*p = RelationServiceRelationFollowerListArgs{}
+}
+
+var RelationServiceRelationFollowerListArgs_Req_DEFAULT *RelationFollowerListRequest
+
+func (p *RelationServiceRelationFollowerListArgs) GetReq() (v *RelationFollowerListRequest) {
+	if !p.IsSetReq() {
+		return RelationServiceRelationFollowerListArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+func (p *RelationServiceRelationFollowerListArgs) SetReq(val *RelationFollowerListRequest) {
+	p.Req = val
+}
+
+var fieldIDToName_RelationServiceRelationFollowerListArgs = map[int16]string{
+	1: "req",
+}
+
+func (p *RelationServiceRelationFollowerListArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *RelationServiceRelationFollowerListArgs) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_RelationServiceRelationFollowerListArgs[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *RelationServiceRelationFollowerListArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := NewRelationFollowerListRequest()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Req = _field
+	return nil
+}
+
+func (p *RelationServiceRelationFollowerListArgs) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("RelationFollowerList_args"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *RelationServiceRelationFollowerListArgs) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := p.Req.Write(oprot); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *RelationServiceRelationFollowerListArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("RelationServiceRelationFollowerListArgs(%+v)", *p)
+
+}
+
+func (p *RelationServiceRelationFollowerListArgs) DeepEqual(ano *RelationServiceRelationFollowerListArgs) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.Req) {
+		return false
+	}
+	return true
+}
+
+func (p *RelationServiceRelationFollowerListArgs) Field1DeepEqual(src *RelationFollowerListRequest) bool {
+
+	if !p.Req.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+
+type RelationServiceRelationFollowerListResult struct {
+	Success *RelationFollowerListResponse `thrift:"success,0,optional" frugal:"0,optional,RelationFollowerListResponse" json:"success,omitempty"`
+}
+
+func NewRelationServiceRelationFollowerListResult() *RelationServiceRelationFollowerListResult {
+	return &RelationServiceRelationFollowerListResult{}
+}
+
+func (p *RelationServiceRelationFollowerListResult) InitDefault() {
+	*p = RelationServiceRelationFollowerListResult{}
+}
+
+var RelationServiceRelationFollowerListResult_Success_DEFAULT *RelationFollowerListResponse
+
+func (p *RelationServiceRelationFollowerListResult) GetSuccess() (v *RelationFollowerListResponse) {
+	if !p.IsSetSuccess() {
+		return RelationServiceRelationFollowerListResult_Success_DEFAULT
+	}
+	return p.Success
+}
+func (p *RelationServiceRelationFollowerListResult) SetSuccess(x interface{}) {
+	p.Success = x.(*RelationFollowerListResponse)
+}
+
+var fieldIDToName_RelationServiceRelationFollowerListResult = map[int16]string{
+	0: "success",
+}
+
+func (p *RelationServiceRelationFollowerListResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *RelationServiceRelationFollowerListResult) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 0:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField0(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_RelationServiceRelationFollowerListResult[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *RelationServiceRelationFollowerListResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := NewRelationFollowerListResponse()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Success = _field
+	return nil
+}
+
+func (p *RelationServiceRelationFollowerListResult) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("RelationFollowerList_result"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField0(oprot); err != nil {
+			fieldId = 0
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *RelationServiceRelationFollowerListResult) writeField0(oprot thrift.TProtocol) (err error) {
+	if p.IsSetSuccess() {
+		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.Success.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
+}
+
+func (p *RelationServiceRelationFollowerListResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("RelationServiceRelationFollowerListResult(%+v)", *p)
+
+}
+
+func (p *RelationServiceRelationFollowerListResult) DeepEqual(ano *RelationServiceRelationFollowerListResult) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field0DeepEqual(ano.Success) {
+		return false
+	}
+	return true
+}
+
+func (p *RelationServiceRelationFollowerListResult) Field0DeepEqual(src *RelationFollowerListResponse) bool {
+
+	if !p.Success.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+
+type RelationServiceRelationFriendListArgs struct {
+	Req *RelationFriendListRequest `thrift:"req,1" frugal:"1,default,RelationFriendListRequest" json:"req"`
+}
+
+func NewRelationServiceRelationFriendListArgs() *RelationServiceRelationFriendListArgs {
+	return &RelationServiceRelationFriendListArgs{}
+}
+
+func (p *RelationServiceRelationFriendListArgs) InitDefault() {
+	*p = RelationServiceRelationFriendListArgs{}
+}
+
+var RelationServiceRelationFriendListArgs_Req_DEFAULT *RelationFriendListRequest
+
+func (p *RelationServiceRelationFriendListArgs) GetReq() (v *RelationFriendListRequest) {
+	if !p.IsSetReq() {
+		return RelationServiceRelationFriendListArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+func (p *RelationServiceRelationFriendListArgs) SetReq(val *RelationFriendListRequest) {
+	p.Req = val
+}
+
+var fieldIDToName_RelationServiceRelationFriendListArgs = map[int16]string{
+	1: "req",
+}
+
+func (p *RelationServiceRelationFriendListArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *RelationServiceRelationFriendListArgs) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_RelationServiceRelationFriendListArgs[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *RelationServiceRelationFriendListArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := NewRelationFriendListRequest()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Req = _field
+	return nil
+}
+
+func (p *RelationServiceRelationFriendListArgs) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("RelationFriendList_args"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *RelationServiceRelationFriendListArgs) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := p.Req.Write(oprot); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *RelationServiceRelationFriendListArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("RelationServiceRelationFriendListArgs(%+v)", *p)
+
+}
+
+func (p *RelationServiceRelationFriendListArgs) DeepEqual(ano *RelationServiceRelationFriendListArgs) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.Req) {
+		return false
+	}
+	return true
+}
+
+func (p *RelationServiceRelationFriendListArgs) Field1DeepEqual(src *RelationFriendListRequest) bool {
+
+	if !p.Req.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+
+type RelationServiceRelationFriendListResult struct {
+	Success *RelationFriendListResponse `thrift:"success,0,optional" frugal:"0,optional,RelationFriendListResponse" json:"success,omitempty"`
+}
+
+func NewRelationServiceRelationFriendListResult() *RelationServiceRelationFriendListResult {
+	return &RelationServiceRelationFriendListResult{}
+}
+
+func (p *RelationServiceRelationFriendListResult) InitDefault() {
+	*p = RelationServiceRelationFriendListResult{}
+}
+
+var RelationServiceRelationFriendListResult_Success_DEFAULT *RelationFriendListResponse
+
+func (p *RelationServiceRelationFriendListResult) GetSuccess() (v *RelationFriendListResponse) {
+	if !p.IsSetSuccess() {
+		return RelationServiceRelationFriendListResult_Success_DEFAULT
+	}
+	return p.Success
+}
+func (p *RelationServiceRelationFriendListResult) SetSuccess(x interface{}) {
+	p.Success = x.(*RelationFriendListResponse)
+}
+
+var fieldIDToName_RelationServiceRelationFriendListResult = map[int16]string{
+	0: "success",
+}
+
+func (p *RelationServiceRelationFriendListResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *RelationServiceRelationFriendListResult) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 0:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField0(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_RelationServiceRelationFriendListResult[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *RelationServiceRelationFriendListResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := NewRelationFriendListResponse()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Success = _field
+	return nil
+}
+
+func (p *RelationServiceRelationFriendListResult) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("RelationFriendList_result"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField0(oprot); err != nil {
+			fieldId = 0
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *RelationServiceRelationFriendListResult) writeField0(oprot thrift.TProtocol) (err error) {
+	if p.IsSetSuccess() {
+		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.Success.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
+}
+
+func (p *RelationServiceRelationFriendListResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("RelationServiceRelationFriendListResult(%+v)", *p)
+
+}
+
+func (p *RelationServiceRelationFriendListResult) DeepEqual(ano *RelationServiceRelationFriendListResult) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field0DeepEqual(ano.Success) {
+		return false
+	}
+	return true
+}
+
+func (p *RelationServiceRelationFriendListResult) Field0DeepEqual(src *RelationFriendListResponse) bool {
+
+	if !p.Success.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+
+type RelationServiceRelationBlockArgs struct {
+	Req *RelationBlockRequest `thrift:"req,1" frugal:"1,default,RelationBlockRequest" json:"req"`
+}
+
+func NewRelationServiceRelationBlockArgs() *RelationServiceRelationBlockArgs {
+	return &RelationServiceRelationBlockArgs{}
+}
+
+func (p *RelationServiceRelationBlockArgs) InitDefault() {
+	*p = RelationServiceRelationBlockArgs{}
+}
+
+var RelationServiceRelationBlockArgs_Req_DEFAULT *RelationBlockRequest
+
+func (p *RelationServiceRelationBlockArgs) GetReq() (v *RelationBlockRequest) {
+	if !p.IsSetReq() {
+		return RelationServiceRelationBlockArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+func (p *RelationServiceRelationBlockArgs) SetReq(val *RelationBlockRequest) {
+	p.Req = val
+}
+
+var fieldIDToName_RelationServiceRelationBlockArgs = map[int16]string{
+	1: "req",
+}
+
+func (p *RelationServiceRelationBlockArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *RelationServiceRelationBlockArgs) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
 	}
-	if err != nil {
-		return
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
 	}
-	return true, err
-}
 
-type relationServiceProcessorRelationFollowList struct {
-	handler RelationService
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_RelationServiceRelationBlockArgs[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *relationServiceProcessorRelationFollowList) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-	args := RelationServiceRelationFollowListArgs{}
-	if err = args.Read(iprot); err != nil {
-		iprot.ReadMessageEnd()
-		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
-		oprot.WriteMessageBegin("RelationFollowList", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return false, err
+func (p *RelationServiceRelationBlockArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := NewRelationBlockRequest()
+	if err := _field.Read(iprot); err != nil {
+		return err
 	}
+	p.Req = _field
+	return nil
+}
 
-	iprot.ReadMessageEnd()
-	var err2 error
-	result := RelationServiceRelationFollowListResult{}
-	var retval *RelationFollowListResponse
-	if retval, err2 = p.handler.RelationFollowList(ctx, args.Req); err2 != nil {
-		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing RelationFollowList: "+err2.Error())
-		oprot.WriteMessageBegin("RelationFollowList", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return true, err2
-	} else {
-		result.Success = retval
-	}
-	if err2 = oprot.WriteMessageBegin("RelationFollowList", thrift.REPLY, seqId); err2 != nil {
-		err = err2
-	}
-	if err2 = result.Write(oprot); err == nil && err2 != nil {
-		err = err2
+func (p *RelationServiceRelationBlockArgs) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("RelationBlock_args"); err != nil {
+		goto WriteStructBeginError
 	}
-	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
-		err = err2
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
 	}
-	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
-		err = err2
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
 	}
-	if err != nil {
-		return
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
 	}
-	return true, err
-}
-
-type relationServiceProcessorRelationFollowerList struct {
-	handler RelationService
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *relationServiceProcessorRelationFollowerList) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-	args := RelationServiceRelationFollowerListArgs{}
-	if err = args.Read(iprot); err != nil {
-		iprot.ReadMessageEnd()
-		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
-		oprot.WriteMessageBegin("RelationFollowerList", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return false, err
+func (p *RelationServiceRelationBlockArgs) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
+		goto WriteFieldBeginError
 	}
-
-	iprot.ReadMessageEnd()
-	var err2 error
-	result := RelationServiceRelationFollowerListResult{}
-	var retval *RelationFollowerListResponse
-	if retval, err2 = p.handler.RelationFollowerList(ctx, args.Req); err2 != nil {
-		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing RelationFollowerList: "+err2.Error())
-		oprot.WriteMessageBegin("RelationFollowerList", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return true, err2
-	} else {
-		result.Success = retval
+	if err := p.Req.Write(oprot); err != nil {
+		return err
 	}
-	if err2 = oprot.WriteMessageBegin("RelationFollowerList", thrift.REPLY, seqId); err2 != nil {
-		err = err2
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
 	}
-	if err2 = result.Write(oprot); err == nil && err2 != nil {
-		err = err2
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *RelationServiceRelationBlockArgs) String() string {
+	if p == nil {
+		return "<nil>"
 	}
-	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
-		err = err2
+	return fmt.Sprintf("RelationServiceRelationBlockArgs(%+v)", *p)
+
+}
+
+func (p *RelationServiceRelationBlockArgs) DeepEqual(ano *RelationServiceRelationBlockArgs) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
 	}
-	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
-		err = err2
+	if !p.Field1DeepEqual(ano.Req) {
+		return false
 	}
-	if err != nil {
-		return
+	return true
+}
+
+func (p *RelationServiceRelationBlockArgs) Field1DeepEqual(src *RelationBlockRequest) bool {
+
+	if !p.Req.DeepEqual(src) {
+		return false
 	}
-	return true, err
+	return true
 }
 
-type relationServiceProcessorRelationFriendList struct {
-	handler RelationService
+type RelationServiceRelationBlockResult struct {
+	Success *RelationBlockResponse `thrift:"success,0,optional" frugal:"0,optional,RelationBlockResponse" json:"success,omitempty"`
 }
 
-func (p *relationServiceProcessorRelationFriendList) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-	args := RelationServiceRelationFriendListArgs{}
-	if err = args.Read(iprot); err != nil {
-		iprot.ReadMessageEnd()
-		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
-		oprot.WriteMessageBegin("RelationFriendList", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return false, err
-	}
+func NewRelationServiceRelationBlockResult() *RelationServiceRelationBlockResult {
+	return &RelationServiceRelationBlockResult{}
+}
 
-	iprot.ReadMessageEnd()
-	var err2 error
-	result := RelationServiceRelationFriendListResult{}
-	var retval *RelationFriendListResponse
-	if retval, err2 = p.handler.RelationFriendList(ctx, args.Req); err2 != nil {
-		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing RelationFriendList: "+err2.Error())
-		oprot.WriteMessageBegin("RelationFriendList", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return true, err2
-	} else {
-		result.Success = retval
-	}
-	if err2 = oprot.WriteMessageBegin("RelationFriendList", thrift.REPLY, seqId); err2 != nil {
-		err = err2
-	}
-	if err2 = result.Write(oprot); err == nil && err2 != nil {
-		err = err2
-	}
-	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
-		err = err2
-	}
-	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
-		err = err2
-	}
-	if err != nil {
-		return
+func (p *RelationServiceRelationBlockResult) InitDefault() {
+	*p = RelationServiceRelationBlockResult{}
+}
+
+var RelationServiceRelationBlockResult_Success_DEFAULT *RelationBlockResponse
+
+func (p *RelationServiceRelationBlockResult) GetSuccess() (v *RelationBlockResponse) {
+	if !p.IsSetSuccess() {
+		return RelationServiceRelationBlockResult_Success_DEFAULT
 	}
-	return true, err
+	return p.Success
+}
+func (p *RelationServiceRelationBlockResult) SetSuccess(x interface{}) {
+	p.Success = x.(*RelationBlockResponse)
 }
 
-type relationServiceProcessorRelationExist struct {
-	handler RelationService
+var fieldIDToName_RelationServiceRelationBlockResult = map[int16]string{
+	0: "success",
 }
 
-func (p *relationServiceProcessorRelationExist) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-	args := RelationServiceRelationExistArgs{}
-	if err = args.Read(iprot); err != nil {
-		iprot.ReadMessageEnd()
-		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
-		oprot.WriteMessageBegin("RelationExist", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return false, err
-	}
+func (p *RelationServiceRelationBlockResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
 
-	iprot.ReadMessageEnd()
-	var err2 error
-	result := RelationServiceRelationExistResult{}
-	var retval bool
-	if retval, err2 = p.handler.RelationExist(ctx, args.UserId, args.AuthorId); err2 != nil {
-		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing RelationExist: "+err2.Error())
-		oprot.WriteMessageBegin("RelationExist", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return true, err2
-	} else {
-		result.Success = &retval
-	}
-	if err2 = oprot.WriteMessageBegin("RelationExist", thrift.REPLY, seqId); err2 != nil {
-		err = err2
-	}
-	if err2 = result.Write(oprot); err == nil && err2 != nil {
-		err = err2
-	}
-	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
-		err = err2
+func (p *RelationServiceRelationBlockResult) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
 	}
-	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
-		err = err2
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 0:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField0(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
 	}
-	if err != nil {
-		return
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
 	}
-	return true, err
-}
 
-type relationServiceProcessorFollowCnt struct {
-	handler RelationService
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_RelationServiceRelationBlockResult[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *relationServiceProcessorFollowCnt) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-	args := RelationServiceFollowCntArgs{}
-	if err = args.Read(iprot); err != nil {
-		iprot.ReadMessageEnd()
-		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
-		oprot.WriteMessageBegin("FollowCnt", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return false, err
+func (p *RelationServiceRelationBlockResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := NewRelationBlockResponse()
+	if err := _field.Read(iprot); err != nil {
+		return err
 	}
+	p.Success = _field
+	return nil
+}
 
-	iprot.ReadMessageEnd()
-	var err2 error
-	result := RelationServiceFollowCntResult{}
-	var retval int64
-	if retval, err2 = p.handler.FollowCnt(ctx, args.UserId); err2 != nil {
-		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing FollowCnt: "+err2.Error())
-		oprot.WriteMessageBegin("FollowCnt", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return true, err2
-	} else {
-		result.Success = &retval
-	}
-	if err2 = oprot.WriteMessageBegin("FollowCnt", thrift.REPLY, seqId); err2 != nil {
-		err = err2
-	}
-	if err2 = result.Write(oprot); err == nil && err2 != nil {
-		err = err2
+func (p *RelationServiceRelationBlockResult) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("RelationBlock_result"); err != nil {
+		goto WriteStructBeginError
 	}
-	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
-		err = err2
+	if p != nil {
+		if err = p.writeField0(oprot); err != nil {
+			fieldId = 0
+			goto WriteFieldError
+		}
 	}
-	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
-		err = err2
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
 	}
-	if err != nil {
-		return
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
 	}
-	return true, err
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-type relationServiceProcessorFollowerCnt struct {
-	handler RelationService
+func (p *RelationServiceRelationBlockResult) writeField0(oprot thrift.TProtocol) (err error) {
+	if p.IsSetSuccess() {
+		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.Success.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
 }
 
-func (p *relationServiceProcessorFollowerCnt) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-	args := RelationServiceFollowerCntArgs{}
-	if err = args.Read(iprot); err != nil {
-		iprot.ReadMessageEnd()
-		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
-		oprot.WriteMessageBegin("FollowerCnt", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return false, err
+func (p *RelationServiceRelationBlockResult) String() string {
+	if p == nil {
+		return "<nil>"
 	}
+	return fmt.Sprintf("RelationServiceRelationBlockResult(%+v)", *p)
 
-	iprot.ReadMessageEnd()
-	var err2 error
-	result := RelationServiceFollowerCntResult{}
-	var retval int64
-	if retval, err2 = p.handler.FollowerCnt(ctx, args.UserId); err2 != nil {
-		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing FollowerCnt: "+err2.Error())
-		oprot.WriteMessageBegin("FollowerCnt", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return true, err2
-	} else {
-		result.Success = &retval
-	}
-	if err2 = oprot.WriteMessageBegin("FollowerCnt", thrift.REPLY, seqId); err2 != nil {
-		err = err2
-	}
-	if err2 = result.Write(oprot); err == nil && err2 != nil {
-		err = err2
-	}
-	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
-		err = err2
+}
+
+func (p *RelationServiceRelationBlockResult) DeepEqual(ano *RelationServiceRelationBlockResult) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
 	}
-	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
-		err = err2
+	if !p.Field0DeepEqual(ano.Success) {
+		return false
 	}
-	if err != nil {
-		return
+	return true
+}
+
+func (p *RelationServiceRelationBlockResult) Field0DeepEqual(src *RelationBlockResponse) bool {
+
+	if !p.Success.DeepEqual(src) {
+		return false
 	}
-	return true, err
+	return true
 }
 
-type RelationServiceRelationActionArgs struct {
-	Req *RelationActionRequest `thrift:"req,1" frugal:"1,default,RelationActionRequest" json:"req"`
+type RelationServiceRelationBlockListArgs struct {
+	Req *RelationBlockListRequest `thrift:"req,1" frugal:"1,default,RelationBlockListRequest" json:"req"`
 }
 
-func NewRelationServiceRelationActionArgs() *RelationServiceRelationActionArgs {
-	return &RelationServiceRelationActionArgs{}
+func NewRelationServiceRelationBlockListArgs() *RelationServiceRelationBlockListArgs {
+	return &RelationServiceRelationBlockListArgs{}
 }
 
-func (p *RelationServiceRelationActionArgs) InitDefault() {
-	*p = RelationServiceRelationActionArgs{}
+func (p *RelationServiceRelationBlockListArgs) InitDefault() {
+	*p = RelationServiceRelationBlockListArgs{}
 }
 
-var RelationServiceRelationActionArgs_Req_DEFAULT *RelationActionRequest
+var RelationServiceRelationBlockListArgs_Req_DEFAULT *RelationBlockListRequest
 
-func (p *RelationServiceRelationActionArgs) GetReq() (v *RelationActionRequest) {
+func (p *RelationServiceRelationBlockListArgs) GetReq() (v *RelationBlockListRequest) {
 	if !p.IsSetReq() {
-		return RelationServiceRelationActionArgs_Req_DEFAULT
+		return RelationServiceRelationBlockListArgs_Req_DEFAULT
 	}
 	return p.Req
 }
-func (p *RelationServiceRelationActionArgs) SetReq(val *RelationActionRequest) {
+func (p *RelationServiceRelationBlockListArgs) SetReq(val *RelationBlockListRequest) {
 	p.Req = val
 }
 
-var fieldIDToName_RelationServiceRelationActionArgs = map[int16]string{
+var fieldIDToName_RelationServiceRelationBlockListArgs = map[int16]string{
 	1: "req",
 }
 
-func (p *RelationServiceRelationActionArgs) IsSetReq() bool {
+func (p *RelationServiceRelationBlockListArgs) IsSetReq() bool {
 	return p.Req != nil
 }
 
-func (p *RelationServiceRelationActionArgs) Read(iprot thrift.TProtocol) (err error) {
+func (p *RelationServiceRelationBlockListArgs) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -2785,7 +6876,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_RelationServiceRelationActionArgs[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_RelationServiceRelationBlockListArgs[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -2795,8 +6886,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *RelationServiceRelationActionArgs) ReadField1(iprot thrift.TProtocol) error {
-	_field := NewRelationActionRequest()
+func (p *RelationServiceRelationBlockListArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := NewRelationBlockListRequest()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -2804,9 +6895,9 @@ func (p *RelationServiceRelationActionArgs) ReadField1(iprot thrift.TProtocol) e
 	return nil
 }
 
-func (p *RelationServiceRelationActionArgs) Write(oprot thrift.TProtocol) (err error) {
+func (p *RelationServiceRelationBlockListArgs) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("RelationAction_args"); err != nil {
+	if err = oprot.WriteStructBegin("RelationBlockList_args"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -2832,7 +6923,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *RelationServiceRelationActionArgs) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *RelationServiceRelationBlockListArgs) writeField1(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
 		goto WriteFieldBeginError
 	}
@@ -2849,15 +6940,15 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *RelationServiceRelationActionArgs) String() string {
+func (p *RelationServiceRelationBlockListArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("RelationServiceRelationActionArgs(%+v)", *p)
+	return fmt.Sprintf("RelationServiceRelationBlockListArgs(%+v)", *p)
 
 }
 
-func (p *RelationServiceRelationActionArgs) DeepEqual(ano *RelationServiceRelationActionArgs) bool {
+func (p *RelationServiceRelationBlockListArgs) DeepEqual(ano *RelationServiceRelationBlockListArgs) bool {
 	if p == ano {
 		return true
 	} else if p == nil || ano == nil {
@@ -2869,7 +6960,7 @@ func (p *RelationServiceRelationActionArgs) DeepEqual(ano *RelationServiceRelati
 	return true
 }
 
-func (p *RelationServiceRelationActionArgs) Field1DeepEqual(src *RelationActionRequest) bool {
+func (p *RelationServiceRelationBlockListArgs) Field1DeepEqual(src *RelationBlockListRequest) bool {
 
 	if !p.Req.DeepEqual(src) {
 		return false
@@ -2877,39 +6968,39 @@ func (p *RelationServiceRelationActionArgs) Field1DeepEqual(src *RelationActionR
 	return true
 }
 
-type RelationServiceRelationActionResult struct {
-	Success *RelationActionResponse `thrift:"success,0,optional" frugal:"0,optional,RelationActionResponse" json:"success,omitempty"`
+type RelationServiceRelationBlockListResult struct {
+	Success *RelationBlockListResponse `thrift:"success,0,optional" frugal:"0,optional,RelationBlockListResponse" json:"success,omitempty"`
 }
 
-func NewRelationServiceRelationActionResult() *RelationServiceRelationActionResult {
-	return &RelationServiceRelationActionResult{}
+func NewRelationServiceRelationBlockListResult() *RelationServiceRelationBlockListResult {
+	return &RelationServiceRelationBlockListResult{}
 }
 
-func (p *RelationServiceRelationActionResult) InitDefault() {
-	*p = RelationServiceRelationActionResult{}
+func (p *RelationServiceRelationBlockListResult) InitDefault() {
+	*p = RelationServiceRelationBlockListResult{}
 }
 
-var RelationServiceRelationActionResult_Success_DEFAULT *RelationActionResponse
+var RelationServiceRelationBlockListResult_Success_DEFAULT *RelationBlockListResponse
 
-func (p *RelationServiceRelationActionResult) GetSuccess() (v *RelationActionResponse) {
+func (p *RelationServiceRelationBlockListResult) GetSuccess() (v *RelationBlockListResponse) {
 	if !p.IsSetSuccess() {
-		return RelationServiceRelationActionResult_Success_DEFAULT
+		return RelationServiceRelationBlockListResult_Success_DEFAULT
 	}
 	return p.Success
 }
-func (p *RelationServiceRelationActionResult) SetSuccess(x interface{}) {
-	p.Success = x.(*RelationActionResponse)
+func (p *RelationServiceRelationBlockListResult) SetSuccess(x interface{}) {
+	p.Success = x.(*RelationBlockListResponse)
 }
 
-var fieldIDToName_RelationServiceRelationActionResult = map[int16]string{
+var fieldIDToName_RelationServiceRelationBlockListResult = map[int16]string{
 	0: "success",
 }
 
-func (p *RelationServiceRelationActionResult) IsSetSuccess() bool {
+func (p *RelationServiceRelationBlockListResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *RelationServiceRelationActionResult) Read(iprot thrift.TProtocol) (err error) {
+func (p *RelationServiceRelationBlockListResult) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -2955,7 +7046,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_RelationServiceRelationActionResult[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_RelationServiceRelationBlockListResult[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -2965,8 +7056,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *RelationServiceRelationActionResult) ReadField0(iprot thrift.TProtocol) error {
-	_field := NewRelationActionResponse()
+func (p *RelationServiceRelationBlockListResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := NewRelationBlockListResponse()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -2974,9 +7065,9 @@ func (p *RelationServiceRelationActionResult) ReadField0(iprot thrift.TProtocol)
 	return nil
 }
 
-func (p *RelationServiceRelationActionResult) Write(oprot thrift.TProtocol) (err error) {
+func (p *RelationServiceRelationBlockListResult) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("RelationAction_result"); err != nil {
+	if err = oprot.WriteStructBegin("RelationBlockList_result"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -3002,7 +7093,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *RelationServiceRelationActionResult) writeField0(oprot thrift.TProtocol) (err error) {
+func (p *RelationServiceRelationBlockListResult) writeField0(oprot thrift.TProtocol) (err error) {
 	if p.IsSetSuccess() {
 		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
 			goto WriteFieldBeginError
@@ -3021,15 +7112,15 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
 }
 
-func (p *RelationServiceRelationActionResult) String() string {
+func (p *RelationServiceRelationBlockListResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("RelationServiceRelationActionResult(%+v)", *p)
+	return fmt.Sprintf("RelationServiceRelationBlockListResult(%+v)", *p)
 
 }
 
-func (p *RelationServiceRelationActionResult) DeepEqual(ano *RelationServiceRelationActionResult) bool {
+func (p *RelationServiceRelationBlockListResult) DeepEqual(ano *RelationServiceRelationBlockListResult) bool {
 	if p == ano {
 		return true
 	} else if p == nil || ano == nil {
@@ -3041,7 +7132,7 @@ func (p *RelationServiceRelationActionResult) DeepEqual(ano *RelationServiceRela
 	return true
 }
 
-func (p *RelationServiceRelationActionResult) Field0DeepEqual(src *RelationActionResponse) bool {
+func (p *RelationServiceRelationBlockListResult) Field0DeepEqual(src *RelationBlockListResponse) bool {
 
 	if !p.Success.DeepEqual(src) {
 		return false
@@ -3049,39 +7140,39 @@ func (p *RelationServiceRelationActionResult) Field0DeepEqual(src *RelationActio
 	return true
 }
 
-type RelationServiceRelationFollowListArgs struct {
-	Req *RelationFollowListRequest `thrift:"req,1" frugal:"1,default,RelationFollowListRequest" json:"req"`
+type RelationServiceRelationExistArgs struct {
+	UserId   int64 `thrift:"user_id,1" frugal:"1,default,i64" json:"user_id"`
+	AuthorId int64 `thrift:"author_id,2" frugal:"2,default,i64" json:"author_id"`
 }
 
-func NewRelationServiceRelationFollowListArgs() *RelationServiceRelationFollowListArgs {
-	return &RelationServiceRelationFollowListArgs{}
+func NewRelationServiceRelationExistArgs() *RelationServiceRelationExistArgs {
+	return &RelationServiceRelationExistArgs{}
 }
 
-func (p *RelationServiceRelationFollowListArgs) InitDefault() {
-	*p = RelationServiceRelationFollowListArgs{}
+func (p *RelationServiceRelationExistArgs) InitDefault() {
+	*p = RelationServiceRelationExistArgs{}
 }
 
-var RelationServiceRelationFollowListArgs_Req_DEFAULT *RelationFollowListRequest
+func (p *RelationServiceRelationExistArgs) GetUserId() (v int64) {
+	return p.UserId
+}
 
-func (p *RelationServiceRelationFollowListArgs) GetReq() (v *RelationFollowListRequest) {
-	if !p.IsSetReq() {
-		return RelationServiceRelationFollowListArgs_Req_DEFAULT
-	}
-	return p.Req
+func (p *RelationServiceRelationExistArgs) GetAuthorId() (v int64) {
+	return p.AuthorId
 }
-func (p *RelationServiceRelationFollowListArgs) SetReq(val *RelationFollowListRequest) {
-	p.Req = val
+func (p *RelationServiceRelationExistArgs) SetUserId(val int64) {
+	p.UserId = val
 }
-
-var fieldIDToName_RelationServiceRelationFollowListArgs = map[int16]string{
-	1: "req",
+func (p *RelationServiceRelationExistArgs) SetAuthorId(val int64) {
+	p.AuthorId = val
 }
 
-func (p *RelationServiceRelationFollowListArgs) IsSetReq() bool {
-	return p.Req != nil
+var fieldIDToName_RelationServiceRelationExistArgs = map[int16]string{
+	1: "user_id",
+	2: "author_id",
 }
 
-func (p *RelationServiceRelationFollowListArgs) Read(iprot thrift.TProtocol) (err error) {
+func (p *RelationServiceRelationExistArgs) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -3101,13 +7192,21 @@ func (p *RelationServiceRelationFollowListArgs) Read(iprot thrift.TProtocol) (er
 
 		switch fieldId {
 		case 1:
-			if fieldTypeId == thrift.STRUCT {
+			if fieldTypeId == thrift.I64 {
 				if err = p.ReadField1(iprot); err != nil {
 					goto ReadFieldError
 				}
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
+		case 2:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
 		default:
 			if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
@@ -3127,7 +7226,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_RelationServiceRelationFollowListArgs[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_RelationServiceRelationExistArgs[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -3137,18 +7236,32 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *RelationServiceRelationFollowListArgs) ReadField1(iprot thrift.TProtocol) error {
-	_field := NewRelationFollowListRequest()
-	if err := _field.Read(iprot); err != nil {
+func (p *RelationServiceRelationExistArgs) ReadField1(iprot thrift.TProtocol) error {
+
+	var _field int64
+	if v, err := iprot.ReadI64(); err != nil {
 		return err
+	} else {
+		_field = v
 	}
-	p.Req = _field
+	p.UserId = _field
 	return nil
 }
+func (p *RelationServiceRelationExistArgs) ReadField2(iprot thrift.TProtocol) error {
 
-func (p *RelationServiceRelationFollowListArgs) Write(oprot thrift.TProtocol) (err error) {
+	var _field int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.AuthorId = _field
+	return nil
+}
+
+func (p *RelationServiceRelationExistArgs) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("RelationFollowList_args"); err != nil {
+	if err = oprot.WriteStructBegin("RelationExist_args"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -3156,6 +7269,10 @@ func (p *RelationServiceRelationFollowListArgs) Write(oprot thrift.TProtocol) (e
 			fieldId = 1
 			goto WriteFieldError
 		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
 	}
 	if err = oprot.WriteFieldStop(); err != nil {
 		goto WriteFieldStopError
@@ -3174,11 +7291,11 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *RelationServiceRelationFollowListArgs) writeField1(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
+func (p *RelationServiceRelationExistArgs) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("user_id", thrift.I64, 1); err != nil {
 		goto WriteFieldBeginError
 	}
-	if err := p.Req.Write(oprot); err != nil {
+	if err := oprot.WriteI64(p.UserId); err != nil {
 		return err
 	}
 	if err = oprot.WriteFieldEnd(); err != nil {
@@ -3191,67 +7308,94 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *RelationServiceRelationFollowListArgs) String() string {
+func (p *RelationServiceRelationExistArgs) writeField2(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("author_id", thrift.I64, 2); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI64(p.AuthorId); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+
+func (p *RelationServiceRelationExistArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("RelationServiceRelationFollowListArgs(%+v)", *p)
+	return fmt.Sprintf("RelationServiceRelationExistArgs(%+v)", *p)
 
 }
 
-func (p *RelationServiceRelationFollowListArgs) DeepEqual(ano *RelationServiceRelationFollowListArgs) bool {
+func (p *RelationServiceRelationExistArgs) DeepEqual(ano *RelationServiceRelationExistArgs) bool {
 	if p == ano {
 		return true
 	} else if p == nil || ano == nil {
 		return false
 	}
-	if !p.Field1DeepEqual(ano.Req) {
+	if !p.Field1DeepEqual(ano.UserId) {
+		return false
+	}
+	if !p.Field2DeepEqual(ano.AuthorId) {
 		return false
 	}
 	return true
 }
 
-func (p *RelationServiceRelationFollowListArgs) Field1DeepEqual(src *RelationFollowListRequest) bool {
+func (p *RelationServiceRelationExistArgs) Field1DeepEqual(src int64) bool {
 
-	if !p.Req.DeepEqual(src) {
+	if p.UserId != src {
 		return false
 	}
 	return true
 }
+func (p *RelationServiceRelationExistArgs) Field2DeepEqual(src int64) bool {
 
-type RelationServiceRelationFollowListResult struct {
-	Success *RelationFollowListResponse `thrift:"success,0,optional" frugal:"0,optional,RelationFollowListResponse" json:"success,omitempty"`
+	if p.AuthorId != src {
+		return false
+	}
+	return true
 }
 
-func NewRelationServiceRelationFollowListResult() *RelationServiceRelationFollowListResult {
-	return &RelationServiceRelationFollowListResult{}
+type RelationServiceRelationExistResult struct {
+	Success *bool `thrift:"success,0,optional" frugal:"0,optional,bool" json:"success,omitempty"`
 }
 
-func (p *RelationServiceRelationFollowListResult) InitDefault() {
-	*p = RelationServiceRelationFollowListResult{}
+func NewRelationServiceRelationExistResult() *RelationServiceRelationExistResult {
+	return &RelationServiceRelationExistResult{}
 }
 
-var RelationServiceRelationFollowListResult_Success_DEFAULT *RelationFollowListResponse
+func (p *RelationServiceRelationExistResult) InitDefault() {
+	*p = RelationServiceRelationExistResult{}
+}
 
-func (p *RelationServiceRelationFollowListResult) GetSuccess() (v *RelationFollowListResponse) {
+var RelationServiceRelationExistResult_Success_DEFAULT bool
+
+func (p *RelationServiceRelationExistResult) GetSuccess() (v bool) {
 	if !p.IsSetSuccess() {
-		return RelationServiceRelationFollowListResult_Success_DEFAULT
+		return RelationServiceRelationExistResult_Success_DEFAULT
 	}
-	return p.Success
+	return *p.Success
 }
-func (p *RelationServiceRelationFollowListResult) SetSuccess(x interface{}) {
-	p.Success = x.(*RelationFollowListResponse)
+func (p *RelationServiceRelationExistResult) SetSuccess(x interface{}) {
+	p.Success = x.(*bool)
 }
 
-var fieldIDToName_RelationServiceRelationFollowListResult = map[int16]string{
+var fieldIDToName_RelationServiceRelationExistResult = map[int16]string{
 	0: "success",
 }
 
-func (p *RelationServiceRelationFollowListResult) IsSetSuccess() bool {
+func (p *RelationServiceRelationExistResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *RelationServiceRelationFollowListResult) Read(iprot thrift.TProtocol) (err error) {
+func (p *RelationServiceRelationExistResult) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -3271,7 +7415,7 @@ func (p *RelationServiceRelationFollowListResult) Read(iprot thrift.TProtocol) (
 
 		switch fieldId {
 		case 0:
-			if fieldTypeId == thrift.STRUCT {
+			if fieldTypeId == thrift.BOOL {
 				if err = p.ReadField0(iprot); err != nil {
 					goto ReadFieldError
 				}
@@ -3297,7 +7441,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_RelationServiceRelationFollowListResult[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_RelationServiceRelationExistResult[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -3307,18 +7451,21 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *RelationServiceRelationFollowListResult) ReadField0(iprot thrift.TProtocol) error {
-	_field := NewRelationFollowListResponse()
-	if err := _field.Read(iprot); err != nil {
+func (p *RelationServiceRelationExistResult) ReadField0(iprot thrift.TProtocol) error {
+
+	var _field *bool
+	if v, err := iprot.ReadBool(); err != nil {
 		return err
+	} else {
+		_field = &v
 	}
 	p.Success = _field
 	return nil
 }
 
-func (p *RelationServiceRelationFollowListResult) Write(oprot thrift.TProtocol) (err error) {
+func (p *RelationServiceRelationExistResult) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("RelationFollowList_result"); err != nil {
+	if err = oprot.WriteStructBegin("RelationExist_result"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -3344,12 +7491,12 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *RelationServiceRelationFollowListResult) writeField0(oprot thrift.TProtocol) (err error) {
+func (p *RelationServiceRelationExistResult) writeField0(oprot thrift.TProtocol) (err error) {
 	if p.IsSetSuccess() {
-		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
+		if err = oprot.WriteFieldBegin("success", thrift.BOOL, 0); err != nil {
 			goto WriteFieldBeginError
 		}
-		if err := p.Success.Write(oprot); err != nil {
+		if err := oprot.WriteBool(*p.Success); err != nil {
 			return err
 		}
 		if err = oprot.WriteFieldEnd(); err != nil {
@@ -3363,15 +7510,15 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
 }
 
-func (p *RelationServiceRelationFollowListResult) String() string {
+func (p *RelationServiceRelationExistResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("RelationServiceRelationFollowListResult(%+v)", *p)
+	return fmt.Sprintf("RelationServiceRelationExistResult(%+v)", *p)
 
 }
 
-func (p *RelationServiceRelationFollowListResult) DeepEqual(ano *RelationServiceRelationFollowListResult) bool {
+func (p *RelationServiceRelationExistResult) DeepEqual(ano *RelationServiceRelationExistResult) bool {
 	if p == ano {
 		return true
 	} else if p == nil || ano == nil {
@@ -3383,47 +7530,43 @@ func (p *RelationServiceRelationFollowListResult) DeepEqual(ano *RelationService
 	return true
 }
 
-func (p *RelationServiceRelationFollowListResult) Field0DeepEqual(src *RelationFollowListResponse) bool {
+func (p *RelationServiceRelationExistResult) Field0DeepEqual(src *bool) bool {
 
-	if !p.Success.DeepEqual(src) {
+	if p.Success == src {
+		return true
+	} else if p.Success == nil || src == nil {
+		return false
+	}
+	if *p.Success != *src {
 		return false
 	}
 	return true
 }
 
-type RelationServiceRelationFollowerListArgs struct {
-	Req *RelationFollowerListRequest `thrift:"req,1" frugal:"1,default,RelationFollowerListRequest" json:"req"`
+type RelationServiceFollowCntArgs struct {
+	UserId int64 `thrift:"user_id,1" frugal:"1,default,i64" json:"user_id"`
 }
 
-func NewRelationServiceRelationFollowerListArgs() *RelationServiceRelationFollowerListArgs {
-	return &RelationServiceRelationFollowerListArgs{}
+func NewRelationServiceFollowCntArgs() *RelationServiceFollowCntArgs {
+	return &RelationServiceFollowCntArgs{}
 }
 
-func (p *RelationServiceRelationFollowerListArgs) InitDefault() {
-	*p = RelationServiceRelationFollowerListArgs{}
+func (p *RelationServiceFollowCntArgs) InitDefault() {
+	*p = RelationServiceFollowCntArgs{}
 }
 
-var RelationServiceRelationFollowerListArgs_Req_DEFAULT *RelationFollowerListRequest
-
-func (p *RelationServiceRelationFollowerListArgs) GetReq() (v *RelationFollowerListRequest) {
-	if !p.IsSetReq() {
-		return RelationServiceRelationFollowerListArgs_Req_DEFAULT
-	}
-	return p.Req
-}
-func (p *RelationServiceRelationFollowerListArgs) SetReq(val *RelationFollowerListRequest) {
-	p.Req = val
+func (p *RelationServiceFollowCntArgs) GetUserId() (v int64) {
+	return p.UserId
 }
-
-var fieldIDToName_RelationServiceRelationFollowerListArgs = map[int16]string{
-	1: "req",
+func (p *RelationServiceFollowCntArgs) SetUserId(val int64) {
+	p.UserId = val
 }
 
-func (p *RelationServiceRelationFollowerListArgs) IsSetReq() bool {
-	return p.Req != nil
+var fieldIDToName_RelationServiceFollowCntArgs = map[int16]string{
+	1: "user_id",
 }
 
-func (p *RelationServiceRelationFollowerListArgs) Read(iprot thrift.TProtocol) (err error) {
+func (p *RelationServiceFollowCntArgs) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -3443,7 +7586,7 @@ func (p *RelationServiceRelationFollowerListArgs) Read(iprot thrift.TProtocol) (
 
 		switch fieldId {
 		case 1:
-			if fieldTypeId == thrift.STRUCT {
+			if fieldTypeId == thrift.I64 {
 				if err = p.ReadField1(iprot); err != nil {
 					goto ReadFieldError
 				}
@@ -3469,7 +7612,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_RelationServiceRelationFollowerListArgs[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_RelationServiceFollowCntArgs[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -3479,18 +7622,21 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *RelationServiceRelationFollowerListArgs) ReadField1(iprot thrift.TProtocol) error {
-	_field := NewRelationFollowerListRequest()
-	if err := _field.Read(iprot); err != nil {
+func (p *RelationServiceFollowCntArgs) ReadField1(iprot thrift.TProtocol) error {
+
+	var _field int64
+	if v, err := iprot.ReadI64(); err != nil {
 		return err
+	} else {
+		_field = v
 	}
-	p.Req = _field
+	p.UserId = _field
 	return nil
 }
 
-func (p *RelationServiceRelationFollowerListArgs) Write(oprot thrift.TProtocol) (err error) {
+func (p *RelationServiceFollowCntArgs) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("RelationFollowerList_args"); err != nil {
+	if err = oprot.WriteStructBegin("FollowCnt_args"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -3516,11 +7662,11 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *RelationServiceRelationFollowerListArgs) writeField1(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
+func (p *RelationServiceFollowCntArgs) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("user_id", thrift.I64, 1); err != nil {
 		goto WriteFieldBeginError
 	}
-	if err := p.Req.Write(oprot); err != nil {
+	if err := oprot.WriteI64(p.UserId); err != nil {
 		return err
 	}
 	if err = oprot.WriteFieldEnd(); err != nil {
@@ -3533,67 +7679,67 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *RelationServiceRelationFollowerListArgs) String() string {
+func (p *RelationServiceFollowCntArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("RelationServiceRelationFollowerListArgs(%+v)", *p)
+	return fmt.Sprintf("RelationServiceFollowCntArgs(%+v)", *p)
 
 }
 
-func (p *RelationServiceRelationFollowerListArgs) DeepEqual(ano *RelationServiceRelationFollowerListArgs) bool {
+func (p *RelationServiceFollowCntArgs) DeepEqual(ano *RelationServiceFollowCntArgs) bool {
 	if p == ano {
 		return true
 	} else if p == nil || ano == nil {
 		return false
 	}
-	if !p.Field1DeepEqual(ano.Req) {
+	if !p.Field1DeepEqual(ano.UserId) {
 		return false
 	}
 	return true
 }
 
-func (p *RelationServiceRelationFollowerListArgs) Field1DeepEqual(src *RelationFollowerListRequest) bool {
+func (p *RelationServiceFollowCntArgs) Field1DeepEqual(src int64) bool {
 
-	if !p.Req.DeepEqual(src) {
+	if p.UserId != src {
 		return false
 	}
 	return true
 }
 
-type RelationServiceRelationFollowerListResult struct {
-	Success *RelationFollowerListResponse `thrift:"success,0,optional" frugal:"0,optional,RelationFollowerListResponse" json:"success,omitempty"`
+type RelationServiceFollowCntResult struct {
+	Success *int64 `thrift:"success,0,optional" frugal:"0,optional,i64" json:"success,omitempty"`
 }
 
-func NewRelationServiceRelationFollowerListResult() *RelationServiceRelationFollowerListResult {
-	return &RelationServiceRelationFollowerListResult{}
+func NewRelationServiceFollowCntResult() *RelationServiceFollowCntResult {
+	return &RelationServiceFollowCntResult{}
 }
 
-func (p *RelationServiceRelationFollowerListResult) InitDefault() {
-	*p = RelationServiceRelationFollowerListResult{}
+func (p *RelationServiceFollowCntResult) InitDefault() {
+	*p = RelationServiceFollowCntResult{}
 }
 
-var RelationServiceRelationFollowerListResult_Success_DEFAULT *RelationFollowerListResponse
+var RelationServiceFollowCntResult_Success_DEFAULT int64
 
-func (p *RelationServiceRelationFollowerListResult) GetSuccess() (v *RelationFollowerListResponse) {
+func (p *RelationServiceFollowCntResult) GetSuccess() (v int64) {
 	if !p.IsSetSuccess() {
-		return RelationServiceRelationFollowerListResult_Success_DEFAULT
+		return RelationServiceFollowCntResult_Success_DEFAULT
 	}
-	return p.Success
+	return *p.Success
 }
-func (p *RelationServiceRelationFollowerListResult) SetSuccess(x interface{}) {
-	p.Success = x.(*RelationFollowerListResponse)
+func (p *RelationServiceFollowCntResult) SetSuccess(x interface{}) {
+	p.Success = x.(*int64)
 }
 
-var fieldIDToName_RelationServiceRelationFollowerListResult = map[int16]string{
+var fieldIDToName_RelationServiceFollowCntResult = map[int16]string{
 	0: "success",
 }
 
-func (p *RelationServiceRelationFollowerListResult) IsSetSuccess() bool {
+func (p *RelationServiceFollowCntResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *RelationServiceRelationFollowerListResult) Read(iprot thrift.TProtocol) (err error) {
+func (p *RelationServiceFollowCntResult) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -3613,7 +7759,7 @@ func (p *RelationServiceRelationFollowerListResult) Read(iprot thrift.TProtocol)
 
 		switch fieldId {
 		case 0:
-			if fieldTypeId == thrift.STRUCT {
+			if fieldTypeId == thrift.I64 {
 				if err = p.ReadField0(iprot); err != nil {
 					goto ReadFieldError
 				}
@@ -3639,7 +7785,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_RelationServiceRelationFollowerListResult[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_RelationServiceFollowCntResult[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -3649,18 +7795,21 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *RelationServiceRelationFollowerListResult) ReadField0(iprot thrift.TProtocol) error {
-	_field := NewRelationFollowerListResponse()
-	if err := _field.Read(iprot); err != nil {
+func (p *RelationServiceFollowCntResult) ReadField0(iprot thrift.TProtocol) error {
+
+	var _field *int64
+	if v, err := iprot.ReadI64(); err != nil {
 		return err
+	} else {
+		_field = &v
 	}
 	p.Success = _field
 	return nil
 }
 
-func (p *RelationServiceRelationFollowerListResult) Write(oprot thrift.TProtocol) (err error) {
+func (p *RelationServiceFollowCntResult) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("RelationFollowerList_result"); err != nil {
+	if err = oprot.WriteStructBegin("FollowCnt_result"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -3686,12 +7835,12 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *RelationServiceRelationFollowerListResult) writeField0(oprot thrift.TProtocol) (err error) {
+func (p *RelationServiceFollowCntResult) writeField0(oprot thrift.TProtocol) (err error) {
 	if p.IsSetSuccess() {
-		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
+		if err = oprot.WriteFieldBegin("success", thrift.I64, 0); err != nil {
 			goto WriteFieldBeginError
 		}
-		if err := p.Success.Write(oprot); err != nil {
+		if err := oprot.WriteI64(*p.Success); err != nil {
 			return err
 		}
 		if err = oprot.WriteFieldEnd(); err != nil {
@@ -3705,15 +7854,15 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
 }
 
-func (p *RelationServiceRelationFollowerListResult) String() string {
+func (p *RelationServiceFollowCntResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("RelationServiceRelationFollowerListResult(%+v)", *p)
+	return fmt.Sprintf("RelationServiceFollowCntResult(%+v)", *p)
 
 }
 
-func (p *RelationServiceRelationFollowerListResult) DeepEqual(ano *RelationServiceRelationFollowerListResult) bool {
+func (p *RelationServiceFollowCntResult) DeepEqual(ano *RelationServiceFollowCntResult) bool {
 	if p == ano {
 		return true
 	} else if p == nil || ano == nil {
@@ -3725,47 +7874,43 @@ func (p *RelationServiceRelationFollowerListResult) DeepEqual(ano *RelationServi
 	return true
 }
 
-func (p *RelationServiceRelationFollowerListResult) Field0DeepEqual(src *RelationFollowerListResponse) bool {
+func (p *RelationServiceFollowCntResult) Field0DeepEqual(src *int64) bool {
 
-	if !p.Success.DeepEqual(src) {
+	if p.Success == src {
+		return true
+	} else if p.Success == nil || src == nil {
+		return false
+	}
+	if *p.Success != *src {
 		return false
 	}
 	return true
 }
 
-type RelationServiceRelationFriendListArgs struct {
-	Req *RelationFriendListRequest `thrift:"req,1" frugal:"1,default,RelationFriendListRequest" json:"req"`
+type RelationServiceFollowerCntArgs struct {
+	UserId int64 `thrift:"user_id,1" frugal:"1,default,i64" json:"user_id"`
 }
 
-func NewRelationServiceRelationFriendListArgs() *RelationServiceRelationFriendListArgs {
-	return &RelationServiceRelationFriendListArgs{}
+func NewRelationServiceFollowerCntArgs() *RelationServiceFollowerCntArgs {
+	return &RelationServiceFollowerCntArgs{}
 }
 
-func (p *RelationServiceRelationFriendListArgs) InitDefault() {
-	*p = RelationServiceRelationFriendListArgs{}
+func (p *RelationServiceFollowerCntArgs) InitDefault() {
+	*p = RelationServiceFollowerCntArgs{}
 }
 
-var RelationServiceRelationFriendListArgs_Req_DEFAULT *RelationFriendListRequest
-
-func (p *RelationServiceRelationFriendListArgs) GetReq() (v *RelationFriendListRequest) {
-	if !p.IsSetReq() {
-		return RelationServiceRelationFriendListArgs_Req_DEFAULT
-	}
-	return p.Req
-}
-func (p *RelationServiceRelationFriendListArgs) SetReq(val *RelationFriendListRequest) {
-	p.Req = val
+func (p *RelationServiceFollowerCntArgs) GetUserId() (v int64) {
+	return p.UserId
 }
-
-var fieldIDToName_RelationServiceRelationFriendListArgs = map[int16]string{
-	1: "req",
+func (p *RelationServiceFollowerCntArgs) SetUserId(val int64) {
+	p.UserId = val
 }
 
-func (p *RelationServiceRelationFriendListArgs) IsSetReq() bool {
-	return p.Req != nil
+var fieldIDToName_RelationServiceFollowerCntArgs = map[int16]string{
+	1: "user_id",
 }
 
-func (p *RelationServiceRelationFriendListArgs) Read(iprot thrift.TProtocol) (err error) {
+func (p *RelationServiceFollowerCntArgs) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -3785,7 +7930,7 @@ func (p *RelationServiceRelationFriendListArgs) Read(iprot thrift.TProtocol) (er
 
 		switch fieldId {
 		case 1:
-			if fieldTypeId == thrift.STRUCT {
+			if fieldTypeId == thrift.I64 {
 				if err = p.ReadField1(iprot); err != nil {
 					goto ReadFieldError
 				}
@@ -3811,7 +7956,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_RelationServiceRelationFriendListArgs[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_RelationServiceFollowerCntArgs[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -3821,18 +7966,21 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *RelationServiceRelationFriendListArgs) ReadField1(iprot thrift.TProtocol) error {
-	_field := NewRelationFriendListRequest()
-	if err := _field.Read(iprot); err != nil {
+func (p *RelationServiceFollowerCntArgs) ReadField1(iprot thrift.TProtocol) error {
+
+	var _field int64
+	if v, err := iprot.ReadI64(); err != nil {
 		return err
+	} else {
+		_field = v
 	}
-	p.Req = _field
+	p.UserId = _field
 	return nil
 }
 
-func (p *RelationServiceRelationFriendListArgs) Write(oprot thrift.TProtocol) (err error) {
+func (p *RelationServiceFollowerCntArgs) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("RelationFriendList_args"); err != nil {
+	if err = oprot.WriteStructBegin("FollowerCnt_args"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -3858,11 +8006,11 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *RelationServiceRelationFriendListArgs) writeField1(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
+func (p *RelationServiceFollowerCntArgs) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("user_id", thrift.I64, 1); err != nil {
 		goto WriteFieldBeginError
 	}
-	if err := p.Req.Write(oprot); err != nil {
+	if err := oprot.WriteI64(p.UserId); err != nil {
 		return err
 	}
 	if err = oprot.WriteFieldEnd(); err != nil {
@@ -3875,67 +8023,67 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *RelationServiceRelationFriendListArgs) String() string {
+func (p *RelationServiceFollowerCntArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("RelationServiceRelationFriendListArgs(%+v)", *p)
+	return fmt.Sprintf("RelationServiceFollowerCntArgs(%+v)", *p)
 
 }
 
-func (p *RelationServiceRelationFriendListArgs) DeepEqual(ano *RelationServiceRelationFriendListArgs) bool {
+func (p *RelationServiceFollowerCntArgs) DeepEqual(ano *RelationServiceFollowerCntArgs) bool {
 	if p == ano {
 		return true
 	} else if p == nil || ano == nil {
 		return false
 	}
-	if !p.Field1DeepEqual(ano.Req) {
+	if !p.Field1DeepEqual(ano.UserId) {
 		return false
 	}
 	return true
 }
 
-func (p *RelationServiceRelationFriendListArgs) Field1DeepEqual(src *RelationFriendListRequest) bool {
+func (p *RelationServiceFollowerCntArgs) Field1DeepEqual(src int64) bool {
 
-	if !p.Req.DeepEqual(src) {
+	if p.UserId != src {
 		return false
 	}
 	return true
 }
 
-type RelationServiceRelationFriendListResult struct {
-	Success *RelationFriendListResponse `thrift:"success,0,optional" frugal:"0,optional,RelationFriendListResponse" json:"success,omitempty"`
+type RelationServiceFollowerCntResult struct {
+	Success *int64 `thrift:"success,0,optional" frugal:"0,optional,i64" json:"success,omitempty"`
 }
 
-func NewRelationServiceRelationFriendListResult() *RelationServiceRelationFriendListResult {
-	return &RelationServiceRelationFriendListResult{}
+func NewRelationServiceFollowerCntResult() *RelationServiceFollowerCntResult {
+	return &RelationServiceFollowerCntResult{}
 }
 
-func (p *RelationServiceRelationFriendListResult) InitDefault() {
-	*p = RelationServiceRelationFriendListResult{}
+func (p *RelationServiceFollowerCntResult) InitDefault() {
+	*p = RelationServiceFollowerCntResult{}
 }
 
-var RelationServiceRelationFriendListResult_Success_DEFAULT *RelationFriendListResponse
+var RelationServiceFollowerCntResult_Success_DEFAULT int64
 
-func (p *RelationServiceRelationFriendListResult) GetSuccess() (v *RelationFriendListResponse) {
+func (p *RelationServiceFollowerCntResult) GetSuccess() (v int64) {
 	if !p.IsSetSuccess() {
-		return RelationServiceRelationFriendListResult_Success_DEFAULT
+		return RelationServiceFollowerCntResult_Success_DEFAULT
 	}
-	return p.Success
+	return *p.Success
 }
-func (p *RelationServiceRelationFriendListResult) SetSuccess(x interface{}) {
-	p.Success = x.(*RelationFriendListResponse)
+func (p *RelationServiceFollowerCntResult) SetSuccess(x interface{}) {
+	p.Success = x.(*int64)
 }
 
-var fieldIDToName_RelationServiceRelationFriendListResult = map[int16]string{
+var fieldIDToName_RelationServiceFollowerCntResult = map[int16]string{
 	0: "success",
 }
 
-func (p *RelationServiceRelationFriendListResult) IsSetSuccess() bool {
+func (p *RelationServiceFollowerCntResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *RelationServiceRelationFriendListResult) Read(iprot thrift.TProtocol) (err error) {
+func (p *RelationServiceFollowerCntResult) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -3955,7 +8103,7 @@ func (p *RelationServiceRelationFriendListResult) Read(iprot thrift.TProtocol) (
 
 		switch fieldId {
 		case 0:
-			if fieldTypeId == thrift.STRUCT {
+			if fieldTypeId == thrift.I64 {
 				if err = p.ReadField0(iprot); err != nil {
 					goto ReadFieldError
 				}
@@ -3981,7 +8129,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_RelationServiceRelationFriendListResult[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_RelationServiceFollowerCntResult[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -3991,18 +8139,21 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *RelationServiceRelationFriendListResult) ReadField0(iprot thrift.TProtocol) error {
-	_field := NewRelationFriendListResponse()
-	if err := _field.Read(iprot); err != nil {
+func (p *RelationServiceFollowerCntResult) ReadField0(iprot thrift.TProtocol) error {
+
+	var _field *int64
+	if v, err := iprot.ReadI64(); err != nil {
 		return err
+	} else {
+		_field = &v
 	}
 	p.Success = _field
 	return nil
 }
 
-func (p *RelationServiceRelationFriendListResult) Write(oprot thrift.TProtocol) (err error) {
+func (p *RelationServiceFollowerCntResult) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("RelationFriendList_result"); err != nil {
+	if err = oprot.WriteStructBegin("FollowerCnt_result"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -4028,12 +8179,12 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *RelationServiceRelationFriendListResult) writeField0(oprot thrift.TProtocol) (err error) {
+func (p *RelationServiceFollowerCntResult) writeField0(oprot thrift.TProtocol) (err error) {
 	if p.IsSetSuccess() {
-		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
+		if err = oprot.WriteFieldBegin("success", thrift.I64, 0); err != nil {
 			goto WriteFieldBeginError
 		}
-		if err := p.Success.Write(oprot); err != nil {
+		if err := oprot.WriteI64(*p.Success); err != nil {
 			return err
 		}
 		if err = oprot.WriteFieldEnd(); err != nil {
@@ -4047,15 +8198,15 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
 }
 
-func (p *RelationServiceRelationFriendListResult) String() string {
+func (p *RelationServiceFollowerCntResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("RelationServiceRelationFriendListResult(%+v)", *p)
+	return fmt.Sprintf("RelationServiceFollowerCntResult(%+v)", *p)
 
 }
 
-func (p *RelationServiceRelationFriendListResult) DeepEqual(ano *RelationServiceRelationFriendListResult) bool {
+func (p *RelationServiceFollowerCntResult) DeepEqual(ano *RelationServiceFollowerCntResult) bool {
 	if p == ano {
 		return true
 	} else if p == nil || ano == nil {
@@ -4067,47 +8218,52 @@ func (p *RelationServiceRelationFriendListResult) DeepEqual(ano *RelationService
 	return true
 }
 
-func (p *RelationServiceRelationFriendListResult) Field0DeepEqual(src *RelationFriendListResponse) bool {
+func (p *RelationServiceFollowerCntResult) Field0DeepEqual(src *int64) bool {
 
-	if !p.Success.DeepEqual(src) {
+	if p.Success == src {
+		return true
+	} else if p.Success == nil || src == nil {
+		return false
+	}
+	if *p.Success != *src {
 		return false
 	}
 	return true
 }
 
-type RelationServiceRelationExistArgs struct {
-	UserId   int64 `thrift:"user_id,1" frugal:"1,default,i64" json:"user_id"`
-	AuthorId int64 `thrift:"author_id,2" frugal:"2,default,i64" json:"author_id"`
+type RelationServiceBatchRelationExistArgs struct {
+	UserId    int64   `thrift:"user_id,1" frugal:"1,default,i64" json:"user_id"`
+	AuthorIds []int64 `thrift:"author_ids,2" frugal:"2,default,list<i64>" json:"author_ids"`
 }
 
-func NewRelationServiceRelationExistArgs() *RelationServiceRelationExistArgs {
-	return &RelationServiceRelationExistArgs{}
+func NewRelationServiceBatchRelationExistArgs() *RelationServiceBatchRelationExistArgs {
+	return &RelationServiceBatchRelationExistArgs{}
 }
 
-func (p *RelationServiceRelationExistArgs) InitDefault() {
-	*p = RelationServiceRelationExistArgs{}
+func (p *RelationServiceBatchRelationExistArgs) InitDefault() {
+	*p = RelationServiceBatchRelationExistArgs{}
 }
 
-func (p *RelationServiceRelationExistArgs) GetUserId() (v int64) {
+func (p *RelationServiceBatchRelationExistArgs) GetUserId() (v int64) {
 	return p.UserId
 }
 
-func (p *RelationServiceRelationExistArgs) GetAuthorId() (v int64) {
-	return p.AuthorId
+func (p *RelationServiceBatchRelationExistArgs) GetAuthorIds() (v []int64) {
+	return p.AuthorIds
 }
-func (p *RelationServiceRelationExistArgs) SetUserId(val int64) {
+func (p *RelationServiceBatchRelationExistArgs) SetUserId(val int64) {
 	p.UserId = val
 }
-func (p *RelationServiceRelationExistArgs) SetAuthorId(val int64) {
-	p.AuthorId = val
+func (p *RelationServiceBatchRelationExistArgs) SetAuthorIds(val []int64) {
+	p.AuthorIds = val
 }
 
-var fieldIDToName_RelationServiceRelationExistArgs = map[int16]string{
+var fieldIDToName_RelationServiceBatchRelationExistArgs = map[int16]string{
 	1: "user_id",
-	2: "author_id",
+	2: "author_ids",
 }
 
-func (p *RelationServiceRelationExistArgs) Read(iprot thrift.TProtocol) (err error) {
+func (p *RelationServiceBatchRelationExistArgs) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -4135,7 +8291,7 @@ func (p *RelationServiceRelationExistArgs) Read(iprot thrift.TProtocol) (err err
 				goto SkipFieldError
 			}
 		case 2:
-			if fieldTypeId == thrift.I64 {
+			if fieldTypeId == thrift.LIST {
 				if err = p.ReadField2(iprot); err != nil {
 					goto ReadFieldError
 				}
@@ -4161,7 +8317,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_RelationServiceRelationExistArgs[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_RelationServiceBatchRelationExistArgs[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -4171,7 +8327,7 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *RelationServiceRelationExistArgs) ReadField1(iprot thrift.TProtocol) error {
+func (p *RelationServiceBatchRelationExistArgs) ReadField1(iprot thrift.TProtocol) error {
 
 	var _field int64
 	if v, err := iprot.ReadI64(); err != nil {
@@ -4182,21 +8338,33 @@ func (p *RelationServiceRelationExistArgs) ReadField1(iprot thrift.TProtocol) er
 	p.UserId = _field
 	return nil
 }
-func (p *RelationServiceRelationExistArgs) ReadField2(iprot thrift.TProtocol) error {
+func (p *RelationServiceBatchRelationExistArgs) ReadField2(iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin()
+	if err != nil {
+		return err
+	}
+	_field := make([]int64, 0, size)
+	for i := 0; i < size; i++ {
 
-	var _field int64
-	if v, err := iprot.ReadI64(); err != nil {
+		var _elem int64
+		if v, err := iprot.ReadI64(); err != nil {
+			return err
+		} else {
+			_elem = v
+		}
+
+		_field = append(_field, _elem)
+	}
+	if err := iprot.ReadListEnd(); err != nil {
 		return err
-	} else {
-		_field = v
 	}
-	p.AuthorId = _field
+	p.AuthorIds = _field
 	return nil
 }
 
-func (p *RelationServiceRelationExistArgs) Write(oprot thrift.TProtocol) (err error) {
+func (p *RelationServiceBatchRelationExistArgs) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("RelationExist_args"); err != nil {
+	if err = oprot.WriteStructBegin("BatchRelationExist_args"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -4226,7 +8394,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *RelationServiceRelationExistArgs) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *RelationServiceBatchRelationExistArgs) writeField1(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("user_id", thrift.I64, 1); err != nil {
 		goto WriteFieldBeginError
 	}
@@ -4243,11 +8411,19 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *RelationServiceRelationExistArgs) writeField2(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("author_id", thrift.I64, 2); err != nil {
+func (p *RelationServiceBatchRelationExistArgs) writeField2(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("author_ids", thrift.LIST, 2); err != nil {
 		goto WriteFieldBeginError
 	}
-	if err := oprot.WriteI64(p.AuthorId); err != nil {
+	if err := oprot.WriteListBegin(thrift.I64, len(p.AuthorIds)); err != nil {
+		return err
+	}
+	for _, v := range p.AuthorIds {
+		if err := oprot.WriteI64(v); err != nil {
+			return err
+		}
+	}
+	if err := oprot.WriteListEnd(); err != nil {
 		return err
 	}
 	if err = oprot.WriteFieldEnd(); err != nil {
@@ -4260,15 +8436,15 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
 }
 
-func (p *RelationServiceRelationExistArgs) String() string {
+func (p *RelationServiceBatchRelationExistArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("RelationServiceRelationExistArgs(%+v)", *p)
+	return fmt.Sprintf("RelationServiceBatchRelationExistArgs(%+v)", *p)
 
 }
 
-func (p *RelationServiceRelationExistArgs) DeepEqual(ano *RelationServiceRelationExistArgs) bool {
+func (p *RelationServiceBatchRelationExistArgs) DeepEqual(ano *RelationServiceBatchRelationExistArgs) bool {
 	if p == ano {
 		return true
 	} else if p == nil || ano == nil {
@@ -4277,60 +8453,66 @@ func (p *RelationServiceRelationExistArgs) DeepEqual(ano *RelationServiceRelatio
 	if !p.Field1DeepEqual(ano.UserId) {
 		return false
 	}
-	if !p.Field2DeepEqual(ano.AuthorId) {
+	if !p.Field2DeepEqual(ano.AuthorIds) {
 		return false
 	}
 	return true
 }
 
-func (p *RelationServiceRelationExistArgs) Field1DeepEqual(src int64) bool {
+func (p *RelationServiceBatchRelationExistArgs) Field1DeepEqual(src int64) bool {
 
 	if p.UserId != src {
 		return false
 	}
 	return true
 }
-func (p *RelationServiceRelationExistArgs) Field2DeepEqual(src int64) bool {
+func (p *RelationServiceBatchRelationExistArgs) Field2DeepEqual(src []int64) bool {
 
-	if p.AuthorId != src {
+	if len(p.AuthorIds) != len(src) {
 		return false
 	}
+	for i, v := range p.AuthorIds {
+		_src := src[i]
+		if v != _src {
+			return false
+		}
+	}
 	return true
 }
 
-type RelationServiceRelationExistResult struct {
-	Success *bool `thrift:"success,0,optional" frugal:"0,optional,bool" json:"success,omitempty"`
+type RelationServiceBatchRelationExistResult struct {
+	Success map[int64]bool `thrift:"success,0,optional" frugal:"0,optional,map<i64:bool>" json:"success,omitempty"`
 }
 
-func NewRelationServiceRelationExistResult() *RelationServiceRelationExistResult {
-	return &RelationServiceRelationExistResult{}
+func NewRelationServiceBatchRelationExistResult() *RelationServiceBatchRelationExistResult {
+	return &RelationServiceBatchRelationExistResult{}
 }
 
-func (p *RelationServiceRelationExistResult) InitDefault() {
-	*p = RelationServiceRelationExistResult{}
+func (p *RelationServiceBatchRelationExistResult) InitDefault() {
+	*p = RelationServiceBatchRelationExistResult{}
 }
 
-var RelationServiceRelationExistResult_Success_DEFAULT bool
+var RelationServiceBatchRelationExistResult_Success_DEFAULT map[int64]bool
 
-func (p *RelationServiceRelationExistResult) GetSuccess() (v bool) {
+func (p *RelationServiceBatchRelationExistResult) GetSuccess() (v map[int64]bool) {
 	if !p.IsSetSuccess() {
-		return RelationServiceRelationExistResult_Success_DEFAULT
+		return RelationServiceBatchRelationExistResult_Success_DEFAULT
 	}
-	return *p.Success
+	return p.Success
 }
-func (p *RelationServiceRelationExistResult) SetSuccess(x interface{}) {
-	p.Success = x.(*bool)
+func (p *RelationServiceBatchRelationExistResult) SetSuccess(x interface{}) {
+	p.Success = x.(map[int64]bool)
 }
 
-var fieldIDToName_RelationServiceRelationExistResult = map[int16]string{
+var fieldIDToName_RelationServiceBatchRelationExistResult = map[int16]string{
 	0: "success",
 }
 
-func (p *RelationServiceRelationExistResult) IsSetSuccess() bool {
+func (p *RelationServiceBatchRelationExistResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *RelationServiceRelationExistResult) Read(iprot thrift.TProtocol) (err error) {
+func (p *RelationServiceBatchRelationExistResult) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -4350,7 +8532,7 @@ func (p *RelationServiceRelationExistResult) Read(iprot thrift.TProtocol) (err e
 
 		switch fieldId {
 		case 0:
-			if fieldTypeId == thrift.BOOL {
+			if fieldTypeId == thrift.MAP {
 				if err = p.ReadField0(iprot); err != nil {
 					goto ReadFieldError
 				}
@@ -4376,7 +8558,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_RelationServiceRelationExistResult[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_RelationServiceBatchRelationExistResult[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -4386,21 +8568,39 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *RelationServiceRelationExistResult) ReadField0(iprot thrift.TProtocol) error {
+func (p *RelationServiceBatchRelationExistResult) ReadField0(iprot thrift.TProtocol) error {
+	_, _, size, err := iprot.ReadMapBegin()
+	if err != nil {
+		return err
+	}
+	_field := make(map[int64]bool, size)
+	for i := 0; i < size; i++ {
+		var _key int64
+		if v, err := iprot.ReadI64(); err != nil {
+			return err
+		} else {
+			_key = v
+		}
 
-	var _field *bool
-	if v, err := iprot.ReadBool(); err != nil {
+		var _val bool
+		if v, err := iprot.ReadBool(); err != nil {
+			return err
+		} else {
+			_val = v
+		}
+
+		_field[_key] = _val
+	}
+	if err := iprot.ReadMapEnd(); err != nil {
 		return err
-	} else {
-		_field = &v
 	}
 	p.Success = _field
 	return nil
 }
 
-func (p *RelationServiceRelationExistResult) Write(oprot thrift.TProtocol) (err error) {
+func (p *RelationServiceBatchRelationExistResult) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("RelationExist_result"); err != nil {
+	if err = oprot.WriteStructBegin("BatchRelationExist_result"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -4426,12 +8626,23 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *RelationServiceRelationExistResult) writeField0(oprot thrift.TProtocol) (err error) {
+func (p *RelationServiceBatchRelationExistResult) writeField0(oprot thrift.TProtocol) (err error) {
 	if p.IsSetSuccess() {
-		if err = oprot.WriteFieldBegin("success", thrift.BOOL, 0); err != nil {
+		if err = oprot.WriteFieldBegin("success", thrift.MAP, 0); err != nil {
 			goto WriteFieldBeginError
 		}
-		if err := oprot.WriteBool(*p.Success); err != nil {
+		if err := oprot.WriteMapBegin(thrift.I64, thrift.BOOL, len(p.Success)); err != nil {
+			return err
+		}
+		for k, v := range p.Success {
+			if err := oprot.WriteI64(k); err != nil {
+				return err
+			}
+			if err := oprot.WriteBool(v); err != nil {
+				return err
+			}
+		}
+		if err := oprot.WriteMapEnd(); err != nil {
 			return err
 		}
 		if err = oprot.WriteFieldEnd(); err != nil {
@@ -4445,15 +8656,15 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
 }
 
-func (p *RelationServiceRelationExistResult) String() string {
+func (p *RelationServiceBatchRelationExistResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("RelationServiceRelationExistResult(%+v)", *p)
+	return fmt.Sprintf("RelationServiceBatchRelationExistResult(%+v)", *p)
 
 }
 
-func (p *RelationServiceRelationExistResult) DeepEqual(ano *RelationServiceRelationExistResult) bool {
+func (p *RelationServiceBatchRelationExistResult) DeepEqual(ano *RelationServiceBatchRelationExistResult) bool {
 	if p == ano {
 		return true
 	} else if p == nil || ano == nil {
@@ -4465,43 +8676,44 @@ func (p *RelationServiceRelationExistResult) DeepEqual(ano *RelationServiceRelat
 	return true
 }
 
-func (p *RelationServiceRelationExistResult) Field0DeepEqual(src *bool) bool {
+func (p *RelationServiceBatchRelationExistResult) Field0DeepEqual(src map[int64]bool) bool {
 
-	if p.Success == src {
-		return true
-	} else if p.Success == nil || src == nil {
+	if len(p.Success) != len(src) {
 		return false
 	}
-	if *p.Success != *src {
-		return false
+	for k, v := range p.Success {
+		_src := src[k]
+		if v != _src {
+			return false
+		}
 	}
 	return true
 }
 
-type RelationServiceFollowCntArgs struct {
-	UserId int64 `thrift:"user_id,1" frugal:"1,default,i64" json:"user_id"`
+type RelationServiceBatchFollowCntArgs struct {
+	UserIds []int64 `thrift:"user_ids,1" frugal:"1,default,list<i64>" json:"user_ids"`
 }
 
-func NewRelationServiceFollowCntArgs() *RelationServiceFollowCntArgs {
-	return &RelationServiceFollowCntArgs{}
+func NewRelationServiceBatchFollowCntArgs() *RelationServiceBatchFollowCntArgs {
+	return &RelationServiceBatchFollowCntArgs{}
 }
 
-func (p *RelationServiceFollowCntArgs) InitDefault() {
-	*p = RelationServiceFollowCntArgs{}
+func (p *RelationServiceBatchFollowCntArgs) InitDefault() {
+	*p = RelationServiceBatchFollowCntArgs{}
 }
 
-func (p *RelationServiceFollowCntArgs) GetUserId() (v int64) {
-	return p.UserId
+func (p *RelationServiceBatchFollowCntArgs) GetUserIds() (v []int64) {
+	return p.UserIds
 }
-func (p *RelationServiceFollowCntArgs) SetUserId(val int64) {
-	p.UserId = val
+func (p *RelationServiceBatchFollowCntArgs) SetUserIds(val []int64) {
+	p.UserIds = val
 }
 
-var fieldIDToName_RelationServiceFollowCntArgs = map[int16]string{
-	1: "user_id",
+var fieldIDToName_RelationServiceBatchFollowCntArgs = map[int16]string{
+	1: "user_ids",
 }
 
-func (p *RelationServiceFollowCntArgs) Read(iprot thrift.TProtocol) (err error) {
+func (p *RelationServiceBatchFollowCntArgs) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -4521,7 +8733,7 @@ func (p *RelationServiceFollowCntArgs) Read(iprot thrift.TProtocol) (err error)
 
 		switch fieldId {
 		case 1:
-			if fieldTypeId == thrift.I64 {
+			if fieldTypeId == thrift.LIST {
 				if err = p.ReadField1(iprot); err != nil {
 					goto ReadFieldError
 				}
@@ -4547,7 +8759,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_RelationServiceFollowCntArgs[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_RelationServiceBatchFollowCntArgs[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -4557,21 +8769,33 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *RelationServiceFollowCntArgs) ReadField1(iprot thrift.TProtocol) error {
+func (p *RelationServiceBatchFollowCntArgs) ReadField1(iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin()
+	if err != nil {
+		return err
+	}
+	_field := make([]int64, 0, size)
+	for i := 0; i < size; i++ {
 
-	var _field int64
-	if v, err := iprot.ReadI64(); err != nil {
+		var _elem int64
+		if v, err := iprot.ReadI64(); err != nil {
+			return err
+		} else {
+			_elem = v
+		}
+
+		_field = append(_field, _elem)
+	}
+	if err := iprot.ReadListEnd(); err != nil {
 		return err
-	} else {
-		_field = v
 	}
-	p.UserId = _field
+	p.UserIds = _field
 	return nil
 }
 
-func (p *RelationServiceFollowCntArgs) Write(oprot thrift.TProtocol) (err error) {
+func (p *RelationServiceBatchFollowCntArgs) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("FollowCnt_args"); err != nil {
+	if err = oprot.WriteStructBegin("BatchFollowCnt_args"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -4597,11 +8821,19 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *RelationServiceFollowCntArgs) writeField1(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("user_id", thrift.I64, 1); err != nil {
+func (p *RelationServiceBatchFollowCntArgs) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("user_ids", thrift.LIST, 1); err != nil {
 		goto WriteFieldBeginError
 	}
-	if err := oprot.WriteI64(p.UserId); err != nil {
+	if err := oprot.WriteListBegin(thrift.I64, len(p.UserIds)); err != nil {
+		return err
+	}
+	for _, v := range p.UserIds {
+		if err := oprot.WriteI64(v); err != nil {
+			return err
+		}
+	}
+	if err := oprot.WriteListEnd(); err != nil {
 		return err
 	}
 	if err = oprot.WriteFieldEnd(); err != nil {
@@ -4614,67 +8846,73 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *RelationServiceFollowCntArgs) String() string {
+func (p *RelationServiceBatchFollowCntArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("RelationServiceFollowCntArgs(%+v)", *p)
+	return fmt.Sprintf("RelationServiceBatchFollowCntArgs(%+v)", *p)
 
 }
 
-func (p *RelationServiceFollowCntArgs) DeepEqual(ano *RelationServiceFollowCntArgs) bool {
+func (p *RelationServiceBatchFollowCntArgs) DeepEqual(ano *RelationServiceBatchFollowCntArgs) bool {
 	if p == ano {
 		return true
 	} else if p == nil || ano == nil {
 		return false
 	}
-	if !p.Field1DeepEqual(ano.UserId) {
+	if !p.Field1DeepEqual(ano.UserIds) {
 		return false
 	}
 	return true
 }
 
-func (p *RelationServiceFollowCntArgs) Field1DeepEqual(src int64) bool {
+func (p *RelationServiceBatchFollowCntArgs) Field1DeepEqual(src []int64) bool {
 
-	if p.UserId != src {
+	if len(p.UserIds) != len(src) {
 		return false
 	}
+	for i, v := range p.UserIds {
+		_src := src[i]
+		if v != _src {
+			return false
+		}
+	}
 	return true
 }
 
-type RelationServiceFollowCntResult struct {
-	Success *int64 `thrift:"success,0,optional" frugal:"0,optional,i64" json:"success,omitempty"`
+type RelationServiceBatchFollowCntResult struct {
+	Success map[int64]int64 `thrift:"success,0,optional" frugal:"0,optional,map<i64:i64>" json:"success,omitempty"`
 }
 
-func NewRelationServiceFollowCntResult() *RelationServiceFollowCntResult {
-	return &RelationServiceFollowCntResult{}
+func NewRelationServiceBatchFollowCntResult() *RelationServiceBatchFollowCntResult {
+	return &RelationServiceBatchFollowCntResult{}
 }
 
-func (p *RelationServiceFollowCntResult) InitDefault() {
-	*p = RelationServiceFollowCntResult{}
+func (p *RelationServiceBatchFollowCntResult) InitDefault() {
+	*p = RelationServiceBatchFollowCntResult{}
 }
 
-var RelationServiceFollowCntResult_Success_DEFAULT int64
+var RelationServiceBatchFollowCntResult_Success_DEFAULT map[int64]int64
 
-func (p *RelationServiceFollowCntResult) GetSuccess() (v int64) {
+func (p *RelationServiceBatchFollowCntResult) GetSuccess() (v map[int64]int64) {
 	if !p.IsSetSuccess() {
-		return RelationServiceFollowCntResult_Success_DEFAULT
+		return RelationServiceBatchFollowCntResult_Success_DEFAULT
 	}
-	return *p.Success
+	return p.Success
 }
-func (p *RelationServiceFollowCntResult) SetSuccess(x interface{}) {
-	p.Success = x.(*int64)
+func (p *RelationServiceBatchFollowCntResult) SetSuccess(x interface{}) {
+	p.Success = x.(map[int64]int64)
 }
 
-var fieldIDToName_RelationServiceFollowCntResult = map[int16]string{
+var fieldIDToName_RelationServiceBatchFollowCntResult = map[int16]string{
 	0: "success",
 }
 
-func (p *RelationServiceFollowCntResult) IsSetSuccess() bool {
+func (p *RelationServiceBatchFollowCntResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *RelationServiceFollowCntResult) Read(iprot thrift.TProtocol) (err error) {
+func (p *RelationServiceBatchFollowCntResult) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -4694,7 +8932,7 @@ func (p *RelationServiceFollowCntResult) Read(iprot thrift.TProtocol) (err error
 
 		switch fieldId {
 		case 0:
-			if fieldTypeId == thrift.I64 {
+			if fieldTypeId == thrift.MAP {
 				if err = p.ReadField0(iprot); err != nil {
 					goto ReadFieldError
 				}
@@ -4720,7 +8958,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_RelationServiceFollowCntResult[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_RelationServiceBatchFollowCntResult[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -4730,21 +8968,39 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *RelationServiceFollowCntResult) ReadField0(iprot thrift.TProtocol) error {
+func (p *RelationServiceBatchFollowCntResult) ReadField0(iprot thrift.TProtocol) error {
+	_, _, size, err := iprot.ReadMapBegin()
+	if err != nil {
+		return err
+	}
+	_field := make(map[int64]int64, size)
+	for i := 0; i < size; i++ {
+		var _key int64
+		if v, err := iprot.ReadI64(); err != nil {
+			return err
+		} else {
+			_key = v
+		}
 
-	var _field *int64
-	if v, err := iprot.ReadI64(); err != nil {
+		var _val int64
+		if v, err := iprot.ReadI64(); err != nil {
+			return err
+		} else {
+			_val = v
+		}
+
+		_field[_key] = _val
+	}
+	if err := iprot.ReadMapEnd(); err != nil {
 		return err
-	} else {
-		_field = &v
 	}
 	p.Success = _field
 	return nil
 }
 
-func (p *RelationServiceFollowCntResult) Write(oprot thrift.TProtocol) (err error) {
+func (p *RelationServiceBatchFollowCntResult) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("FollowCnt_result"); err != nil {
+	if err = oprot.WriteStructBegin("BatchFollowCnt_result"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -4770,12 +9026,23 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *RelationServiceFollowCntResult) writeField0(oprot thrift.TProtocol) (err error) {
+func (p *RelationServiceBatchFollowCntResult) writeField0(oprot thrift.TProtocol) (err error) {
 	if p.IsSetSuccess() {
-		if err = oprot.WriteFieldBegin("success", thrift.I64, 0); err != nil {
+		if err = oprot.WriteFieldBegin("success", thrift.MAP, 0); err != nil {
 			goto WriteFieldBeginError
 		}
-		if err := oprot.WriteI64(*p.Success); err != nil {
+		if err := oprot.WriteMapBegin(thrift.I64, thrift.I64, len(p.Success)); err != nil {
+			return err
+		}
+		for k, v := range p.Success {
+			if err := oprot.WriteI64(k); err != nil {
+				return err
+			}
+			if err := oprot.WriteI64(v); err != nil {
+				return err
+			}
+		}
+		if err := oprot.WriteMapEnd(); err != nil {
 			return err
 		}
 		if err = oprot.WriteFieldEnd(); err != nil {
@@ -4789,15 +9056,15 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
 }
 
-func (p *RelationServiceFollowCntResult) String() string {
+func (p *RelationServiceBatchFollowCntResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("RelationServiceFollowCntResult(%+v)", *p)
+	return fmt.Sprintf("RelationServiceBatchFollowCntResult(%+v)", *p)
 
 }
 
-func (p *RelationServiceFollowCntResult) DeepEqual(ano *RelationServiceFollowCntResult) bool {
+func (p *RelationServiceBatchFollowCntResult) DeepEqual(ano *RelationServiceBatchFollowCntResult) bool {
 	if p == ano {
 		return true
 	} else if p == nil || ano == nil {
@@ -4809,43 +9076,44 @@ func (p *RelationServiceFollowCntResult) DeepEqual(ano *RelationServiceFollowCnt
 	return true
 }
 
-func (p *RelationServiceFollowCntResult) Field0DeepEqual(src *int64) bool {
+func (p *RelationServiceBatchFollowCntResult) Field0DeepEqual(src map[int64]int64) bool {
 
-	if p.Success == src {
-		return true
-	} else if p.Success == nil || src == nil {
+	if len(p.Success) != len(src) {
 		return false
 	}
-	if *p.Success != *src {
-		return false
+	for k, v := range p.Success {
+		_src := src[k]
+		if v != _src {
+			return false
+		}
 	}
 	return true
 }
 
-type RelationServiceFollowerCntArgs struct {
-	UserId int64 `thrift:"user_id,1" frugal:"1,default,i64" json:"user_id"`
+type RelationServiceBatchFollowerCntArgs struct {
+	UserIds []int64 `thrift:"user_ids,1" frugal:"1,default,list<i64>" json:"user_ids"`
 }
 
-func NewRelationServiceFollowerCntArgs() *RelationServiceFollowerCntArgs {
-	return &RelationServiceFollowerCntArgs{}
+func NewRelationServiceBatchFollowerCntArgs() *RelationServiceBatchFollowerCntArgs {
+	return &RelationServiceBatchFollowerCntArgs{}
 }
 
-func (p *RelationServiceFollowerCntArgs) InitDefault() {
-	*p = RelationServiceFollowerCntArgs{}
+func (p *RelationServiceBatchFollowerCntArgs) InitDefault() {
+	*p = RelationServiceBatchFollowerCntArgs{}
 }
 
-func (p *RelationServiceFollowerCntArgs) GetUserId() (v int64) {
-	return p.UserId
+func (p *RelationServiceBatchFollowerCntArgs) GetUserIds() (v []int64) {
+	return p.UserIds
 }
-func (p *RelationServiceFollowerCntArgs) SetUserId(val int64) {
-	p.UserId = val
+func (p *RelationServiceBatchFollowerCntArgs) SetUserIds(val []int64) {
+	p.UserIds = val
 }
 
-var fieldIDToName_RelationServiceFollowerCntArgs = map[int16]string{
-	1: "user_id",
+var fieldIDToName_RelationServiceBatchFollowerCntArgs = map[int16]string{
+	1: "user_ids",
 }
 
-func (p *RelationServiceFollowerCntArgs) Read(iprot thrift.TProtocol) (err error) {
+func (p *RelationServiceBatchFollowerCntArgs) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -4865,7 +9133,7 @@ func (p *RelationServiceFollowerCntArgs) Read(iprot thrift.TProtocol) (err error
 
 		switch fieldId {
 		case 1:
-			if fieldTypeId == thrift.I64 {
+			if fieldTypeId == thrift.LIST {
 				if err = p.ReadField1(iprot); err != nil {
 					goto ReadFieldError
 				}
@@ -4891,7 +9159,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_RelationServiceFollowerCntArgs[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_RelationServiceBatchFollowerCntArgs[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -4901,21 +9169,33 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *RelationServiceFollowerCntArgs) ReadField1(iprot thrift.TProtocol) error {
+func (p *RelationServiceBatchFollowerCntArgs) ReadField1(iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin()
+	if err != nil {
+		return err
+	}
+	_field := make([]int64, 0, size)
+	for i := 0; i < size; i++ {
 
-	var _field int64
-	if v, err := iprot.ReadI64(); err != nil {
+		var _elem int64
+		if v, err := iprot.ReadI64(); err != nil {
+			return err
+		} else {
+			_elem = v
+		}
+
+		_field = append(_field, _elem)
+	}
+	if err := iprot.ReadListEnd(); err != nil {
 		return err
-	} else {
-		_field = v
 	}
-	p.UserId = _field
+	p.UserIds = _field
 	return nil
 }
 
-func (p *RelationServiceFollowerCntArgs) Write(oprot thrift.TProtocol) (err error) {
+func (p *RelationServiceBatchFollowerCntArgs) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("FollowerCnt_args"); err != nil {
+	if err = oprot.WriteStructBegin("BatchFollowerCnt_args"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -4941,11 +9221,19 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *RelationServiceFollowerCntArgs) writeField1(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("user_id", thrift.I64, 1); err != nil {
+func (p *RelationServiceBatchFollowerCntArgs) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("user_ids", thrift.LIST, 1); err != nil {
 		goto WriteFieldBeginError
 	}
-	if err := oprot.WriteI64(p.UserId); err != nil {
+	if err := oprot.WriteListBegin(thrift.I64, len(p.UserIds)); err != nil {
+		return err
+	}
+	for _, v := range p.UserIds {
+		if err := oprot.WriteI64(v); err != nil {
+			return err
+		}
+	}
+	if err := oprot.WriteListEnd(); err != nil {
 		return err
 	}
 	if err = oprot.WriteFieldEnd(); err != nil {
@@ -4958,67 +9246,73 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *RelationServiceFollowerCntArgs) String() string {
+func (p *RelationServiceBatchFollowerCntArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("RelationServiceFollowerCntArgs(%+v)", *p)
+	return fmt.Sprintf("RelationServiceBatchFollowerCntArgs(%+v)", *p)
 
 }
 
-func (p *RelationServiceFollowerCntArgs) DeepEqual(ano *RelationServiceFollowerCntArgs) bool {
+func (p *RelationServiceBatchFollowerCntArgs) DeepEqual(ano *RelationServiceBatchFollowerCntArgs) bool {
 	if p == ano {
 		return true
 	} else if p == nil || ano == nil {
 		return false
 	}
-	if !p.Field1DeepEqual(ano.UserId) {
+	if !p.Field1DeepEqual(ano.UserIds) {
 		return false
 	}
 	return true
 }
 
-func (p *RelationServiceFollowerCntArgs) Field1DeepEqual(src int64) bool {
+func (p *RelationServiceBatchFollowerCntArgs) Field1DeepEqual(src []int64) bool {
 
-	if p.UserId != src {
+	if len(p.UserIds) != len(src) {
 		return false
 	}
+	for i, v := range p.UserIds {
+		_src := src[i]
+		if v != _src {
+			return false
+		}
+	}
 	return true
 }
 
-type RelationServiceFollowerCntResult struct {
-	Success *int64 `thrift:"success,0,optional" frugal:"0,optional,i64" json:"success,omitempty"`
+type RelationServiceBatchFollowerCntResult struct {
+	Success map[int64]int64 `thrift:"success,0,optional" frugal:"0,optional,map<i64:i64>" json:"success,omitempty"`
 }
 
-func NewRelationServiceFollowerCntResult() *RelationServiceFollowerCntResult {
-	return &RelationServiceFollowerCntResult{}
+func NewRelationServiceBatchFollowerCntResult() *RelationServiceBatchFollowerCntResult {
+	return &RelationServiceBatchFollowerCntResult{}
 }
 
-func (p *RelationServiceFollowerCntResult) InitDefault() {
-	*p = RelationServiceFollowerCntResult{}
+func (p *RelationServiceBatchFollowerCntResult) InitDefault() {
+	*p = RelationServiceBatchFollowerCntResult{}
 }
 
-var RelationServiceFollowerCntResult_Success_DEFAULT int64
+var RelationServiceBatchFollowerCntResult_Success_DEFAULT map[int64]int64
 
-func (p *RelationServiceFollowerCntResult) GetSuccess() (v int64) {
+func (p *RelationServiceBatchFollowerCntResult) GetSuccess() (v map[int64]int64) {
 	if !p.IsSetSuccess() {
-		return RelationServiceFollowerCntResult_Success_DEFAULT
+		return RelationServiceBatchFollowerCntResult_Success_DEFAULT
 	}
-	return *p.Success
+	return p.Success
 }
-func (p *RelationServiceFollowerCntResult) SetSuccess(x interface{}) {
-	p.Success = x.(*int64)
+func (p *RelationServiceBatchFollowerCntResult) SetSuccess(x interface{}) {
+	p.Success = x.(map[int64]int64)
 }
 
-var fieldIDToName_RelationServiceFollowerCntResult = map[int16]string{
+var fieldIDToName_RelationServiceBatchFollowerCntResult = map[int16]string{
 	0: "success",
 }
 
-func (p *RelationServiceFollowerCntResult) IsSetSuccess() bool {
+func (p *RelationServiceBatchFollowerCntResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *RelationServiceFollowerCntResult) Read(iprot thrift.TProtocol) (err error) {
+func (p *RelationServiceBatchFollowerCntResult) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -5038,7 +9332,7 @@ func (p *RelationServiceFollowerCntResult) Read(iprot thrift.TProtocol) (err err
 
 		switch fieldId {
 		case 0:
-			if fieldTypeId == thrift.I64 {
+			if fieldTypeId == thrift.MAP {
 				if err = p.ReadField0(iprot); err != nil {
 					goto ReadFieldError
 				}
@@ -5064,7 +9358,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_RelationServiceFollowerCntResult[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_RelationServiceBatchFollowerCntResult[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -5074,21 +9368,39 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *RelationServiceFollowerCntResult) ReadField0(iprot thrift.TProtocol) error {
+func (p *RelationServiceBatchFollowerCntResult) ReadField0(iprot thrift.TProtocol) error {
+	_, _, size, err := iprot.ReadMapBegin()
+	if err != nil {
+		return err
+	}
+	_field := make(map[int64]int64, size)
+	for i := 0; i < size; i++ {
+		var _key int64
+		if v, err := iprot.ReadI64(); err != nil {
+			return err
+		} else {
+			_key = v
+		}
 
-	var _field *int64
-	if v, err := iprot.ReadI64(); err != nil {
+		var _val int64
+		if v, err := iprot.ReadI64(); err != nil {
+			return err
+		} else {
+			_val = v
+		}
+
+		_field[_key] = _val
+	}
+	if err := iprot.ReadMapEnd(); err != nil {
 		return err
-	} else {
-		_field = &v
 	}
 	p.Success = _field
 	return nil
 }
 
-func (p *RelationServiceFollowerCntResult) Write(oprot thrift.TProtocol) (err error) {
+func (p *RelationServiceBatchFollowerCntResult) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("FollowerCnt_result"); err != nil {
+	if err = oprot.WriteStructBegin("BatchFollowerCnt_result"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -5114,12 +9426,23 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *RelationServiceFollowerCntResult) writeField0(oprot thrift.TProtocol) (err error) {
+func (p *RelationServiceBatchFollowerCntResult) writeField0(oprot thrift.TProtocol) (err error) {
 	if p.IsSetSuccess() {
-		if err = oprot.WriteFieldBegin("success", thrift.I64, 0); err != nil {
+		if err = oprot.WriteFieldBegin("success", thrift.MAP, 0); err != nil {
 			goto WriteFieldBeginError
 		}
-		if err := oprot.WriteI64(*p.Success); err != nil {
+		if err := oprot.WriteMapBegin(thrift.I64, thrift.I64, len(p.Success)); err != nil {
+			return err
+		}
+		for k, v := range p.Success {
+			if err := oprot.WriteI64(k); err != nil {
+				return err
+			}
+			if err := oprot.WriteI64(v); err != nil {
+				return err
+			}
+		}
+		if err := oprot.WriteMapEnd(); err != nil {
 			return err
 		}
 		if err = oprot.WriteFieldEnd(); err != nil {
@@ -5133,15 +9456,15 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
 }
 
-func (p *RelationServiceFollowerCntResult) String() string {
+func (p *RelationServiceBatchFollowerCntResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("RelationServiceFollowerCntResult(%+v)", *p)
+	return fmt.Sprintf("RelationServiceBatchFollowerCntResult(%+v)", *p)
 
 }
 
-func (p *RelationServiceFollowerCntResult) DeepEqual(ano *RelationServiceFollowerCntResult) bool {
+func (p *RelationServiceBatchFollowerCntResult) DeepEqual(ano *RelationServiceBatchFollowerCntResult) bool {
 	if p == ano {
 		return true
 	} else if p == nil || ano == nil {
@@ -5153,15 +9476,16 @@ func (p *RelationServiceFollowerCntResult) DeepEqual(ano *RelationServiceFollowe
 	return true
 }
 
-func (p *RelationServiceFollowerCntResult) Field0DeepEqual(src *int64) bool {
+func (p *RelationServiceBatchFollowerCntResult) Field0DeepEqual(src map[int64]int64) bool {
 
-	if p.Success == src {
-		return true
-	} else if p.Success == nil || src == nil {
+	if len(p.Success) != len(src) {
 		return false
 	}
-	if *p.Success != *src {
-		return false
+	for k, v := range p.Success {
+		_src := src[k]
+		if v != _src {
+			return false
+		}
 	}
 	return true
 }
@@ -15,9 +15,14 @@ type Client interface {
 	RelationFollowList(ctx context.Context, req *relation.RelationFollowListRequest, callOptions ...callopt.Option) (r *relation.RelationFollowListResponse, err error)
 	RelationFollowerList(ctx context.Context, req *relation.RelationFollowerListRequest, callOptions ...callopt.Option) (r *relation.RelationFollowerListResponse, err error)
 	RelationFriendList(ctx context.Context, req *relation.RelationFriendListRequest, callOptions ...callopt.Option) (r *relation.RelationFriendListResponse, err error)
+	RelationBlock(ctx context.Context, req *relation.RelationBlockRequest, callOptions ...callopt.Option) (r *relation.RelationBlockResponse, err error)
+	RelationBlockList(ctx context.Context, req *relation.RelationBlockListRequest, callOptions ...callopt.Option) (r *relation.RelationBlockListResponse, err error)
 	RelationExist(ctx context.Context, userId int64, authorId int64, callOptions ...callopt.Option) (r bool, err error)
 	FollowCnt(ctx context.Context, userId int64, callOptions ...callopt.Option) (r int64, err error)
 	FollowerCnt(ctx context.Context, userId int64, callOptions ...callopt.Option) (r int64, err error)
+	BatchRelationExist(ctx context.Context, userId int64, authorIds []int64, callOptions ...callopt.Option) (r map[int64]bool, err error)
+	BatchFollowCnt(ctx context.Context, userIds []int64, callOptions ...callopt.Option) (r map[int64]int64, err error)
+	BatchFollowerCnt(ctx context.Context, userIds []int64, callOptions ...callopt.Option) (r map[int64]int64, err error)
 }
 
 // NewClient creates a client for the service defined in IDL.
@@ -69,6 +74,16 @@ func (p *kRelationServiceClient) RelationFriendList(ctx context.Context, req *re
 	return p.kClient.RelationFriendList(ctx, req)
 }
 
+func (p *kRelationServiceClient) RelationBlock(ctx context.Context, req *relation.RelationBlockRequest, callOptions ...callopt.Option) (r *relation.RelationBlockResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.RelationBlock(ctx, req)
+}
+
+func (p *kRelationServiceClient) RelationBlockList(ctx context.Context, req *relation.RelationBlockListRequest, callOptions ...callopt.Option) (r *relation.RelationBlockListResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.RelationBlockList(ctx, req)
+}
+
 func (p *kRelationServiceClient) RelationExist(ctx context.Context, userId int64, authorId int64, callOptions ...callopt.Option) (r bool, err error) {
 	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
 	return p.kClient.RelationExist(ctx, userId, authorId)
@@ -83,3 +98,18 @@ func (p *kRelationServiceClient) FollowerCnt(ctx context.Context, userId int64,
 	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
 	return p.kClient.FollowerCnt(ctx, userId)
 }
+
+func (p *kRelationServiceClient) BatchRelationExist(ctx context.Context, userId int64, authorIds []int64, callOptions ...callopt.Option) (r map[int64]bool, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.BatchRelationExist(ctx, userId, authorIds)
+}
+
+func (p *kRelationServiceClient) BatchFollowCnt(ctx context.Context, userIds []int64, callOptions ...callopt.Option) (r map[int64]int64, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.BatchFollowCnt(ctx, userIds)
+}
+
+func (p *kRelationServiceClient) BatchFollowerCnt(ctx context.Context, userIds []int64, callOptions ...callopt.Option) (r map[int64]int64, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.BatchFollowerCnt(ctx, userIds)
+}
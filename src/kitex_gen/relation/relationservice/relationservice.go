@@ -23,9 +23,14 @@ func NewServiceInfo() *kitex.ServiceInfo {
 		"RelationFollowList":   kitex.NewMethodInfo(relationFollowListHandler, newRelationServiceRelationFollowListArgs, newRelationServiceRelationFollowListResult, false),
 		"RelationFollowerList": kitex.NewMethodInfo(relationFollowerListHandler, newRelationServiceRelationFollowerListArgs, newRelationServiceRelationFollowerListResult, false),
 		"RelationFriendList":   kitex.NewMethodInfo(relationFriendListHandler, newRelationServiceRelationFriendListArgs, newRelationServiceRelationFriendListResult, false),
+		"RelationBlock":        kitex.NewMethodInfo(relationBlockHandler, newRelationServiceRelationBlockArgs, newRelationServiceRelationBlockResult, false),
+		"RelationBlockList":    kitex.NewMethodInfo(relationBlockListHandler, newRelationServiceRelationBlockListArgs, newRelationServiceRelationBlockListResult, false),
 		"RelationExist":        kitex.NewMethodInfo(relationExistHandler, newRelationServiceRelationExistArgs, newRelationServiceRelationExistResult, false),
 		"FollowCnt":            kitex.NewMethodInfo(followCntHandler, newRelationServiceFollowCntArgs, newRelationServiceFollowCntResult, false),
 		"FollowerCnt":          kitex.NewMethodInfo(followerCntHandler, newRelationServiceFollowerCntArgs, newRelationServiceFollowerCntResult, false),
+		"BatchRelationExist":   kitex.NewMethodInfo(batchRelationExistHandler, newRelationServiceBatchRelationExistArgs, newRelationServiceBatchRelationExistResult, false),
+		"BatchFollowCnt":       kitex.NewMethodInfo(batchFollowCntHandler, newRelationServiceBatchFollowCntArgs, newRelationServiceBatchFollowCntResult, false),
+		"BatchFollowerCnt":     kitex.NewMethodInfo(batchFollowerCntHandler, newRelationServiceBatchFollowerCntArgs, newRelationServiceBatchFollowerCntResult, false),
 	}
 	extra := map[string]interface{}{
 		"PackageName":     "relation",
@@ -114,6 +119,42 @@ func newRelationServiceRelationFriendListResult() interface{} {
 	return relation.NewRelationServiceRelationFriendListResult()
 }
 
+func relationBlockHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*relation.RelationServiceRelationBlockArgs)
+	realResult := result.(*relation.RelationServiceRelationBlockResult)
+	success, err := handler.(relation.RelationService).RelationBlock(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newRelationServiceRelationBlockArgs() interface{} {
+	return relation.NewRelationServiceRelationBlockArgs()
+}
+
+func newRelationServiceRelationBlockResult() interface{} {
+	return relation.NewRelationServiceRelationBlockResult()
+}
+
+func relationBlockListHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*relation.RelationServiceRelationBlockListArgs)
+	realResult := result.(*relation.RelationServiceRelationBlockListResult)
+	success, err := handler.(relation.RelationService).RelationBlockList(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newRelationServiceRelationBlockListArgs() interface{} {
+	return relation.NewRelationServiceRelationBlockListArgs()
+}
+
+func newRelationServiceRelationBlockListResult() interface{} {
+	return relation.NewRelationServiceRelationBlockListResult()
+}
+
 func relationExistHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
 	realArg := arg.(*relation.RelationServiceRelationExistArgs)
 	realResult := result.(*relation.RelationServiceRelationExistResult)
@@ -168,6 +209,60 @@ func newRelationServiceFollowerCntResult() interface{} {
 	return relation.NewRelationServiceFollowerCntResult()
 }
 
+func batchRelationExistHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*relation.RelationServiceBatchRelationExistArgs)
+	realResult := result.(*relation.RelationServiceBatchRelationExistResult)
+	success, err := handler.(relation.RelationService).BatchRelationExist(ctx, realArg.UserId, realArg.AuthorIds)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newRelationServiceBatchRelationExistArgs() interface{} {
+	return relation.NewRelationServiceBatchRelationExistArgs()
+}
+
+func newRelationServiceBatchRelationExistResult() interface{} {
+	return relation.NewRelationServiceBatchRelationExistResult()
+}
+
+func batchFollowCntHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*relation.RelationServiceBatchFollowCntArgs)
+	realResult := result.(*relation.RelationServiceBatchFollowCntResult)
+	success, err := handler.(relation.RelationService).BatchFollowCnt(ctx, realArg.UserIds)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newRelationServiceBatchFollowCntArgs() interface{} {
+	return relation.NewRelationServiceBatchFollowCntArgs()
+}
+
+func newRelationServiceBatchFollowCntResult() interface{} {
+	return relation.NewRelationServiceBatchFollowCntResult()
+}
+
+func batchFollowerCntHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*relation.RelationServiceBatchFollowerCntArgs)
+	realResult := result.(*relation.RelationServiceBatchFollowerCntResult)
+	success, err := handler.(relation.RelationService).BatchFollowerCnt(ctx, realArg.UserIds)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newRelationServiceBatchFollowerCntArgs() interface{} {
+	return relation.NewRelationServiceBatchFollowerCntArgs()
+}
+
+func newRelationServiceBatchFollowerCntResult() interface{} {
+	return relation.NewRelationServiceBatchFollowerCntResult()
+}
+
 type kClient struct {
 	c client.Client
 }
@@ -218,6 +313,26 @@ func (p *kClient) RelationFriendList(ctx context.Context, req *relation.Relation
 	return _result.GetSuccess(), nil
 }
 
+func (p *kClient) RelationBlock(ctx context.Context, req *relation.RelationBlockRequest) (r *relation.RelationBlockResponse, err error) {
+	var _args relation.RelationServiceRelationBlockArgs
+	_args.Req = req
+	var _result relation.RelationServiceRelationBlockResult
+	if err = p.c.Call(ctx, "RelationBlock", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) RelationBlockList(ctx context.Context, req *relation.RelationBlockListRequest) (r *relation.RelationBlockListResponse, err error) {
+	var _args relation.RelationServiceRelationBlockListArgs
+	_args.Req = req
+	var _result relation.RelationServiceRelationBlockListResult
+	if err = p.c.Call(ctx, "RelationBlockList", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
 func (p *kClient) RelationExist(ctx context.Context, userId int64, authorId int64) (r bool, err error) {
 	var _args relation.RelationServiceRelationExistArgs
 	_args.UserId = userId
@@ -248,3 +363,34 @@ func (p *kClient) FollowerCnt(ctx context.Context, userId int64) (r int64, err e
 	}
 	return _result.GetSuccess(), nil
 }
+
+func (p *kClient) BatchRelationExist(ctx context.Context, userId int64, authorIds []int64) (r map[int64]bool, err error) {
+	var _args relation.RelationServiceBatchRelationExistArgs
+	_args.UserId = userId
+	_args.AuthorIds = authorIds
+	var _result relation.RelationServiceBatchRelationExistResult
+	if err = p.c.Call(ctx, "BatchRelationExist", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) BatchFollowCnt(ctx context.Context, userIds []int64) (r map[int64]int64, err error) {
+	var _args relation.RelationServiceBatchFollowCntArgs
+	_args.UserIds = userIds
+	var _result relation.RelationServiceBatchFollowCntResult
+	if err = p.c.Call(ctx, "BatchFollowCnt", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) BatchFollowerCnt(ctx context.Context, userIds []int64) (r map[int64]int64, err error) {
+	var _args relation.RelationServiceBatchFollowerCntArgs
+	_args.UserIds = userIds
+	var _result relation.RelationServiceBatchFollowerCntResult
+	if err = p.c.Call(ctx, "BatchFollowerCnt", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
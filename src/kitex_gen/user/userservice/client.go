@@ -14,6 +14,8 @@ type Client interface {
 	Register(ctx context.Context, req *user.UserRegisterRequest, callOptions ...callopt.Option) (r *user.UserRegisterResponse, err error)
 	Login(ctx context.Context, req *user.UserLoginRequest, callOptions ...callopt.Option) (r *user.UserLoginResponse, err error)
 	UserInfo(ctx context.Context, req *user.UserInfoRequest, callOptions ...callopt.Option) (r *user.UserInfoResponse, err error)
+	BatchUserInfo(ctx context.Context, req *user.BatchUserInfoRequest, callOptions ...callopt.Option) (r []*user.User, err error)
+	UpdatePrivacy(ctx context.Context, req *user.UserPrivacyRequest, callOptions ...callopt.Option) (r *user.UserPrivacyResponse, err error)
 }
 
 // NewClient creates a client for the service defined in IDL.
@@ -59,3 +61,13 @@ func (p *kUserServiceClient) UserInfo(ctx context.Context, req *user.UserInfoReq
 	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
 	return p.kClient.UserInfo(ctx, req)
 }
+
+func (p *kUserServiceClient) BatchUserInfo(ctx context.Context, req *user.BatchUserInfoRequest, callOptions ...callopt.Option) (r []*user.User, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.BatchUserInfo(ctx, req)
+}
+
+func (p *kUserServiceClient) UpdatePrivacy(ctx context.Context, req *user.UserPrivacyRequest, callOptions ...callopt.Option) (r *user.UserPrivacyResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.UpdatePrivacy(ctx, req)
+}
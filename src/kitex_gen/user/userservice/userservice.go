@@ -19,9 +19,11 @@ func NewServiceInfo() *kitex.ServiceInfo {
 	serviceName := "UserService"
 	handlerType := (*user.UserService)(nil)
 	methods := map[string]kitex.MethodInfo{
-		"Register": kitex.NewMethodInfo(registerHandler, newUserServiceRegisterArgs, newUserServiceRegisterResult, false),
-		"Login":    kitex.NewMethodInfo(loginHandler, newUserServiceLoginArgs, newUserServiceLoginResult, false),
-		"UserInfo": kitex.NewMethodInfo(userInfoHandler, newUserServiceUserInfoArgs, newUserServiceUserInfoResult, false),
+		"Register":      kitex.NewMethodInfo(registerHandler, newUserServiceRegisterArgs, newUserServiceRegisterResult, false),
+		"Login":         kitex.NewMethodInfo(loginHandler, newUserServiceLoginArgs, newUserServiceLoginResult, false),
+		"UserInfo":      kitex.NewMethodInfo(userInfoHandler, newUserServiceUserInfoArgs, newUserServiceUserInfoResult, false),
+		"BatchUserInfo": kitex.NewMethodInfo(batchUserInfoHandler, newUserServiceBatchUserInfoArgs, newUserServiceBatchUserInfoResult, false),
+		"UpdatePrivacy": kitex.NewMethodInfo(updatePrivacyHandler, newUserServiceUpdatePrivacyArgs, newUserServiceUpdatePrivacyResult, false),
 	}
 	extra := map[string]interface{}{
 		"PackageName":     "user",
@@ -92,6 +94,42 @@ func newUserServiceUserInfoResult() interface{} {
 	return user.NewUserServiceUserInfoResult()
 }
 
+func batchUserInfoHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*user.UserServiceBatchUserInfoArgs)
+	realResult := result.(*user.UserServiceBatchUserInfoResult)
+	success, err := handler.(user.UserService).BatchUserInfo(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newUserServiceBatchUserInfoArgs() interface{} {
+	return user.NewUserServiceBatchUserInfoArgs()
+}
+
+func newUserServiceBatchUserInfoResult() interface{} {
+	return user.NewUserServiceBatchUserInfoResult()
+}
+
+func updatePrivacyHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*user.UserServiceUpdatePrivacyArgs)
+	realResult := result.(*user.UserServiceUpdatePrivacyResult)
+	success, err := handler.(user.UserService).UpdatePrivacy(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newUserServiceUpdatePrivacyArgs() interface{} {
+	return user.NewUserServiceUpdatePrivacyArgs()
+}
+
+func newUserServiceUpdatePrivacyResult() interface{} {
+	return user.NewUserServiceUpdatePrivacyResult()
+}
+
 type kClient struct {
 	c client.Client
 }
@@ -131,3 +169,23 @@ func (p *kClient) UserInfo(ctx context.Context, req *user.UserInfoRequest) (r *u
 	}
 	return _result.GetSuccess(), nil
 }
+
+func (p *kClient) BatchUserInfo(ctx context.Context, req *user.BatchUserInfoRequest) (r []*user.User, err error) {
+	var _args user.UserServiceBatchUserInfoArgs
+	_args.Req = req
+	var _result user.UserServiceBatchUserInfoResult
+	if err = p.c.Call(ctx, "BatchUserInfo", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) UpdatePrivacy(ctx context.Context, req *user.UserPrivacyRequest) (r *user.UserPrivacyResponse, err error) {
+	var _args user.UserServiceUpdatePrivacyArgs
+	_args.Req = req
+	var _result user.UserServiceUpdatePrivacyResult
+	if err = p.c.Call(ctx, "UpdatePrivacy", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
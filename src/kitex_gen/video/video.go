@@ -3,7 +3,6 @@
 package video
 
 import (
-	"bytes"
 	"context"
 	"douyin/src/kitex_gen/user"
 	"fmt"
@@ -21,6 +20,11 @@ type Video struct {
 	CommentCount  int64      `thrift:"comment_count,7" frugal:"7,default,i64" json:"comment_count"`
 	IsFavorite    bool       `thrift:"is_favorite,8" frugal:"8,default,bool" json:"is_favorite"`
 	Title         string     `thrift:"title,9" frugal:"9,default,string" json:"title"`
+	HlsUrl        string     `thrift:"hls_url,10" frugal:"10,default,string" json:"hls_url"`
+	Duration      int32      `thrift:"duration,11" frugal:"11,default,i32" json:"duration"`
+	Width         int32      `thrift:"width,12" frugal:"12,default,i32" json:"width"`
+	Height        int32      `thrift:"height,13" frugal:"13,default,i32" json:"height"`
+	Visibility    int32      `thrift:"visibility,14" frugal:"14,default,i32" json:"visibility"`
 }
 
 func NewVideo() *Video {
@@ -71,6 +75,26 @@ func (p *Video) GetIsFavorite() (v bool) {
 func (p *Video) GetTitle() (v string) {
 	return p.Title
 }
+
+func (p *Video) GetHlsUrl() (v string) {
+	return p.HlsUrl
+}
+
+func (p *Video) GetDuration() (v int32) {
+	return p.Duration
+}
+
+func (p *Video) GetWidth() (v int32) {
+	return p.Width
+}
+
+func (p *Video) GetHeight() (v int32) {
+	return p.Height
+}
+
+func (p *Video) GetVisibility() (v int32) {
+	return p.Visibility
+}
 func (p *Video) SetId(val int64) {
 	p.Id = val
 }
@@ -98,17 +122,37 @@ func (p *Video) SetIsFavorite(val bool) {
 func (p *Video) SetTitle(val string) {
 	p.Title = val
 }
+func (p *Video) SetHlsUrl(val string) {
+	p.HlsUrl = val
+}
+func (p *Video) SetDuration(val int32) {
+	p.Duration = val
+}
+func (p *Video) SetWidth(val int32) {
+	p.Width = val
+}
+func (p *Video) SetHeight(val int32) {
+	p.Height = val
+}
+func (p *Video) SetVisibility(val int32) {
+	p.Visibility = val
+}
 
 var fieldIDToName_Video = map[int16]string{
-	1: "id",
-	2: "author",
-	3: "play_url",
-	4: "cover_url",
-	5: "upload_time",
-	6: "favorite_count",
-	7: "comment_count",
-	8: "is_favorite",
-	9: "title",
+	1:  "id",
+	2:  "author",
+	3:  "play_url",
+	4:  "cover_url",
+	5:  "upload_time",
+	6:  "favorite_count",
+	7:  "comment_count",
+	8:  "is_favorite",
+	9:  "title",
+	10: "hls_url",
+	11: "duration",
+	12: "width",
+	13: "height",
+	14: "visibility",
 }
 
 func (p *Video) IsSetAuthor() bool {
@@ -206,6 +250,46 @@ func (p *Video) Read(iprot thrift.TProtocol) (err error) {
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
+		case 10:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField10(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 11:
+			if fieldTypeId == thrift.I32 {
+				if err = p.ReadField11(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 12:
+			if fieldTypeId == thrift.I32 {
+				if err = p.ReadField12(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 13:
+			if fieldTypeId == thrift.I32 {
+				if err = p.ReadField13(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 14:
+			if fieldTypeId == thrift.I32 {
+				if err = p.ReadField14(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
 		default:
 			if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
@@ -331,6 +415,61 @@ func (p *Video) ReadField9(iprot thrift.TProtocol) error {
 	p.Title = _field
 	return nil
 }
+func (p *Video) ReadField10(iprot thrift.TProtocol) error {
+
+	var _field string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.HlsUrl = _field
+	return nil
+}
+func (p *Video) ReadField11(iprot thrift.TProtocol) error {
+
+	var _field int32
+	if v, err := iprot.ReadI32(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.Duration = _field
+	return nil
+}
+func (p *Video) ReadField12(iprot thrift.TProtocol) error {
+
+	var _field int32
+	if v, err := iprot.ReadI32(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.Width = _field
+	return nil
+}
+func (p *Video) ReadField13(iprot thrift.TProtocol) error {
+
+	var _field int32
+	if v, err := iprot.ReadI32(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.Height = _field
+	return nil
+}
+func (p *Video) ReadField14(iprot thrift.TProtocol) error {
+
+	var _field int32
+	if v, err := iprot.ReadI32(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.Visibility = _field
+	return nil
+}
 
 func (p *Video) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
@@ -374,6 +513,26 @@ func (p *Video) Write(oprot thrift.TProtocol) (err error) {
 			fieldId = 9
 			goto WriteFieldError
 		}
+		if err = p.writeField10(oprot); err != nil {
+			fieldId = 10
+			goto WriteFieldError
+		}
+		if err = p.writeField11(oprot); err != nil {
+			fieldId = 11
+			goto WriteFieldError
+		}
+		if err = p.writeField12(oprot); err != nil {
+			fieldId = 12
+			goto WriteFieldError
+		}
+		if err = p.writeField13(oprot); err != nil {
+			fieldId = 13
+			goto WriteFieldError
+		}
+		if err = p.writeField14(oprot); err != nil {
+			fieldId = 14
+			goto WriteFieldError
+		}
 	}
 	if err = oprot.WriteFieldStop(); err != nil {
 		goto WriteFieldStopError
@@ -545,6 +704,91 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 9 end error: ", p), err)
 }
 
+func (p *Video) writeField10(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("hls_url", thrift.STRING, 10); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.HlsUrl); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 10 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 10 end error: ", p), err)
+}
+
+func (p *Video) writeField11(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("duration", thrift.I32, 11); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI32(p.Duration); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 11 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 11 end error: ", p), err)
+}
+
+func (p *Video) writeField12(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("width", thrift.I32, 12); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI32(p.Width); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 12 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 12 end error: ", p), err)
+}
+
+func (p *Video) writeField13(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("height", thrift.I32, 13); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI32(p.Height); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 13 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 13 end error: ", p), err)
+}
+
+func (p *Video) writeField14(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("visibility", thrift.I32, 14); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI32(p.Visibility); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 14 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 14 end error: ", p), err)
+}
+
 func (p *Video) String() string {
 	if p == nil {
 		return "<nil>"
@@ -586,6 +830,21 @@ func (p *Video) DeepEqual(ano *Video) bool {
 	if !p.Field9DeepEqual(ano.Title) {
 		return false
 	}
+	if !p.Field10DeepEqual(ano.HlsUrl) {
+		return false
+	}
+	if !p.Field11DeepEqual(ano.Duration) {
+		return false
+	}
+	if !p.Field12DeepEqual(ano.Width) {
+		return false
+	}
+	if !p.Field13DeepEqual(ano.Height) {
+		return false
+	}
+	if !p.Field14DeepEqual(ano.Visibility) {
+		return false
+	}
 	return true
 }
 
@@ -652,10 +911,47 @@ func (p *Video) Field9DeepEqual(src string) bool {
 	}
 	return true
 }
+func (p *Video) Field10DeepEqual(src string) bool {
+
+	if strings.Compare(p.HlsUrl, src) != 0 {
+		return false
+	}
+	return true
+}
+func (p *Video) Field11DeepEqual(src int32) bool {
+
+	if p.Duration != src {
+		return false
+	}
+	return true
+}
+func (p *Video) Field12DeepEqual(src int32) bool {
+
+	if p.Width != src {
+		return false
+	}
+	return true
+}
+func (p *Video) Field13DeepEqual(src int32) bool {
+
+	if p.Height != src {
+		return false
+	}
+	return true
+}
+func (p *Video) Field14DeepEqual(src int32) bool {
+
+	if p.Visibility != src {
+		return false
+	}
+	return true
+}
 
 type FeedRequest struct {
-	LatestTime int64  `thrift:"latest_time,1" frugal:"1,default,i64" json:"latest_time"`
-	UserId     *int64 `thrift:"user_id,2,optional" frugal:"2,optional,i64" json:"user_id,omitempty"`
+	LatestTime int64   `thrift:"latest_time,1" frugal:"1,default,i64" json:"latest_time"`
+	UserId     *int64  `thrift:"user_id,2,optional" frugal:"2,optional,i64" json:"user_id,omitempty"`
+	Mode       int32   `thrift:"mode,3" frugal:"3,default,i32" json:"mode"`
+	Cursor     *string `thrift:"cursor,4,optional" frugal:"4,optional,string" json:"cursor,omitempty"`
 }
 
 func NewFeedRequest() *FeedRequest {
@@ -678,22 +974,47 @@ func (p *FeedRequest) GetUserId() (v int64) {
 	}
 	return *p.UserId
 }
+
+func (p *FeedRequest) GetMode() (v int32) {
+	return p.Mode
+}
+
+var FeedRequest_Cursor_DEFAULT string
+
+func (p *FeedRequest) GetCursor() (v string) {
+	if !p.IsSetCursor() {
+		return FeedRequest_Cursor_DEFAULT
+	}
+	return *p.Cursor
+}
 func (p *FeedRequest) SetLatestTime(val int64) {
 	p.LatestTime = val
 }
 func (p *FeedRequest) SetUserId(val *int64) {
 	p.UserId = val
 }
+func (p *FeedRequest) SetMode(val int32) {
+	p.Mode = val
+}
+func (p *FeedRequest) SetCursor(val *string) {
+	p.Cursor = val
+}
 
 var fieldIDToName_FeedRequest = map[int16]string{
 	1: "latest_time",
 	2: "user_id",
+	3: "mode",
+	4: "cursor",
 }
 
 func (p *FeedRequest) IsSetUserId() bool {
 	return p.UserId != nil
 }
 
+func (p *FeedRequest) IsSetCursor() bool {
+	return p.Cursor != nil
+}
+
 func (p *FeedRequest) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
@@ -729,6 +1050,22 @@ func (p *FeedRequest) Read(iprot thrift.TProtocol) (err error) {
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
+		case 3:
+			if fieldTypeId == thrift.I32 {
+				if err = p.ReadField3(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 4:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField4(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
 		default:
 			if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
@@ -780,6 +1117,28 @@ func (p *FeedRequest) ReadField2(iprot thrift.TProtocol) error {
 	p.UserId = _field
 	return nil
 }
+func (p *FeedRequest) ReadField3(iprot thrift.TProtocol) error {
+
+	var _field int32
+	if v, err := iprot.ReadI32(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.Mode = _field
+	return nil
+}
+func (p *FeedRequest) ReadField4(iprot thrift.TProtocol) error {
+
+	var _field *string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.Cursor = _field
+	return nil
+}
 
 func (p *FeedRequest) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
@@ -795,6 +1154,14 @@ func (p *FeedRequest) Write(oprot thrift.TProtocol) (err error) {
 			fieldId = 2
 			goto WriteFieldError
 		}
+		if err = p.writeField3(oprot); err != nil {
+			fieldId = 3
+			goto WriteFieldError
+		}
+		if err = p.writeField4(oprot); err != nil {
+			fieldId = 4
+			goto WriteFieldError
+		}
 	}
 	if err = oprot.WriteFieldStop(); err != nil {
 		goto WriteFieldStopError
@@ -849,14 +1216,50 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
 }
 
-func (p *FeedRequest) String() string {
-	if p == nil {
-		return "<nil>"
+func (p *FeedRequest) writeField3(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("mode", thrift.I32, 3); err != nil {
+		goto WriteFieldBeginError
 	}
-	return fmt.Sprintf("FeedRequest(%+v)", *p)
-
-}
-
+	if err := oprot.WriteI32(p.Mode); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
+}
+
+func (p *FeedRequest) writeField4(oprot thrift.TProtocol) (err error) {
+	if p.IsSetCursor() {
+		if err = oprot.WriteFieldBegin("cursor", thrift.STRING, 4); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.Cursor); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
+}
+
+func (p *FeedRequest) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("FeedRequest(%+v)", *p)
+
+}
+
 func (p *FeedRequest) DeepEqual(ano *FeedRequest) bool {
 	if p == ano {
 		return true
@@ -869,6 +1272,12 @@ func (p *FeedRequest) DeepEqual(ano *FeedRequest) bool {
 	if !p.Field2DeepEqual(ano.UserId) {
 		return false
 	}
+	if !p.Field3DeepEqual(ano.Mode) {
+		return false
+	}
+	if !p.Field4DeepEqual(ano.Cursor) {
+		return false
+	}
 	return true
 }
 
@@ -891,12 +1300,32 @@ func (p *FeedRequest) Field2DeepEqual(src *int64) bool {
 	}
 	return true
 }
+func (p *FeedRequest) Field3DeepEqual(src int32) bool {
+
+	if p.Mode != src {
+		return false
+	}
+	return true
+}
+func (p *FeedRequest) Field4DeepEqual(src *string) bool {
+
+	if p.Cursor == src {
+		return true
+	} else if p.Cursor == nil || src == nil {
+		return false
+	}
+	if strings.Compare(*p.Cursor, *src) != 0 {
+		return false
+	}
+	return true
+}
 
 type FeedResponse struct {
 	StatusCode int32    `thrift:"status_code,1" frugal:"1,default,i32" json:"status_code"`
 	StatusMsg  *string  `thrift:"status_msg,2,optional" frugal:"2,optional,string" json:"status_msg,omitempty"`
 	VideoList  []*Video `thrift:"video_list,3" frugal:"3,default,list<Video>" json:"video_list"`
 	NextTime   *int64   `thrift:"next_time,4,optional" frugal:"4,optional,i64" json:"next_time,omitempty"`
+	NextCursor *string  `thrift:"next_cursor,5,optional" frugal:"5,optional,string" json:"next_cursor,omitempty"`
 }
 
 func NewFeedResponse() *FeedResponse {
@@ -932,6 +1361,15 @@ func (p *FeedResponse) GetNextTime() (v int64) {
 	}
 	return *p.NextTime
 }
+
+var FeedResponse_NextCursor_DEFAULT string
+
+func (p *FeedResponse) GetNextCursor() (v string) {
+	if !p.IsSetNextCursor() {
+		return FeedResponse_NextCursor_DEFAULT
+	}
+	return *p.NextCursor
+}
 func (p *FeedResponse) SetStatusCode(val int32) {
 	p.StatusCode = val
 }
@@ -944,12 +1382,16 @@ func (p *FeedResponse) SetVideoList(val []*Video) {
 func (p *FeedResponse) SetNextTime(val *int64) {
 	p.NextTime = val
 }
+func (p *FeedResponse) SetNextCursor(val *string) {
+	p.NextCursor = val
+}
 
 var fieldIDToName_FeedResponse = map[int16]string{
 	1: "status_code",
 	2: "status_msg",
 	3: "video_list",
 	4: "next_time",
+	5: "next_cursor",
 }
 
 func (p *FeedResponse) IsSetStatusMsg() bool {
@@ -960,6 +1402,10 @@ func (p *FeedResponse) IsSetNextTime() bool {
 	return p.NextTime != nil
 }
 
+func (p *FeedResponse) IsSetNextCursor() bool {
+	return p.NextCursor != nil
+}
+
 func (p *FeedResponse) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
@@ -1011,6 +1457,14 @@ func (p *FeedResponse) Read(iprot thrift.TProtocol) (err error) {
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
+		case 5:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField5(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
 		default:
 			if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
@@ -1095,6 +1549,17 @@ func (p *FeedResponse) ReadField4(iprot thrift.TProtocol) error {
 	p.NextTime = _field
 	return nil
 }
+func (p *FeedResponse) ReadField5(iprot thrift.TProtocol) error {
+
+	var _field *string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.NextCursor = _field
+	return nil
+}
 
 func (p *FeedResponse) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
@@ -1118,6 +1583,10 @@ func (p *FeedResponse) Write(oprot thrift.TProtocol) (err error) {
 			fieldId = 4
 			goto WriteFieldError
 		}
+		if err = p.writeField5(oprot); err != nil {
+			fieldId = 5
+			goto WriteFieldError
+		}
 	}
 	if err = oprot.WriteFieldStop(); err != nil {
 		goto WriteFieldStopError
@@ -1216,6 +1685,25 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
 }
 
+func (p *FeedResponse) writeField5(oprot thrift.TProtocol) (err error) {
+	if p.IsSetNextCursor() {
+		if err = oprot.WriteFieldBegin("next_cursor", thrift.STRING, 5); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.NextCursor); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 end error: ", p), err)
+}
+
 func (p *FeedResponse) String() string {
 	if p == nil {
 		return "<nil>"
@@ -1242,6 +1730,9 @@ func (p *FeedResponse) DeepEqual(ano *FeedResponse) bool {
 	if !p.Field4DeepEqual(ano.NextTime) {
 		return false
 	}
+	if !p.Field5DeepEqual(ano.NextCursor) {
+		return false
+	}
 	return true
 }
 
@@ -1289,49 +1780,70 @@ func (p *FeedResponse) Field4DeepEqual(src *int64) bool {
 	}
 	return true
 }
+func (p *FeedResponse) Field5DeepEqual(src *string) bool {
 
-type VideoInfoRequest struct {
-	UserId  *int64 `thrift:"user_id,1,optional" frugal:"1,optional,i64" json:"user_id,omitempty"`
-	VideoId int64  `thrift:"video_id,2" frugal:"2,default,i64" json:"video_id"`
+	if p.NextCursor == src {
+		return true
+	} else if p.NextCursor == nil || src == nil {
+		return false
+	}
+	if strings.Compare(*p.NextCursor, *src) != 0 {
+		return false
+	}
+	return true
 }
 
-func NewVideoInfoRequest() *VideoInfoRequest {
-	return &VideoInfoRequest{}
+type FollowFeedRequest struct {
+	LatestTime int64  `thrift:"latest_time,1" frugal:"1,default,i64" json:"latest_time"`
+	UserId     int64  `thrift:"user_id,2" frugal:"2,default,i64" json:"user_id"`
+	LastId     *int64 `thrift:"last_id,3,optional" frugal:"3,optional,i64" json:"last_id,omitempty"`
 }
 
-func (p *VideoInfoRequest) InitDefault() {
-	*p = VideoInfoRequest{}
+func NewFollowFeedRequest() *FollowFeedRequest {
+	return &FollowFeedRequest{}
 }
 
-var VideoInfoRequest_UserId_DEFAULT int64
+func (p *FollowFeedRequest) InitDefault() {
+	*p = FollowFeedRequest{}
+}
 
-func (p *VideoInfoRequest) GetUserId() (v int64) {
-	if !p.IsSetUserId() {
-		return VideoInfoRequest_UserId_DEFAULT
-	}
-	return *p.UserId
+func (p *FollowFeedRequest) GetLatestTime() (v int64) {
+	return p.LatestTime
 }
 
-func (p *VideoInfoRequest) GetVideoId() (v int64) {
-	return p.VideoId
+func (p *FollowFeedRequest) GetUserId() (v int64) {
+	return p.UserId
 }
-func (p *VideoInfoRequest) SetUserId(val *int64) {
+
+var FollowFeedRequest_LastId_DEFAULT int64
+
+func (p *FollowFeedRequest) GetLastId() (v int64) {
+	if !p.IsSetLastId() {
+		return FollowFeedRequest_LastId_DEFAULT
+	}
+	return *p.LastId
+}
+func (p *FollowFeedRequest) SetLatestTime(val int64) {
+	p.LatestTime = val
+}
+func (p *FollowFeedRequest) SetUserId(val int64) {
 	p.UserId = val
 }
-func (p *VideoInfoRequest) SetVideoId(val int64) {
-	p.VideoId = val
+func (p *FollowFeedRequest) SetLastId(val *int64) {
+	p.LastId = val
 }
 
-var fieldIDToName_VideoInfoRequest = map[int16]string{
-	1: "user_id",
-	2: "video_id",
+var fieldIDToName_FollowFeedRequest = map[int16]string{
+	1: "latest_time",
+	2: "user_id",
+	3: "last_id",
 }
 
-func (p *VideoInfoRequest) IsSetUserId() bool {
-	return p.UserId != nil
+func (p *FollowFeedRequest) IsSetLastId() bool {
+	return p.LastId != nil
 }
 
-func (p *VideoInfoRequest) Read(iprot thrift.TProtocol) (err error) {
+func (p *FollowFeedRequest) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -1366,6 +1878,14 @@ func (p *VideoInfoRequest) Read(iprot thrift.TProtocol) (err error) {
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
+		case 3:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField3(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
 		default:
 			if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
@@ -1385,7 +1905,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_VideoInfoRequest[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_FollowFeedRequest[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -1395,18 +1915,18 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *VideoInfoRequest) ReadField1(iprot thrift.TProtocol) error {
+func (p *FollowFeedRequest) ReadField1(iprot thrift.TProtocol) error {
 
-	var _field *int64
+	var _field int64
 	if v, err := iprot.ReadI64(); err != nil {
 		return err
 	} else {
-		_field = &v
+		_field = v
 	}
-	p.UserId = _field
+	p.LatestTime = _field
 	return nil
 }
-func (p *VideoInfoRequest) ReadField2(iprot thrift.TProtocol) error {
+func (p *FollowFeedRequest) ReadField2(iprot thrift.TProtocol) error {
 
 	var _field int64
 	if v, err := iprot.ReadI64(); err != nil {
@@ -1414,13 +1934,24 @@ func (p *VideoInfoRequest) ReadField2(iprot thrift.TProtocol) error {
 	} else {
 		_field = v
 	}
-	p.VideoId = _field
+	p.UserId = _field
 	return nil
 }
+func (p *FollowFeedRequest) ReadField3(iprot thrift.TProtocol) error {
 
-func (p *VideoInfoRequest) Write(oprot thrift.TProtocol) (err error) {
+	var _field *int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.LastId = _field
+	return nil
+}
+
+func (p *FollowFeedRequest) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("Video_info_request"); err != nil {
+	if err = oprot.WriteStructBegin("Follow_feed_request"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -1432,6 +1963,10 @@ func (p *VideoInfoRequest) Write(oprot thrift.TProtocol) (err error) {
 			fieldId = 2
 			goto WriteFieldError
 		}
+		if err = p.writeField3(oprot); err != nil {
+			fieldId = 3
+			goto WriteFieldError
+		}
 	}
 	if err = oprot.WriteFieldStop(); err != nil {
 		goto WriteFieldStopError
@@ -1450,17 +1985,15 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *VideoInfoRequest) writeField1(oprot thrift.TProtocol) (err error) {
-	if p.IsSetUserId() {
-		if err = oprot.WriteFieldBegin("user_id", thrift.I64, 1); err != nil {
-			goto WriteFieldBeginError
-		}
-		if err := oprot.WriteI64(*p.UserId); err != nil {
-			return err
-		}
-		if err = oprot.WriteFieldEnd(); err != nil {
-			goto WriteFieldEndError
-		}
+func (p *FollowFeedRequest) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("latest_time", thrift.I64, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI64(p.LatestTime); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
 	}
 	return nil
 WriteFieldBeginError:
@@ -1469,11 +2002,11 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *VideoInfoRequest) writeField2(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("video_id", thrift.I64, 2); err != nil {
+func (p *FollowFeedRequest) writeField2(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("user_id", thrift.I64, 2); err != nil {
 		goto WriteFieldBeginError
 	}
-	if err := oprot.WriteI64(p.VideoId); err != nil {
+	if err := oprot.WriteI64(p.UserId); err != nil {
 		return err
 	}
 	if err = oprot.WriteFieldEnd(); err != nil {
@@ -1486,91 +2019,165 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
 }
 
-func (p *VideoInfoRequest) String() string {
+func (p *FollowFeedRequest) writeField3(oprot thrift.TProtocol) (err error) {
+	if p.IsSetLastId() {
+		if err = oprot.WriteFieldBegin("last_id", thrift.I64, 3); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteI64(*p.LastId); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
+}
+
+func (p *FollowFeedRequest) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("VideoInfoRequest(%+v)", *p)
+	return fmt.Sprintf("FollowFeedRequest(%+v)", *p)
 
 }
 
-func (p *VideoInfoRequest) DeepEqual(ano *VideoInfoRequest) bool {
+func (p *FollowFeedRequest) DeepEqual(ano *FollowFeedRequest) bool {
 	if p == ano {
 		return true
 	} else if p == nil || ano == nil {
 		return false
 	}
-	if !p.Field1DeepEqual(ano.UserId) {
+	if !p.Field1DeepEqual(ano.LatestTime) {
 		return false
 	}
-	if !p.Field2DeepEqual(ano.VideoId) {
+	if !p.Field2DeepEqual(ano.UserId) {
+		return false
+	}
+	if !p.Field3DeepEqual(ano.LastId) {
 		return false
 	}
 	return true
 }
 
-func (p *VideoInfoRequest) Field1DeepEqual(src *int64) bool {
+func (p *FollowFeedRequest) Field1DeepEqual(src int64) bool {
 
-	if p.UserId == src {
-		return true
-	} else if p.UserId == nil || src == nil {
+	if p.LatestTime != src {
 		return false
 	}
-	if *p.UserId != *src {
+	return true
+}
+func (p *FollowFeedRequest) Field2DeepEqual(src int64) bool {
+
+	if p.UserId != src {
 		return false
 	}
 	return true
 }
-func (p *VideoInfoRequest) Field2DeepEqual(src int64) bool {
+func (p *FollowFeedRequest) Field3DeepEqual(src *int64) bool {
 
-	if p.VideoId != src {
+	if p.LastId == src {
+		return true
+	} else if p.LastId == nil || src == nil {
+		return false
+	}
+	if *p.LastId != *src {
 		return false
 	}
 	return true
 }
 
-type VideoInfoListRequest struct {
-	UserId      *int64  `thrift:"user_id,1,optional" frugal:"1,optional,i64" json:"user_id,omitempty"`
-	VideoIdList []int64 `thrift:"video_id_list,2" frugal:"2,default,list<i64>" json:"video_id_list"`
+type FollowFeedResponse struct {
+	StatusCode int32    `thrift:"status_code,1" frugal:"1,default,i32" json:"status_code"`
+	StatusMsg  *string  `thrift:"status_msg,2,optional" frugal:"2,optional,string" json:"status_msg,omitempty"`
+	VideoList  []*Video `thrift:"video_list,3" frugal:"3,default,list<Video>" json:"video_list"`
+	NextTime   *int64   `thrift:"next_time,4,optional" frugal:"4,optional,i64" json:"next_time,omitempty"`
+	NextId     *int64   `thrift:"next_id,5,optional" frugal:"5,optional,i64" json:"next_id,omitempty"`
 }
 
-func NewVideoInfoListRequest() *VideoInfoListRequest {
-	return &VideoInfoListRequest{}
+func NewFollowFeedResponse() *FollowFeedResponse {
+	return &FollowFeedResponse{}
 }
 
-func (p *VideoInfoListRequest) InitDefault() {
-	*p = VideoInfoListRequest{}
+func (p *FollowFeedResponse) InitDefault() {
+	*p = FollowFeedResponse{}
 }
 
-var VideoInfoListRequest_UserId_DEFAULT int64
+func (p *FollowFeedResponse) GetStatusCode() (v int32) {
+	return p.StatusCode
+}
 
-func (p *VideoInfoListRequest) GetUserId() (v int64) {
-	if !p.IsSetUserId() {
-		return VideoInfoListRequest_UserId_DEFAULT
+var FollowFeedResponse_StatusMsg_DEFAULT string
+
+func (p *FollowFeedResponse) GetStatusMsg() (v string) {
+	if !p.IsSetStatusMsg() {
+		return FollowFeedResponse_StatusMsg_DEFAULT
 	}
-	return *p.UserId
+	return *p.StatusMsg
 }
 
-func (p *VideoInfoListRequest) GetVideoIdList() (v []int64) {
-	return p.VideoIdList
+func (p *FollowFeedResponse) GetVideoList() (v []*Video) {
+	return p.VideoList
 }
-func (p *VideoInfoListRequest) SetUserId(val *int64) {
-	p.UserId = val
+
+var FollowFeedResponse_NextTime_DEFAULT int64
+
+func (p *FollowFeedResponse) GetNextTime() (v int64) {
+	if !p.IsSetNextTime() {
+		return FollowFeedResponse_NextTime_DEFAULT
+	}
+	return *p.NextTime
 }
-func (p *VideoInfoListRequest) SetVideoIdList(val []int64) {
-	p.VideoIdList = val
+
+var FollowFeedResponse_NextId_DEFAULT int64
+
+func (p *FollowFeedResponse) GetNextId() (v int64) {
+	if !p.IsSetNextId() {
+		return FollowFeedResponse_NextId_DEFAULT
+	}
+	return *p.NextId
+}
+func (p *FollowFeedResponse) SetStatusCode(val int32) {
+	p.StatusCode = val
+}
+func (p *FollowFeedResponse) SetStatusMsg(val *string) {
+	p.StatusMsg = val
+}
+func (p *FollowFeedResponse) SetVideoList(val []*Video) {
+	p.VideoList = val
+}
+func (p *FollowFeedResponse) SetNextTime(val *int64) {
+	p.NextTime = val
+}
+func (p *FollowFeedResponse) SetNextId(val *int64) {
+	p.NextId = val
 }
 
-var fieldIDToName_VideoInfoListRequest = map[int16]string{
-	1: "user_id",
-	2: "video_id_list",
+var fieldIDToName_FollowFeedResponse = map[int16]string{
+	1: "status_code",
+	2: "status_msg",
+	3: "video_list",
+	4: "next_time",
+	5: "next_id",
 }
 
-func (p *VideoInfoListRequest) IsSetUserId() bool {
-	return p.UserId != nil
+func (p *FollowFeedResponse) IsSetNextId() bool {
+	return p.NextId != nil
 }
 
-func (p *VideoInfoListRequest) Read(iprot thrift.TProtocol) (err error) {
+func (p *FollowFeedResponse) IsSetStatusMsg() bool {
+	return p.StatusMsg != nil
+}
+
+func (p *FollowFeedResponse) IsSetNextTime() bool {
+	return p.NextTime != nil
+}
+
+func (p *FollowFeedResponse) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -1590,7 +2197,7 @@ func (p *VideoInfoListRequest) Read(iprot thrift.TProtocol) (err error) {
 
 		switch fieldId {
 		case 1:
-			if fieldTypeId == thrift.I64 {
+			if fieldTypeId == thrift.I32 {
 				if err = p.ReadField1(iprot); err != nil {
 					goto ReadFieldError
 				}
@@ -1598,13 +2205,37 @@ func (p *VideoInfoListRequest) Read(iprot thrift.TProtocol) (err error) {
 				goto SkipFieldError
 			}
 		case 2:
-			if fieldTypeId == thrift.LIST {
+			if fieldTypeId == thrift.STRING {
 				if err = p.ReadField2(iprot); err != nil {
 					goto ReadFieldError
 				}
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
+		case 3:
+			if fieldTypeId == thrift.LIST {
+				if err = p.ReadField3(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 4:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField4(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 5:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField5(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
 		default:
 			if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
@@ -1624,7 +2255,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_VideoInfoListRequest[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_FollowFeedResponse[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -1634,30 +2265,40 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *VideoInfoListRequest) ReadField1(iprot thrift.TProtocol) error {
+func (p *FollowFeedResponse) ReadField1(iprot thrift.TProtocol) error {
 
-	var _field *int64
-	if v, err := iprot.ReadI64(); err != nil {
+	var _field int32
+	if v, err := iprot.ReadI32(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.StatusCode = _field
+	return nil
+}
+func (p *FollowFeedResponse) ReadField2(iprot thrift.TProtocol) error {
+
+	var _field *string
+	if v, err := iprot.ReadString(); err != nil {
 		return err
 	} else {
 		_field = &v
 	}
-	p.UserId = _field
+	p.StatusMsg = _field
 	return nil
 }
-func (p *VideoInfoListRequest) ReadField2(iprot thrift.TProtocol) error {
+func (p *FollowFeedResponse) ReadField3(iprot thrift.TProtocol) error {
 	_, size, err := iprot.ReadListBegin()
 	if err != nil {
 		return err
 	}
-	_field := make([]int64, 0, size)
+	_field := make([]*Video, 0, size)
+	values := make([]Video, size)
 	for i := 0; i < size; i++ {
+		_elem := &values[i]
 
-		var _elem int64
-		if v, err := iprot.ReadI64(); err != nil {
+		if err := _elem.Read(iprot); err != nil {
 			return err
-		} else {
-			_elem = v
 		}
 
 		_field = append(_field, _elem)
@@ -1665,13 +2306,35 @@ func (p *VideoInfoListRequest) ReadField2(iprot thrift.TProtocol) error {
 	if err := iprot.ReadListEnd(); err != nil {
 		return err
 	}
-	p.VideoIdList = _field
+	p.VideoList = _field
 	return nil
 }
+func (p *FollowFeedResponse) ReadField4(iprot thrift.TProtocol) error {
 
-func (p *VideoInfoListRequest) Write(oprot thrift.TProtocol) (err error) {
+	var _field *int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.NextTime = _field
+	return nil
+}
+func (p *FollowFeedResponse) ReadField5(iprot thrift.TProtocol) error {
+
+	var _field *int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.NextId = _field
+	return nil
+}
+
+func (p *FollowFeedResponse) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("Video_info_list_request"); err != nil {
+	if err = oprot.WriteStructBegin("Follow_feed_response"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -1683,6 +2346,18 @@ func (p *VideoInfoListRequest) Write(oprot thrift.TProtocol) (err error) {
 			fieldId = 2
 			goto WriteFieldError
 		}
+		if err = p.writeField3(oprot); err != nil {
+			fieldId = 3
+			goto WriteFieldError
+		}
+		if err = p.writeField4(oprot); err != nil {
+			fieldId = 4
+			goto WriteFieldError
+		}
+		if err = p.writeField5(oprot); err != nil {
+			fieldId = 5
+			goto WriteFieldError
+		}
 	}
 	if err = oprot.WriteFieldStop(); err != nil {
 		goto WriteFieldStopError
@@ -1701,12 +2376,29 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *VideoInfoListRequest) writeField1(oprot thrift.TProtocol) (err error) {
-	if p.IsSetUserId() {
-		if err = oprot.WriteFieldBegin("user_id", thrift.I64, 1); err != nil {
+func (p *FollowFeedResponse) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("status_code", thrift.I32, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI32(p.StatusCode); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *FollowFeedResponse) writeField2(oprot thrift.TProtocol) (err error) {
+	if p.IsSetStatusMsg() {
+		if err = oprot.WriteFieldBegin("status_msg", thrift.STRING, 2); err != nil {
 			goto WriteFieldBeginError
 		}
-		if err := oprot.WriteI64(*p.UserId); err != nil {
+		if err := oprot.WriteString(*p.StatusMsg); err != nil {
 			return err
 		}
 		if err = oprot.WriteFieldEnd(); err != nil {
@@ -1715,20 +2407,20 @@ func (p *VideoInfoListRequest) writeField1(oprot thrift.TProtocol) (err error) {
 	}
 	return nil
 WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
 WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
 }
 
-func (p *VideoInfoListRequest) writeField2(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("video_id_list", thrift.LIST, 2); err != nil {
+func (p *FollowFeedResponse) writeField3(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("video_list", thrift.LIST, 3); err != nil {
 		goto WriteFieldBeginError
 	}
-	if err := oprot.WriteListBegin(thrift.I64, len(p.VideoIdList)); err != nil {
+	if err := oprot.WriteListBegin(thrift.STRUCT, len(p.VideoList)); err != nil {
 		return err
 	}
-	for _, v := range p.VideoIdList {
-		if err := oprot.WriteI64(v); err != nil {
+	for _, v := range p.VideoList {
+		if err := v.Write(oprot); err != nil {
 			return err
 		}
 	}
@@ -1740,102 +2432,180 @@ func (p *VideoInfoListRequest) writeField2(oprot thrift.TProtocol) (err error) {
 	}
 	return nil
 WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
 WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
 }
 
-func (p *VideoInfoListRequest) String() string {
+func (p *FollowFeedResponse) writeField4(oprot thrift.TProtocol) (err error) {
+	if p.IsSetNextTime() {
+		if err = oprot.WriteFieldBegin("next_time", thrift.I64, 4); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteI64(*p.NextTime); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
+}
+
+func (p *FollowFeedResponse) writeField5(oprot thrift.TProtocol) (err error) {
+	if p.IsSetNextId() {
+		if err = oprot.WriteFieldBegin("next_id", thrift.I64, 5); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteI64(*p.NextId); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 end error: ", p), err)
+}
+
+func (p *FollowFeedResponse) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("VideoInfoListRequest(%+v)", *p)
+	return fmt.Sprintf("FollowFeedResponse(%+v)", *p)
 
 }
 
-func (p *VideoInfoListRequest) DeepEqual(ano *VideoInfoListRequest) bool {
+func (p *FollowFeedResponse) DeepEqual(ano *FollowFeedResponse) bool {
 	if p == ano {
 		return true
 	} else if p == nil || ano == nil {
 		return false
 	}
-	if !p.Field1DeepEqual(ano.UserId) {
+	if !p.Field1DeepEqual(ano.StatusCode) {
 		return false
 	}
-	if !p.Field2DeepEqual(ano.VideoIdList) {
+	if !p.Field2DeepEqual(ano.StatusMsg) {
+		return false
+	}
+	if !p.Field3DeepEqual(ano.VideoList) {
+		return false
+	}
+	if !p.Field4DeepEqual(ano.NextTime) {
+		return false
+	}
+	if !p.Field5DeepEqual(ano.NextId) {
 		return false
 	}
 	return true
 }
 
-func (p *VideoInfoListRequest) Field1DeepEqual(src *int64) bool {
+func (p *FollowFeedResponse) Field1DeepEqual(src int32) bool {
 
-	if p.UserId == src {
+	if p.StatusCode != src {
+		return false
+	}
+	return true
+}
+func (p *FollowFeedResponse) Field2DeepEqual(src *string) bool {
+
+	if p.StatusMsg == src {
 		return true
-	} else if p.UserId == nil || src == nil {
+	} else if p.StatusMsg == nil || src == nil {
 		return false
 	}
-	if *p.UserId != *src {
+	if strings.Compare(*p.StatusMsg, *src) != 0 {
 		return false
 	}
 	return true
 }
-func (p *VideoInfoListRequest) Field2DeepEqual(src []int64) bool {
+func (p *FollowFeedResponse) Field3DeepEqual(src []*Video) bool {
 
-	if len(p.VideoIdList) != len(src) {
+	if len(p.VideoList) != len(src) {
 		return false
 	}
-	for i, v := range p.VideoIdList {
+	for i, v := range p.VideoList {
 		_src := src[i]
-		if v != _src {
+		if !v.DeepEqual(_src) {
 			return false
 		}
 	}
 	return true
 }
+func (p *FollowFeedResponse) Field4DeepEqual(src *int64) bool {
 
-type PublishActionRequest struct {
-	UserId int64  `thrift:"user_id,1" frugal:"1,default,i64" json:"user_id"`
-	Data   []byte `thrift:"data,2" frugal:"2,default,binary" json:"data"`
-	Title  string `thrift:"title,3" frugal:"3,default,string" json:"title"`
-}
-
-func NewPublishActionRequest() *PublishActionRequest {
-	return &PublishActionRequest{}
+	if p.NextTime == src {
+		return true
+	} else if p.NextTime == nil || src == nil {
+		return false
+	}
+	if *p.NextTime != *src {
+		return false
+	}
+	return true
 }
+func (p *FollowFeedResponse) Field5DeepEqual(src *int64) bool {
 
-func (p *PublishActionRequest) InitDefault() {
-	*p = PublishActionRequest{}
-}
+	if p.NextId == src {
+		return true
+	} else if p.NextId == nil || src == nil {
+		return false
+	}
+	if *p.NextId != *src {
+		return false
+	}
+	return true
+}
 
-func (p *PublishActionRequest) GetUserId() (v int64) {
-	return p.UserId
+type VideoInfoRequest struct {
+	UserId  *int64 `thrift:"user_id,1,optional" frugal:"1,optional,i64" json:"user_id,omitempty"`
+	VideoId int64  `thrift:"video_id,2" frugal:"2,default,i64" json:"video_id"`
 }
 
-func (p *PublishActionRequest) GetData() (v []byte) {
-	return p.Data
+func NewVideoInfoRequest() *VideoInfoRequest {
+	return &VideoInfoRequest{}
 }
 
-func (p *PublishActionRequest) GetTitle() (v string) {
-	return p.Title
+func (p *VideoInfoRequest) InitDefault() {
+	*p = VideoInfoRequest{}
 }
-func (p *PublishActionRequest) SetUserId(val int64) {
-	p.UserId = val
+
+var VideoInfoRequest_UserId_DEFAULT int64
+
+func (p *VideoInfoRequest) GetUserId() (v int64) {
+	if !p.IsSetUserId() {
+		return VideoInfoRequest_UserId_DEFAULT
+	}
+	return *p.UserId
 }
-func (p *PublishActionRequest) SetData(val []byte) {
-	p.Data = val
+
+func (p *VideoInfoRequest) GetVideoId() (v int64) {
+	return p.VideoId
 }
-func (p *PublishActionRequest) SetTitle(val string) {
-	p.Title = val
+func (p *VideoInfoRequest) SetUserId(val *int64) {
+	p.UserId = val
+}
+func (p *VideoInfoRequest) SetVideoId(val int64) {
+	p.VideoId = val
 }
 
-var fieldIDToName_PublishActionRequest = map[int16]string{
+var fieldIDToName_VideoInfoRequest = map[int16]string{
 	1: "user_id",
-	2: "data",
-	3: "title",
+	2: "video_id",
 }
 
-func (p *PublishActionRequest) Read(iprot thrift.TProtocol) (err error) {
+func (p *VideoInfoRequest) IsSetUserId() bool {
+	return p.UserId != nil
+}
+
+func (p *VideoInfoRequest) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -1863,21 +2633,13 @@ func (p *PublishActionRequest) Read(iprot thrift.TProtocol) (err error) {
 				goto SkipFieldError
 			}
 		case 2:
-			if fieldTypeId == thrift.STRING {
+			if fieldTypeId == thrift.I64 {
 				if err = p.ReadField2(iprot); err != nil {
 					goto ReadFieldError
 				}
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
-		case 3:
-			if fieldTypeId == thrift.STRING {
-				if err = p.ReadField3(iprot); err != nil {
-					goto ReadFieldError
-				}
-			} else if err = iprot.Skip(fieldTypeId); err != nil {
-				goto SkipFieldError
-			}
 		default:
 			if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
@@ -1897,7 +2659,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_PublishActionRequest[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_VideoInfoRequest[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -1907,43 +2669,32 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *PublishActionRequest) ReadField1(iprot thrift.TProtocol) error {
+func (p *VideoInfoRequest) ReadField1(iprot thrift.TProtocol) error {
 
-	var _field int64
+	var _field *int64
 	if v, err := iprot.ReadI64(); err != nil {
 		return err
 	} else {
-		_field = v
+		_field = &v
 	}
 	p.UserId = _field
 	return nil
 }
-func (p *PublishActionRequest) ReadField2(iprot thrift.TProtocol) error {
-
-	var _field []byte
-	if v, err := iprot.ReadBinary(); err != nil {
-		return err
-	} else {
-		_field = []byte(v)
-	}
-	p.Data = _field
-	return nil
-}
-func (p *PublishActionRequest) ReadField3(iprot thrift.TProtocol) error {
+func (p *VideoInfoRequest) ReadField2(iprot thrift.TProtocol) error {
 
-	var _field string
-	if v, err := iprot.ReadString(); err != nil {
+	var _field int64
+	if v, err := iprot.ReadI64(); err != nil {
 		return err
 	} else {
 		_field = v
 	}
-	p.Title = _field
+	p.VideoId = _field
 	return nil
 }
 
-func (p *PublishActionRequest) Write(oprot thrift.TProtocol) (err error) {
+func (p *VideoInfoRequest) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("Publish_action_request"); err != nil {
+	if err = oprot.WriteStructBegin("Video_info_request"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -1955,10 +2706,6 @@ func (p *PublishActionRequest) Write(oprot thrift.TProtocol) (err error) {
 			fieldId = 2
 			goto WriteFieldError
 		}
-		if err = p.writeField3(oprot); err != nil {
-			fieldId = 3
-			goto WriteFieldError
-		}
 	}
 	if err = oprot.WriteFieldStop(); err != nil {
 		goto WriteFieldStopError
@@ -1977,15 +2724,17 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *PublishActionRequest) writeField1(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("user_id", thrift.I64, 1); err != nil {
-		goto WriteFieldBeginError
-	}
-	if err := oprot.WriteI64(p.UserId); err != nil {
-		return err
-	}
-	if err = oprot.WriteFieldEnd(); err != nil {
-		goto WriteFieldEndError
+func (p *VideoInfoRequest) writeField1(oprot thrift.TProtocol) (err error) {
+	if p.IsSetUserId() {
+		if err = oprot.WriteFieldBegin("user_id", thrift.I64, 1); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteI64(*p.UserId); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
 	}
 	return nil
 WriteFieldBeginError:
@@ -1994,11 +2743,11 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *PublishActionRequest) writeField2(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("data", thrift.STRING, 2); err != nil {
+func (p *VideoInfoRequest) writeField2(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("video_id", thrift.I64, 2); err != nil {
 		goto WriteFieldBeginError
 	}
-	if err := oprot.WriteBinary([]byte(p.Data)); err != nil {
+	if err := oprot.WriteI64(p.VideoId); err != nil {
 		return err
 	}
 	if err = oprot.WriteFieldEnd(); err != nil {
@@ -2011,32 +2760,15 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
 }
 
-func (p *PublishActionRequest) writeField3(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("title", thrift.STRING, 3); err != nil {
-		goto WriteFieldBeginError
-	}
-	if err := oprot.WriteString(p.Title); err != nil {
-		return err
-	}
-	if err = oprot.WriteFieldEnd(); err != nil {
-		goto WriteFieldEndError
-	}
-	return nil
-WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
-WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
-}
-
-func (p *PublishActionRequest) String() string {
+func (p *VideoInfoRequest) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("PublishActionRequest(%+v)", *p)
+	return fmt.Sprintf("VideoInfoRequest(%+v)", *p)
 
 }
 
-func (p *PublishActionRequest) DeepEqual(ano *PublishActionRequest) bool {
+func (p *VideoInfoRequest) DeepEqual(ano *VideoInfoRequest) bool {
 	if p == ano {
 		return true
 	} else if p == nil || ano == nil {
@@ -2045,79 +2777,74 @@ func (p *PublishActionRequest) DeepEqual(ano *PublishActionRequest) bool {
 	if !p.Field1DeepEqual(ano.UserId) {
 		return false
 	}
-	if !p.Field2DeepEqual(ano.Data) {
-		return false
-	}
-	if !p.Field3DeepEqual(ano.Title) {
+	if !p.Field2DeepEqual(ano.VideoId) {
 		return false
 	}
 	return true
 }
 
-func (p *PublishActionRequest) Field1DeepEqual(src int64) bool {
+func (p *VideoInfoRequest) Field1DeepEqual(src *int64) bool {
 
-	if p.UserId != src {
+	if p.UserId == src {
+		return true
+	} else if p.UserId == nil || src == nil {
 		return false
 	}
-	return true
-}
-func (p *PublishActionRequest) Field2DeepEqual(src []byte) bool {
-
-	if bytes.Compare(p.Data, src) != 0 {
+	if *p.UserId != *src {
 		return false
 	}
 	return true
 }
-func (p *PublishActionRequest) Field3DeepEqual(src string) bool {
+func (p *VideoInfoRequest) Field2DeepEqual(src int64) bool {
 
-	if strings.Compare(p.Title, src) != 0 {
+	if p.VideoId != src {
 		return false
 	}
 	return true
 }
 
-type PublishActionResponse struct {
-	StatusCode int32   `thrift:"status_code,1" frugal:"1,default,i32" json:"status_code"`
-	StatusMsg  *string `thrift:"status_msg,2,optional" frugal:"2,optional,string" json:"status_msg,omitempty"`
-}
-
-func NewPublishActionResponse() *PublishActionResponse {
-	return &PublishActionResponse{}
+type VideoInfoListRequest struct {
+	UserId      *int64  `thrift:"user_id,1,optional" frugal:"1,optional,i64" json:"user_id,omitempty"`
+	VideoIdList []int64 `thrift:"video_id_list,2" frugal:"2,default,list<i64>" json:"video_id_list"`
 }
 
-func (p *PublishActionResponse) InitDefault() {
-	*p = PublishActionResponse{}
+func NewVideoInfoListRequest() *VideoInfoListRequest {
+	return &VideoInfoListRequest{}
 }
 
-func (p *PublishActionResponse) GetStatusCode() (v int32) {
-	return p.StatusCode
+func (p *VideoInfoListRequest) InitDefault() {
+	*p = VideoInfoListRequest{}
 }
 
-var PublishActionResponse_StatusMsg_DEFAULT string
+var VideoInfoListRequest_UserId_DEFAULT int64
 
-func (p *PublishActionResponse) GetStatusMsg() (v string) {
-	if !p.IsSetStatusMsg() {
-		return PublishActionResponse_StatusMsg_DEFAULT
+func (p *VideoInfoListRequest) GetUserId() (v int64) {
+	if !p.IsSetUserId() {
+		return VideoInfoListRequest_UserId_DEFAULT
 	}
-	return *p.StatusMsg
+	return *p.UserId
 }
-func (p *PublishActionResponse) SetStatusCode(val int32) {
-	p.StatusCode = val
+
+func (p *VideoInfoListRequest) GetVideoIdList() (v []int64) {
+	return p.VideoIdList
 }
-func (p *PublishActionResponse) SetStatusMsg(val *string) {
-	p.StatusMsg = val
+func (p *VideoInfoListRequest) SetUserId(val *int64) {
+	p.UserId = val
+}
+func (p *VideoInfoListRequest) SetVideoIdList(val []int64) {
+	p.VideoIdList = val
 }
 
-var fieldIDToName_PublishActionResponse = map[int16]string{
-	1: "status_code",
-	2: "status_msg",
+var fieldIDToName_VideoInfoListRequest = map[int16]string{
+	1: "user_id",
+	2: "video_id_list",
 }
 
-func (p *PublishActionResponse) IsSetStatusMsg() bool {
-	return p.StatusMsg != nil
+func (p *VideoInfoListRequest) IsSetUserId() bool {
+	return p.UserId != nil
 }
 
-func (p *PublishActionResponse) Read(iprot thrift.TProtocol) (err error) {
+func (p *VideoInfoListRequest) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -2137,7 +2864,7 @@ func (p *PublishActionResponse) Read(iprot thrift.TProtocol) (err error) {
 
 		switch fieldId {
 		case 1:
-			if fieldTypeId == thrift.I32 {
+			if fieldTypeId == thrift.I64 {
 				if err = p.ReadField1(iprot); err != nil {
 					goto ReadFieldError
 				}
@@ -2145,7 +2872,7 @@ func (p *PublishActionResponse) Read(iprot thrift.TProtocol) (err error) {
 				goto SkipFieldError
 			}
 		case 2:
-			if fieldTypeId == thrift.STRING {
+			if fieldTypeId == thrift.LIST {
 				if err = p.ReadField2(iprot); err != nil {
 					goto ReadFieldError
 				}
@@ -2171,7 +2898,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_PublishActionResponse[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_VideoInfoListRequest[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -2181,32 +2908,44 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *PublishActionResponse) ReadField1(iprot thrift.TProtocol) error {
+func (p *VideoInfoListRequest) ReadField1(iprot thrift.TProtocol) error {
 
-	var _field int32
-	if v, err := iprot.ReadI32(); err != nil {
+	var _field *int64
+	if v, err := iprot.ReadI64(); err != nil {
 		return err
 	} else {
-		_field = v
+		_field = &v
 	}
-	p.StatusCode = _field
+	p.UserId = _field
 	return nil
 }
-func (p *PublishActionResponse) ReadField2(iprot thrift.TProtocol) error {
+func (p *VideoInfoListRequest) ReadField2(iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin()
+	if err != nil {
+		return err
+	}
+	_field := make([]int64, 0, size)
+	for i := 0; i < size; i++ {
 
-	var _field *string
-	if v, err := iprot.ReadString(); err != nil {
+		var _elem int64
+		if v, err := iprot.ReadI64(); err != nil {
+			return err
+		} else {
+			_elem = v
+		}
+
+		_field = append(_field, _elem)
+	}
+	if err := iprot.ReadListEnd(); err != nil {
 		return err
-	} else {
-		_field = &v
 	}
-	p.StatusMsg = _field
+	p.VideoIdList = _field
 	return nil
 }
 
-func (p *PublishActionResponse) Write(oprot thrift.TProtocol) (err error) {
+func (p *VideoInfoListRequest) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("Publish_action_response"); err != nil {
+	if err = oprot.WriteStructBegin("Video_info_list_request"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -2236,15 +2975,17 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *PublishActionResponse) writeField1(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("status_code", thrift.I32, 1); err != nil {
-		goto WriteFieldBeginError
-	}
-	if err := oprot.WriteI32(p.StatusCode); err != nil {
-		return err
-	}
-	if err = oprot.WriteFieldEnd(); err != nil {
-		goto WriteFieldEndError
+func (p *VideoInfoListRequest) writeField1(oprot thrift.TProtocol) (err error) {
+	if p.IsSetUserId() {
+		if err = oprot.WriteFieldBegin("user_id", thrift.I64, 1); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteI64(*p.UserId); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
 	}
 	return nil
 WriteFieldBeginError:
@@ -2253,17 +2994,23 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *PublishActionResponse) writeField2(oprot thrift.TProtocol) (err error) {
-	if p.IsSetStatusMsg() {
-		if err = oprot.WriteFieldBegin("status_msg", thrift.STRING, 2); err != nil {
-			goto WriteFieldBeginError
-		}
-		if err := oprot.WriteString(*p.StatusMsg); err != nil {
+func (p *VideoInfoListRequest) writeField2(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("video_id_list", thrift.LIST, 2); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteListBegin(thrift.I64, len(p.VideoIdList)); err != nil {
+		return err
+	}
+	for _, v := range p.VideoIdList {
+		if err := oprot.WriteI64(v); err != nil {
 			return err
 		}
-		if err = oprot.WriteFieldEnd(); err != nil {
-			goto WriteFieldEndError
-		}
+	}
+	if err := oprot.WriteListEnd(); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
 	}
 	return nil
 WriteFieldBeginError:
@@ -2272,91 +3019,124 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
 }
 
-func (p *PublishActionResponse) String() string {
+func (p *VideoInfoListRequest) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("PublishActionResponse(%+v)", *p)
+	return fmt.Sprintf("VideoInfoListRequest(%+v)", *p)
 
 }
 
-func (p *PublishActionResponse) DeepEqual(ano *PublishActionResponse) bool {
+func (p *VideoInfoListRequest) DeepEqual(ano *VideoInfoListRequest) bool {
 	if p == ano {
 		return true
 	} else if p == nil || ano == nil {
 		return false
 	}
-	if !p.Field1DeepEqual(ano.StatusCode) {
+	if !p.Field1DeepEqual(ano.UserId) {
 		return false
 	}
-	if !p.Field2DeepEqual(ano.StatusMsg) {
+	if !p.Field2DeepEqual(ano.VideoIdList) {
 		return false
 	}
 	return true
 }
 
-func (p *PublishActionResponse) Field1DeepEqual(src int32) bool {
+func (p *VideoInfoListRequest) Field1DeepEqual(src *int64) bool {
 
-	if p.StatusCode != src {
+	if p.UserId == src {
+		return true
+	} else if p.UserId == nil || src == nil {
+		return false
+	}
+	if *p.UserId != *src {
 		return false
 	}
 	return true
 }
-func (p *PublishActionResponse) Field2DeepEqual(src *string) bool {
+func (p *VideoInfoListRequest) Field2DeepEqual(src []int64) bool {
 
-	if p.StatusMsg == src {
-		return true
-	} else if p.StatusMsg == nil || src == nil {
+	if len(p.VideoIdList) != len(src) {
 		return false
 	}
-	if strings.Compare(*p.StatusMsg, *src) != 0 {
-		return false
+	for i, v := range p.VideoIdList {
+		_src := src[i]
+		if v != _src {
+			return false
+		}
 	}
 	return true
 }
 
-type PublishListRequest struct {
-	UserId   *int64 `thrift:"user_id,1,optional" frugal:"1,optional,i64" json:"user_id,omitempty"`
-	AuthorId int64  `thrift:"author_id,2" frugal:"2,default,i64" json:"author_id"`
+type PublishActionRequest struct {
+	UserId     int64   `thrift:"user_id,1" frugal:"1,default,i64" json:"user_id"`
+	Title      string  `thrift:"title,3" frugal:"3,default,string" json:"title"`
+	VideoName  string  `thrift:"video_name,4" frugal:"4,default,string" json:"video_name"`
+	CoverName  *string `thrift:"cover_name,5,optional" frugal:"5,optional,string" json:"cover_name,omitempty"`
+	Visibility int32   `thrift:"visibility,6" frugal:"6,default,i32" json:"visibility"`
 }
 
-func NewPublishListRequest() *PublishListRequest {
-	return &PublishListRequest{}
+func NewPublishActionRequest() *PublishActionRequest {
+	return &PublishActionRequest{}
 }
 
-func (p *PublishListRequest) InitDefault() {
-	*p = PublishListRequest{}
+func (p *PublishActionRequest) InitDefault() {
+	*p = PublishActionRequest{}
 }
 
-var PublishListRequest_UserId_DEFAULT int64
+func (p *PublishActionRequest) GetUserId() (v int64) {
+	return p.UserId
+}
 
-func (p *PublishListRequest) GetUserId() (v int64) {
-	if !p.IsSetUserId() {
-		return PublishListRequest_UserId_DEFAULT
+func (p *PublishActionRequest) GetTitle() (v string) {
+	return p.Title
+}
+
+func (p *PublishActionRequest) GetVideoName() (v string) {
+	return p.VideoName
+}
+
+var PublishActionRequest_CoverName_DEFAULT string
+
+func (p *PublishActionRequest) GetCoverName() (v string) {
+	if !p.IsSetCoverName() {
+		return PublishActionRequest_CoverName_DEFAULT
 	}
-	return *p.UserId
+	return *p.CoverName
 }
 
-func (p *PublishListRequest) GetAuthorId() (v int64) {
-	return p.AuthorId
+func (p *PublishActionRequest) GetVisibility() (v int32) {
+	return p.Visibility
 }
-func (p *PublishListRequest) SetUserId(val *int64) {
+func (p *PublishActionRequest) SetUserId(val int64) {
 	p.UserId = val
 }
-func (p *PublishListRequest) SetAuthorId(val int64) {
-	p.AuthorId = val
+func (p *PublishActionRequest) SetTitle(val string) {
+	p.Title = val
+}
+func (p *PublishActionRequest) SetVideoName(val string) {
+	p.VideoName = val
+}
+func (p *PublishActionRequest) SetCoverName(val *string) {
+	p.CoverName = val
+}
+func (p *PublishActionRequest) SetVisibility(val int32) {
+	p.Visibility = val
 }
 
-var fieldIDToName_PublishListRequest = map[int16]string{
+var fieldIDToName_PublishActionRequest = map[int16]string{
 	1: "user_id",
-	2: "author_id",
+	3: "title",
+	4: "video_name",
+	5: "cover_name",
+	6: "visibility",
 }
 
-func (p *PublishListRequest) IsSetUserId() bool {
-	return p.UserId != nil
+func (p *PublishActionRequest) IsSetCoverName() bool {
+	return p.CoverName != nil
 }
 
-func (p *PublishListRequest) Read(iprot thrift.TProtocol) (err error) {
+func (p *PublishActionRequest) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -2383,9 +3163,33 @@ func (p *PublishListRequest) Read(iprot thrift.TProtocol) (err error) {
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
-		case 2:
-			if fieldTypeId == thrift.I64 {
-				if err = p.ReadField2(iprot); err != nil {
+		case 3:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField3(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 4:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField4(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 5:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField5(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 6:
+			if fieldTypeId == thrift.I32 {
+				if err = p.ReadField6(iprot); err != nil {
 					goto ReadFieldError
 				}
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
@@ -2410,7 +3214,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_PublishListRequest[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_PublishActionRequest[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -2420,32 +3224,65 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *PublishListRequest) ReadField1(iprot thrift.TProtocol) error {
+func (p *PublishActionRequest) ReadField1(iprot thrift.TProtocol) error {
 
-	var _field *int64
+	var _field int64
 	if v, err := iprot.ReadI64(); err != nil {
 		return err
 	} else {
-		_field = &v
+		_field = v
 	}
 	p.UserId = _field
 	return nil
 }
-func (p *PublishListRequest) ReadField2(iprot thrift.TProtocol) error {
+func (p *PublishActionRequest) ReadField3(iprot thrift.TProtocol) error {
 
-	var _field int64
-	if v, err := iprot.ReadI64(); err != nil {
+	var _field string
+	if v, err := iprot.ReadString(); err != nil {
 		return err
 	} else {
 		_field = v
 	}
-	p.AuthorId = _field
+	p.Title = _field
 	return nil
 }
+func (p *PublishActionRequest) ReadField4(iprot thrift.TProtocol) error {
 
-func (p *PublishListRequest) Write(oprot thrift.TProtocol) (err error) {
+	var _field string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.VideoName = _field
+	return nil
+}
+func (p *PublishActionRequest) ReadField5(iprot thrift.TProtocol) error {
+
+	var _field *string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.CoverName = _field
+	return nil
+}
+func (p *PublishActionRequest) ReadField6(iprot thrift.TProtocol) error {
+
+	var _field int32
+	if v, err := iprot.ReadI32(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.Visibility = _field
+	return nil
+}
+
+func (p *PublishActionRequest) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("Publish_list_request"); err != nil {
+	if err = oprot.WriteStructBegin("Publish_action_request"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -2453,8 +3290,20 @@ func (p *PublishListRequest) Write(oprot thrift.TProtocol) (err error) {
 			fieldId = 1
 			goto WriteFieldError
 		}
-		if err = p.writeField2(oprot); err != nil {
-			fieldId = 2
+		if err = p.writeField3(oprot); err != nil {
+			fieldId = 3
+			goto WriteFieldError
+		}
+		if err = p.writeField4(oprot); err != nil {
+			fieldId = 4
+			goto WriteFieldError
+		}
+		if err = p.writeField5(oprot); err != nil {
+			fieldId = 5
+			goto WriteFieldError
+		}
+		if err = p.writeField6(oprot); err != nil {
+			fieldId = 6
 			goto WriteFieldError
 		}
 	}
@@ -2475,17 +3324,15 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *PublishListRequest) writeField1(oprot thrift.TProtocol) (err error) {
-	if p.IsSetUserId() {
-		if err = oprot.WriteFieldBegin("user_id", thrift.I64, 1); err != nil {
-			goto WriteFieldBeginError
-		}
-		if err := oprot.WriteI64(*p.UserId); err != nil {
-			return err
-		}
-		if err = oprot.WriteFieldEnd(); err != nil {
-			goto WriteFieldEndError
-		}
+func (p *PublishActionRequest) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("user_id", thrift.I64, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI64(p.UserId); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
 	}
 	return nil
 WriteFieldBeginError:
@@ -2494,11 +3341,11 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *PublishListRequest) writeField2(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("author_id", thrift.I64, 2); err != nil {
+func (p *PublishActionRequest) writeField3(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("title", thrift.STRING, 3); err != nil {
 		goto WriteFieldBeginError
 	}
-	if err := oprot.WriteI64(p.AuthorId); err != nil {
+	if err := oprot.WriteString(p.Title); err != nil {
 		return err
 	}
 	if err = oprot.WriteFieldEnd(); err != nil {
@@ -2506,105 +3353,188 @@ func (p *PublishListRequest) writeField2(oprot thrift.TProtocol) (err error) {
 	}
 	return nil
 WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
 WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
-}
-
-func (p *PublishListRequest) String() string {
-	if p == nil {
-		return "<nil>"
-	}
-	return fmt.Sprintf("PublishListRequest(%+v)", *p)
-
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
 }
 
-func (p *PublishListRequest) DeepEqual(ano *PublishListRequest) bool {
-	if p == ano {
-		return true
-	} else if p == nil || ano == nil {
-		return false
+func (p *PublishActionRequest) writeField4(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("video_name", thrift.STRING, 4); err != nil {
+		goto WriteFieldBeginError
 	}
-	if !p.Field1DeepEqual(ano.UserId) {
-		return false
+	if err := oprot.WriteString(p.VideoName); err != nil {
+		return err
 	}
-	if !p.Field2DeepEqual(ano.AuthorId) {
-		return false
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
+}
+
+func (p *PublishActionRequest) writeField5(oprot thrift.TProtocol) (err error) {
+	if p.IsSetCoverName() {
+		if err = oprot.WriteFieldBegin("cover_name", thrift.STRING, 5); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.CoverName); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 end error: ", p), err)
+}
+
+func (p *PublishActionRequest) writeField6(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("visibility", thrift.I32, 6); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI32(p.Visibility); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 6 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 6 end error: ", p), err)
+}
+
+func (p *PublishActionRequest) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("PublishActionRequest(%+v)", *p)
+
+}
+
+func (p *PublishActionRequest) DeepEqual(ano *PublishActionRequest) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.UserId) {
+		return false
+	}
+	if !p.Field3DeepEqual(ano.Title) {
+		return false
+	}
+	if !p.Field4DeepEqual(ano.VideoName) {
+		return false
+	}
+	if !p.Field5DeepEqual(ano.CoverName) {
+		return false
+	}
+	if !p.Field6DeepEqual(ano.Visibility) {
+		return false
 	}
 	return true
 }
 
-func (p *PublishListRequest) Field1DeepEqual(src *int64) bool {
+func (p *PublishActionRequest) Field1DeepEqual(src int64) bool {
 
-	if p.UserId == src {
+	if p.UserId != src {
+		return false
+	}
+	return true
+}
+func (p *PublishActionRequest) Field3DeepEqual(src string) bool {
+
+	if strings.Compare(p.Title, src) != 0 {
+		return false
+	}
+	return true
+}
+func (p *PublishActionRequest) Field4DeepEqual(src string) bool {
+
+	if strings.Compare(p.VideoName, src) != 0 {
+		return false
+	}
+	return true
+}
+func (p *PublishActionRequest) Field5DeepEqual(src *string) bool {
+
+	if p.CoverName == src {
 		return true
-	} else if p.UserId == nil || src == nil {
+	} else if p.CoverName == nil || src == nil {
 		return false
 	}
-	if *p.UserId != *src {
+	if strings.Compare(*p.CoverName, *src) != 0 {
 		return false
 	}
 	return true
 }
-func (p *PublishListRequest) Field2DeepEqual(src int64) bool {
+func (p *PublishActionRequest) Field6DeepEqual(src int32) bool {
 
-	if p.AuthorId != src {
+	if p.Visibility != src {
 		return false
 	}
 	return true
 }
 
-type PublishListResponse struct {
-	StatusCode int32    `thrift:"status_code,1" frugal:"1,default,i32" json:"status_code"`
-	StatusMsg  *string  `thrift:"status_msg,2,optional" frugal:"2,optional,string" json:"status_msg,omitempty"`
-	VideoList  []*Video `thrift:"video_list,3" frugal:"3,default,list<Video>" json:"video_list"`
+type PublishActionResponse struct {
+	StatusCode int32   `thrift:"status_code,1" frugal:"1,default,i32" json:"status_code"`
+	StatusMsg  *string `thrift:"status_msg,2,optional" frugal:"2,optional,string" json:"status_msg,omitempty"`
+	VideoId    int64   `thrift:"video_id,3" frugal:"3,default,i64" json:"video_id"`
 }
 
-func NewPublishListResponse() *PublishListResponse {
-	return &PublishListResponse{}
+func NewPublishActionResponse() *PublishActionResponse {
+	return &PublishActionResponse{}
 }
 
-func (p *PublishListResponse) InitDefault() {
-	*p = PublishListResponse{}
+func (p *PublishActionResponse) InitDefault() {
+	*p = PublishActionResponse{}
 }
 
-func (p *PublishListResponse) GetStatusCode() (v int32) {
+func (p *PublishActionResponse) GetStatusCode() (v int32) {
 	return p.StatusCode
 }
 
-var PublishListResponse_StatusMsg_DEFAULT string
+var PublishActionResponse_StatusMsg_DEFAULT string
 
-func (p *PublishListResponse) GetStatusMsg() (v string) {
+func (p *PublishActionResponse) GetStatusMsg() (v string) {
 	if !p.IsSetStatusMsg() {
-		return PublishListResponse_StatusMsg_DEFAULT
+		return PublishActionResponse_StatusMsg_DEFAULT
 	}
 	return *p.StatusMsg
 }
 
-func (p *PublishListResponse) GetVideoList() (v []*Video) {
-	return p.VideoList
+func (p *PublishActionResponse) GetVideoId() (v int64) {
+	return p.VideoId
 }
-func (p *PublishListResponse) SetStatusCode(val int32) {
+func (p *PublishActionResponse) SetStatusCode(val int32) {
 	p.StatusCode = val
 }
-func (p *PublishListResponse) SetStatusMsg(val *string) {
+func (p *PublishActionResponse) SetStatusMsg(val *string) {
 	p.StatusMsg = val
 }
-func (p *PublishListResponse) SetVideoList(val []*Video) {
-	p.VideoList = val
+func (p *PublishActionResponse) SetVideoId(val int64) {
+	p.VideoId = val
 }
 
-var fieldIDToName_PublishListResponse = map[int16]string{
+var fieldIDToName_PublishActionResponse = map[int16]string{
 	1: "status_code",
 	2: "status_msg",
-	3: "video_list",
+	3: "video_id",
 }
 
-func (p *PublishListResponse) IsSetStatusMsg() bool {
+func (p *PublishActionResponse) IsSetStatusMsg() bool {
 	return p.StatusMsg != nil
 }
 
-func (p *PublishListResponse) Read(iprot thrift.TProtocol) (err error) {
+func (p *PublishActionResponse) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -2640,7 +3570,7 @@ func (p *PublishListResponse) Read(iprot thrift.TProtocol) (err error) {
 				goto SkipFieldError
 			}
 		case 3:
-			if fieldTypeId == thrift.LIST {
+			if fieldTypeId == thrift.I64 {
 				if err = p.ReadField3(iprot); err != nil {
 					goto ReadFieldError
 				}
@@ -2666,7 +3596,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_PublishListResponse[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_PublishActionResponse[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -2676,7 +3606,7 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *PublishListResponse) ReadField1(iprot thrift.TProtocol) error {
+func (p *PublishActionResponse) ReadField1(iprot thrift.TProtocol) error {
 
 	var _field int32
 	if v, err := iprot.ReadI32(); err != nil {
@@ -2687,7 +3617,7 @@ func (p *PublishListResponse) ReadField1(iprot thrift.TProtocol) error {
 	p.StatusCode = _field
 	return nil
 }
-func (p *PublishListResponse) ReadField2(iprot thrift.TProtocol) error {
+func (p *PublishActionResponse) ReadField2(iprot thrift.TProtocol) error {
 
 	var _field *string
 	if v, err := iprot.ReadString(); err != nil {
@@ -2698,32 +3628,21 @@ func (p *PublishListResponse) ReadField2(iprot thrift.TProtocol) error {
 	p.StatusMsg = _field
 	return nil
 }
-func (p *PublishListResponse) ReadField3(iprot thrift.TProtocol) error {
-	_, size, err := iprot.ReadListBegin()
-	if err != nil {
-		return err
-	}
-	_field := make([]*Video, 0, size)
-	values := make([]Video, size)
-	for i := 0; i < size; i++ {
-		_elem := &values[i]
+func (p *PublishActionResponse) ReadField3(iprot thrift.TProtocol) error {
 
-		if err := _elem.Read(iprot); err != nil {
-			return err
-		}
-
-		_field = append(_field, _elem)
-	}
-	if err := iprot.ReadListEnd(); err != nil {
+	var _field int64
+	if v, err := iprot.ReadI64(); err != nil {
 		return err
+	} else {
+		_field = v
 	}
-	p.VideoList = _field
+	p.VideoId = _field
 	return nil
 }
 
-func (p *PublishListResponse) Write(oprot thrift.TProtocol) (err error) {
+func (p *PublishActionResponse) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("Publish_list_response"); err != nil {
+	if err = oprot.WriteStructBegin("Publish_action_response"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -2757,7 +3676,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *PublishListResponse) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *PublishActionResponse) writeField1(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("status_code", thrift.I32, 1); err != nil {
 		goto WriteFieldBeginError
 	}
@@ -2774,7 +3693,7 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *PublishListResponse) writeField2(oprot thrift.TProtocol) (err error) {
+func (p *PublishActionResponse) writeField2(oprot thrift.TProtocol) (err error) {
 	if p.IsSetStatusMsg() {
 		if err = oprot.WriteFieldBegin("status_msg", thrift.STRING, 2); err != nil {
 			goto WriteFieldBeginError
@@ -2793,19 +3712,11 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
 }
 
-func (p *PublishListResponse) writeField3(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("video_list", thrift.LIST, 3); err != nil {
+func (p *PublishActionResponse) writeField3(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("video_id", thrift.I64, 3); err != nil {
 		goto WriteFieldBeginError
 	}
-	if err := oprot.WriteListBegin(thrift.STRUCT, len(p.VideoList)); err != nil {
-		return err
-	}
-	for _, v := range p.VideoList {
-		if err := v.Write(oprot); err != nil {
-			return err
-		}
-	}
-	if err := oprot.WriteListEnd(); err != nil {
+	if err := oprot.WriteI64(p.VideoId); err != nil {
 		return err
 	}
 	if err = oprot.WriteFieldEnd(); err != nil {
@@ -2818,15 +3729,15 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
 }
 
-func (p *PublishListResponse) String() string {
+func (p *PublishActionResponse) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("PublishListResponse(%+v)", *p)
+	return fmt.Sprintf("PublishActionResponse(%+v)", *p)
 
 }
 
-func (p *PublishListResponse) DeepEqual(ano *PublishListResponse) bool {
+func (p *PublishActionResponse) DeepEqual(ano *PublishActionResponse) bool {
 	if p == ano {
 		return true
 	} else if p == nil || ano == nil {
@@ -2838,20 +3749,20 @@ func (p *PublishListResponse) DeepEqual(ano *PublishListResponse) bool {
 	if !p.Field2DeepEqual(ano.StatusMsg) {
 		return false
 	}
-	if !p.Field3DeepEqual(ano.VideoList) {
+	if !p.Field3DeepEqual(ano.VideoId) {
 		return false
 	}
 	return true
 }
 
-func (p *PublishListResponse) Field1DeepEqual(src int32) bool {
+func (p *PublishActionResponse) Field1DeepEqual(src int32) bool {
 
 	if p.StatusCode != src {
 		return false
 	}
 	return true
 }
-func (p *PublishListResponse) Field2DeepEqual(src *string) bool {
+func (p *PublishActionResponse) Field2DeepEqual(src *string) bool {
 
 	if p.StatusMsg == src {
 		return true
@@ -2863,662 +3774,9647 @@ func (p *PublishListResponse) Field2DeepEqual(src *string) bool {
 	}
 	return true
 }
-func (p *PublishListResponse) Field3DeepEqual(src []*Video) bool {
+func (p *PublishActionResponse) Field3DeepEqual(src int64) bool {
 
-	if len(p.VideoList) != len(src) {
+	if p.VideoId != src {
 		return false
 	}
-	for i, v := range p.VideoList {
-		_src := src[i]
-		if !v.DeepEqual(_src) {
-			return false
-		}
-	}
 	return true
 }
 
-type VideoService interface {
-	Feed(ctx context.Context, req *FeedRequest) (r *FeedResponse, err error)
-
-	PublishAction(ctx context.Context, req *PublishActionRequest) (r *PublishActionResponse, err error)
-
-	PublishList(ctx context.Context, req *PublishListRequest) (r *PublishListResponse, err error)
-
-	PublishIDList(ctx context.Context, userId int64) (r []int64, err error)
-
-	VideoInfo(ctx context.Context, req *VideoInfoRequest) (r *Video, err error)
-
-	VideoInfoList(ctx context.Context, req *VideoInfoListRequest) (r []*Video, err error)
-
-	WorkCount(ctx context.Context, userId int64) (r int64, err error)
-
-	AuthorId(ctx context.Context, videoId int64) (r int64, err error)
-
-	VideoExist(ctx context.Context, videoId int64) (r bool, err error)
+type PublishStatusRequest struct {
+	UserId  int64 `thrift:"user_id,1" frugal:"1,default,i64" json:"user_id"`
+	VideoId int64 `thrift:"video_id,2" frugal:"2,default,i64" json:"video_id"`
 }
 
-type VideoServiceClient struct {
-	c thrift.TClient
+func NewPublishStatusRequest() *PublishStatusRequest {
+	return &PublishStatusRequest{}
 }
 
-func NewVideoServiceClientFactory(t thrift.TTransport, f thrift.TProtocolFactory) *VideoServiceClient {
-	return &VideoServiceClient{
-		c: thrift.NewTStandardClient(f.GetProtocol(t), f.GetProtocol(t)),
-	}
+func (p *PublishStatusRequest) InitDefault() {
+	*p = PublishStatusRequest{}
 }
 
-func NewVideoServiceClientProtocol(t thrift.TTransport, iprot thrift.TProtocol, oprot thrift.TProtocol) *VideoServiceClient {
-	return &VideoServiceClient{
-		c: thrift.NewTStandardClient(iprot, oprot),
-	}
+func (p *PublishStatusRequest) GetUserId() (v int64) {
+	return p.UserId
 }
 
-func NewVideoServiceClient(c thrift.TClient) *VideoServiceClient {
-	return &VideoServiceClient{
-		c: c,
-	}
+func (p *PublishStatusRequest) GetVideoId() (v int64) {
+	return p.VideoId
+}
+func (p *PublishStatusRequest) SetUserId(val int64) {
+	p.UserId = val
+}
+func (p *PublishStatusRequest) SetVideoId(val int64) {
+	p.VideoId = val
 }
 
-func (p *VideoServiceClient) Client_() thrift.TClient {
-	return p.c
+var fieldIDToName_PublishStatusRequest = map[int16]string{
+	1: "user_id",
+	2: "video_id",
 }
 
-func (p *VideoServiceClient) Feed(ctx context.Context, req *FeedRequest) (r *FeedResponse, err error) {
-	var _args VideoServiceFeedArgs
-	_args.Req = req
-	var _result VideoServiceFeedResult
-	if err = p.Client_().Call(ctx, "Feed", &_args, &_result); err != nil {
-		return
+func (p *PublishStatusRequest) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
 	}
-	return _result.GetSuccess(), nil
-}
-func (p *VideoServiceClient) PublishAction(ctx context.Context, req *PublishActionRequest) (r *PublishActionResponse, err error) {
-	var _args VideoServicePublishActionArgs
-	_args.Req = req
-	var _result VideoServicePublishActionResult
-	if err = p.Client_().Call(ctx, "PublishAction", &_args, &_result); err != nil {
-		return
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 2:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
 	}
-	return _result.GetSuccess(), nil
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_PublishStatusRequest[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
-func (p *VideoServiceClient) PublishList(ctx context.Context, req *PublishListRequest) (r *PublishListResponse, err error) {
-	var _args VideoServicePublishListArgs
-	_args.Req = req
-	var _result VideoServicePublishListResult
-	if err = p.Client_().Call(ctx, "PublishList", &_args, &_result); err != nil {
-		return
+
+func (p *PublishStatusRequest) ReadField1(iprot thrift.TProtocol) error {
+
+	var _field int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = v
 	}
-	return _result.GetSuccess(), nil
+	p.UserId = _field
+	return nil
 }
-func (p *VideoServiceClient) PublishIDList(ctx context.Context, userId int64) (r []int64, err error) {
-	var _args VideoServicePublishIDListArgs
-	_args.UserId = userId
-	var _result VideoServicePublishIDListResult
-	if err = p.Client_().Call(ctx, "PublishIDList", &_args, &_result); err != nil {
-		return
+func (p *PublishStatusRequest) ReadField2(iprot thrift.TProtocol) error {
+
+	var _field int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = v
 	}
-	return _result.GetSuccess(), nil
+	p.VideoId = _field
+	return nil
 }
-func (p *VideoServiceClient) VideoInfo(ctx context.Context, req *VideoInfoRequest) (r *Video, err error) {
-	var _args VideoServiceVideoInfoArgs
-	_args.Req = req
-	var _result VideoServiceVideoInfoResult
-	if err = p.Client_().Call(ctx, "VideoInfo", &_args, &_result); err != nil {
-		return
+
+func (p *PublishStatusRequest) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("Publish_status_request"); err != nil {
+		goto WriteStructBeginError
 	}
-	return _result.GetSuccess(), nil
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
-func (p *VideoServiceClient) VideoInfoList(ctx context.Context, req *VideoInfoListRequest) (r []*Video, err error) {
-	var _args VideoServiceVideoInfoListArgs
-	_args.Req = req
-	var _result VideoServiceVideoInfoListResult
-	if err = p.Client_().Call(ctx, "VideoInfoList", &_args, &_result); err != nil {
-		return
+
+func (p *PublishStatusRequest) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("user_id", thrift.I64, 1); err != nil {
+		goto WriteFieldBeginError
 	}
-	return _result.GetSuccess(), nil
+	if err := oprot.WriteI64(p.UserId); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
-func (p *VideoServiceClient) WorkCount(ctx context.Context, userId int64) (r int64, err error) {
-	var _args VideoServiceWorkCountArgs
-	_args.UserId = userId
-	var _result VideoServiceWorkCountResult
-	if err = p.Client_().Call(ctx, "WorkCount", &_args, &_result); err != nil {
-		return
+
+func (p *PublishStatusRequest) writeField2(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("video_id", thrift.I64, 2); err != nil {
+		goto WriteFieldBeginError
 	}
-	return _result.GetSuccess(), nil
+	if err := oprot.WriteI64(p.VideoId); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
 }
-func (p *VideoServiceClient) AuthorId(ctx context.Context, videoId int64) (r int64, err error) {
-	var _args VideoServiceAuthorIdArgs
-	_args.VideoId = videoId
-	var _result VideoServiceAuthorIdResult
-	if err = p.Client_().Call(ctx, "AuthorId", &_args, &_result); err != nil {
-		return
+
+func (p *PublishStatusRequest) String() string {
+	if p == nil {
+		return "<nil>"
 	}
-	return _result.GetSuccess(), nil
+	return fmt.Sprintf("PublishStatusRequest(%+v)", *p)
+
 }
-func (p *VideoServiceClient) VideoExist(ctx context.Context, videoId int64) (r bool, err error) {
-	var _args VideoServiceVideoExistArgs
-	_args.VideoId = videoId
-	var _result VideoServiceVideoExistResult
-	if err = p.Client_().Call(ctx, "VideoExist", &_args, &_result); err != nil {
-		return
+
+func (p *PublishStatusRequest) DeepEqual(ano *PublishStatusRequest) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
 	}
-	return _result.GetSuccess(), nil
+	if !p.Field1DeepEqual(ano.UserId) {
+		return false
+	}
+	if !p.Field2DeepEqual(ano.VideoId) {
+		return false
+	}
+	return true
 }
 
-type VideoServiceProcessor struct {
-	processorMap map[string]thrift.TProcessorFunction
-	handler      VideoService
+func (p *PublishStatusRequest) Field1DeepEqual(src int64) bool {
+
+	if p.UserId != src {
+		return false
+	}
+	return true
 }
+func (p *PublishStatusRequest) Field2DeepEqual(src int64) bool {
 
-func (p *VideoServiceProcessor) AddToProcessorMap(key string, processor thrift.TProcessorFunction) {
-	p.processorMap[key] = processor
+	if p.VideoId != src {
+		return false
+	}
+	return true
 }
 
-func (p *VideoServiceProcessor) GetProcessorFunction(key string) (processor thrift.TProcessorFunction, ok bool) {
-	processor, ok = p.processorMap[key]
-	return processor, ok
+type PublishStatusResponse struct {
+	StatusCode int32   `thrift:"status_code,1" frugal:"1,default,i32" json:"status_code"`
+	StatusMsg  *string `thrift:"status_msg,2,optional" frugal:"2,optional,string" json:"status_msg,omitempty"`
+	Status     string  `thrift:"status,3" frugal:"3,default,string" json:"status"`
+	Progress   int32   `thrift:"progress,4" frugal:"4,default,i32" json:"progress"`
 }
 
-func (p *VideoServiceProcessor) ProcessorMap() map[string]thrift.TProcessorFunction {
-	return p.processorMap
+func NewPublishStatusResponse() *PublishStatusResponse {
+	return &PublishStatusResponse{}
 }
 
-func NewVideoServiceProcessor(handler VideoService) *VideoServiceProcessor {
-	self := &VideoServiceProcessor{handler: handler, processorMap: make(map[string]thrift.TProcessorFunction)}
-	self.AddToProcessorMap("Feed", &videoServiceProcessorFeed{handler: handler})
-	self.AddToProcessorMap("PublishAction", &videoServiceProcessorPublishAction{handler: handler})
-	self.AddToProcessorMap("PublishList", &videoServiceProcessorPublishList{handler: handler})
-	self.AddToProcessorMap("PublishIDList", &videoServiceProcessorPublishIDList{handler: handler})
-	self.AddToProcessorMap("VideoInfo", &videoServiceProcessorVideoInfo{handler: handler})
-	self.AddToProcessorMap("VideoInfoList", &videoServiceProcessorVideoInfoList{handler: handler})
-	self.AddToProcessorMap("WorkCount", &videoServiceProcessorWorkCount{handler: handler})
-	self.AddToProcessorMap("AuthorId", &videoServiceProcessorAuthorId{handler: handler})
-	self.AddToProcessorMap("VideoExist", &videoServiceProcessorVideoExist{handler: handler})
-	return self
+func (p *PublishStatusResponse) InitDefault() {
+	*p = PublishStatusResponse{}
 }
-func (p *VideoServiceProcessor) Process(ctx context.Context, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-	name, _, seqId, err := iprot.ReadMessageBegin()
-	if err != nil {
-		return false, err
-	}
-	if processor, ok := p.GetProcessorFunction(name); ok {
-		return processor.Process(ctx, seqId, iprot, oprot)
+
+func (p *PublishStatusResponse) GetStatusCode() (v int32) {
+	return p.StatusCode
+}
+
+var PublishStatusResponse_StatusMsg_DEFAULT string
+
+func (p *PublishStatusResponse) GetStatusMsg() (v string) {
+	if !p.IsSetStatusMsg() {
+		return PublishStatusResponse_StatusMsg_DEFAULT
 	}
-	iprot.Skip(thrift.STRUCT)
-	iprot.ReadMessageEnd()
-	x := thrift.NewTApplicationException(thrift.UNKNOWN_METHOD, "Unknown function "+name)
-	oprot.WriteMessageBegin(name, thrift.EXCEPTION, seqId)
-	x.Write(oprot)
-	oprot.WriteMessageEnd()
-	oprot.Flush(ctx)
-	return false, x
+	return *p.StatusMsg
 }
 
-type videoServiceProcessorFeed struct {
-	handler VideoService
+func (p *PublishStatusResponse) GetStatus() (v string) {
+	return p.Status
 }
 
-func (p *videoServiceProcessorFeed) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-	args := VideoServiceFeedArgs{}
-	if err = args.Read(iprot); err != nil {
-		iprot.ReadMessageEnd()
-		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+func (p *PublishStatusResponse) GetProgress() (v int32) {
+	return p.Progress
+}
+func (p *PublishStatusResponse) SetStatusCode(val int32) {
+	p.StatusCode = val
+}
+func (p *PublishStatusResponse) SetStatusMsg(val *string) {
+	p.StatusMsg = val
+}
+func (p *PublishStatusResponse) SetStatus(val string) {
+	p.Status = val
+}
+func (p *PublishStatusResponse) SetProgress(val int32) {
+	p.Progress = val
+}
+
+var fieldIDToName_PublishStatusResponse = map[int16]string{
+	1: "status_code",
+	2: "status_msg",
+	3: "status",
+	4: "progress",
+}
+
+func (p *PublishStatusResponse) IsSetStatusMsg() bool {
+	return p.StatusMsg != nil
+}
+
+func (p *PublishStatusResponse) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.I32 {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 2:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 3:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField3(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 4:
+			if fieldTypeId == thrift.I32 {
+				if err = p.ReadField4(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_PublishStatusResponse[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *PublishStatusResponse) ReadField1(iprot thrift.TProtocol) error {
+
+	var _field int32
+	if v, err := iprot.ReadI32(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.StatusCode = _field
+	return nil
+}
+func (p *PublishStatusResponse) ReadField2(iprot thrift.TProtocol) error {
+
+	var _field *string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.StatusMsg = _field
+	return nil
+}
+func (p *PublishStatusResponse) ReadField3(iprot thrift.TProtocol) error {
+
+	var _field string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.Status = _field
+	return nil
+}
+func (p *PublishStatusResponse) ReadField4(iprot thrift.TProtocol) error {
+
+	var _field int32
+	if v, err := iprot.ReadI32(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.Progress = _field
+	return nil
+}
+
+func (p *PublishStatusResponse) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("Publish_status_response"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+		if err = p.writeField3(oprot); err != nil {
+			fieldId = 3
+			goto WriteFieldError
+		}
+		if err = p.writeField4(oprot); err != nil {
+			fieldId = 4
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *PublishStatusResponse) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("status_code", thrift.I32, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI32(p.StatusCode); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *PublishStatusResponse) writeField2(oprot thrift.TProtocol) (err error) {
+	if p.IsSetStatusMsg() {
+		if err = oprot.WriteFieldBegin("status_msg", thrift.STRING, 2); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.StatusMsg); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+
+func (p *PublishStatusResponse) writeField3(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("status", thrift.STRING, 3); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.Status); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
+}
+
+func (p *PublishStatusResponse) writeField4(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("progress", thrift.I32, 4); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI32(p.Progress); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
+}
+
+func (p *PublishStatusResponse) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("PublishStatusResponse(%+v)", *p)
+
+}
+
+func (p *PublishStatusResponse) DeepEqual(ano *PublishStatusResponse) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.StatusCode) {
+		return false
+	}
+	if !p.Field2DeepEqual(ano.StatusMsg) {
+		return false
+	}
+	if !p.Field3DeepEqual(ano.Status) {
+		return false
+	}
+	if !p.Field4DeepEqual(ano.Progress) {
+		return false
+	}
+	return true
+}
+
+func (p *PublishStatusResponse) Field1DeepEqual(src int32) bool {
+
+	if p.StatusCode != src {
+		return false
+	}
+	return true
+}
+func (p *PublishStatusResponse) Field2DeepEqual(src *string) bool {
+
+	if p.StatusMsg == src {
+		return true
+	} else if p.StatusMsg == nil || src == nil {
+		return false
+	}
+	if strings.Compare(*p.StatusMsg, *src) != 0 {
+		return false
+	}
+	return true
+}
+func (p *PublishStatusResponse) Field3DeepEqual(src string) bool {
+
+	if strings.Compare(p.Status, src) != 0 {
+		return false
+	}
+	return true
+}
+func (p *PublishStatusResponse) Field4DeepEqual(src int32) bool {
+
+	if p.Progress != src {
+		return false
+	}
+	return true
+}
+
+type UpdateCoverRequest struct {
+	UserId    int64  `thrift:"user_id,1" frugal:"1,default,i64" json:"user_id"`
+	VideoId   int64  `thrift:"video_id,2" frugal:"2,default,i64" json:"video_id"`
+	CoverName string `thrift:"cover_name,3" frugal:"3,default,string" json:"cover_name"`
+}
+
+func NewUpdateCoverRequest() *UpdateCoverRequest {
+	return &UpdateCoverRequest{}
+}
+
+func (p *UpdateCoverRequest) InitDefault() {
+	*p = UpdateCoverRequest{}
+}
+
+func (p *UpdateCoverRequest) GetUserId() (v int64) {
+	return p.UserId
+}
+
+func (p *UpdateCoverRequest) GetVideoId() (v int64) {
+	return p.VideoId
+}
+
+func (p *UpdateCoverRequest) GetCoverName() (v string) {
+	return p.CoverName
+}
+func (p *UpdateCoverRequest) SetUserId(val int64) {
+	p.UserId = val
+}
+func (p *UpdateCoverRequest) SetVideoId(val int64) {
+	p.VideoId = val
+}
+func (p *UpdateCoverRequest) SetCoverName(val string) {
+	p.CoverName = val
+}
+
+var fieldIDToName_UpdateCoverRequest = map[int16]string{
+	1: "user_id",
+	2: "video_id",
+	3: "cover_name",
+}
+
+func (p *UpdateCoverRequest) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 2:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 3:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField3(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_UpdateCoverRequest[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *UpdateCoverRequest) ReadField1(iprot thrift.TProtocol) error {
+
+	var _field int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.UserId = _field
+	return nil
+}
+func (p *UpdateCoverRequest) ReadField2(iprot thrift.TProtocol) error {
+
+	var _field int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.VideoId = _field
+	return nil
+}
+func (p *UpdateCoverRequest) ReadField3(iprot thrift.TProtocol) error {
+
+	var _field string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.CoverName = _field
+	return nil
+}
+
+func (p *UpdateCoverRequest) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("Update_cover_request"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+		if err = p.writeField3(oprot); err != nil {
+			fieldId = 3
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *UpdateCoverRequest) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("user_id", thrift.I64, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI64(p.UserId); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *UpdateCoverRequest) writeField2(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("video_id", thrift.I64, 2); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI64(p.VideoId); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+
+func (p *UpdateCoverRequest) writeField3(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("cover_name", thrift.STRING, 3); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.CoverName); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
+}
+
+func (p *UpdateCoverRequest) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("UpdateCoverRequest(%+v)", *p)
+
+}
+
+func (p *UpdateCoverRequest) DeepEqual(ano *UpdateCoverRequest) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.UserId) {
+		return false
+	}
+	if !p.Field2DeepEqual(ano.VideoId) {
+		return false
+	}
+	if !p.Field3DeepEqual(ano.CoverName) {
+		return false
+	}
+	return true
+}
+
+func (p *UpdateCoverRequest) Field1DeepEqual(src int64) bool {
+
+	if p.UserId != src {
+		return false
+	}
+	return true
+}
+func (p *UpdateCoverRequest) Field2DeepEqual(src int64) bool {
+
+	if p.VideoId != src {
+		return false
+	}
+	return true
+}
+func (p *UpdateCoverRequest) Field3DeepEqual(src string) bool {
+
+	if strings.Compare(p.CoverName, src) != 0 {
+		return false
+	}
+	return true
+}
+
+type UpdateCoverResponse struct {
+	StatusCode int32   `thrift:"status_code,1" frugal:"1,default,i32" json:"status_code"`
+	StatusMsg  *string `thrift:"status_msg,2,optional" frugal:"2,optional,string" json:"status_msg,omitempty"`
+	CoverUrl   string  `thrift:"cover_url,3" frugal:"3,default,string" json:"cover_url"`
+}
+
+func NewUpdateCoverResponse() *UpdateCoverResponse {
+	return &UpdateCoverResponse{}
+}
+
+func (p *UpdateCoverResponse) InitDefault() {
+	*p = UpdateCoverResponse{}
+}
+
+func (p *UpdateCoverResponse) GetStatusCode() (v int32) {
+	return p.StatusCode
+}
+
+var UpdateCoverResponse_StatusMsg_DEFAULT string
+
+func (p *UpdateCoverResponse) GetStatusMsg() (v string) {
+	if !p.IsSetStatusMsg() {
+		return UpdateCoverResponse_StatusMsg_DEFAULT
+	}
+	return *p.StatusMsg
+}
+
+func (p *UpdateCoverResponse) GetCoverUrl() (v string) {
+	return p.CoverUrl
+}
+func (p *UpdateCoverResponse) SetStatusCode(val int32) {
+	p.StatusCode = val
+}
+func (p *UpdateCoverResponse) SetStatusMsg(val *string) {
+	p.StatusMsg = val
+}
+func (p *UpdateCoverResponse) SetCoverUrl(val string) {
+	p.CoverUrl = val
+}
+
+var fieldIDToName_UpdateCoverResponse = map[int16]string{
+	1: "status_code",
+	2: "status_msg",
+	3: "cover_url",
+}
+
+func (p *UpdateCoverResponse) IsSetStatusMsg() bool {
+	return p.StatusMsg != nil
+}
+
+func (p *UpdateCoverResponse) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.I32 {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 2:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 3:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField3(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_UpdateCoverResponse[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *UpdateCoverResponse) ReadField1(iprot thrift.TProtocol) error {
+
+	var _field int32
+	if v, err := iprot.ReadI32(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.StatusCode = _field
+	return nil
+}
+func (p *UpdateCoverResponse) ReadField2(iprot thrift.TProtocol) error {
+
+	var _field *string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.StatusMsg = _field
+	return nil
+}
+func (p *UpdateCoverResponse) ReadField3(iprot thrift.TProtocol) error {
+
+	var _field string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.CoverUrl = _field
+	return nil
+}
+
+func (p *UpdateCoverResponse) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("Update_cover_response"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+		if err = p.writeField3(oprot); err != nil {
+			fieldId = 3
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *UpdateCoverResponse) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("status_code", thrift.I32, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI32(p.StatusCode); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *UpdateCoverResponse) writeField2(oprot thrift.TProtocol) (err error) {
+	if p.IsSetStatusMsg() {
+		if err = oprot.WriteFieldBegin("status_msg", thrift.STRING, 2); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.StatusMsg); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+
+func (p *UpdateCoverResponse) writeField3(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("cover_url", thrift.STRING, 3); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.CoverUrl); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
+}
+
+func (p *UpdateCoverResponse) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("UpdateCoverResponse(%+v)", *p)
+
+}
+
+func (p *UpdateCoverResponse) DeepEqual(ano *UpdateCoverResponse) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.StatusCode) {
+		return false
+	}
+	if !p.Field2DeepEqual(ano.StatusMsg) {
+		return false
+	}
+	if !p.Field3DeepEqual(ano.CoverUrl) {
+		return false
+	}
+	return true
+}
+
+func (p *UpdateCoverResponse) Field1DeepEqual(src int32) bool {
+
+	if p.StatusCode != src {
+		return false
+	}
+	return true
+}
+func (p *UpdateCoverResponse) Field2DeepEqual(src *string) bool {
+
+	if p.StatusMsg == src {
+		return true
+	} else if p.StatusMsg == nil || src == nil {
+		return false
+	}
+	if strings.Compare(*p.StatusMsg, *src) != 0 {
+		return false
+	}
+	return true
+}
+func (p *UpdateCoverResponse) Field3DeepEqual(src string) bool {
+
+	if strings.Compare(p.CoverUrl, src) != 0 {
+		return false
+	}
+	return true
+}
+
+type DeleteVideoRequest struct {
+	UserId  int64 `thrift:"user_id,1" frugal:"1,default,i64" json:"user_id"`
+	VideoId int64 `thrift:"video_id,2" frugal:"2,default,i64" json:"video_id"`
+}
+
+func NewDeleteVideoRequest() *DeleteVideoRequest {
+	return &DeleteVideoRequest{}
+}
+
+func (p *DeleteVideoRequest) InitDefault() {
+	*p = DeleteVideoRequest{}
+}
+
+func (p *DeleteVideoRequest) GetUserId() (v int64) {
+	return p.UserId
+}
+
+func (p *DeleteVideoRequest) GetVideoId() (v int64) {
+	return p.VideoId
+}
+func (p *DeleteVideoRequest) SetUserId(val int64) {
+	p.UserId = val
+}
+func (p *DeleteVideoRequest) SetVideoId(val int64) {
+	p.VideoId = val
+}
+
+var fieldIDToName_DeleteVideoRequest = map[int16]string{
+	1: "user_id",
+	2: "video_id",
+}
+
+func (p *DeleteVideoRequest) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 2:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_DeleteVideoRequest[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *DeleteVideoRequest) ReadField1(iprot thrift.TProtocol) error {
+
+	var _field int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.UserId = _field
+	return nil
+}
+func (p *DeleteVideoRequest) ReadField2(iprot thrift.TProtocol) error {
+
+	var _field int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.VideoId = _field
+	return nil
+}
+
+func (p *DeleteVideoRequest) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("Delete_video_request"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *DeleteVideoRequest) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("user_id", thrift.I64, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI64(p.UserId); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *DeleteVideoRequest) writeField2(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("video_id", thrift.I64, 2); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI64(p.VideoId); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+
+func (p *DeleteVideoRequest) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("DeleteVideoRequest(%+v)", *p)
+
+}
+
+func (p *DeleteVideoRequest) DeepEqual(ano *DeleteVideoRequest) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.UserId) {
+		return false
+	}
+	if !p.Field2DeepEqual(ano.VideoId) {
+		return false
+	}
+	return true
+}
+
+func (p *DeleteVideoRequest) Field1DeepEqual(src int64) bool {
+
+	if p.UserId != src {
+		return false
+	}
+	return true
+}
+func (p *DeleteVideoRequest) Field2DeepEqual(src int64) bool {
+
+	if p.VideoId != src {
+		return false
+	}
+	return true
+}
+
+type DeleteVideoResponse struct {
+	StatusCode int32   `thrift:"status_code,1" frugal:"1,default,i32" json:"status_code"`
+	StatusMsg  *string `thrift:"status_msg,2,optional" frugal:"2,optional,string" json:"status_msg,omitempty"`
+}
+
+func NewDeleteVideoResponse() *DeleteVideoResponse {
+	return &DeleteVideoResponse{}
+}
+
+func (p *DeleteVideoResponse) InitDefault() {
+	*p = DeleteVideoResponse{}
+}
+
+func (p *DeleteVideoResponse) GetStatusCode() (v int32) {
+	return p.StatusCode
+}
+
+var DeleteVideoResponse_StatusMsg_DEFAULT string
+
+func (p *DeleteVideoResponse) GetStatusMsg() (v string) {
+	if !p.IsSetStatusMsg() {
+		return DeleteVideoResponse_StatusMsg_DEFAULT
+	}
+	return *p.StatusMsg
+}
+func (p *DeleteVideoResponse) SetStatusCode(val int32) {
+	p.StatusCode = val
+}
+func (p *DeleteVideoResponse) SetStatusMsg(val *string) {
+	p.StatusMsg = val
+}
+
+var fieldIDToName_DeleteVideoResponse = map[int16]string{
+	1: "status_code",
+	2: "status_msg",
+}
+
+func (p *DeleteVideoResponse) IsSetStatusMsg() bool {
+	return p.StatusMsg != nil
+}
+
+func (p *DeleteVideoResponse) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.I32 {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 2:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_DeleteVideoResponse[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *DeleteVideoResponse) ReadField1(iprot thrift.TProtocol) error {
+
+	var _field int32
+	if v, err := iprot.ReadI32(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.StatusCode = _field
+	return nil
+}
+func (p *DeleteVideoResponse) ReadField2(iprot thrift.TProtocol) error {
+
+	var _field *string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.StatusMsg = _field
+	return nil
+}
+
+func (p *DeleteVideoResponse) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("Delete_video_response"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *DeleteVideoResponse) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("status_code", thrift.I32, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI32(p.StatusCode); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *DeleteVideoResponse) writeField2(oprot thrift.TProtocol) (err error) {
+	if p.IsSetStatusMsg() {
+		if err = oprot.WriteFieldBegin("status_msg", thrift.STRING, 2); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.StatusMsg); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+
+func (p *DeleteVideoResponse) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("DeleteVideoResponse(%+v)", *p)
+
+}
+
+func (p *DeleteVideoResponse) DeepEqual(ano *DeleteVideoResponse) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.StatusCode) {
+		return false
+	}
+	if !p.Field2DeepEqual(ano.StatusMsg) {
+		return false
+	}
+	return true
+}
+
+func (p *DeleteVideoResponse) Field1DeepEqual(src int32) bool {
+
+	if p.StatusCode != src {
+		return false
+	}
+	return true
+}
+func (p *DeleteVideoResponse) Field2DeepEqual(src *string) bool {
+
+	if p.StatusMsg == src {
+		return true
+	} else if p.StatusMsg == nil || src == nil {
+		return false
+	}
+	if strings.Compare(*p.StatusMsg, *src) != 0 {
+		return false
+	}
+	return true
+}
+
+type UpdateVideoRequest struct {
+	UserId     int64   `thrift:"user_id,1" frugal:"1,default,i64" json:"user_id"`
+	VideoId    int64   `thrift:"video_id,2" frugal:"2,default,i64" json:"video_id"`
+	Title      *string `thrift:"title,3,optional" frugal:"3,optional,string" json:"title,omitempty"`
+	Visibility *int32  `thrift:"visibility,4,optional" frugal:"4,optional,i32" json:"visibility,omitempty"`
+}
+
+func NewUpdateVideoRequest() *UpdateVideoRequest {
+	return &UpdateVideoRequest{}
+}
+
+func (p *UpdateVideoRequest) InitDefault() {
+	*p = UpdateVideoRequest{}
+}
+
+func (p *UpdateVideoRequest) GetUserId() (v int64) {
+	return p.UserId
+}
+
+func (p *UpdateVideoRequest) GetVideoId() (v int64) {
+	return p.VideoId
+}
+
+var UpdateVideoRequest_Title_DEFAULT string
+
+func (p *UpdateVideoRequest) GetTitle() (v string) {
+	if !p.IsSetTitle() {
+		return UpdateVideoRequest_Title_DEFAULT
+	}
+	return *p.Title
+}
+
+var UpdateVideoRequest_Visibility_DEFAULT int32
+
+func (p *UpdateVideoRequest) GetVisibility() (v int32) {
+	if !p.IsSetVisibility() {
+		return UpdateVideoRequest_Visibility_DEFAULT
+	}
+	return *p.Visibility
+}
+func (p *UpdateVideoRequest) SetUserId(val int64) {
+	p.UserId = val
+}
+func (p *UpdateVideoRequest) SetVideoId(val int64) {
+	p.VideoId = val
+}
+func (p *UpdateVideoRequest) SetTitle(val *string) {
+	p.Title = val
+}
+func (p *UpdateVideoRequest) SetVisibility(val *int32) {
+	p.Visibility = val
+}
+
+var fieldIDToName_UpdateVideoRequest = map[int16]string{
+	1: "user_id",
+	2: "video_id",
+	3: "title",
+	4: "visibility",
+}
+
+func (p *UpdateVideoRequest) IsSetTitle() bool {
+	return p.Title != nil
+}
+
+func (p *UpdateVideoRequest) IsSetVisibility() bool {
+	return p.Visibility != nil
+}
+
+func (p *UpdateVideoRequest) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 2:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 3:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField3(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 4:
+			if fieldTypeId == thrift.I32 {
+				if err = p.ReadField4(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_UpdateVideoRequest[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *UpdateVideoRequest) ReadField1(iprot thrift.TProtocol) error {
+
+	var _field int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.UserId = _field
+	return nil
+}
+func (p *UpdateVideoRequest) ReadField2(iprot thrift.TProtocol) error {
+
+	var _field int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.VideoId = _field
+	return nil
+}
+func (p *UpdateVideoRequest) ReadField3(iprot thrift.TProtocol) error {
+
+	var _field *string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.Title = _field
+	return nil
+}
+func (p *UpdateVideoRequest) ReadField4(iprot thrift.TProtocol) error {
+
+	var _field *int32
+	if v, err := iprot.ReadI32(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.Visibility = _field
+	return nil
+}
+
+func (p *UpdateVideoRequest) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("Update_video_request"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+		if err = p.writeField3(oprot); err != nil {
+			fieldId = 3
+			goto WriteFieldError
+		}
+		if err = p.writeField4(oprot); err != nil {
+			fieldId = 4
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *UpdateVideoRequest) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("user_id", thrift.I64, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI64(p.UserId); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *UpdateVideoRequest) writeField2(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("video_id", thrift.I64, 2); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI64(p.VideoId); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+
+func (p *UpdateVideoRequest) writeField3(oprot thrift.TProtocol) (err error) {
+	if p.IsSetTitle() {
+		if err = oprot.WriteFieldBegin("title", thrift.STRING, 3); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.Title); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
+}
+
+func (p *UpdateVideoRequest) writeField4(oprot thrift.TProtocol) (err error) {
+	if p.IsSetVisibility() {
+		if err = oprot.WriteFieldBegin("visibility", thrift.I32, 4); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteI32(*p.Visibility); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
+}
+
+func (p *UpdateVideoRequest) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("UpdateVideoRequest(%+v)", *p)
+
+}
+
+func (p *UpdateVideoRequest) DeepEqual(ano *UpdateVideoRequest) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.UserId) {
+		return false
+	}
+	if !p.Field2DeepEqual(ano.VideoId) {
+		return false
+	}
+	if !p.Field3DeepEqual(ano.Title) {
+		return false
+	}
+	if !p.Field4DeepEqual(ano.Visibility) {
+		return false
+	}
+	return true
+}
+
+func (p *UpdateVideoRequest) Field1DeepEqual(src int64) bool {
+
+	if p.UserId != src {
+		return false
+	}
+	return true
+}
+func (p *UpdateVideoRequest) Field2DeepEqual(src int64) bool {
+
+	if p.VideoId != src {
+		return false
+	}
+	return true
+}
+func (p *UpdateVideoRequest) Field3DeepEqual(src *string) bool {
+
+	if p.Title == src {
+		return true
+	} else if p.Title == nil || src == nil {
+		return false
+	}
+	if strings.Compare(*p.Title, *src) != 0 {
+		return false
+	}
+	return true
+}
+func (p *UpdateVideoRequest) Field4DeepEqual(src *int32) bool {
+
+	if p.Visibility == src {
+		return true
+	} else if p.Visibility == nil || src == nil {
+		return false
+	}
+	if *p.Visibility != *src {
+		return false
+	}
+	return true
+}
+
+type UpdateVideoResponse struct {
+	StatusCode int32   `thrift:"status_code,1" frugal:"1,default,i32" json:"status_code"`
+	StatusMsg  *string `thrift:"status_msg,2,optional" frugal:"2,optional,string" json:"status_msg,omitempty"`
+}
+
+func NewUpdateVideoResponse() *UpdateVideoResponse {
+	return &UpdateVideoResponse{}
+}
+
+func (p *UpdateVideoResponse) InitDefault() {
+	*p = UpdateVideoResponse{}
+}
+
+func (p *UpdateVideoResponse) GetStatusCode() (v int32) {
+	return p.StatusCode
+}
+
+var UpdateVideoResponse_StatusMsg_DEFAULT string
+
+func (p *UpdateVideoResponse) GetStatusMsg() (v string) {
+	if !p.IsSetStatusMsg() {
+		return UpdateVideoResponse_StatusMsg_DEFAULT
+	}
+	return *p.StatusMsg
+}
+func (p *UpdateVideoResponse) SetStatusCode(val int32) {
+	p.StatusCode = val
+}
+func (p *UpdateVideoResponse) SetStatusMsg(val *string) {
+	p.StatusMsg = val
+}
+
+var fieldIDToName_UpdateVideoResponse = map[int16]string{
+	1: "status_code",
+	2: "status_msg",
+}
+
+func (p *UpdateVideoResponse) IsSetStatusMsg() bool {
+	return p.StatusMsg != nil
+}
+
+func (p *UpdateVideoResponse) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.I32 {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 2:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_UpdateVideoResponse[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *UpdateVideoResponse) ReadField1(iprot thrift.TProtocol) error {
+
+	var _field int32
+	if v, err := iprot.ReadI32(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.StatusCode = _field
+	return nil
+}
+func (p *UpdateVideoResponse) ReadField2(iprot thrift.TProtocol) error {
+
+	var _field *string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.StatusMsg = _field
+	return nil
+}
+
+func (p *UpdateVideoResponse) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("Update_video_response"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *UpdateVideoResponse) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("status_code", thrift.I32, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI32(p.StatusCode); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *UpdateVideoResponse) writeField2(oprot thrift.TProtocol) (err error) {
+	if p.IsSetStatusMsg() {
+		if err = oprot.WriteFieldBegin("status_msg", thrift.STRING, 2); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.StatusMsg); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+
+func (p *UpdateVideoResponse) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("UpdateVideoResponse(%+v)", *p)
+
+}
+
+func (p *UpdateVideoResponse) DeepEqual(ano *UpdateVideoResponse) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.StatusCode) {
+		return false
+	}
+	if !p.Field2DeepEqual(ano.StatusMsg) {
+		return false
+	}
+	return true
+}
+
+func (p *UpdateVideoResponse) Field1DeepEqual(src int32) bool {
+
+	if p.StatusCode != src {
+		return false
+	}
+	return true
+}
+func (p *UpdateVideoResponse) Field2DeepEqual(src *string) bool {
+
+	if p.StatusMsg == src {
+		return true
+	} else if p.StatusMsg == nil || src == nil {
+		return false
+	}
+	if strings.Compare(*p.StatusMsg, *src) != 0 {
+		return false
+	}
+	return true
+}
+
+type PublishListRequest struct {
+	UserId   *int64  `thrift:"user_id,1,optional" frugal:"1,optional,i64" json:"user_id,omitempty"`
+	AuthorId int64   `thrift:"author_id,2" frugal:"2,default,i64" json:"author_id"`
+	Cursor   *string `thrift:"cursor,3,optional" frugal:"3,optional,string" json:"cursor,omitempty"`
+	Count    int32   `thrift:"count,4" frugal:"4,default,i32" json:"count"`
+}
+
+func NewPublishListRequest() *PublishListRequest {
+	return &PublishListRequest{}
+}
+
+func (p *PublishListRequest) InitDefault() {
+	*p = PublishListRequest{}
+}
+
+var PublishListRequest_UserId_DEFAULT int64
+
+func (p *PublishListRequest) GetUserId() (v int64) {
+	if !p.IsSetUserId() {
+		return PublishListRequest_UserId_DEFAULT
+	}
+	return *p.UserId
+}
+
+func (p *PublishListRequest) GetAuthorId() (v int64) {
+	return p.AuthorId
+}
+
+var PublishListRequest_Cursor_DEFAULT string
+
+func (p *PublishListRequest) GetCursor() (v string) {
+	if !p.IsSetCursor() {
+		return PublishListRequest_Cursor_DEFAULT
+	}
+	return *p.Cursor
+}
+
+func (p *PublishListRequest) GetCount() (v int32) {
+	return p.Count
+}
+func (p *PublishListRequest) SetUserId(val *int64) {
+	p.UserId = val
+}
+func (p *PublishListRequest) SetAuthorId(val int64) {
+	p.AuthorId = val
+}
+func (p *PublishListRequest) SetCursor(val *string) {
+	p.Cursor = val
+}
+func (p *PublishListRequest) SetCount(val int32) {
+	p.Count = val
+}
+
+var fieldIDToName_PublishListRequest = map[int16]string{
+	1: "user_id",
+	2: "author_id",
+	3: "cursor",
+	4: "count",
+}
+
+func (p *PublishListRequest) IsSetUserId() bool {
+	return p.UserId != nil
+}
+
+func (p *PublishListRequest) IsSetCursor() bool {
+	return p.Cursor != nil
+}
+
+func (p *PublishListRequest) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 2:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 3:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField3(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 4:
+			if fieldTypeId == thrift.I32 {
+				if err = p.ReadField4(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_PublishListRequest[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *PublishListRequest) ReadField1(iprot thrift.TProtocol) error {
+
+	var _field *int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.UserId = _field
+	return nil
+}
+func (p *PublishListRequest) ReadField2(iprot thrift.TProtocol) error {
+
+	var _field int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.AuthorId = _field
+	return nil
+}
+func (p *PublishListRequest) ReadField3(iprot thrift.TProtocol) error {
+
+	var _field *string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.Cursor = _field
+	return nil
+}
+func (p *PublishListRequest) ReadField4(iprot thrift.TProtocol) error {
+
+	var _field int32
+	if v, err := iprot.ReadI32(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.Count = _field
+	return nil
+}
+
+func (p *PublishListRequest) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("Publish_list_request"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+		if err = p.writeField3(oprot); err != nil {
+			fieldId = 3
+			goto WriteFieldError
+		}
+		if err = p.writeField4(oprot); err != nil {
+			fieldId = 4
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *PublishListRequest) writeField1(oprot thrift.TProtocol) (err error) {
+	if p.IsSetUserId() {
+		if err = oprot.WriteFieldBegin("user_id", thrift.I64, 1); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteI64(*p.UserId); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *PublishListRequest) writeField2(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("author_id", thrift.I64, 2); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI64(p.AuthorId); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+
+func (p *PublishListRequest) writeField3(oprot thrift.TProtocol) (err error) {
+	if p.IsSetCursor() {
+		if err = oprot.WriteFieldBegin("cursor", thrift.STRING, 3); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.Cursor); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
+}
+
+func (p *PublishListRequest) writeField4(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("count", thrift.I32, 4); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI32(p.Count); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
+}
+
+func (p *PublishListRequest) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("PublishListRequest(%+v)", *p)
+
+}
+
+func (p *PublishListRequest) DeepEqual(ano *PublishListRequest) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.UserId) {
+		return false
+	}
+	if !p.Field2DeepEqual(ano.AuthorId) {
+		return false
+	}
+	if !p.Field3DeepEqual(ano.Cursor) {
+		return false
+	}
+	if !p.Field4DeepEqual(ano.Count) {
+		return false
+	}
+	return true
+}
+
+func (p *PublishListRequest) Field1DeepEqual(src *int64) bool {
+
+	if p.UserId == src {
+		return true
+	} else if p.UserId == nil || src == nil {
+		return false
+	}
+	if *p.UserId != *src {
+		return false
+	}
+	return true
+}
+func (p *PublishListRequest) Field2DeepEqual(src int64) bool {
+
+	if p.AuthorId != src {
+		return false
+	}
+	return true
+}
+func (p *PublishListRequest) Field3DeepEqual(src *string) bool {
+
+	if p.Cursor == src {
+		return true
+	} else if p.Cursor == nil || src == nil {
+		return false
+	}
+	if strings.Compare(*p.Cursor, *src) != 0 {
+		return false
+	}
+	return true
+}
+func (p *PublishListRequest) Field4DeepEqual(src int32) bool {
+
+	if p.Count != src {
+		return false
+	}
+	return true
+}
+
+type PublishListResponse struct {
+	StatusCode int32    `thrift:"status_code,1" frugal:"1,default,i32" json:"status_code"`
+	StatusMsg  *string  `thrift:"status_msg,2,optional" frugal:"2,optional,string" json:"status_msg,omitempty"`
+	VideoList  []*Video `thrift:"video_list,3" frugal:"3,default,list<Video>" json:"video_list"`
+	NextCursor *string  `thrift:"next_cursor,4,optional" frugal:"4,optional,string" json:"next_cursor,omitempty"`
+	HasMore    bool     `thrift:"has_more,5" frugal:"5,default,bool" json:"has_more"`
+}
+
+func NewPublishListResponse() *PublishListResponse {
+	return &PublishListResponse{}
+}
+
+func (p *PublishListResponse) InitDefault() {
+	*p = PublishListResponse{}
+}
+
+func (p *PublishListResponse) GetStatusCode() (v int32) {
+	return p.StatusCode
+}
+
+var PublishListResponse_StatusMsg_DEFAULT string
+
+func (p *PublishListResponse) GetStatusMsg() (v string) {
+	if !p.IsSetStatusMsg() {
+		return PublishListResponse_StatusMsg_DEFAULT
+	}
+	return *p.StatusMsg
+}
+
+func (p *PublishListResponse) GetVideoList() (v []*Video) {
+	return p.VideoList
+}
+
+var PublishListResponse_NextCursor_DEFAULT string
+
+func (p *PublishListResponse) GetNextCursor() (v string) {
+	if !p.IsSetNextCursor() {
+		return PublishListResponse_NextCursor_DEFAULT
+	}
+	return *p.NextCursor
+}
+
+func (p *PublishListResponse) GetHasMore() (v bool) {
+	return p.HasMore
+}
+func (p *PublishListResponse) SetStatusCode(val int32) {
+	p.StatusCode = val
+}
+func (p *PublishListResponse) SetStatusMsg(val *string) {
+	p.StatusMsg = val
+}
+func (p *PublishListResponse) SetVideoList(val []*Video) {
+	p.VideoList = val
+}
+func (p *PublishListResponse) SetNextCursor(val *string) {
+	p.NextCursor = val
+}
+func (p *PublishListResponse) SetHasMore(val bool) {
+	p.HasMore = val
+}
+
+var fieldIDToName_PublishListResponse = map[int16]string{
+	1: "status_code",
+	2: "status_msg",
+	3: "video_list",
+	4: "next_cursor",
+	5: "has_more",
+}
+
+func (p *PublishListResponse) IsSetStatusMsg() bool {
+	return p.StatusMsg != nil
+}
+
+func (p *PublishListResponse) IsSetNextCursor() bool {
+	return p.NextCursor != nil
+}
+
+func (p *PublishListResponse) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.I32 {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 2:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 3:
+			if fieldTypeId == thrift.LIST {
+				if err = p.ReadField3(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 4:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField4(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 5:
+			if fieldTypeId == thrift.BOOL {
+				if err = p.ReadField5(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_PublishListResponse[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *PublishListResponse) ReadField1(iprot thrift.TProtocol) error {
+
+	var _field int32
+	if v, err := iprot.ReadI32(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.StatusCode = _field
+	return nil
+}
+func (p *PublishListResponse) ReadField2(iprot thrift.TProtocol) error {
+
+	var _field *string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.StatusMsg = _field
+	return nil
+}
+func (p *PublishListResponse) ReadField3(iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin()
+	if err != nil {
+		return err
+	}
+	_field := make([]*Video, 0, size)
+	values := make([]Video, size)
+	for i := 0; i < size; i++ {
+		_elem := &values[i]
+
+		if err := _elem.Read(iprot); err != nil {
+			return err
+		}
+
+		_field = append(_field, _elem)
+	}
+	if err := iprot.ReadListEnd(); err != nil {
+		return err
+	}
+	p.VideoList = _field
+	return nil
+}
+func (p *PublishListResponse) ReadField4(iprot thrift.TProtocol) error {
+
+	var _field *string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.NextCursor = _field
+	return nil
+}
+func (p *PublishListResponse) ReadField5(iprot thrift.TProtocol) error {
+
+	var _field bool
+	if v, err := iprot.ReadBool(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.HasMore = _field
+	return nil
+}
+
+func (p *PublishListResponse) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("Publish_list_response"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+		if err = p.writeField3(oprot); err != nil {
+			fieldId = 3
+			goto WriteFieldError
+		}
+		if err = p.writeField4(oprot); err != nil {
+			fieldId = 4
+			goto WriteFieldError
+		}
+		if err = p.writeField5(oprot); err != nil {
+			fieldId = 5
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *PublishListResponse) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("status_code", thrift.I32, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI32(p.StatusCode); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *PublishListResponse) writeField2(oprot thrift.TProtocol) (err error) {
+	if p.IsSetStatusMsg() {
+		if err = oprot.WriteFieldBegin("status_msg", thrift.STRING, 2); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.StatusMsg); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+
+func (p *PublishListResponse) writeField3(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("video_list", thrift.LIST, 3); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteListBegin(thrift.STRUCT, len(p.VideoList)); err != nil {
+		return err
+	}
+	for _, v := range p.VideoList {
+		if err := v.Write(oprot); err != nil {
+			return err
+		}
+	}
+	if err := oprot.WriteListEnd(); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
+}
+
+func (p *PublishListResponse) writeField4(oprot thrift.TProtocol) (err error) {
+	if p.IsSetNextCursor() {
+		if err = oprot.WriteFieldBegin("next_cursor", thrift.STRING, 4); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.NextCursor); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
+}
+
+func (p *PublishListResponse) writeField5(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("has_more", thrift.BOOL, 5); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteBool(p.HasMore); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 end error: ", p), err)
+}
+
+func (p *PublishListResponse) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("PublishListResponse(%+v)", *p)
+
+}
+
+func (p *PublishListResponse) DeepEqual(ano *PublishListResponse) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.StatusCode) {
+		return false
+	}
+	if !p.Field2DeepEqual(ano.StatusMsg) {
+		return false
+	}
+	if !p.Field3DeepEqual(ano.VideoList) {
+		return false
+	}
+	if !p.Field4DeepEqual(ano.NextCursor) {
+		return false
+	}
+	if !p.Field5DeepEqual(ano.HasMore) {
+		return false
+	}
+	return true
+}
+
+func (p *PublishListResponse) Field1DeepEqual(src int32) bool {
+
+	if p.StatusCode != src {
+		return false
+	}
+	return true
+}
+func (p *PublishListResponse) Field2DeepEqual(src *string) bool {
+
+	if p.StatusMsg == src {
+		return true
+	} else if p.StatusMsg == nil || src == nil {
+		return false
+	}
+	if strings.Compare(*p.StatusMsg, *src) != 0 {
+		return false
+	}
+	return true
+}
+func (p *PublishListResponse) Field3DeepEqual(src []*Video) bool {
+
+	if len(p.VideoList) != len(src) {
+		return false
+	}
+	for i, v := range p.VideoList {
+		_src := src[i]
+		if !v.DeepEqual(_src) {
+			return false
+		}
+	}
+	return true
+}
+func (p *PublishListResponse) Field4DeepEqual(src *string) bool {
+
+	if p.NextCursor == src {
+		return true
+	} else if p.NextCursor == nil || src == nil {
+		return false
+	}
+	if strings.Compare(*p.NextCursor, *src) != 0 {
+		return false
+	}
+	return true
+}
+func (p *PublishListResponse) Field5DeepEqual(src bool) bool {
+
+	if p.HasMore != src {
+		return false
+	}
+	return true
+}
+
+type TopicFeedRequest struct {
+	UserId *int64  `thrift:"user_id,1,optional" frugal:"1,optional,i64" json:"user_id,omitempty"`
+	Tag    string  `thrift:"tag,2" frugal:"2,default,string" json:"tag"`
+	Sort   *string `thrift:"sort,3,optional" frugal:"3,optional,string" json:"sort,omitempty"`
+	Cursor *string `thrift:"cursor,4,optional" frugal:"4,optional,string" json:"cursor,omitempty"`
+	Count  int32   `thrift:"count,5" frugal:"5,default,i32" json:"count"`
+}
+
+func NewTopicFeedRequest() *TopicFeedRequest {
+	return &TopicFeedRequest{}
+}
+
+func (p *TopicFeedRequest) InitDefault() {
+	*p = TopicFeedRequest{}
+}
+
+var TopicFeedRequest_UserId_DEFAULT int64
+
+func (p *TopicFeedRequest) GetUserId() (v int64) {
+	if !p.IsSetUserId() {
+		return TopicFeedRequest_UserId_DEFAULT
+	}
+	return *p.UserId
+}
+
+func (p *TopicFeedRequest) GetTag() (v string) {
+	return p.Tag
+}
+
+var TopicFeedRequest_Sort_DEFAULT string
+
+func (p *TopicFeedRequest) GetSort() (v string) {
+	if !p.IsSetSort() {
+		return TopicFeedRequest_Sort_DEFAULT
+	}
+	return *p.Sort
+}
+
+var TopicFeedRequest_Cursor_DEFAULT string
+
+func (p *TopicFeedRequest) GetCursor() (v string) {
+	if !p.IsSetCursor() {
+		return TopicFeedRequest_Cursor_DEFAULT
+	}
+	return *p.Cursor
+}
+
+func (p *TopicFeedRequest) GetCount() (v int32) {
+	return p.Count
+}
+func (p *TopicFeedRequest) SetUserId(val *int64) {
+	p.UserId = val
+}
+func (p *TopicFeedRequest) SetTag(val string) {
+	p.Tag = val
+}
+func (p *TopicFeedRequest) SetSort(val *string) {
+	p.Sort = val
+}
+func (p *TopicFeedRequest) SetCursor(val *string) {
+	p.Cursor = val
+}
+func (p *TopicFeedRequest) SetCount(val int32) {
+	p.Count = val
+}
+
+var fieldIDToName_TopicFeedRequest = map[int16]string{
+	1: "user_id",
+	2: "tag",
+	3: "sort",
+	4: "cursor",
+	5: "count",
+}
+
+func (p *TopicFeedRequest) IsSetUserId() bool {
+	return p.UserId != nil
+}
+
+func (p *TopicFeedRequest) IsSetSort() bool {
+	return p.Sort != nil
+}
+
+func (p *TopicFeedRequest) IsSetCursor() bool {
+	return p.Cursor != nil
+}
+
+func (p *TopicFeedRequest) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 2:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 3:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField3(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 4:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField4(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 5:
+			if fieldTypeId == thrift.I32 {
+				if err = p.ReadField5(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_TopicFeedRequest[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *TopicFeedRequest) ReadField1(iprot thrift.TProtocol) error {
+
+	var _field *int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.UserId = _field
+	return nil
+}
+func (p *TopicFeedRequest) ReadField2(iprot thrift.TProtocol) error {
+
+	var _field string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.Tag = _field
+	return nil
+}
+func (p *TopicFeedRequest) ReadField3(iprot thrift.TProtocol) error {
+
+	var _field *string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.Sort = _field
+	return nil
+}
+func (p *TopicFeedRequest) ReadField4(iprot thrift.TProtocol) error {
+
+	var _field *string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.Cursor = _field
+	return nil
+}
+func (p *TopicFeedRequest) ReadField5(iprot thrift.TProtocol) error {
+
+	var _field int32
+	if v, err := iprot.ReadI32(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.Count = _field
+	return nil
+}
+
+func (p *TopicFeedRequest) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("Topic_feed_request"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+		if err = p.writeField3(oprot); err != nil {
+			fieldId = 3
+			goto WriteFieldError
+		}
+		if err = p.writeField4(oprot); err != nil {
+			fieldId = 4
+			goto WriteFieldError
+		}
+		if err = p.writeField5(oprot); err != nil {
+			fieldId = 5
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *TopicFeedRequest) writeField1(oprot thrift.TProtocol) (err error) {
+	if p.IsSetUserId() {
+		if err = oprot.WriteFieldBegin("user_id", thrift.I64, 1); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteI64(*p.UserId); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *TopicFeedRequest) writeField2(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("tag", thrift.STRING, 2); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.Tag); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+
+func (p *TopicFeedRequest) writeField3(oprot thrift.TProtocol) (err error) {
+	if p.IsSetSort() {
+		if err = oprot.WriteFieldBegin("sort", thrift.STRING, 3); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.Sort); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
+}
+
+func (p *TopicFeedRequest) writeField4(oprot thrift.TProtocol) (err error) {
+	if p.IsSetCursor() {
+		if err = oprot.WriteFieldBegin("cursor", thrift.STRING, 4); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.Cursor); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
+}
+
+func (p *TopicFeedRequest) writeField5(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("count", thrift.I32, 5); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI32(p.Count); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 end error: ", p), err)
+}
+
+func (p *TopicFeedRequest) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("TopicFeedRequest(%+v)", *p)
+
+}
+
+func (p *TopicFeedRequest) DeepEqual(ano *TopicFeedRequest) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.UserId) {
+		return false
+	}
+	if !p.Field2DeepEqual(ano.Tag) {
+		return false
+	}
+	if !p.Field3DeepEqual(ano.Sort) {
+		return false
+	}
+	if !p.Field4DeepEqual(ano.Cursor) {
+		return false
+	}
+	if !p.Field5DeepEqual(ano.Count) {
+		return false
+	}
+	return true
+}
+
+func (p *TopicFeedRequest) Field1DeepEqual(src *int64) bool {
+
+	if p.UserId == src {
+		return true
+	} else if p.UserId == nil || src == nil {
+		return false
+	}
+	if *p.UserId != *src {
+		return false
+	}
+	return true
+}
+func (p *TopicFeedRequest) Field2DeepEqual(src string) bool {
+
+	if strings.Compare(p.Tag, src) != 0 {
+		return false
+	}
+	return true
+}
+func (p *TopicFeedRequest) Field3DeepEqual(src *string) bool {
+
+	if p.Sort == src {
+		return true
+	} else if p.Sort == nil || src == nil {
+		return false
+	}
+	if strings.Compare(*p.Sort, *src) != 0 {
+		return false
+	}
+	return true
+}
+func (p *TopicFeedRequest) Field4DeepEqual(src *string) bool {
+
+	if p.Cursor == src {
+		return true
+	} else if p.Cursor == nil || src == nil {
+		return false
+	}
+	if strings.Compare(*p.Cursor, *src) != 0 {
+		return false
+	}
+	return true
+}
+func (p *TopicFeedRequest) Field5DeepEqual(src int32) bool {
+
+	if p.Count != src {
+		return false
+	}
+	return true
+}
+
+type TopicFeedResponse struct {
+	StatusCode int32    `thrift:"status_code,1" frugal:"1,default,i32" json:"status_code"`
+	StatusMsg  *string  `thrift:"status_msg,2,optional" frugal:"2,optional,string" json:"status_msg,omitempty"`
+	VideoList  []*Video `thrift:"video_list,3" frugal:"3,default,list<Video>" json:"video_list"`
+	NextCursor *string  `thrift:"next_cursor,4,optional" frugal:"4,optional,string" json:"next_cursor,omitempty"`
+	HasMore    bool     `thrift:"has_more,5" frugal:"5,default,bool" json:"has_more"`
+}
+
+func NewTopicFeedResponse() *TopicFeedResponse {
+	return &TopicFeedResponse{}
+}
+
+func (p *TopicFeedResponse) InitDefault() {
+	*p = TopicFeedResponse{}
+}
+
+func (p *TopicFeedResponse) GetStatusCode() (v int32) {
+	return p.StatusCode
+}
+
+var TopicFeedResponse_StatusMsg_DEFAULT string
+
+func (p *TopicFeedResponse) GetStatusMsg() (v string) {
+	if !p.IsSetStatusMsg() {
+		return TopicFeedResponse_StatusMsg_DEFAULT
+	}
+	return *p.StatusMsg
+}
+
+func (p *TopicFeedResponse) GetVideoList() (v []*Video) {
+	return p.VideoList
+}
+
+var TopicFeedResponse_NextCursor_DEFAULT string
+
+func (p *TopicFeedResponse) GetNextCursor() (v string) {
+	if !p.IsSetNextCursor() {
+		return TopicFeedResponse_NextCursor_DEFAULT
+	}
+	return *p.NextCursor
+}
+
+func (p *TopicFeedResponse) GetHasMore() (v bool) {
+	return p.HasMore
+}
+func (p *TopicFeedResponse) SetStatusCode(val int32) {
+	p.StatusCode = val
+}
+func (p *TopicFeedResponse) SetStatusMsg(val *string) {
+	p.StatusMsg = val
+}
+func (p *TopicFeedResponse) SetVideoList(val []*Video) {
+	p.VideoList = val
+}
+func (p *TopicFeedResponse) SetNextCursor(val *string) {
+	p.NextCursor = val
+}
+func (p *TopicFeedResponse) SetHasMore(val bool) {
+	p.HasMore = val
+}
+
+var fieldIDToName_TopicFeedResponse = map[int16]string{
+	1: "status_code",
+	2: "status_msg",
+	3: "video_list",
+	4: "next_cursor",
+	5: "has_more",
+}
+
+func (p *TopicFeedResponse) IsSetStatusMsg() bool {
+	return p.StatusMsg != nil
+}
+
+func (p *TopicFeedResponse) IsSetNextCursor() bool {
+	return p.NextCursor != nil
+}
+
+func (p *TopicFeedResponse) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.I32 {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 2:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 3:
+			if fieldTypeId == thrift.LIST {
+				if err = p.ReadField3(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 4:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField4(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 5:
+			if fieldTypeId == thrift.BOOL {
+				if err = p.ReadField5(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_TopicFeedResponse[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *TopicFeedResponse) ReadField1(iprot thrift.TProtocol) error {
+
+	var _field int32
+	if v, err := iprot.ReadI32(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.StatusCode = _field
+	return nil
+}
+func (p *TopicFeedResponse) ReadField2(iprot thrift.TProtocol) error {
+
+	var _field *string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.StatusMsg = _field
+	return nil
+}
+func (p *TopicFeedResponse) ReadField3(iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin()
+	if err != nil {
+		return err
+	}
+	_field := make([]*Video, 0, size)
+	values := make([]Video, size)
+	for i := 0; i < size; i++ {
+		_elem := &values[i]
+
+		if err := _elem.Read(iprot); err != nil {
+			return err
+		}
+
+		_field = append(_field, _elem)
+	}
+	if err := iprot.ReadListEnd(); err != nil {
+		return err
+	}
+	p.VideoList = _field
+	return nil
+}
+func (p *TopicFeedResponse) ReadField4(iprot thrift.TProtocol) error {
+
+	var _field *string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.NextCursor = _field
+	return nil
+}
+func (p *TopicFeedResponse) ReadField5(iprot thrift.TProtocol) error {
+
+	var _field bool
+	if v, err := iprot.ReadBool(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.HasMore = _field
+	return nil
+}
+
+func (p *TopicFeedResponse) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("Topic_feed_response"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+		if err = p.writeField3(oprot); err != nil {
+			fieldId = 3
+			goto WriteFieldError
+		}
+		if err = p.writeField4(oprot); err != nil {
+			fieldId = 4
+			goto WriteFieldError
+		}
+		if err = p.writeField5(oprot); err != nil {
+			fieldId = 5
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *TopicFeedResponse) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("status_code", thrift.I32, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI32(p.StatusCode); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *TopicFeedResponse) writeField2(oprot thrift.TProtocol) (err error) {
+	if p.IsSetStatusMsg() {
+		if err = oprot.WriteFieldBegin("status_msg", thrift.STRING, 2); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.StatusMsg); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+
+func (p *TopicFeedResponse) writeField3(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("video_list", thrift.LIST, 3); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteListBegin(thrift.STRUCT, len(p.VideoList)); err != nil {
+		return err
+	}
+	for _, v := range p.VideoList {
+		if err := v.Write(oprot); err != nil {
+			return err
+		}
+	}
+	if err := oprot.WriteListEnd(); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
+}
+
+func (p *TopicFeedResponse) writeField4(oprot thrift.TProtocol) (err error) {
+	if p.IsSetNextCursor() {
+		if err = oprot.WriteFieldBegin("next_cursor", thrift.STRING, 4); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.NextCursor); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
+}
+
+func (p *TopicFeedResponse) writeField5(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("has_more", thrift.BOOL, 5); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteBool(p.HasMore); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 end error: ", p), err)
+}
+
+func (p *TopicFeedResponse) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("TopicFeedResponse(%+v)", *p)
+
+}
+
+func (p *TopicFeedResponse) DeepEqual(ano *TopicFeedResponse) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.StatusCode) {
+		return false
+	}
+	if !p.Field2DeepEqual(ano.StatusMsg) {
+		return false
+	}
+	if !p.Field3DeepEqual(ano.VideoList) {
+		return false
+	}
+	if !p.Field4DeepEqual(ano.NextCursor) {
+		return false
+	}
+	if !p.Field5DeepEqual(ano.HasMore) {
+		return false
+	}
+	return true
+}
+
+func (p *TopicFeedResponse) Field1DeepEqual(src int32) bool {
+
+	if p.StatusCode != src {
+		return false
+	}
+	return true
+}
+func (p *TopicFeedResponse) Field2DeepEqual(src *string) bool {
+
+	if p.StatusMsg == src {
+		return true
+	} else if p.StatusMsg == nil || src == nil {
+		return false
+	}
+	if strings.Compare(*p.StatusMsg, *src) != 0 {
+		return false
+	}
+	return true
+}
+func (p *TopicFeedResponse) Field3DeepEqual(src []*Video) bool {
+
+	if len(p.VideoList) != len(src) {
+		return false
+	}
+	for i, v := range p.VideoList {
+		_src := src[i]
+		if !v.DeepEqual(_src) {
+			return false
+		}
+	}
+	return true
+}
+func (p *TopicFeedResponse) Field4DeepEqual(src *string) bool {
+
+	if p.NextCursor == src {
+		return true
+	} else if p.NextCursor == nil || src == nil {
+		return false
+	}
+	if strings.Compare(*p.NextCursor, *src) != 0 {
+		return false
+	}
+	return true
+}
+func (p *TopicFeedResponse) Field5DeepEqual(src bool) bool {
+
+	if p.HasMore != src {
+		return false
+	}
+	return true
+}
+
+type Topic struct {
+	Name  string `thrift:"name,1" frugal:"1,default,string" json:"name"`
+	Count int64  `thrift:"count,2" frugal:"2,default,i64" json:"count"`
+}
+
+func NewTopic() *Topic {
+	return &Topic{}
+}
+
+func (p *Topic) InitDefault() {
+	*p = Topic{}
+}
+
+func (p *Topic) GetName() (v string) {
+	return p.Name
+}
+
+func (p *Topic) GetCount() (v int64) {
+	return p.Count
+}
+func (p *Topic) SetName(val string) {
+	p.Name = val
+}
+func (p *Topic) SetCount(val int64) {
+	p.Count = val
+}
+
+var fieldIDToName_Topic = map[int16]string{
+	1: "name",
+	2: "count",
+}
+
+func (p *Topic) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 2:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_Topic[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *Topic) ReadField1(iprot thrift.TProtocol) error {
+
+	var _field string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.Name = _field
+	return nil
+}
+func (p *Topic) ReadField2(iprot thrift.TProtocol) error {
+
+	var _field int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.Count = _field
+	return nil
+}
+
+func (p *Topic) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("Topic"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *Topic) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("name", thrift.STRING, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.Name); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *Topic) writeField2(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("count", thrift.I64, 2); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI64(p.Count); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+
+func (p *Topic) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("Topic(%+v)", *p)
+
+}
+
+func (p *Topic) DeepEqual(ano *Topic) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.Name) {
+		return false
+	}
+	if !p.Field2DeepEqual(ano.Count) {
+		return false
+	}
+	return true
+}
+
+func (p *Topic) Field1DeepEqual(src string) bool {
+
+	if strings.Compare(p.Name, src) != 0 {
+		return false
+	}
+	return true
+}
+func (p *Topic) Field2DeepEqual(src int64) bool {
+
+	if p.Count != src {
+		return false
+	}
+	return true
+}
+
+type TrendingTopicsRequest struct {
+	Count int32 `thrift:"count,1" frugal:"1,default,i32" json:"count"`
+}
+
+func NewTrendingTopicsRequest() *TrendingTopicsRequest {
+	return &TrendingTopicsRequest{}
+}
+
+func (p *TrendingTopicsRequest) InitDefault() {
+	*p = TrendingTopicsRequest{}
+}
+
+func (p *TrendingTopicsRequest) GetCount() (v int32) {
+	return p.Count
+}
+func (p *TrendingTopicsRequest) SetCount(val int32) {
+	p.Count = val
+}
+
+var fieldIDToName_TrendingTopicsRequest = map[int16]string{
+	1: "count",
+}
+
+func (p *TrendingTopicsRequest) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.I32 {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_TrendingTopicsRequest[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *TrendingTopicsRequest) ReadField1(iprot thrift.TProtocol) error {
+
+	var _field int32
+	if v, err := iprot.ReadI32(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.Count = _field
+	return nil
+}
+
+func (p *TrendingTopicsRequest) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("Trending_topics_request"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *TrendingTopicsRequest) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("count", thrift.I32, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI32(p.Count); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *TrendingTopicsRequest) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("TrendingTopicsRequest(%+v)", *p)
+
+}
+
+func (p *TrendingTopicsRequest) DeepEqual(ano *TrendingTopicsRequest) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.Count) {
+		return false
+	}
+	return true
+}
+
+func (p *TrendingTopicsRequest) Field1DeepEqual(src int32) bool {
+
+	if p.Count != src {
+		return false
+	}
+	return true
+}
+
+type TrendingTopicsResponse struct {
+	StatusCode int32    `thrift:"status_code,1" frugal:"1,default,i32" json:"status_code"`
+	StatusMsg  *string  `thrift:"status_msg,2,optional" frugal:"2,optional,string" json:"status_msg,omitempty"`
+	TopicList  []*Topic `thrift:"topic_list,3" frugal:"3,default,list<Topic>" json:"topic_list"`
+}
+
+func NewTrendingTopicsResponse() *TrendingTopicsResponse {
+	return &TrendingTopicsResponse{}
+}
+
+func (p *TrendingTopicsResponse) InitDefault() {
+	*p = TrendingTopicsResponse{}
+}
+
+func (p *TrendingTopicsResponse) GetStatusCode() (v int32) {
+	return p.StatusCode
+}
+
+var TrendingTopicsResponse_StatusMsg_DEFAULT string
+
+func (p *TrendingTopicsResponse) GetStatusMsg() (v string) {
+	if !p.IsSetStatusMsg() {
+		return TrendingTopicsResponse_StatusMsg_DEFAULT
+	}
+	return *p.StatusMsg
+}
+
+func (p *TrendingTopicsResponse) GetTopicList() (v []*Topic) {
+	return p.TopicList
+}
+func (p *TrendingTopicsResponse) SetStatusCode(val int32) {
+	p.StatusCode = val
+}
+func (p *TrendingTopicsResponse) SetStatusMsg(val *string) {
+	p.StatusMsg = val
+}
+func (p *TrendingTopicsResponse) SetTopicList(val []*Topic) {
+	p.TopicList = val
+}
+
+var fieldIDToName_TrendingTopicsResponse = map[int16]string{
+	1: "status_code",
+	2: "status_msg",
+	3: "topic_list",
+}
+
+func (p *TrendingTopicsResponse) IsSetStatusMsg() bool {
+	return p.StatusMsg != nil
+}
+
+func (p *TrendingTopicsResponse) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.I32 {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 2:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 3:
+			if fieldTypeId == thrift.LIST {
+				if err = p.ReadField3(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_TrendingTopicsResponse[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *TrendingTopicsResponse) ReadField1(iprot thrift.TProtocol) error {
+
+	var _field int32
+	if v, err := iprot.ReadI32(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.StatusCode = _field
+	return nil
+}
+func (p *TrendingTopicsResponse) ReadField2(iprot thrift.TProtocol) error {
+
+	var _field *string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.StatusMsg = _field
+	return nil
+}
+func (p *TrendingTopicsResponse) ReadField3(iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin()
+	if err != nil {
+		return err
+	}
+	_field := make([]*Topic, 0, size)
+	values := make([]Topic, size)
+	for i := 0; i < size; i++ {
+		_elem := &values[i]
+
+		if err := _elem.Read(iprot); err != nil {
+			return err
+		}
+
+		_field = append(_field, _elem)
+	}
+	if err := iprot.ReadListEnd(); err != nil {
+		return err
+	}
+	p.TopicList = _field
+	return nil
+}
+
+func (p *TrendingTopicsResponse) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("Trending_topics_response"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+		if err = p.writeField3(oprot); err != nil {
+			fieldId = 3
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *TrendingTopicsResponse) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("status_code", thrift.I32, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI32(p.StatusCode); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *TrendingTopicsResponse) writeField2(oprot thrift.TProtocol) (err error) {
+	if p.IsSetStatusMsg() {
+		if err = oprot.WriteFieldBegin("status_msg", thrift.STRING, 2); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.StatusMsg); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+
+func (p *TrendingTopicsResponse) writeField3(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("topic_list", thrift.LIST, 3); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteListBegin(thrift.STRUCT, len(p.TopicList)); err != nil {
+		return err
+	}
+	for _, v := range p.TopicList {
+		if err := v.Write(oprot); err != nil {
+			return err
+		}
+	}
+	if err := oprot.WriteListEnd(); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
+}
+
+func (p *TrendingTopicsResponse) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("TrendingTopicsResponse(%+v)", *p)
+
+}
+
+func (p *TrendingTopicsResponse) DeepEqual(ano *TrendingTopicsResponse) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.StatusCode) {
+		return false
+	}
+	if !p.Field2DeepEqual(ano.StatusMsg) {
+		return false
+	}
+	if !p.Field3DeepEqual(ano.TopicList) {
+		return false
+	}
+	return true
+}
+
+func (p *TrendingTopicsResponse) Field1DeepEqual(src int32) bool {
+
+	if p.StatusCode != src {
+		return false
+	}
+	return true
+}
+func (p *TrendingTopicsResponse) Field2DeepEqual(src *string) bool {
+
+	if p.StatusMsg == src {
+		return true
+	} else if p.StatusMsg == nil || src == nil {
+		return false
+	}
+	if strings.Compare(*p.StatusMsg, *src) != 0 {
+		return false
+	}
+	return true
+}
+func (p *TrendingTopicsResponse) Field3DeepEqual(src []*Topic) bool {
+
+	if len(p.TopicList) != len(src) {
+		return false
+	}
+	for i, v := range p.TopicList {
+		_src := src[i]
+		if !v.DeepEqual(_src) {
+			return false
+		}
+	}
+	return true
+}
+
+type HotVideosRequest struct {
+	UserId *int64  `thrift:"user_id,1,optional" frugal:"1,optional,i64" json:"user_id,omitempty"`
+	Window *string `thrift:"window,2,optional" frugal:"2,optional,string" json:"window,omitempty"`
+	Count  int32   `thrift:"count,3" frugal:"3,default,i32" json:"count"`
+}
+
+func NewHotVideosRequest() *HotVideosRequest {
+	return &HotVideosRequest{}
+}
+
+func (p *HotVideosRequest) InitDefault() {
+	*p = HotVideosRequest{}
+}
+
+var HotVideosRequest_UserId_DEFAULT int64
+
+func (p *HotVideosRequest) GetUserId() (v int64) {
+	if !p.IsSetUserId() {
+		return HotVideosRequest_UserId_DEFAULT
+	}
+	return *p.UserId
+}
+
+var HotVideosRequest_Window_DEFAULT string
+
+func (p *HotVideosRequest) GetWindow() (v string) {
+	if !p.IsSetWindow() {
+		return HotVideosRequest_Window_DEFAULT
+	}
+	return *p.Window
+}
+
+func (p *HotVideosRequest) GetCount() (v int32) {
+	return p.Count
+}
+func (p *HotVideosRequest) SetUserId(val *int64) {
+	p.UserId = val
+}
+func (p *HotVideosRequest) SetWindow(val *string) {
+	p.Window = val
+}
+func (p *HotVideosRequest) SetCount(val int32) {
+	p.Count = val
+}
+
+var fieldIDToName_HotVideosRequest = map[int16]string{
+	1: "user_id",
+	2: "window",
+	3: "count",
+}
+
+func (p *HotVideosRequest) IsSetUserId() bool {
+	return p.UserId != nil
+}
+
+func (p *HotVideosRequest) IsSetWindow() bool {
+	return p.Window != nil
+}
+
+func (p *HotVideosRequest) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 2:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 3:
+			if fieldTypeId == thrift.I32 {
+				if err = p.ReadField3(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_HotVideosRequest[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *HotVideosRequest) ReadField1(iprot thrift.TProtocol) error {
+
+	var _field *int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.UserId = _field
+	return nil
+}
+func (p *HotVideosRequest) ReadField2(iprot thrift.TProtocol) error {
+
+	var _field *string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.Window = _field
+	return nil
+}
+func (p *HotVideosRequest) ReadField3(iprot thrift.TProtocol) error {
+
+	var _field int32
+	if v, err := iprot.ReadI32(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.Count = _field
+	return nil
+}
+
+func (p *HotVideosRequest) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("Hot_videos_request"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+		if err = p.writeField3(oprot); err != nil {
+			fieldId = 3
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *HotVideosRequest) writeField1(oprot thrift.TProtocol) (err error) {
+	if p.IsSetUserId() {
+		if err = oprot.WriteFieldBegin("user_id", thrift.I64, 1); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteI64(*p.UserId); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *HotVideosRequest) writeField2(oprot thrift.TProtocol) (err error) {
+	if p.IsSetWindow() {
+		if err = oprot.WriteFieldBegin("window", thrift.STRING, 2); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.Window); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+
+func (p *HotVideosRequest) writeField3(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("count", thrift.I32, 3); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI32(p.Count); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
+}
+
+func (p *HotVideosRequest) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("HotVideosRequest(%+v)", *p)
+
+}
+
+func (p *HotVideosRequest) DeepEqual(ano *HotVideosRequest) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.UserId) {
+		return false
+	}
+	if !p.Field2DeepEqual(ano.Window) {
+		return false
+	}
+	if !p.Field3DeepEqual(ano.Count) {
+		return false
+	}
+	return true
+}
+
+func (p *HotVideosRequest) Field1DeepEqual(src *int64) bool {
+
+	if p.UserId == src {
+		return true
+	} else if p.UserId == nil || src == nil {
+		return false
+	}
+	if *p.UserId != *src {
+		return false
+	}
+	return true
+}
+func (p *HotVideosRequest) Field2DeepEqual(src *string) bool {
+
+	if p.Window == src {
+		return true
+	} else if p.Window == nil || src == nil {
+		return false
+	}
+	if strings.Compare(*p.Window, *src) != 0 {
+		return false
+	}
+	return true
+}
+func (p *HotVideosRequest) Field3DeepEqual(src int32) bool {
+
+	if p.Count != src {
+		return false
+	}
+	return true
+}
+
+type HotVideosResponse struct {
+	StatusCode int32    `thrift:"status_code,1" frugal:"1,default,i32" json:"status_code"`
+	StatusMsg  *string  `thrift:"status_msg,2,optional" frugal:"2,optional,string" json:"status_msg,omitempty"`
+	VideoList  []*Video `thrift:"video_list,3" frugal:"3,default,list<Video>" json:"video_list"`
+}
+
+func NewHotVideosResponse() *HotVideosResponse {
+	return &HotVideosResponse{}
+}
+
+func (p *HotVideosResponse) InitDefault() {
+	*p = HotVideosResponse{}
+}
+
+func (p *HotVideosResponse) GetStatusCode() (v int32) {
+	return p.StatusCode
+}
+
+var HotVideosResponse_StatusMsg_DEFAULT string
+
+func (p *HotVideosResponse) GetStatusMsg() (v string) {
+	if !p.IsSetStatusMsg() {
+		return HotVideosResponse_StatusMsg_DEFAULT
+	}
+	return *p.StatusMsg
+}
+
+func (p *HotVideosResponse) GetVideoList() (v []*Video) {
+	return p.VideoList
+}
+func (p *HotVideosResponse) SetStatusCode(val int32) {
+	p.StatusCode = val
+}
+func (p *HotVideosResponse) SetStatusMsg(val *string) {
+	p.StatusMsg = val
+}
+func (p *HotVideosResponse) SetVideoList(val []*Video) {
+	p.VideoList = val
+}
+
+var fieldIDToName_HotVideosResponse = map[int16]string{
+	1: "status_code",
+	2: "status_msg",
+	3: "video_list",
+}
+
+func (p *HotVideosResponse) IsSetStatusMsg() bool {
+	return p.StatusMsg != nil
+}
+
+func (p *HotVideosResponse) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.I32 {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 2:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 3:
+			if fieldTypeId == thrift.LIST {
+				if err = p.ReadField3(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_HotVideosResponse[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *HotVideosResponse) ReadField1(iprot thrift.TProtocol) error {
+
+	var _field int32
+	if v, err := iprot.ReadI32(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.StatusCode = _field
+	return nil
+}
+func (p *HotVideosResponse) ReadField2(iprot thrift.TProtocol) error {
+
+	var _field *string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.StatusMsg = _field
+	return nil
+}
+func (p *HotVideosResponse) ReadField3(iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin()
+	if err != nil {
+		return err
+	}
+	_field := make([]*Video, 0, size)
+	values := make([]Video, size)
+	for i := 0; i < size; i++ {
+		_elem := &values[i]
+
+		if err := _elem.Read(iprot); err != nil {
+			return err
+		}
+
+		_field = append(_field, _elem)
+	}
+	if err := iprot.ReadListEnd(); err != nil {
+		return err
+	}
+	p.VideoList = _field
+	return nil
+}
+
+func (p *HotVideosResponse) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("Hot_videos_response"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+		if err = p.writeField3(oprot); err != nil {
+			fieldId = 3
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *HotVideosResponse) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("status_code", thrift.I32, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI32(p.StatusCode); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *HotVideosResponse) writeField2(oprot thrift.TProtocol) (err error) {
+	if p.IsSetStatusMsg() {
+		if err = oprot.WriteFieldBegin("status_msg", thrift.STRING, 2); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.StatusMsg); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+
+func (p *HotVideosResponse) writeField3(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("video_list", thrift.LIST, 3); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteListBegin(thrift.STRUCT, len(p.VideoList)); err != nil {
+		return err
+	}
+	for _, v := range p.VideoList {
+		if err := v.Write(oprot); err != nil {
+			return err
+		}
+	}
+	if err := oprot.WriteListEnd(); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
+}
+
+func (p *HotVideosResponse) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("HotVideosResponse(%+v)", *p)
+
+}
+
+func (p *HotVideosResponse) DeepEqual(ano *HotVideosResponse) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.StatusCode) {
+		return false
+	}
+	if !p.Field2DeepEqual(ano.StatusMsg) {
+		return false
+	}
+	if !p.Field3DeepEqual(ano.VideoList) {
+		return false
+	}
+	return true
+}
+
+func (p *HotVideosResponse) Field1DeepEqual(src int32) bool {
+
+	if p.StatusCode != src {
+		return false
+	}
+	return true
+}
+func (p *HotVideosResponse) Field2DeepEqual(src *string) bool {
+
+	if p.StatusMsg == src {
+		return true
+	} else if p.StatusMsg == nil || src == nil {
+		return false
+	}
+	if strings.Compare(*p.StatusMsg, *src) != 0 {
+		return false
+	}
+	return true
+}
+func (p *HotVideosResponse) Field3DeepEqual(src []*Video) bool {
+
+	if len(p.VideoList) != len(src) {
+		return false
+	}
+	for i, v := range p.VideoList {
+		_src := src[i]
+		if !v.DeepEqual(_src) {
+			return false
+		}
+	}
+	return true
+}
+
+type VideoService interface {
+	Feed(ctx context.Context, req *FeedRequest) (r *FeedResponse, err error)
+
+	FollowFeed(ctx context.Context, req *FollowFeedRequest) (r *FollowFeedResponse, err error)
+
+	PublishAction(ctx context.Context, req *PublishActionRequest) (r *PublishActionResponse, err error)
+
+	PublishList(ctx context.Context, req *PublishListRequest) (r *PublishListResponse, err error)
+
+	PublishStatus(ctx context.Context, req *PublishStatusRequest) (r *PublishStatusResponse, err error)
+
+	UpdateCover(ctx context.Context, req *UpdateCoverRequest) (r *UpdateCoverResponse, err error)
+
+	DeleteVideo(ctx context.Context, req *DeleteVideoRequest) (r *DeleteVideoResponse, err error)
+
+	UpdateVideo(ctx context.Context, req *UpdateVideoRequest) (r *UpdateVideoResponse, err error)
+
+	TopicFeed(ctx context.Context, req *TopicFeedRequest) (r *TopicFeedResponse, err error)
+
+	TrendingTopics(ctx context.Context, req *TrendingTopicsRequest) (r *TrendingTopicsResponse, err error)
+
+	HotVideos(ctx context.Context, req *HotVideosRequest) (r *HotVideosResponse, err error)
+
+	PublishIDList(ctx context.Context, userId int64) (r []int64, err error)
+
+	VideoInfo(ctx context.Context, req *VideoInfoRequest) (r *Video, err error)
+
+	VideoInfoList(ctx context.Context, req *VideoInfoListRequest) (r []*Video, err error)
+
+	WorkCount(ctx context.Context, userId int64) (r int64, err error)
+
+	BatchWorkCount(ctx context.Context, userIds []int64) (r map[int64]int64, err error)
+
+	AuthorId(ctx context.Context, videoId int64) (r int64, err error)
+
+	VideoExist(ctx context.Context, videoId int64) (r bool, err error)
+
+	ClearSeen(ctx context.Context, userId int64) (err error)
+}
+
+type VideoServiceClient struct {
+	c thrift.TClient
+}
+
+func NewVideoServiceClientFactory(t thrift.TTransport, f thrift.TProtocolFactory) *VideoServiceClient {
+	return &VideoServiceClient{
+		c: thrift.NewTStandardClient(f.GetProtocol(t), f.GetProtocol(t)),
+	}
+}
+
+func NewVideoServiceClientProtocol(t thrift.TTransport, iprot thrift.TProtocol, oprot thrift.TProtocol) *VideoServiceClient {
+	return &VideoServiceClient{
+		c: thrift.NewTStandardClient(iprot, oprot),
+	}
+}
+
+func NewVideoServiceClient(c thrift.TClient) *VideoServiceClient {
+	return &VideoServiceClient{
+		c: c,
+	}
+}
+
+func (p *VideoServiceClient) Client_() thrift.TClient {
+	return p.c
+}
+
+func (p *VideoServiceClient) Feed(ctx context.Context, req *FeedRequest) (r *FeedResponse, err error) {
+	var _args VideoServiceFeedArgs
+	_args.Req = req
+	var _result VideoServiceFeedResult
+	if err = p.Client_().Call(ctx, "Feed", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *VideoServiceClient) FollowFeed(ctx context.Context, req *FollowFeedRequest) (r *FollowFeedResponse, err error) {
+	var _args VideoServiceFollowFeedArgs
+	_args.Req = req
+	var _result VideoServiceFollowFeedResult
+	if err = p.Client_().Call(ctx, "FollowFeed", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *VideoServiceClient) PublishAction(ctx context.Context, req *PublishActionRequest) (r *PublishActionResponse, err error) {
+	var _args VideoServicePublishActionArgs
+	_args.Req = req
+	var _result VideoServicePublishActionResult
+	if err = p.Client_().Call(ctx, "PublishAction", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *VideoServiceClient) PublishList(ctx context.Context, req *PublishListRequest) (r *PublishListResponse, err error) {
+	var _args VideoServicePublishListArgs
+	_args.Req = req
+	var _result VideoServicePublishListResult
+	if err = p.Client_().Call(ctx, "PublishList", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *VideoServiceClient) PublishStatus(ctx context.Context, req *PublishStatusRequest) (r *PublishStatusResponse, err error) {
+	var _args VideoServicePublishStatusArgs
+	_args.Req = req
+	var _result VideoServicePublishStatusResult
+	if err = p.Client_().Call(ctx, "PublishStatus", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *VideoServiceClient) UpdateCover(ctx context.Context, req *UpdateCoverRequest) (r *UpdateCoverResponse, err error) {
+	var _args VideoServiceUpdateCoverArgs
+	_args.Req = req
+	var _result VideoServiceUpdateCoverResult
+	if err = p.Client_().Call(ctx, "UpdateCover", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *VideoServiceClient) DeleteVideo(ctx context.Context, req *DeleteVideoRequest) (r *DeleteVideoResponse, err error) {
+	var _args VideoServiceDeleteVideoArgs
+	_args.Req = req
+	var _result VideoServiceDeleteVideoResult
+	if err = p.Client_().Call(ctx, "DeleteVideo", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *VideoServiceClient) UpdateVideo(ctx context.Context, req *UpdateVideoRequest) (r *UpdateVideoResponse, err error) {
+	var _args VideoServiceUpdateVideoArgs
+	_args.Req = req
+	var _result VideoServiceUpdateVideoResult
+	if err = p.Client_().Call(ctx, "UpdateVideo", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *VideoServiceClient) TopicFeed(ctx context.Context, req *TopicFeedRequest) (r *TopicFeedResponse, err error) {
+	var _args VideoServiceTopicFeedArgs
+	_args.Req = req
+	var _result VideoServiceTopicFeedResult
+	if err = p.Client_().Call(ctx, "TopicFeed", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *VideoServiceClient) TrendingTopics(ctx context.Context, req *TrendingTopicsRequest) (r *TrendingTopicsResponse, err error) {
+	var _args VideoServiceTrendingTopicsArgs
+	_args.Req = req
+	var _result VideoServiceTrendingTopicsResult
+	if err = p.Client_().Call(ctx, "TrendingTopics", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *VideoServiceClient) HotVideos(ctx context.Context, req *HotVideosRequest) (r *HotVideosResponse, err error) {
+	var _args VideoServiceHotVideosArgs
+	_args.Req = req
+	var _result VideoServiceHotVideosResult
+	if err = p.Client_().Call(ctx, "HotVideos", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *VideoServiceClient) PublishIDList(ctx context.Context, userId int64) (r []int64, err error) {
+	var _args VideoServicePublishIDListArgs
+	_args.UserId = userId
+	var _result VideoServicePublishIDListResult
+	if err = p.Client_().Call(ctx, "PublishIDList", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *VideoServiceClient) VideoInfo(ctx context.Context, req *VideoInfoRequest) (r *Video, err error) {
+	var _args VideoServiceVideoInfoArgs
+	_args.Req = req
+	var _result VideoServiceVideoInfoResult
+	if err = p.Client_().Call(ctx, "VideoInfo", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *VideoServiceClient) VideoInfoList(ctx context.Context, req *VideoInfoListRequest) (r []*Video, err error) {
+	var _args VideoServiceVideoInfoListArgs
+	_args.Req = req
+	var _result VideoServiceVideoInfoListResult
+	if err = p.Client_().Call(ctx, "VideoInfoList", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *VideoServiceClient) WorkCount(ctx context.Context, userId int64) (r int64, err error) {
+	var _args VideoServiceWorkCountArgs
+	_args.UserId = userId
+	var _result VideoServiceWorkCountResult
+	if err = p.Client_().Call(ctx, "WorkCount", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *VideoServiceClient) BatchWorkCount(ctx context.Context, userIds []int64) (r map[int64]int64, err error) {
+	var _args VideoServiceBatchWorkCountArgs
+	_args.UserIds = userIds
+	var _result VideoServiceBatchWorkCountResult
+	if err = p.Client_().Call(ctx, "BatchWorkCount", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *VideoServiceClient) AuthorId(ctx context.Context, videoId int64) (r int64, err error) {
+	var _args VideoServiceAuthorIdArgs
+	_args.VideoId = videoId
+	var _result VideoServiceAuthorIdResult
+	if err = p.Client_().Call(ctx, "AuthorId", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *VideoServiceClient) VideoExist(ctx context.Context, videoId int64) (r bool, err error) {
+	var _args VideoServiceVideoExistArgs
+	_args.VideoId = videoId
+	var _result VideoServiceVideoExistResult
+	if err = p.Client_().Call(ctx, "VideoExist", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *VideoServiceClient) ClearSeen(ctx context.Context, userId int64) (err error) {
+	var _args VideoServiceClearSeenArgs
+	_args.UserId = userId
+	var _result VideoServiceClearSeenResult
+	if err = p.Client_().Call(ctx, "ClearSeen", &_args, &_result); err != nil {
+		return
+	}
+	return nil
+}
+
+type VideoServiceProcessor struct {
+	processorMap map[string]thrift.TProcessorFunction
+	handler      VideoService
+}
+
+func (p *VideoServiceProcessor) AddToProcessorMap(key string, processor thrift.TProcessorFunction) {
+	p.processorMap[key] = processor
+}
+
+func (p *VideoServiceProcessor) GetProcessorFunction(key string) (processor thrift.TProcessorFunction, ok bool) {
+	processor, ok = p.processorMap[key]
+	return processor, ok
+}
+
+func (p *VideoServiceProcessor) ProcessorMap() map[string]thrift.TProcessorFunction {
+	return p.processorMap
+}
+
+func NewVideoServiceProcessor(handler VideoService) *VideoServiceProcessor {
+	self := &VideoServiceProcessor{handler: handler, processorMap: make(map[string]thrift.TProcessorFunction)}
+	self.AddToProcessorMap("Feed", &videoServiceProcessorFeed{handler: handler})
+	self.AddToProcessorMap("FollowFeed", &videoServiceProcessorFollowFeed{handler: handler})
+	self.AddToProcessorMap("PublishAction", &videoServiceProcessorPublishAction{handler: handler})
+	self.AddToProcessorMap("PublishList", &videoServiceProcessorPublishList{handler: handler})
+	self.AddToProcessorMap("PublishStatus", &videoServiceProcessorPublishStatus{handler: handler})
+	self.AddToProcessorMap("UpdateCover", &videoServiceProcessorUpdateCover{handler: handler})
+	self.AddToProcessorMap("DeleteVideo", &videoServiceProcessorDeleteVideo{handler: handler})
+	self.AddToProcessorMap("UpdateVideo", &videoServiceProcessorUpdateVideo{handler: handler})
+	self.AddToProcessorMap("TopicFeed", &videoServiceProcessorTopicFeed{handler: handler})
+	self.AddToProcessorMap("TrendingTopics", &videoServiceProcessorTrendingTopics{handler: handler})
+	self.AddToProcessorMap("HotVideos", &videoServiceProcessorHotVideos{handler: handler})
+	self.AddToProcessorMap("PublishIDList", &videoServiceProcessorPublishIDList{handler: handler})
+	self.AddToProcessorMap("VideoInfo", &videoServiceProcessorVideoInfo{handler: handler})
+	self.AddToProcessorMap("VideoInfoList", &videoServiceProcessorVideoInfoList{handler: handler})
+	self.AddToProcessorMap("WorkCount", &videoServiceProcessorWorkCount{handler: handler})
+	self.AddToProcessorMap("BatchWorkCount", &videoServiceProcessorBatchWorkCount{handler: handler})
+	self.AddToProcessorMap("AuthorId", &videoServiceProcessorAuthorId{handler: handler})
+	self.AddToProcessorMap("VideoExist", &videoServiceProcessorVideoExist{handler: handler})
+	self.AddToProcessorMap("ClearSeen", &videoServiceProcessorClearSeen{handler: handler})
+	return self
+}
+func (p *VideoServiceProcessor) Process(ctx context.Context, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	name, _, seqId, err := iprot.ReadMessageBegin()
+	if err != nil {
+		return false, err
+	}
+	if processor, ok := p.GetProcessorFunction(name); ok {
+		return processor.Process(ctx, seqId, iprot, oprot)
+	}
+	iprot.Skip(thrift.STRUCT)
+	iprot.ReadMessageEnd()
+	x := thrift.NewTApplicationException(thrift.UNKNOWN_METHOD, "Unknown function "+name)
+	oprot.WriteMessageBegin(name, thrift.EXCEPTION, seqId)
+	x.Write(oprot)
+	oprot.WriteMessageEnd()
+	oprot.Flush(ctx)
+	return false, x
+}
+
+type videoServiceProcessorFeed struct {
+	handler VideoService
+}
+
+func (p *videoServiceProcessorFeed) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := VideoServiceFeedArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("Feed", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := VideoServiceFeedResult{}
+	var retval *FeedResponse
+	if retval, err2 = p.handler.Feed(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing Feed: "+err2.Error())
 		oprot.WriteMessageBegin("Feed", thrift.EXCEPTION, seqId)
 		x.Write(oprot)
 		oprot.WriteMessageEnd()
 		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("Feed", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type videoServiceProcessorFollowFeed struct {
+	handler VideoService
+}
+
+func (p *videoServiceProcessorFollowFeed) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := VideoServiceFollowFeedArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("FollowFeed", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := VideoServiceFollowFeedResult{}
+	var retval *FollowFeedResponse
+	if retval, err2 = p.handler.FollowFeed(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing FollowFeed: "+err2.Error())
+		oprot.WriteMessageBegin("FollowFeed", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("FollowFeed", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type videoServiceProcessorPublishAction struct {
+	handler VideoService
+}
+
+func (p *videoServiceProcessorPublishAction) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := VideoServicePublishActionArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("PublishAction", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := VideoServicePublishActionResult{}
+	var retval *PublishActionResponse
+	if retval, err2 = p.handler.PublishAction(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing PublishAction: "+err2.Error())
+		oprot.WriteMessageBegin("PublishAction", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("PublishAction", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type videoServiceProcessorPublishList struct {
+	handler VideoService
+}
+
+func (p *videoServiceProcessorPublishList) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := VideoServicePublishListArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("PublishList", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := VideoServicePublishListResult{}
+	var retval *PublishListResponse
+	if retval, err2 = p.handler.PublishList(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing PublishList: "+err2.Error())
+		oprot.WriteMessageBegin("PublishList", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("PublishList", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type videoServiceProcessorPublishStatus struct {
+	handler VideoService
+}
+
+func (p *videoServiceProcessorPublishStatus) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := VideoServicePublishStatusArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("PublishStatus", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := VideoServicePublishStatusResult{}
+	var retval *PublishStatusResponse
+	if retval, err2 = p.handler.PublishStatus(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing PublishStatus: "+err2.Error())
+		oprot.WriteMessageBegin("PublishStatus", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("PublishStatus", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type videoServiceProcessorUpdateCover struct {
+	handler VideoService
+}
+
+func (p *videoServiceProcessorUpdateCover) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := VideoServiceUpdateCoverArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("UpdateCover", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := VideoServiceUpdateCoverResult{}
+	var retval *UpdateCoverResponse
+	if retval, err2 = p.handler.UpdateCover(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing UpdateCover: "+err2.Error())
+		oprot.WriteMessageBegin("UpdateCover", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("UpdateCover", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type videoServiceProcessorDeleteVideo struct {
+	handler VideoService
+}
+
+func (p *videoServiceProcessorDeleteVideo) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := VideoServiceDeleteVideoArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("DeleteVideo", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := VideoServiceDeleteVideoResult{}
+	var retval *DeleteVideoResponse
+	if retval, err2 = p.handler.DeleteVideo(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing DeleteVideo: "+err2.Error())
+		oprot.WriteMessageBegin("DeleteVideo", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("DeleteVideo", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type videoServiceProcessorUpdateVideo struct {
+	handler VideoService
+}
+
+func (p *videoServiceProcessorUpdateVideo) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := VideoServiceUpdateVideoArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("UpdateVideo", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := VideoServiceUpdateVideoResult{}
+	var retval *UpdateVideoResponse
+	if retval, err2 = p.handler.UpdateVideo(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing UpdateVideo: "+err2.Error())
+		oprot.WriteMessageBegin("UpdateVideo", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("UpdateVideo", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type videoServiceProcessorTopicFeed struct {
+	handler VideoService
+}
+
+func (p *videoServiceProcessorTopicFeed) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := VideoServiceTopicFeedArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("TopicFeed", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := VideoServiceTopicFeedResult{}
+	var retval *TopicFeedResponse
+	if retval, err2 = p.handler.TopicFeed(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing TopicFeed: "+err2.Error())
+		oprot.WriteMessageBegin("TopicFeed", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("TopicFeed", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type videoServiceProcessorTrendingTopics struct {
+	handler VideoService
+}
+
+func (p *videoServiceProcessorTrendingTopics) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := VideoServiceTrendingTopicsArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("TrendingTopics", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := VideoServiceTrendingTopicsResult{}
+	var retval *TrendingTopicsResponse
+	if retval, err2 = p.handler.TrendingTopics(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing TrendingTopics: "+err2.Error())
+		oprot.WriteMessageBegin("TrendingTopics", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("TrendingTopics", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type videoServiceProcessorHotVideos struct {
+	handler VideoService
+}
+
+func (p *videoServiceProcessorHotVideos) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := VideoServiceHotVideosArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("HotVideos", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := VideoServiceHotVideosResult{}
+	var retval *HotVideosResponse
+	if retval, err2 = p.handler.HotVideos(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing HotVideos: "+err2.Error())
+		oprot.WriteMessageBegin("HotVideos", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("HotVideos", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type videoServiceProcessorPublishIDList struct {
+	handler VideoService
+}
+
+func (p *videoServiceProcessorPublishIDList) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := VideoServicePublishIDListArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("PublishIDList", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := VideoServicePublishIDListResult{}
+	var retval []int64
+	if retval, err2 = p.handler.PublishIDList(ctx, args.UserId); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing PublishIDList: "+err2.Error())
+		oprot.WriteMessageBegin("PublishIDList", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("PublishIDList", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type videoServiceProcessorVideoInfo struct {
+	handler VideoService
+}
+
+func (p *videoServiceProcessorVideoInfo) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := VideoServiceVideoInfoArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("VideoInfo", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := VideoServiceVideoInfoResult{}
+	var retval *Video
+	if retval, err2 = p.handler.VideoInfo(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing VideoInfo: "+err2.Error())
+		oprot.WriteMessageBegin("VideoInfo", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("VideoInfo", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type videoServiceProcessorVideoInfoList struct {
+	handler VideoService
+}
+
+func (p *videoServiceProcessorVideoInfoList) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := VideoServiceVideoInfoListArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("VideoInfoList", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := VideoServiceVideoInfoListResult{}
+	var retval []*Video
+	if retval, err2 = p.handler.VideoInfoList(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing VideoInfoList: "+err2.Error())
+		oprot.WriteMessageBegin("VideoInfoList", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("VideoInfoList", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type videoServiceProcessorWorkCount struct {
+	handler VideoService
+}
+
+func (p *videoServiceProcessorWorkCount) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := VideoServiceWorkCountArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("WorkCount", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := VideoServiceWorkCountResult{}
+	var retval int64
+	if retval, err2 = p.handler.WorkCount(ctx, args.UserId); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing WorkCount: "+err2.Error())
+		oprot.WriteMessageBegin("WorkCount", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = &retval
+	}
+	if err2 = oprot.WriteMessageBegin("WorkCount", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type videoServiceProcessorBatchWorkCount struct {
+	handler VideoService
+}
+
+func (p *videoServiceProcessorBatchWorkCount) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := VideoServiceBatchWorkCountArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("BatchWorkCount", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := VideoServiceBatchWorkCountResult{}
+	var retval map[int64]int64
+	if retval, err2 = p.handler.BatchWorkCount(ctx, args.UserIds); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing BatchWorkCount: "+err2.Error())
+		oprot.WriteMessageBegin("BatchWorkCount", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("BatchWorkCount", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type videoServiceProcessorAuthorId struct {
+	handler VideoService
+}
+
+func (p *videoServiceProcessorAuthorId) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := VideoServiceAuthorIdArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("AuthorId", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := VideoServiceAuthorIdResult{}
+	var retval int64
+	if retval, err2 = p.handler.AuthorId(ctx, args.VideoId); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing AuthorId: "+err2.Error())
+		oprot.WriteMessageBegin("AuthorId", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = &retval
+	}
+	if err2 = oprot.WriteMessageBegin("AuthorId", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type videoServiceProcessorVideoExist struct {
+	handler VideoService
+}
+
+func (p *videoServiceProcessorVideoExist) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := VideoServiceVideoExistArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("VideoExist", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := VideoServiceVideoExistResult{}
+	var retval bool
+	if retval, err2 = p.handler.VideoExist(ctx, args.VideoId); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing VideoExist: "+err2.Error())
+		oprot.WriteMessageBegin("VideoExist", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = &retval
+	}
+	if err2 = oprot.WriteMessageBegin("VideoExist", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type videoServiceProcessorClearSeen struct {
+	handler VideoService
+}
+
+func (p *videoServiceProcessorClearSeen) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := VideoServiceClearSeenArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("ClearSeen", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
 		return false, err
 	}
 
-	iprot.ReadMessageEnd()
-	var err2 error
-	result := VideoServiceFeedResult{}
-	var retval *FeedResponse
-	if retval, err2 = p.handler.Feed(ctx, args.Req); err2 != nil {
-		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing Feed: "+err2.Error())
-		oprot.WriteMessageBegin("Feed", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return true, err2
-	} else {
-		result.Success = retval
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := VideoServiceClearSeenResult{}
+	if err2 = p.handler.ClearSeen(ctx, args.UserId); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing ClearSeen: "+err2.Error())
+		oprot.WriteMessageBegin("ClearSeen", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	}
+	if err2 = oprot.WriteMessageBegin("ClearSeen", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type VideoServiceFeedArgs struct {
+	Req *FeedRequest `thrift:"req,1" frugal:"1,default,FeedRequest" json:"req"`
+}
+
+func NewVideoServiceFeedArgs() *VideoServiceFeedArgs {
+	return &VideoServiceFeedArgs{}
+}
+
+func (p *VideoServiceFeedArgs) InitDefault() {
+	*p = VideoServiceFeedArgs{}
+}
+
+var VideoServiceFeedArgs_Req_DEFAULT *FeedRequest
+
+func (p *VideoServiceFeedArgs) GetReq() (v *FeedRequest) {
+	if !p.IsSetReq() {
+		return VideoServiceFeedArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+func (p *VideoServiceFeedArgs) SetReq(val *FeedRequest) {
+	p.Req = val
+}
+
+var fieldIDToName_VideoServiceFeedArgs = map[int16]string{
+	1: "req",
+}
+
+func (p *VideoServiceFeedArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *VideoServiceFeedArgs) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_VideoServiceFeedArgs[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *VideoServiceFeedArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := NewFeedRequest()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Req = _field
+	return nil
+}
+
+func (p *VideoServiceFeedArgs) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("Feed_args"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *VideoServiceFeedArgs) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := p.Req.Write(oprot); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *VideoServiceFeedArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("VideoServiceFeedArgs(%+v)", *p)
+
+}
+
+func (p *VideoServiceFeedArgs) DeepEqual(ano *VideoServiceFeedArgs) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.Req) {
+		return false
+	}
+	return true
+}
+
+func (p *VideoServiceFeedArgs) Field1DeepEqual(src *FeedRequest) bool {
+
+	if !p.Req.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+
+type VideoServiceFeedResult struct {
+	Success *FeedResponse `thrift:"success,0,optional" frugal:"0,optional,FeedResponse" json:"success,omitempty"`
+}
+
+func NewVideoServiceFeedResult() *VideoServiceFeedResult {
+	return &VideoServiceFeedResult{}
+}
+
+func (p *VideoServiceFeedResult) InitDefault() {
+	*p = VideoServiceFeedResult{}
+}
+
+var VideoServiceFeedResult_Success_DEFAULT *FeedResponse
+
+func (p *VideoServiceFeedResult) GetSuccess() (v *FeedResponse) {
+	if !p.IsSetSuccess() {
+		return VideoServiceFeedResult_Success_DEFAULT
+	}
+	return p.Success
+}
+func (p *VideoServiceFeedResult) SetSuccess(x interface{}) {
+	p.Success = x.(*FeedResponse)
+}
+
+var fieldIDToName_VideoServiceFeedResult = map[int16]string{
+	0: "success",
+}
+
+func (p *VideoServiceFeedResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *VideoServiceFeedResult) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 0:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField0(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_VideoServiceFeedResult[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *VideoServiceFeedResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := NewFeedResponse()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Success = _field
+	return nil
+}
+
+func (p *VideoServiceFeedResult) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("Feed_result"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField0(oprot); err != nil {
+			fieldId = 0
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *VideoServiceFeedResult) writeField0(oprot thrift.TProtocol) (err error) {
+	if p.IsSetSuccess() {
+		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.Success.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
+}
+
+func (p *VideoServiceFeedResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("VideoServiceFeedResult(%+v)", *p)
+
+}
+
+func (p *VideoServiceFeedResult) DeepEqual(ano *VideoServiceFeedResult) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field0DeepEqual(ano.Success) {
+		return false
+	}
+	return true
+}
+
+func (p *VideoServiceFeedResult) Field0DeepEqual(src *FeedResponse) bool {
+
+	if !p.Success.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+
+type VideoServiceFollowFeedArgs struct {
+	Req *FollowFeedRequest `thrift:"req,1" frugal:"1,default,FollowFeedRequest" json:"req"`
+}
+
+func NewVideoServiceFollowFeedArgs() *VideoServiceFollowFeedArgs {
+	return &VideoServiceFollowFeedArgs{}
+}
+
+func (p *VideoServiceFollowFeedArgs) InitDefault() {
+	*p = VideoServiceFollowFeedArgs{}
+}
+
+var VideoServiceFollowFeedArgs_Req_DEFAULT *FollowFeedRequest
+
+func (p *VideoServiceFollowFeedArgs) GetReq() (v *FollowFeedRequest) {
+	if !p.IsSetReq() {
+		return VideoServiceFollowFeedArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+func (p *VideoServiceFollowFeedArgs) SetReq(val *FollowFeedRequest) {
+	p.Req = val
+}
+
+var fieldIDToName_VideoServiceFollowFeedArgs = map[int16]string{
+	1: "req",
+}
+
+func (p *VideoServiceFollowFeedArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *VideoServiceFollowFeedArgs) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_VideoServiceFollowFeedArgs[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *VideoServiceFollowFeedArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := NewFollowFeedRequest()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Req = _field
+	return nil
+}
+
+func (p *VideoServiceFollowFeedArgs) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("FollowFeed_args"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *VideoServiceFollowFeedArgs) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := p.Req.Write(oprot); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *VideoServiceFollowFeedArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("VideoServiceFollowFeedArgs(%+v)", *p)
+
+}
+
+func (p *VideoServiceFollowFeedArgs) DeepEqual(ano *VideoServiceFollowFeedArgs) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.Req) {
+		return false
+	}
+	return true
+}
+
+func (p *VideoServiceFollowFeedArgs) Field1DeepEqual(src *FollowFeedRequest) bool {
+
+	if !p.Req.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+
+type VideoServiceFollowFeedResult struct {
+	Success *FollowFeedResponse `thrift:"success,0,optional" frugal:"0,optional,FollowFeedResponse" json:"success,omitempty"`
+}
+
+func NewVideoServiceFollowFeedResult() *VideoServiceFollowFeedResult {
+	return &VideoServiceFollowFeedResult{}
+}
+
+func (p *VideoServiceFollowFeedResult) InitDefault() {
+	*p = VideoServiceFollowFeedResult{}
+}
+
+var VideoServiceFollowFeedResult_Success_DEFAULT *FollowFeedResponse
+
+func (p *VideoServiceFollowFeedResult) GetSuccess() (v *FollowFeedResponse) {
+	if !p.IsSetSuccess() {
+		return VideoServiceFollowFeedResult_Success_DEFAULT
+	}
+	return p.Success
+}
+func (p *VideoServiceFollowFeedResult) SetSuccess(x interface{}) {
+	p.Success = x.(*FollowFeedResponse)
+}
+
+var fieldIDToName_VideoServiceFollowFeedResult = map[int16]string{
+	0: "success",
+}
+
+func (p *VideoServiceFollowFeedResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *VideoServiceFollowFeedResult) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 0:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField0(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_VideoServiceFollowFeedResult[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *VideoServiceFollowFeedResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := NewFollowFeedResponse()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Success = _field
+	return nil
+}
+
+func (p *VideoServiceFollowFeedResult) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("FollowFeed_result"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField0(oprot); err != nil {
+			fieldId = 0
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *VideoServiceFollowFeedResult) writeField0(oprot thrift.TProtocol) (err error) {
+	if p.IsSetSuccess() {
+		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.Success.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
+}
+
+func (p *VideoServiceFollowFeedResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("VideoServiceFollowFeedResult(%+v)", *p)
+
+}
+
+func (p *VideoServiceFollowFeedResult) DeepEqual(ano *VideoServiceFollowFeedResult) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field0DeepEqual(ano.Success) {
+		return false
+	}
+	return true
+}
+
+func (p *VideoServiceFollowFeedResult) Field0DeepEqual(src *FollowFeedResponse) bool {
+
+	if !p.Success.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+
+type VideoServicePublishActionArgs struct {
+	Req *PublishActionRequest `thrift:"req,1" frugal:"1,default,PublishActionRequest" json:"req"`
+}
+
+func NewVideoServicePublishActionArgs() *VideoServicePublishActionArgs {
+	return &VideoServicePublishActionArgs{}
+}
+
+func (p *VideoServicePublishActionArgs) InitDefault() {
+	*p = VideoServicePublishActionArgs{}
+}
+
+var VideoServicePublishActionArgs_Req_DEFAULT *PublishActionRequest
+
+func (p *VideoServicePublishActionArgs) GetReq() (v *PublishActionRequest) {
+	if !p.IsSetReq() {
+		return VideoServicePublishActionArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+func (p *VideoServicePublishActionArgs) SetReq(val *PublishActionRequest) {
+	p.Req = val
+}
+
+var fieldIDToName_VideoServicePublishActionArgs = map[int16]string{
+	1: "req",
+}
+
+func (p *VideoServicePublishActionArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *VideoServicePublishActionArgs) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_VideoServicePublishActionArgs[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *VideoServicePublishActionArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := NewPublishActionRequest()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Req = _field
+	return nil
+}
+
+func (p *VideoServicePublishActionArgs) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("PublishAction_args"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *VideoServicePublishActionArgs) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := p.Req.Write(oprot); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *VideoServicePublishActionArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("VideoServicePublishActionArgs(%+v)", *p)
+
+}
+
+func (p *VideoServicePublishActionArgs) DeepEqual(ano *VideoServicePublishActionArgs) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.Req) {
+		return false
+	}
+	return true
+}
+
+func (p *VideoServicePublishActionArgs) Field1DeepEqual(src *PublishActionRequest) bool {
+
+	if !p.Req.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+
+type VideoServicePublishActionResult struct {
+	Success *PublishActionResponse `thrift:"success,0,optional" frugal:"0,optional,PublishActionResponse" json:"success,omitempty"`
+}
+
+func NewVideoServicePublishActionResult() *VideoServicePublishActionResult {
+	return &VideoServicePublishActionResult{}
+}
+
+func (p *VideoServicePublishActionResult) InitDefault() {
+	*p = VideoServicePublishActionResult{}
+}
+
+var VideoServicePublishActionResult_Success_DEFAULT *PublishActionResponse
+
+func (p *VideoServicePublishActionResult) GetSuccess() (v *PublishActionResponse) {
+	if !p.IsSetSuccess() {
+		return VideoServicePublishActionResult_Success_DEFAULT
+	}
+	return p.Success
+}
+func (p *VideoServicePublishActionResult) SetSuccess(x interface{}) {
+	p.Success = x.(*PublishActionResponse)
+}
+
+var fieldIDToName_VideoServicePublishActionResult = map[int16]string{
+	0: "success",
+}
+
+func (p *VideoServicePublishActionResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *VideoServicePublishActionResult) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 0:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField0(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_VideoServicePublishActionResult[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *VideoServicePublishActionResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := NewPublishActionResponse()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Success = _field
+	return nil
+}
+
+func (p *VideoServicePublishActionResult) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("PublishAction_result"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField0(oprot); err != nil {
+			fieldId = 0
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *VideoServicePublishActionResult) writeField0(oprot thrift.TProtocol) (err error) {
+	if p.IsSetSuccess() {
+		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.Success.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
+}
+
+func (p *VideoServicePublishActionResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("VideoServicePublishActionResult(%+v)", *p)
+
+}
+
+func (p *VideoServicePublishActionResult) DeepEqual(ano *VideoServicePublishActionResult) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field0DeepEqual(ano.Success) {
+		return false
+	}
+	return true
+}
+
+func (p *VideoServicePublishActionResult) Field0DeepEqual(src *PublishActionResponse) bool {
+
+	if !p.Success.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+
+type VideoServicePublishListArgs struct {
+	Req *PublishListRequest `thrift:"req,1" frugal:"1,default,PublishListRequest" json:"req"`
+}
+
+func NewVideoServicePublishListArgs() *VideoServicePublishListArgs {
+	return &VideoServicePublishListArgs{}
+}
+
+func (p *VideoServicePublishListArgs) InitDefault() {
+	*p = VideoServicePublishListArgs{}
+}
+
+var VideoServicePublishListArgs_Req_DEFAULT *PublishListRequest
+
+func (p *VideoServicePublishListArgs) GetReq() (v *PublishListRequest) {
+	if !p.IsSetReq() {
+		return VideoServicePublishListArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+func (p *VideoServicePublishListArgs) SetReq(val *PublishListRequest) {
+	p.Req = val
+}
+
+var fieldIDToName_VideoServicePublishListArgs = map[int16]string{
+	1: "req",
+}
+
+func (p *VideoServicePublishListArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *VideoServicePublishListArgs) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_VideoServicePublishListArgs[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *VideoServicePublishListArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := NewPublishListRequest()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Req = _field
+	return nil
+}
+
+func (p *VideoServicePublishListArgs) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("PublishList_args"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *VideoServicePublishListArgs) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := p.Req.Write(oprot); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *VideoServicePublishListArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("VideoServicePublishListArgs(%+v)", *p)
+
+}
+
+func (p *VideoServicePublishListArgs) DeepEqual(ano *VideoServicePublishListArgs) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.Req) {
+		return false
+	}
+	return true
+}
+
+func (p *VideoServicePublishListArgs) Field1DeepEqual(src *PublishListRequest) bool {
+
+	if !p.Req.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+
+type VideoServicePublishListResult struct {
+	Success *PublishListResponse `thrift:"success,0,optional" frugal:"0,optional,PublishListResponse" json:"success,omitempty"`
+}
+
+func NewVideoServicePublishListResult() *VideoServicePublishListResult {
+	return &VideoServicePublishListResult{}
+}
+
+func (p *VideoServicePublishListResult) InitDefault() {
+	*p = VideoServicePublishListResult{}
+}
+
+var VideoServicePublishListResult_Success_DEFAULT *PublishListResponse
+
+func (p *VideoServicePublishListResult) GetSuccess() (v *PublishListResponse) {
+	if !p.IsSetSuccess() {
+		return VideoServicePublishListResult_Success_DEFAULT
+	}
+	return p.Success
+}
+func (p *VideoServicePublishListResult) SetSuccess(x interface{}) {
+	p.Success = x.(*PublishListResponse)
+}
+
+var fieldIDToName_VideoServicePublishListResult = map[int16]string{
+	0: "success",
+}
+
+func (p *VideoServicePublishListResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *VideoServicePublishListResult) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 0:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField0(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_VideoServicePublishListResult[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *VideoServicePublishListResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := NewPublishListResponse()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Success = _field
+	return nil
+}
+
+func (p *VideoServicePublishListResult) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("PublishList_result"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField0(oprot); err != nil {
+			fieldId = 0
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *VideoServicePublishListResult) writeField0(oprot thrift.TProtocol) (err error) {
+	if p.IsSetSuccess() {
+		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.Success.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
+}
+
+func (p *VideoServicePublishListResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("VideoServicePublishListResult(%+v)", *p)
+
+}
+
+func (p *VideoServicePublishListResult) DeepEqual(ano *VideoServicePublishListResult) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field0DeepEqual(ano.Success) {
+		return false
+	}
+	return true
+}
+
+func (p *VideoServicePublishListResult) Field0DeepEqual(src *PublishListResponse) bool {
+
+	if !p.Success.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+
+type VideoServicePublishStatusArgs struct {
+	Req *PublishStatusRequest `thrift:"req,1" frugal:"1,default,PublishStatusRequest" json:"req"`
+}
+
+func NewVideoServicePublishStatusArgs() *VideoServicePublishStatusArgs {
+	return &VideoServicePublishStatusArgs{}
+}
+
+func (p *VideoServicePublishStatusArgs) InitDefault() {
+	*p = VideoServicePublishStatusArgs{}
+}
+
+var VideoServicePublishStatusArgs_Req_DEFAULT *PublishStatusRequest
+
+func (p *VideoServicePublishStatusArgs) GetReq() (v *PublishStatusRequest) {
+	if !p.IsSetReq() {
+		return VideoServicePublishStatusArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+func (p *VideoServicePublishStatusArgs) SetReq(val *PublishStatusRequest) {
+	p.Req = val
+}
+
+var fieldIDToName_VideoServicePublishStatusArgs = map[int16]string{
+	1: "req",
+}
+
+func (p *VideoServicePublishStatusArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *VideoServicePublishStatusArgs) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_VideoServicePublishStatusArgs[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *VideoServicePublishStatusArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := NewPublishStatusRequest()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Req = _field
+	return nil
+}
+
+func (p *VideoServicePublishStatusArgs) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("PublishStatus_args"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *VideoServicePublishStatusArgs) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := p.Req.Write(oprot); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *VideoServicePublishStatusArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("VideoServicePublishStatusArgs(%+v)", *p)
+
+}
+
+func (p *VideoServicePublishStatusArgs) DeepEqual(ano *VideoServicePublishStatusArgs) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.Req) {
+		return false
+	}
+	return true
+}
+
+func (p *VideoServicePublishStatusArgs) Field1DeepEqual(src *PublishStatusRequest) bool {
+
+	if !p.Req.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+
+type VideoServicePublishStatusResult struct {
+	Success *PublishStatusResponse `thrift:"success,0,optional" frugal:"0,optional,PublishStatusResponse" json:"success,omitempty"`
+}
+
+func NewVideoServicePublishStatusResult() *VideoServicePublishStatusResult {
+	return &VideoServicePublishStatusResult{}
+}
+
+func (p *VideoServicePublishStatusResult) InitDefault() {
+	*p = VideoServicePublishStatusResult{}
+}
+
+var VideoServicePublishStatusResult_Success_DEFAULT *PublishStatusResponse
+
+func (p *VideoServicePublishStatusResult) GetSuccess() (v *PublishStatusResponse) {
+	if !p.IsSetSuccess() {
+		return VideoServicePublishStatusResult_Success_DEFAULT
+	}
+	return p.Success
+}
+func (p *VideoServicePublishStatusResult) SetSuccess(x interface{}) {
+	p.Success = x.(*PublishStatusResponse)
+}
+
+var fieldIDToName_VideoServicePublishStatusResult = map[int16]string{
+	0: "success",
+}
+
+func (p *VideoServicePublishStatusResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *VideoServicePublishStatusResult) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 0:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField0(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_VideoServicePublishStatusResult[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *VideoServicePublishStatusResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := NewPublishStatusResponse()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Success = _field
+	return nil
+}
+
+func (p *VideoServicePublishStatusResult) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("PublishStatus_result"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField0(oprot); err != nil {
+			fieldId = 0
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *VideoServicePublishStatusResult) writeField0(oprot thrift.TProtocol) (err error) {
+	if p.IsSetSuccess() {
+		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.Success.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
+}
+
+func (p *VideoServicePublishStatusResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("VideoServicePublishStatusResult(%+v)", *p)
+
+}
+
+func (p *VideoServicePublishStatusResult) DeepEqual(ano *VideoServicePublishStatusResult) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field0DeepEqual(ano.Success) {
+		return false
+	}
+	return true
+}
+
+func (p *VideoServicePublishStatusResult) Field0DeepEqual(src *PublishStatusResponse) bool {
+
+	if !p.Success.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+
+type VideoServiceUpdateCoverArgs struct {
+	Req *UpdateCoverRequest `thrift:"req,1" frugal:"1,default,UpdateCoverRequest" json:"req"`
+}
+
+func NewVideoServiceUpdateCoverArgs() *VideoServiceUpdateCoverArgs {
+	return &VideoServiceUpdateCoverArgs{}
+}
+
+func (p *VideoServiceUpdateCoverArgs) InitDefault() {
+	*p = VideoServiceUpdateCoverArgs{}
+}
+
+var VideoServiceUpdateCoverArgs_Req_DEFAULT *UpdateCoverRequest
+
+func (p *VideoServiceUpdateCoverArgs) GetReq() (v *UpdateCoverRequest) {
+	if !p.IsSetReq() {
+		return VideoServiceUpdateCoverArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+func (p *VideoServiceUpdateCoverArgs) SetReq(val *UpdateCoverRequest) {
+	p.Req = val
+}
+
+var fieldIDToName_VideoServiceUpdateCoverArgs = map[int16]string{
+	1: "req",
+}
+
+func (p *VideoServiceUpdateCoverArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *VideoServiceUpdateCoverArgs) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_VideoServiceUpdateCoverArgs[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *VideoServiceUpdateCoverArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := NewUpdateCoverRequest()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Req = _field
+	return nil
+}
+
+func (p *VideoServiceUpdateCoverArgs) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("UpdateCover_args"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *VideoServiceUpdateCoverArgs) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := p.Req.Write(oprot); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *VideoServiceUpdateCoverArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("VideoServiceUpdateCoverArgs(%+v)", *p)
+
+}
+
+func (p *VideoServiceUpdateCoverArgs) DeepEqual(ano *VideoServiceUpdateCoverArgs) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.Req) {
+		return false
+	}
+	return true
+}
+
+func (p *VideoServiceUpdateCoverArgs) Field1DeepEqual(src *UpdateCoverRequest) bool {
+
+	if !p.Req.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+
+type VideoServiceUpdateCoverResult struct {
+	Success *UpdateCoverResponse `thrift:"success,0,optional" frugal:"0,optional,UpdateCoverResponse" json:"success,omitempty"`
+}
+
+func NewVideoServiceUpdateCoverResult() *VideoServiceUpdateCoverResult {
+	return &VideoServiceUpdateCoverResult{}
+}
+
+func (p *VideoServiceUpdateCoverResult) InitDefault() {
+	*p = VideoServiceUpdateCoverResult{}
+}
+
+var VideoServiceUpdateCoverResult_Success_DEFAULT *UpdateCoverResponse
+
+func (p *VideoServiceUpdateCoverResult) GetSuccess() (v *UpdateCoverResponse) {
+	if !p.IsSetSuccess() {
+		return VideoServiceUpdateCoverResult_Success_DEFAULT
+	}
+	return p.Success
+}
+func (p *VideoServiceUpdateCoverResult) SetSuccess(x interface{}) {
+	p.Success = x.(*UpdateCoverResponse)
+}
+
+var fieldIDToName_VideoServiceUpdateCoverResult = map[int16]string{
+	0: "success",
+}
+
+func (p *VideoServiceUpdateCoverResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *VideoServiceUpdateCoverResult) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 0:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField0(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_VideoServiceUpdateCoverResult[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *VideoServiceUpdateCoverResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := NewUpdateCoverResponse()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Success = _field
+	return nil
+}
+
+func (p *VideoServiceUpdateCoverResult) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("UpdateCover_result"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField0(oprot); err != nil {
+			fieldId = 0
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *VideoServiceUpdateCoverResult) writeField0(oprot thrift.TProtocol) (err error) {
+	if p.IsSetSuccess() {
+		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.Success.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
+}
+
+func (p *VideoServiceUpdateCoverResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("VideoServiceUpdateCoverResult(%+v)", *p)
+
+}
+
+func (p *VideoServiceUpdateCoverResult) DeepEqual(ano *VideoServiceUpdateCoverResult) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field0DeepEqual(ano.Success) {
+		return false
+	}
+	return true
+}
+
+func (p *VideoServiceUpdateCoverResult) Field0DeepEqual(src *UpdateCoverResponse) bool {
+
+	if !p.Success.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+
+type VideoServiceDeleteVideoArgs struct {
+	Req *DeleteVideoRequest `thrift:"req,1" frugal:"1,default,DeleteVideoRequest" json:"req"`
+}
+
+func NewVideoServiceDeleteVideoArgs() *VideoServiceDeleteVideoArgs {
+	return &VideoServiceDeleteVideoArgs{}
+}
+
+func (p *VideoServiceDeleteVideoArgs) InitDefault() {
+	*p = VideoServiceDeleteVideoArgs{}
+}
+
+var VideoServiceDeleteVideoArgs_Req_DEFAULT *DeleteVideoRequest
+
+func (p *VideoServiceDeleteVideoArgs) GetReq() (v *DeleteVideoRequest) {
+	if !p.IsSetReq() {
+		return VideoServiceDeleteVideoArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+func (p *VideoServiceDeleteVideoArgs) SetReq(val *DeleteVideoRequest) {
+	p.Req = val
+}
+
+var fieldIDToName_VideoServiceDeleteVideoArgs = map[int16]string{
+	1: "req",
+}
+
+func (p *VideoServiceDeleteVideoArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *VideoServiceDeleteVideoArgs) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_VideoServiceDeleteVideoArgs[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *VideoServiceDeleteVideoArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := NewDeleteVideoRequest()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Req = _field
+	return nil
+}
+
+func (p *VideoServiceDeleteVideoArgs) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("DeleteVideo_args"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *VideoServiceDeleteVideoArgs) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := p.Req.Write(oprot); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *VideoServiceDeleteVideoArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("VideoServiceDeleteVideoArgs(%+v)", *p)
+
+}
+
+func (p *VideoServiceDeleteVideoArgs) DeepEqual(ano *VideoServiceDeleteVideoArgs) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.Req) {
+		return false
+	}
+	return true
+}
+
+func (p *VideoServiceDeleteVideoArgs) Field1DeepEqual(src *DeleteVideoRequest) bool {
+
+	if !p.Req.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+
+type VideoServiceDeleteVideoResult struct {
+	Success *DeleteVideoResponse `thrift:"success,0,optional" frugal:"0,optional,DeleteVideoResponse" json:"success,omitempty"`
+}
+
+func NewVideoServiceDeleteVideoResult() *VideoServiceDeleteVideoResult {
+	return &VideoServiceDeleteVideoResult{}
+}
+
+func (p *VideoServiceDeleteVideoResult) InitDefault() {
+	*p = VideoServiceDeleteVideoResult{}
+}
+
+var VideoServiceDeleteVideoResult_Success_DEFAULT *DeleteVideoResponse
+
+func (p *VideoServiceDeleteVideoResult) GetSuccess() (v *DeleteVideoResponse) {
+	if !p.IsSetSuccess() {
+		return VideoServiceDeleteVideoResult_Success_DEFAULT
+	}
+	return p.Success
+}
+func (p *VideoServiceDeleteVideoResult) SetSuccess(x interface{}) {
+	p.Success = x.(*DeleteVideoResponse)
+}
+
+var fieldIDToName_VideoServiceDeleteVideoResult = map[int16]string{
+	0: "success",
+}
+
+func (p *VideoServiceDeleteVideoResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *VideoServiceDeleteVideoResult) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 0:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField0(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_VideoServiceDeleteVideoResult[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *VideoServiceDeleteVideoResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := NewDeleteVideoResponse()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Success = _field
+	return nil
+}
+
+func (p *VideoServiceDeleteVideoResult) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("DeleteVideo_result"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField0(oprot); err != nil {
+			fieldId = 0
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *VideoServiceDeleteVideoResult) writeField0(oprot thrift.TProtocol) (err error) {
+	if p.IsSetSuccess() {
+		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.Success.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
+}
+
+func (p *VideoServiceDeleteVideoResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("VideoServiceDeleteVideoResult(%+v)", *p)
+
+}
+
+func (p *VideoServiceDeleteVideoResult) DeepEqual(ano *VideoServiceDeleteVideoResult) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field0DeepEqual(ano.Success) {
+		return false
+	}
+	return true
+}
+
+func (p *VideoServiceDeleteVideoResult) Field0DeepEqual(src *DeleteVideoResponse) bool {
+
+	if !p.Success.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+
+type VideoServiceUpdateVideoArgs struct {
+	Req *UpdateVideoRequest `thrift:"req,1" frugal:"1,default,UpdateVideoRequest" json:"req"`
+}
+
+func NewVideoServiceUpdateVideoArgs() *VideoServiceUpdateVideoArgs {
+	return &VideoServiceUpdateVideoArgs{}
+}
+
+func (p *VideoServiceUpdateVideoArgs) InitDefault() {
+	*p = VideoServiceUpdateVideoArgs{}
+}
+
+var VideoServiceUpdateVideoArgs_Req_DEFAULT *UpdateVideoRequest
+
+func (p *VideoServiceUpdateVideoArgs) GetReq() (v *UpdateVideoRequest) {
+	if !p.IsSetReq() {
+		return VideoServiceUpdateVideoArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+func (p *VideoServiceUpdateVideoArgs) SetReq(val *UpdateVideoRequest) {
+	p.Req = val
+}
+
+var fieldIDToName_VideoServiceUpdateVideoArgs = map[int16]string{
+	1: "req",
+}
+
+func (p *VideoServiceUpdateVideoArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *VideoServiceUpdateVideoArgs) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_VideoServiceUpdateVideoArgs[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *VideoServiceUpdateVideoArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := NewUpdateVideoRequest()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Req = _field
+	return nil
+}
+
+func (p *VideoServiceUpdateVideoArgs) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("UpdateVideo_args"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *VideoServiceUpdateVideoArgs) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := p.Req.Write(oprot); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *VideoServiceUpdateVideoArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("VideoServiceUpdateVideoArgs(%+v)", *p)
+
+}
+
+func (p *VideoServiceUpdateVideoArgs) DeepEqual(ano *VideoServiceUpdateVideoArgs) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
 	}
-	if err2 = oprot.WriteMessageBegin("Feed", thrift.REPLY, seqId); err2 != nil {
-		err = err2
+	if !p.Field1DeepEqual(ano.Req) {
+		return false
 	}
-	if err2 = result.Write(oprot); err == nil && err2 != nil {
-		err = err2
+	return true
+}
+
+func (p *VideoServiceUpdateVideoArgs) Field1DeepEqual(src *UpdateVideoRequest) bool {
+
+	if !p.Req.DeepEqual(src) {
+		return false
 	}
-	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
-		err = err2
+	return true
+}
+
+type VideoServiceUpdateVideoResult struct {
+	Success *UpdateVideoResponse `thrift:"success,0,optional" frugal:"0,optional,UpdateVideoResponse" json:"success,omitempty"`
+}
+
+func NewVideoServiceUpdateVideoResult() *VideoServiceUpdateVideoResult {
+	return &VideoServiceUpdateVideoResult{}
+}
+
+func (p *VideoServiceUpdateVideoResult) InitDefault() {
+	*p = VideoServiceUpdateVideoResult{}
+}
+
+var VideoServiceUpdateVideoResult_Success_DEFAULT *UpdateVideoResponse
+
+func (p *VideoServiceUpdateVideoResult) GetSuccess() (v *UpdateVideoResponse) {
+	if !p.IsSetSuccess() {
+		return VideoServiceUpdateVideoResult_Success_DEFAULT
 	}
-	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
-		err = err2
+	return p.Success
+}
+func (p *VideoServiceUpdateVideoResult) SetSuccess(x interface{}) {
+	p.Success = x.(*UpdateVideoResponse)
+}
+
+var fieldIDToName_VideoServiceUpdateVideoResult = map[int16]string{
+	0: "success",
+}
+
+func (p *VideoServiceUpdateVideoResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *VideoServiceUpdateVideoResult) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
 	}
-	if err != nil {
-		return
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 0:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField0(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
 	}
-	return true, err
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_VideoServiceUpdateVideoResult[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-type videoServiceProcessorPublishAction struct {
-	handler VideoService
+func (p *VideoServiceUpdateVideoResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := NewUpdateVideoResponse()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Success = _field
+	return nil
 }
 
-func (p *videoServiceProcessorPublishAction) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-	args := VideoServicePublishActionArgs{}
-	if err = args.Read(iprot); err != nil {
-		iprot.ReadMessageEnd()
-		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
-		oprot.WriteMessageBegin("PublishAction", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return false, err
+func (p *VideoServiceUpdateVideoResult) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("UpdateVideo_result"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField0(oprot); err != nil {
+			fieldId = 0
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
 	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
 
-	iprot.ReadMessageEnd()
-	var err2 error
-	result := VideoServicePublishActionResult{}
-	var retval *PublishActionResponse
-	if retval, err2 = p.handler.PublishAction(ctx, args.Req); err2 != nil {
-		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing PublishAction: "+err2.Error())
-		oprot.WriteMessageBegin("PublishAction", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return true, err2
-	} else {
-		result.Success = retval
+func (p *VideoServiceUpdateVideoResult) writeField0(oprot thrift.TProtocol) (err error) {
+	if p.IsSetSuccess() {
+		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.Success.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
 	}
-	if err2 = oprot.WriteMessageBegin("PublishAction", thrift.REPLY, seqId); err2 != nil {
-		err = err2
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
+}
+
+func (p *VideoServiceUpdateVideoResult) String() string {
+	if p == nil {
+		return "<nil>"
 	}
-	if err2 = result.Write(oprot); err == nil && err2 != nil {
-		err = err2
+	return fmt.Sprintf("VideoServiceUpdateVideoResult(%+v)", *p)
+
+}
+
+func (p *VideoServiceUpdateVideoResult) DeepEqual(ano *VideoServiceUpdateVideoResult) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
 	}
-	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
-		err = err2
+	if !p.Field0DeepEqual(ano.Success) {
+		return false
 	}
-	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
-		err = err2
+	return true
+}
+
+func (p *VideoServiceUpdateVideoResult) Field0DeepEqual(src *UpdateVideoResponse) bool {
+
+	if !p.Success.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+
+type VideoServiceTopicFeedArgs struct {
+	Req *TopicFeedRequest `thrift:"req,1" frugal:"1,default,TopicFeedRequest" json:"req"`
+}
+
+func NewVideoServiceTopicFeedArgs() *VideoServiceTopicFeedArgs {
+	return &VideoServiceTopicFeedArgs{}
+}
+
+func (p *VideoServiceTopicFeedArgs) InitDefault() {
+	*p = VideoServiceTopicFeedArgs{}
+}
+
+var VideoServiceTopicFeedArgs_Req_DEFAULT *TopicFeedRequest
+
+func (p *VideoServiceTopicFeedArgs) GetReq() (v *TopicFeedRequest) {
+	if !p.IsSetReq() {
+		return VideoServiceTopicFeedArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+func (p *VideoServiceTopicFeedArgs) SetReq(val *TopicFeedRequest) {
+	p.Req = val
+}
+
+var fieldIDToName_VideoServiceTopicFeedArgs = map[int16]string{
+	1: "req",
+}
+
+func (p *VideoServiceTopicFeedArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *VideoServiceTopicFeedArgs) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
 	}
-	if err != nil {
-		return
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
 	}
-	return true, err
-}
 
-type videoServiceProcessorPublishList struct {
-	handler VideoService
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_VideoServiceTopicFeedArgs[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *videoServiceProcessorPublishList) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-	args := VideoServicePublishListArgs{}
-	if err = args.Read(iprot); err != nil {
-		iprot.ReadMessageEnd()
-		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
-		oprot.WriteMessageBegin("PublishList", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return false, err
+func (p *VideoServiceTopicFeedArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := NewTopicFeedRequest()
+	if err := _field.Read(iprot); err != nil {
+		return err
 	}
+	p.Req = _field
+	return nil
+}
 
-	iprot.ReadMessageEnd()
-	var err2 error
-	result := VideoServicePublishListResult{}
-	var retval *PublishListResponse
-	if retval, err2 = p.handler.PublishList(ctx, args.Req); err2 != nil {
-		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing PublishList: "+err2.Error())
-		oprot.WriteMessageBegin("PublishList", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return true, err2
-	} else {
-		result.Success = retval
-	}
-	if err2 = oprot.WriteMessageBegin("PublishList", thrift.REPLY, seqId); err2 != nil {
-		err = err2
-	}
-	if err2 = result.Write(oprot); err == nil && err2 != nil {
-		err = err2
+func (p *VideoServiceTopicFeedArgs) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("TopicFeed_args"); err != nil {
+		goto WriteStructBeginError
 	}
-	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
-		err = err2
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
 	}
-	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
-		err = err2
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
 	}
-	if err != nil {
-		return
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
 	}
-	return true, err
-}
-
-type videoServiceProcessorPublishIDList struct {
-	handler VideoService
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *videoServiceProcessorPublishIDList) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-	args := VideoServicePublishIDListArgs{}
-	if err = args.Read(iprot); err != nil {
-		iprot.ReadMessageEnd()
-		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
-		oprot.WriteMessageBegin("PublishIDList", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return false, err
-	}
-
-	iprot.ReadMessageEnd()
-	var err2 error
-	result := VideoServicePublishIDListResult{}
-	var retval []int64
-	if retval, err2 = p.handler.PublishIDList(ctx, args.UserId); err2 != nil {
-		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing PublishIDList: "+err2.Error())
-		oprot.WriteMessageBegin("PublishIDList", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return true, err2
-	} else {
-		result.Success = retval
+func (p *VideoServiceTopicFeedArgs) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
+		goto WriteFieldBeginError
 	}
-	if err2 = oprot.WriteMessageBegin("PublishIDList", thrift.REPLY, seqId); err2 != nil {
-		err = err2
+	if err := p.Req.Write(oprot); err != nil {
+		return err
 	}
-	if err2 = result.Write(oprot); err == nil && err2 != nil {
-		err = err2
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
 	}
-	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
-		err = err2
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *VideoServiceTopicFeedArgs) String() string {
+	if p == nil {
+		return "<nil>"
 	}
-	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
-		err = err2
+	return fmt.Sprintf("VideoServiceTopicFeedArgs(%+v)", *p)
+
+}
+
+func (p *VideoServiceTopicFeedArgs) DeepEqual(ano *VideoServiceTopicFeedArgs) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
 	}
-	if err != nil {
-		return
+	if !p.Field1DeepEqual(ano.Req) {
+		return false
 	}
-	return true, err
+	return true
 }
 
-type videoServiceProcessorVideoInfo struct {
-	handler VideoService
-}
+func (p *VideoServiceTopicFeedArgs) Field1DeepEqual(src *TopicFeedRequest) bool {
 
-func (p *videoServiceProcessorVideoInfo) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-	args := VideoServiceVideoInfoArgs{}
-	if err = args.Read(iprot); err != nil {
-		iprot.ReadMessageEnd()
-		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
-		oprot.WriteMessageBegin("VideoInfo", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return false, err
+	if !p.Req.DeepEqual(src) {
+		return false
 	}
+	return true
+}
 
-	iprot.ReadMessageEnd()
-	var err2 error
-	result := VideoServiceVideoInfoResult{}
-	var retval *Video
-	if retval, err2 = p.handler.VideoInfo(ctx, args.Req); err2 != nil {
-		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing VideoInfo: "+err2.Error())
-		oprot.WriteMessageBegin("VideoInfo", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return true, err2
-	} else {
-		result.Success = retval
-	}
-	if err2 = oprot.WriteMessageBegin("VideoInfo", thrift.REPLY, seqId); err2 != nil {
-		err = err2
-	}
-	if err2 = result.Write(oprot); err == nil && err2 != nil {
-		err = err2
-	}
-	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
-		err = err2
-	}
-	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
-		err = err2
-	}
-	if err != nil {
-		return
-	}
-	return true, err
+type VideoServiceTopicFeedResult struct {
+	Success *TopicFeedResponse `thrift:"success,0,optional" frugal:"0,optional,TopicFeedResponse" json:"success,omitempty"`
 }
 
-type videoServiceProcessorVideoInfoList struct {
-	handler VideoService
+func NewVideoServiceTopicFeedResult() *VideoServiceTopicFeedResult {
+	return &VideoServiceTopicFeedResult{}
 }
 
-func (p *videoServiceProcessorVideoInfoList) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-	args := VideoServiceVideoInfoListArgs{}
-	if err = args.Read(iprot); err != nil {
-		iprot.ReadMessageEnd()
-		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
-		oprot.WriteMessageBegin("VideoInfoList", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return false, err
-	}
+func (p *VideoServiceTopicFeedResult) InitDefault() {
+	*p = VideoServiceTopicFeedResult{}
+}
 
-	iprot.ReadMessageEnd()
-	var err2 error
-	result := VideoServiceVideoInfoListResult{}
-	var retval []*Video
-	if retval, err2 = p.handler.VideoInfoList(ctx, args.Req); err2 != nil {
-		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing VideoInfoList: "+err2.Error())
-		oprot.WriteMessageBegin("VideoInfoList", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return true, err2
-	} else {
-		result.Success = retval
-	}
-	if err2 = oprot.WriteMessageBegin("VideoInfoList", thrift.REPLY, seqId); err2 != nil {
-		err = err2
-	}
-	if err2 = result.Write(oprot); err == nil && err2 != nil {
-		err = err2
-	}
-	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
-		err = err2
-	}
-	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
-		err = err2
-	}
-	if err != nil {
-		return
+var VideoServiceTopicFeedResult_Success_DEFAULT *TopicFeedResponse
+
+func (p *VideoServiceTopicFeedResult) GetSuccess() (v *TopicFeedResponse) {
+	if !p.IsSetSuccess() {
+		return VideoServiceTopicFeedResult_Success_DEFAULT
 	}
-	return true, err
+	return p.Success
+}
+func (p *VideoServiceTopicFeedResult) SetSuccess(x interface{}) {
+	p.Success = x.(*TopicFeedResponse)
+}
+
+var fieldIDToName_VideoServiceTopicFeedResult = map[int16]string{
+	0: "success",
 }
 
-type videoServiceProcessorWorkCount struct {
-	handler VideoService
+func (p *VideoServiceTopicFeedResult) IsSetSuccess() bool {
+	return p.Success != nil
 }
 
-func (p *videoServiceProcessorWorkCount) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-	args := VideoServiceWorkCountArgs{}
-	if err = args.Read(iprot); err != nil {
-		iprot.ReadMessageEnd()
-		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
-		oprot.WriteMessageBegin("WorkCount", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return false, err
-	}
+func (p *VideoServiceTopicFeedResult) Read(iprot thrift.TProtocol) (err error) {
 
-	iprot.ReadMessageEnd()
-	var err2 error
-	result := VideoServiceWorkCountResult{}
-	var retval int64
-	if retval, err2 = p.handler.WorkCount(ctx, args.UserId); err2 != nil {
-		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing WorkCount: "+err2.Error())
-		oprot.WriteMessageBegin("WorkCount", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return true, err2
-	} else {
-		result.Success = &retval
-	}
-	if err2 = oprot.WriteMessageBegin("WorkCount", thrift.REPLY, seqId); err2 != nil {
-		err = err2
-	}
-	if err2 = result.Write(oprot); err == nil && err2 != nil {
-		err = err2
-	}
-	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
-		err = err2
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
 	}
-	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
-		err = err2
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 0:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField0(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
 	}
-	if err != nil {
-		return
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
 	}
-	return true, err
-}
 
-type videoServiceProcessorAuthorId struct {
-	handler VideoService
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_VideoServiceTopicFeedResult[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *videoServiceProcessorAuthorId) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-	args := VideoServiceAuthorIdArgs{}
-	if err = args.Read(iprot); err != nil {
-		iprot.ReadMessageEnd()
-		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
-		oprot.WriteMessageBegin("AuthorId", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return false, err
+func (p *VideoServiceTopicFeedResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := NewTopicFeedResponse()
+	if err := _field.Read(iprot); err != nil {
+		return err
 	}
+	p.Success = _field
+	return nil
+}
 
-	iprot.ReadMessageEnd()
-	var err2 error
-	result := VideoServiceAuthorIdResult{}
-	var retval int64
-	if retval, err2 = p.handler.AuthorId(ctx, args.VideoId); err2 != nil {
-		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing AuthorId: "+err2.Error())
-		oprot.WriteMessageBegin("AuthorId", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return true, err2
-	} else {
-		result.Success = &retval
-	}
-	if err2 = oprot.WriteMessageBegin("AuthorId", thrift.REPLY, seqId); err2 != nil {
-		err = err2
-	}
-	if err2 = result.Write(oprot); err == nil && err2 != nil {
-		err = err2
+func (p *VideoServiceTopicFeedResult) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("TopicFeed_result"); err != nil {
+		goto WriteStructBeginError
 	}
-	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
-		err = err2
+	if p != nil {
+		if err = p.writeField0(oprot); err != nil {
+			fieldId = 0
+			goto WriteFieldError
+		}
 	}
-	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
-		err = err2
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
 	}
-	if err != nil {
-		return
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
 	}
-	return true, err
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-type videoServiceProcessorVideoExist struct {
-	handler VideoService
+func (p *VideoServiceTopicFeedResult) writeField0(oprot thrift.TProtocol) (err error) {
+	if p.IsSetSuccess() {
+		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.Success.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
 }
 
-func (p *videoServiceProcessorVideoExist) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-	args := VideoServiceVideoExistArgs{}
-	if err = args.Read(iprot); err != nil {
-		iprot.ReadMessageEnd()
-		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
-		oprot.WriteMessageBegin("VideoExist", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return false, err
+func (p *VideoServiceTopicFeedResult) String() string {
+	if p == nil {
+		return "<nil>"
 	}
+	return fmt.Sprintf("VideoServiceTopicFeedResult(%+v)", *p)
 
-	iprot.ReadMessageEnd()
-	var err2 error
-	result := VideoServiceVideoExistResult{}
-	var retval bool
-	if retval, err2 = p.handler.VideoExist(ctx, args.VideoId); err2 != nil {
-		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing VideoExist: "+err2.Error())
-		oprot.WriteMessageBegin("VideoExist", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return true, err2
-	} else {
-		result.Success = &retval
-	}
-	if err2 = oprot.WriteMessageBegin("VideoExist", thrift.REPLY, seqId); err2 != nil {
-		err = err2
-	}
-	if err2 = result.Write(oprot); err == nil && err2 != nil {
-		err = err2
-	}
-	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
-		err = err2
+}
+
+func (p *VideoServiceTopicFeedResult) DeepEqual(ano *VideoServiceTopicFeedResult) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
 	}
-	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
-		err = err2
+	if !p.Field0DeepEqual(ano.Success) {
+		return false
 	}
-	if err != nil {
-		return
+	return true
+}
+
+func (p *VideoServiceTopicFeedResult) Field0DeepEqual(src *TopicFeedResponse) bool {
+
+	if !p.Success.DeepEqual(src) {
+		return false
 	}
-	return true, err
+	return true
 }
 
-type VideoServiceFeedArgs struct {
-	Req *FeedRequest `thrift:"req,1" frugal:"1,default,FeedRequest" json:"req"`
+type VideoServiceTrendingTopicsArgs struct {
+	Req *TrendingTopicsRequest `thrift:"req,1" frugal:"1,default,TrendingTopicsRequest" json:"req"`
 }
 
-func NewVideoServiceFeedArgs() *VideoServiceFeedArgs {
-	return &VideoServiceFeedArgs{}
+func NewVideoServiceTrendingTopicsArgs() *VideoServiceTrendingTopicsArgs {
+	return &VideoServiceTrendingTopicsArgs{}
 }
 
-func (p *VideoServiceFeedArgs) InitDefault() {
-	*p = VideoServiceFeedArgs{}
+func (p *VideoServiceTrendingTopicsArgs) InitDefault() {
+	*p = VideoServiceTrendingTopicsArgs{}
 }
 
-var VideoServiceFeedArgs_Req_DEFAULT *FeedRequest
+var VideoServiceTrendingTopicsArgs_Req_DEFAULT *TrendingTopicsRequest
 
-func (p *VideoServiceFeedArgs) GetReq() (v *FeedRequest) {
+func (p *VideoServiceTrendingTopicsArgs) GetReq() (v *TrendingTopicsRequest) {
 	if !p.IsSetReq() {
-		return VideoServiceFeedArgs_Req_DEFAULT
+		return VideoServiceTrendingTopicsArgs_Req_DEFAULT
 	}
 	return p.Req
 }
-func (p *VideoServiceFeedArgs) SetReq(val *FeedRequest) {
+func (p *VideoServiceTrendingTopicsArgs) SetReq(val *TrendingTopicsRequest) {
 	p.Req = val
 }
 
-var fieldIDToName_VideoServiceFeedArgs = map[int16]string{
+var fieldIDToName_VideoServiceTrendingTopicsArgs = map[int16]string{
 	1: "req",
 }
 
-func (p *VideoServiceFeedArgs) IsSetReq() bool {
+func (p *VideoServiceTrendingTopicsArgs) IsSetReq() bool {
 	return p.Req != nil
 }
 
-func (p *VideoServiceFeedArgs) Read(iprot thrift.TProtocol) (err error) {
+func (p *VideoServiceTrendingTopicsArgs) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -3564,7 +13460,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_VideoServiceFeedArgs[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_VideoServiceTrendingTopicsArgs[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -3574,8 +13470,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *VideoServiceFeedArgs) ReadField1(iprot thrift.TProtocol) error {
-	_field := NewFeedRequest()
+func (p *VideoServiceTrendingTopicsArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := NewTrendingTopicsRequest()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -3583,9 +13479,9 @@ func (p *VideoServiceFeedArgs) ReadField1(iprot thrift.TProtocol) error {
 	return nil
 }
 
-func (p *VideoServiceFeedArgs) Write(oprot thrift.TProtocol) (err error) {
+func (p *VideoServiceTrendingTopicsArgs) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("Feed_args"); err != nil {
+	if err = oprot.WriteStructBegin("TrendingTopics_args"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -3611,7 +13507,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *VideoServiceFeedArgs) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *VideoServiceTrendingTopicsArgs) writeField1(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
 		goto WriteFieldBeginError
 	}
@@ -3628,15 +13524,15 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *VideoServiceFeedArgs) String() string {
+func (p *VideoServiceTrendingTopicsArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("VideoServiceFeedArgs(%+v)", *p)
+	return fmt.Sprintf("VideoServiceTrendingTopicsArgs(%+v)", *p)
 
 }
 
-func (p *VideoServiceFeedArgs) DeepEqual(ano *VideoServiceFeedArgs) bool {
+func (p *VideoServiceTrendingTopicsArgs) DeepEqual(ano *VideoServiceTrendingTopicsArgs) bool {
 	if p == ano {
 		return true
 	} else if p == nil || ano == nil {
@@ -3648,7 +13544,7 @@ func (p *VideoServiceFeedArgs) DeepEqual(ano *VideoServiceFeedArgs) bool {
 	return true
 }
 
-func (p *VideoServiceFeedArgs) Field1DeepEqual(src *FeedRequest) bool {
+func (p *VideoServiceTrendingTopicsArgs) Field1DeepEqual(src *TrendingTopicsRequest) bool {
 
 	if !p.Req.DeepEqual(src) {
 		return false
@@ -3656,39 +13552,39 @@ func (p *VideoServiceFeedArgs) Field1DeepEqual(src *FeedRequest) bool {
 	return true
 }
 
-type VideoServiceFeedResult struct {
-	Success *FeedResponse `thrift:"success,0,optional" frugal:"0,optional,FeedResponse" json:"success,omitempty"`
+type VideoServiceTrendingTopicsResult struct {
+	Success *TrendingTopicsResponse `thrift:"success,0,optional" frugal:"0,optional,TrendingTopicsResponse" json:"success,omitempty"`
 }
 
-func NewVideoServiceFeedResult() *VideoServiceFeedResult {
-	return &VideoServiceFeedResult{}
+func NewVideoServiceTrendingTopicsResult() *VideoServiceTrendingTopicsResult {
+	return &VideoServiceTrendingTopicsResult{}
 }
 
-func (p *VideoServiceFeedResult) InitDefault() {
-	*p = VideoServiceFeedResult{}
+func (p *VideoServiceTrendingTopicsResult) InitDefault() {
+	*p = VideoServiceTrendingTopicsResult{}
 }
 
-var VideoServiceFeedResult_Success_DEFAULT *FeedResponse
+var VideoServiceTrendingTopicsResult_Success_DEFAULT *TrendingTopicsResponse
 
-func (p *VideoServiceFeedResult) GetSuccess() (v *FeedResponse) {
+func (p *VideoServiceTrendingTopicsResult) GetSuccess() (v *TrendingTopicsResponse) {
 	if !p.IsSetSuccess() {
-		return VideoServiceFeedResult_Success_DEFAULT
+		return VideoServiceTrendingTopicsResult_Success_DEFAULT
 	}
 	return p.Success
 }
-func (p *VideoServiceFeedResult) SetSuccess(x interface{}) {
-	p.Success = x.(*FeedResponse)
+func (p *VideoServiceTrendingTopicsResult) SetSuccess(x interface{}) {
+	p.Success = x.(*TrendingTopicsResponse)
 }
 
-var fieldIDToName_VideoServiceFeedResult = map[int16]string{
+var fieldIDToName_VideoServiceTrendingTopicsResult = map[int16]string{
 	0: "success",
 }
 
-func (p *VideoServiceFeedResult) IsSetSuccess() bool {
+func (p *VideoServiceTrendingTopicsResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *VideoServiceFeedResult) Read(iprot thrift.TProtocol) (err error) {
+func (p *VideoServiceTrendingTopicsResult) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -3734,7 +13630,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_VideoServiceFeedResult[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_VideoServiceTrendingTopicsResult[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -3744,8 +13640,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *VideoServiceFeedResult) ReadField0(iprot thrift.TProtocol) error {
-	_field := NewFeedResponse()
+func (p *VideoServiceTrendingTopicsResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := NewTrendingTopicsResponse()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -3753,9 +13649,9 @@ func (p *VideoServiceFeedResult) ReadField0(iprot thrift.TProtocol) error {
 	return nil
 }
 
-func (p *VideoServiceFeedResult) Write(oprot thrift.TProtocol) (err error) {
+func (p *VideoServiceTrendingTopicsResult) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("Feed_result"); err != nil {
+	if err = oprot.WriteStructBegin("TrendingTopics_result"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -3781,7 +13677,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *VideoServiceFeedResult) writeField0(oprot thrift.TProtocol) (err error) {
+func (p *VideoServiceTrendingTopicsResult) writeField0(oprot thrift.TProtocol) (err error) {
 	if p.IsSetSuccess() {
 		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
 			goto WriteFieldBeginError
@@ -3800,15 +13696,15 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
 }
 
-func (p *VideoServiceFeedResult) String() string {
+func (p *VideoServiceTrendingTopicsResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("VideoServiceFeedResult(%+v)", *p)
+	return fmt.Sprintf("VideoServiceTrendingTopicsResult(%+v)", *p)
 
 }
 
-func (p *VideoServiceFeedResult) DeepEqual(ano *VideoServiceFeedResult) bool {
+func (p *VideoServiceTrendingTopicsResult) DeepEqual(ano *VideoServiceTrendingTopicsResult) bool {
 	if p == ano {
 		return true
 	} else if p == nil || ano == nil {
@@ -3820,7 +13716,7 @@ func (p *VideoServiceFeedResult) DeepEqual(ano *VideoServiceFeedResult) bool {
 	return true
 }
 
-func (p *VideoServiceFeedResult) Field0DeepEqual(src *FeedResponse) bool {
+func (p *VideoServiceTrendingTopicsResult) Field0DeepEqual(src *TrendingTopicsResponse) bool {
 
 	if !p.Success.DeepEqual(src) {
 		return false
@@ -3828,39 +13724,39 @@ func (p *VideoServiceFeedResult) Field0DeepEqual(src *FeedResponse) bool {
 	return true
 }
 
-type VideoServicePublishActionArgs struct {
-	Req *PublishActionRequest `thrift:"req,1" frugal:"1,default,PublishActionRequest" json:"req"`
+type VideoServiceHotVideosArgs struct {
+	Req *HotVideosRequest `thrift:"req,1" frugal:"1,default,HotVideosRequest" json:"req"`
 }
 
-func NewVideoServicePublishActionArgs() *VideoServicePublishActionArgs {
-	return &VideoServicePublishActionArgs{}
+func NewVideoServiceHotVideosArgs() *VideoServiceHotVideosArgs {
+	return &VideoServiceHotVideosArgs{}
 }
 
-func (p *VideoServicePublishActionArgs) InitDefault() {
-	*p = VideoServicePublishActionArgs{}
+func (p *VideoServiceHotVideosArgs) InitDefault() {
+	*p = VideoServiceHotVideosArgs{}
 }
 
-var VideoServicePublishActionArgs_Req_DEFAULT *PublishActionRequest
+var VideoServiceHotVideosArgs_Req_DEFAULT *HotVideosRequest
 
-func (p *VideoServicePublishActionArgs) GetReq() (v *PublishActionRequest) {
+func (p *VideoServiceHotVideosArgs) GetReq() (v *HotVideosRequest) {
 	if !p.IsSetReq() {
-		return VideoServicePublishActionArgs_Req_DEFAULT
+		return VideoServiceHotVideosArgs_Req_DEFAULT
 	}
 	return p.Req
 }
-func (p *VideoServicePublishActionArgs) SetReq(val *PublishActionRequest) {
+func (p *VideoServiceHotVideosArgs) SetReq(val *HotVideosRequest) {
 	p.Req = val
 }
 
-var fieldIDToName_VideoServicePublishActionArgs = map[int16]string{
+var fieldIDToName_VideoServiceHotVideosArgs = map[int16]string{
 	1: "req",
 }
 
-func (p *VideoServicePublishActionArgs) IsSetReq() bool {
+func (p *VideoServiceHotVideosArgs) IsSetReq() bool {
 	return p.Req != nil
 }
 
-func (p *VideoServicePublishActionArgs) Read(iprot thrift.TProtocol) (err error) {
+func (p *VideoServiceHotVideosArgs) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -3906,7 +13802,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_VideoServicePublishActionArgs[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_VideoServiceHotVideosArgs[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -3916,8 +13812,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *VideoServicePublishActionArgs) ReadField1(iprot thrift.TProtocol) error {
-	_field := NewPublishActionRequest()
+func (p *VideoServiceHotVideosArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := NewHotVideosRequest()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -3925,9 +13821,9 @@ func (p *VideoServicePublishActionArgs) ReadField1(iprot thrift.TProtocol) error
 	return nil
 }
 
-func (p *VideoServicePublishActionArgs) Write(oprot thrift.TProtocol) (err error) {
+func (p *VideoServiceHotVideosArgs) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("PublishAction_args"); err != nil {
+	if err = oprot.WriteStructBegin("HotVideos_args"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -3953,7 +13849,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *VideoServicePublishActionArgs) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *VideoServiceHotVideosArgs) writeField1(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
 		goto WriteFieldBeginError
 	}
@@ -3970,15 +13866,15 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *VideoServicePublishActionArgs) String() string {
+func (p *VideoServiceHotVideosArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("VideoServicePublishActionArgs(%+v)", *p)
+	return fmt.Sprintf("VideoServiceHotVideosArgs(%+v)", *p)
 
 }
 
-func (p *VideoServicePublishActionArgs) DeepEqual(ano *VideoServicePublishActionArgs) bool {
+func (p *VideoServiceHotVideosArgs) DeepEqual(ano *VideoServiceHotVideosArgs) bool {
 	if p == ano {
 		return true
 	} else if p == nil || ano == nil {
@@ -3990,7 +13886,7 @@ func (p *VideoServicePublishActionArgs) DeepEqual(ano *VideoServicePublishAction
 	return true
 }
 
-func (p *VideoServicePublishActionArgs) Field1DeepEqual(src *PublishActionRequest) bool {
+func (p *VideoServiceHotVideosArgs) Field1DeepEqual(src *HotVideosRequest) bool {
 
 	if !p.Req.DeepEqual(src) {
 		return false
@@ -3998,39 +13894,39 @@ func (p *VideoServicePublishActionArgs) Field1DeepEqual(src *PublishActionReques
 	return true
 }
 
-type VideoServicePublishActionResult struct {
-	Success *PublishActionResponse `thrift:"success,0,optional" frugal:"0,optional,PublishActionResponse" json:"success,omitempty"`
+type VideoServiceHotVideosResult struct {
+	Success *HotVideosResponse `thrift:"success,0,optional" frugal:"0,optional,HotVideosResponse" json:"success,omitempty"`
 }
 
-func NewVideoServicePublishActionResult() *VideoServicePublishActionResult {
-	return &VideoServicePublishActionResult{}
+func NewVideoServiceHotVideosResult() *VideoServiceHotVideosResult {
+	return &VideoServiceHotVideosResult{}
 }
 
-func (p *VideoServicePublishActionResult) InitDefault() {
-	*p = VideoServicePublishActionResult{}
+func (p *VideoServiceHotVideosResult) InitDefault() {
+	*p = VideoServiceHotVideosResult{}
 }
 
-var VideoServicePublishActionResult_Success_DEFAULT *PublishActionResponse
+var VideoServiceHotVideosResult_Success_DEFAULT *HotVideosResponse
 
-func (p *VideoServicePublishActionResult) GetSuccess() (v *PublishActionResponse) {
+func (p *VideoServiceHotVideosResult) GetSuccess() (v *HotVideosResponse) {
 	if !p.IsSetSuccess() {
-		return VideoServicePublishActionResult_Success_DEFAULT
+		return VideoServiceHotVideosResult_Success_DEFAULT
 	}
 	return p.Success
 }
-func (p *VideoServicePublishActionResult) SetSuccess(x interface{}) {
-	p.Success = x.(*PublishActionResponse)
+func (p *VideoServiceHotVideosResult) SetSuccess(x interface{}) {
+	p.Success = x.(*HotVideosResponse)
 }
 
-var fieldIDToName_VideoServicePublishActionResult = map[int16]string{
+var fieldIDToName_VideoServiceHotVideosResult = map[int16]string{
 	0: "success",
 }
 
-func (p *VideoServicePublishActionResult) IsSetSuccess() bool {
+func (p *VideoServiceHotVideosResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *VideoServicePublishActionResult) Read(iprot thrift.TProtocol) (err error) {
+func (p *VideoServiceHotVideosResult) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -4076,7 +13972,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_VideoServicePublishActionResult[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_VideoServiceHotVideosResult[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -4086,8 +13982,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *VideoServicePublishActionResult) ReadField0(iprot thrift.TProtocol) error {
-	_field := NewPublishActionResponse()
+func (p *VideoServiceHotVideosResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := NewHotVideosResponse()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -4095,9 +13991,9 @@ func (p *VideoServicePublishActionResult) ReadField0(iprot thrift.TProtocol) err
 	return nil
 }
 
-func (p *VideoServicePublishActionResult) Write(oprot thrift.TProtocol) (err error) {
+func (p *VideoServiceHotVideosResult) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("PublishAction_result"); err != nil {
+	if err = oprot.WriteStructBegin("HotVideos_result"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -4123,7 +14019,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *VideoServicePublishActionResult) writeField0(oprot thrift.TProtocol) (err error) {
+func (p *VideoServiceHotVideosResult) writeField0(oprot thrift.TProtocol) (err error) {
 	if p.IsSetSuccess() {
 		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
 			goto WriteFieldBeginError
@@ -4142,15 +14038,15 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
 }
 
-func (p *VideoServicePublishActionResult) String() string {
+func (p *VideoServiceHotVideosResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("VideoServicePublishActionResult(%+v)", *p)
+	return fmt.Sprintf("VideoServiceHotVideosResult(%+v)", *p)
 
 }
 
-func (p *VideoServicePublishActionResult) DeepEqual(ano *VideoServicePublishActionResult) bool {
+func (p *VideoServiceHotVideosResult) DeepEqual(ano *VideoServiceHotVideosResult) bool {
 	if p == ano {
 		return true
 	} else if p == nil || ano == nil {
@@ -4162,7 +14058,7 @@ func (p *VideoServicePublishActionResult) DeepEqual(ano *VideoServicePublishActi
 	return true
 }
 
-func (p *VideoServicePublishActionResult) Field0DeepEqual(src *PublishActionResponse) bool {
+func (p *VideoServiceHotVideosResult) Field0DeepEqual(src *HotVideosResponse) bool {
 
 	if !p.Success.DeepEqual(src) {
 		return false
@@ -4170,39 +14066,30 @@ func (p *VideoServicePublishActionResult) Field0DeepEqual(src *PublishActionResp
 	return true
 }
 
-type VideoServicePublishListArgs struct {
-	Req *PublishListRequest `thrift:"req,1" frugal:"1,default,PublishListRequest" json:"req"`
+type VideoServicePublishIDListArgs struct {
+	UserId int64 `thrift:"user_id,1" frugal:"1,default,i64" json:"user_id"`
 }
 
-func NewVideoServicePublishListArgs() *VideoServicePublishListArgs {
-	return &VideoServicePublishListArgs{}
+func NewVideoServicePublishIDListArgs() *VideoServicePublishIDListArgs {
+	return &VideoServicePublishIDListArgs{}
 }
 
-func (p *VideoServicePublishListArgs) InitDefault() {
-	*p = VideoServicePublishListArgs{}
+func (p *VideoServicePublishIDListArgs) InitDefault() {
+	*p = VideoServicePublishIDListArgs{}
 }
 
-var VideoServicePublishListArgs_Req_DEFAULT *PublishListRequest
-
-func (p *VideoServicePublishListArgs) GetReq() (v *PublishListRequest) {
-	if !p.IsSetReq() {
-		return VideoServicePublishListArgs_Req_DEFAULT
-	}
-	return p.Req
-}
-func (p *VideoServicePublishListArgs) SetReq(val *PublishListRequest) {
-	p.Req = val
+func (p *VideoServicePublishIDListArgs) GetUserId() (v int64) {
+	return p.UserId
 }
-
-var fieldIDToName_VideoServicePublishListArgs = map[int16]string{
-	1: "req",
+func (p *VideoServicePublishIDListArgs) SetUserId(val int64) {
+	p.UserId = val
 }
 
-func (p *VideoServicePublishListArgs) IsSetReq() bool {
-	return p.Req != nil
+var fieldIDToName_VideoServicePublishIDListArgs = map[int16]string{
+	1: "user_id",
 }
 
-func (p *VideoServicePublishListArgs) Read(iprot thrift.TProtocol) (err error) {
+func (p *VideoServicePublishIDListArgs) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -4222,7 +14109,7 @@ func (p *VideoServicePublishListArgs) Read(iprot thrift.TProtocol) (err error) {
 
 		switch fieldId {
 		case 1:
-			if fieldTypeId == thrift.STRUCT {
+			if fieldTypeId == thrift.I64 {
 				if err = p.ReadField1(iprot); err != nil {
 					goto ReadFieldError
 				}
@@ -4248,7 +14135,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_VideoServicePublishListArgs[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_VideoServicePublishIDListArgs[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -4258,18 +14145,21 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *VideoServicePublishListArgs) ReadField1(iprot thrift.TProtocol) error {
-	_field := NewPublishListRequest()
-	if err := _field.Read(iprot); err != nil {
+func (p *VideoServicePublishIDListArgs) ReadField1(iprot thrift.TProtocol) error {
+
+	var _field int64
+	if v, err := iprot.ReadI64(); err != nil {
 		return err
+	} else {
+		_field = v
 	}
-	p.Req = _field
+	p.UserId = _field
 	return nil
 }
 
-func (p *VideoServicePublishListArgs) Write(oprot thrift.TProtocol) (err error) {
+func (p *VideoServicePublishIDListArgs) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("PublishList_args"); err != nil {
+	if err = oprot.WriteStructBegin("PublishIDList_args"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -4295,11 +14185,11 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *VideoServicePublishListArgs) writeField1(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
+func (p *VideoServicePublishIDListArgs) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("user_id", thrift.I64, 1); err != nil {
 		goto WriteFieldBeginError
 	}
-	if err := p.Req.Write(oprot); err != nil {
+	if err := oprot.WriteI64(p.UserId); err != nil {
 		return err
 	}
 	if err = oprot.WriteFieldEnd(); err != nil {
@@ -4312,67 +14202,67 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *VideoServicePublishListArgs) String() string {
+func (p *VideoServicePublishIDListArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("VideoServicePublishListArgs(%+v)", *p)
+	return fmt.Sprintf("VideoServicePublishIDListArgs(%+v)", *p)
 
 }
 
-func (p *VideoServicePublishListArgs) DeepEqual(ano *VideoServicePublishListArgs) bool {
+func (p *VideoServicePublishIDListArgs) DeepEqual(ano *VideoServicePublishIDListArgs) bool {
 	if p == ano {
 		return true
 	} else if p == nil || ano == nil {
 		return false
 	}
-	if !p.Field1DeepEqual(ano.Req) {
+	if !p.Field1DeepEqual(ano.UserId) {
 		return false
 	}
 	return true
 }
 
-func (p *VideoServicePublishListArgs) Field1DeepEqual(src *PublishListRequest) bool {
+func (p *VideoServicePublishIDListArgs) Field1DeepEqual(src int64) bool {
 
-	if !p.Req.DeepEqual(src) {
+	if p.UserId != src {
 		return false
 	}
 	return true
 }
 
-type VideoServicePublishListResult struct {
-	Success *PublishListResponse `thrift:"success,0,optional" frugal:"0,optional,PublishListResponse" json:"success,omitempty"`
+type VideoServicePublishIDListResult struct {
+	Success []int64 `thrift:"success,0,optional" frugal:"0,optional,list<i64>" json:"success,omitempty"`
 }
 
-func NewVideoServicePublishListResult() *VideoServicePublishListResult {
-	return &VideoServicePublishListResult{}
+func NewVideoServicePublishIDListResult() *VideoServicePublishIDListResult {
+	return &VideoServicePublishIDListResult{}
 }
 
-func (p *VideoServicePublishListResult) InitDefault() {
-	*p = VideoServicePublishListResult{}
+func (p *VideoServicePublishIDListResult) InitDefault() {
+	*p = VideoServicePublishIDListResult{}
 }
 
-var VideoServicePublishListResult_Success_DEFAULT *PublishListResponse
+var VideoServicePublishIDListResult_Success_DEFAULT []int64
 
-func (p *VideoServicePublishListResult) GetSuccess() (v *PublishListResponse) {
+func (p *VideoServicePublishIDListResult) GetSuccess() (v []int64) {
 	if !p.IsSetSuccess() {
-		return VideoServicePublishListResult_Success_DEFAULT
+		return VideoServicePublishIDListResult_Success_DEFAULT
 	}
 	return p.Success
 }
-func (p *VideoServicePublishListResult) SetSuccess(x interface{}) {
-	p.Success = x.(*PublishListResponse)
+func (p *VideoServicePublishIDListResult) SetSuccess(x interface{}) {
+	p.Success = x.([]int64)
 }
 
-var fieldIDToName_VideoServicePublishListResult = map[int16]string{
+var fieldIDToName_VideoServicePublishIDListResult = map[int16]string{
 	0: "success",
 }
 
-func (p *VideoServicePublishListResult) IsSetSuccess() bool {
+func (p *VideoServicePublishIDListResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *VideoServicePublishListResult) Read(iprot thrift.TProtocol) (err error) {
+func (p *VideoServicePublishIDListResult) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -4392,7 +14282,7 @@ func (p *VideoServicePublishListResult) Read(iprot thrift.TProtocol) (err error)
 
 		switch fieldId {
 		case 0:
-			if fieldTypeId == thrift.STRUCT {
+			if fieldTypeId == thrift.LIST {
 				if err = p.ReadField0(iprot); err != nil {
 					goto ReadFieldError
 				}
@@ -4418,7 +14308,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_VideoServicePublishListResult[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_VideoServicePublishIDListResult[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -4428,18 +14318,33 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *VideoServicePublishListResult) ReadField0(iprot thrift.TProtocol) error {
-	_field := NewPublishListResponse()
-	if err := _field.Read(iprot); err != nil {
+func (p *VideoServicePublishIDListResult) ReadField0(iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin()
+	if err != nil {
+		return err
+	}
+	_field := make([]int64, 0, size)
+	for i := 0; i < size; i++ {
+
+		var _elem int64
+		if v, err := iprot.ReadI64(); err != nil {
+			return err
+		} else {
+			_elem = v
+		}
+
+		_field = append(_field, _elem)
+	}
+	if err := iprot.ReadListEnd(); err != nil {
 		return err
 	}
 	p.Success = _field
 	return nil
 }
 
-func (p *VideoServicePublishListResult) Write(oprot thrift.TProtocol) (err error) {
+func (p *VideoServicePublishIDListResult) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("PublishList_result"); err != nil {
+	if err = oprot.WriteStructBegin("PublishIDList_result"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -4465,12 +14370,20 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *VideoServicePublishListResult) writeField0(oprot thrift.TProtocol) (err error) {
+func (p *VideoServicePublishIDListResult) writeField0(oprot thrift.TProtocol) (err error) {
 	if p.IsSetSuccess() {
-		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
+		if err = oprot.WriteFieldBegin("success", thrift.LIST, 0); err != nil {
 			goto WriteFieldBeginError
 		}
-		if err := p.Success.Write(oprot); err != nil {
+		if err := oprot.WriteListBegin(thrift.I64, len(p.Success)); err != nil {
+			return err
+		}
+		for _, v := range p.Success {
+			if err := oprot.WriteI64(v); err != nil {
+				return err
+			}
+		}
+		if err := oprot.WriteListEnd(); err != nil {
 			return err
 		}
 		if err = oprot.WriteFieldEnd(); err != nil {
@@ -4484,15 +14397,15 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
 }
 
-func (p *VideoServicePublishListResult) String() string {
+func (p *VideoServicePublishIDListResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("VideoServicePublishListResult(%+v)", *p)
+	return fmt.Sprintf("VideoServicePublishIDListResult(%+v)", *p)
 
 }
 
-func (p *VideoServicePublishListResult) DeepEqual(ano *VideoServicePublishListResult) bool {
+func (p *VideoServicePublishIDListResult) DeepEqual(ano *VideoServicePublishIDListResult) bool {
 	if p == ano {
 		return true
 	} else if p == nil || ano == nil {
@@ -4504,38 +14417,53 @@ func (p *VideoServicePublishListResult) DeepEqual(ano *VideoServicePublishListRe
 	return true
 }
 
-func (p *VideoServicePublishListResult) Field0DeepEqual(src *PublishListResponse) bool {
+func (p *VideoServicePublishIDListResult) Field0DeepEqual(src []int64) bool {
 
-	if !p.Success.DeepEqual(src) {
+	if len(p.Success) != len(src) {
 		return false
 	}
+	for i, v := range p.Success {
+		_src := src[i]
+		if v != _src {
+			return false
+		}
+	}
 	return true
 }
 
-type VideoServicePublishIDListArgs struct {
-	UserId int64 `thrift:"user_id,1" frugal:"1,default,i64" json:"user_id"`
+type VideoServiceVideoInfoArgs struct {
+	Req *VideoInfoRequest `thrift:"req,1" frugal:"1,default,VideoInfoRequest" json:"req"`
 }
 
-func NewVideoServicePublishIDListArgs() *VideoServicePublishIDListArgs {
-	return &VideoServicePublishIDListArgs{}
+func NewVideoServiceVideoInfoArgs() *VideoServiceVideoInfoArgs {
+	return &VideoServiceVideoInfoArgs{}
 }
 
-func (p *VideoServicePublishIDListArgs) InitDefault() {
-	*p = VideoServicePublishIDListArgs{}
+func (p *VideoServiceVideoInfoArgs) InitDefault() {
+	*p = VideoServiceVideoInfoArgs{}
 }
 
-func (p *VideoServicePublishIDListArgs) GetUserId() (v int64) {
-	return p.UserId
+var VideoServiceVideoInfoArgs_Req_DEFAULT *VideoInfoRequest
+
+func (p *VideoServiceVideoInfoArgs) GetReq() (v *VideoInfoRequest) {
+	if !p.IsSetReq() {
+		return VideoServiceVideoInfoArgs_Req_DEFAULT
+	}
+	return p.Req
 }
-func (p *VideoServicePublishIDListArgs) SetUserId(val int64) {
-	p.UserId = val
+func (p *VideoServiceVideoInfoArgs) SetReq(val *VideoInfoRequest) {
+	p.Req = val
 }
 
-var fieldIDToName_VideoServicePublishIDListArgs = map[int16]string{
-	1: "user_id",
+var fieldIDToName_VideoServiceVideoInfoArgs = map[int16]string{
+	1: "req",
 }
 
-func (p *VideoServicePublishIDListArgs) Read(iprot thrift.TProtocol) (err error) {
+func (p *VideoServiceVideoInfoArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *VideoServiceVideoInfoArgs) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -4555,7 +14483,7 @@ func (p *VideoServicePublishIDListArgs) Read(iprot thrift.TProtocol) (err error)
 
 		switch fieldId {
 		case 1:
-			if fieldTypeId == thrift.I64 {
+			if fieldTypeId == thrift.STRUCT {
 				if err = p.ReadField1(iprot); err != nil {
 					goto ReadFieldError
 				}
@@ -4581,7 +14509,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_VideoServicePublishIDListArgs[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_VideoServiceVideoInfoArgs[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -4591,21 +14519,18 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *VideoServicePublishIDListArgs) ReadField1(iprot thrift.TProtocol) error {
-
-	var _field int64
-	if v, err := iprot.ReadI64(); err != nil {
+func (p *VideoServiceVideoInfoArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := NewVideoInfoRequest()
+	if err := _field.Read(iprot); err != nil {
 		return err
-	} else {
-		_field = v
 	}
-	p.UserId = _field
+	p.Req = _field
 	return nil
 }
 
-func (p *VideoServicePublishIDListArgs) Write(oprot thrift.TProtocol) (err error) {
+func (p *VideoServiceVideoInfoArgs) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("PublishIDList_args"); err != nil {
+	if err = oprot.WriteStructBegin("VideoInfo_args"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -4631,11 +14556,11 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *VideoServicePublishIDListArgs) writeField1(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("user_id", thrift.I64, 1); err != nil {
+func (p *VideoServiceVideoInfoArgs) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
 		goto WriteFieldBeginError
 	}
-	if err := oprot.WriteI64(p.UserId); err != nil {
+	if err := p.Req.Write(oprot); err != nil {
 		return err
 	}
 	if err = oprot.WriteFieldEnd(); err != nil {
@@ -4648,67 +14573,67 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *VideoServicePublishIDListArgs) String() string {
+func (p *VideoServiceVideoInfoArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("VideoServicePublishIDListArgs(%+v)", *p)
+	return fmt.Sprintf("VideoServiceVideoInfoArgs(%+v)", *p)
 
 }
 
-func (p *VideoServicePublishIDListArgs) DeepEqual(ano *VideoServicePublishIDListArgs) bool {
+func (p *VideoServiceVideoInfoArgs) DeepEqual(ano *VideoServiceVideoInfoArgs) bool {
 	if p == ano {
 		return true
 	} else if p == nil || ano == nil {
 		return false
 	}
-	if !p.Field1DeepEqual(ano.UserId) {
+	if !p.Field1DeepEqual(ano.Req) {
 		return false
 	}
 	return true
 }
 
-func (p *VideoServicePublishIDListArgs) Field1DeepEqual(src int64) bool {
+func (p *VideoServiceVideoInfoArgs) Field1DeepEqual(src *VideoInfoRequest) bool {
 
-	if p.UserId != src {
+	if !p.Req.DeepEqual(src) {
 		return false
 	}
 	return true
 }
 
-type VideoServicePublishIDListResult struct {
-	Success []int64 `thrift:"success,0,optional" frugal:"0,optional,list<i64>" json:"success,omitempty"`
+type VideoServiceVideoInfoResult struct {
+	Success *Video `thrift:"success,0,optional" frugal:"0,optional,Video" json:"success,omitempty"`
 }
 
-func NewVideoServicePublishIDListResult() *VideoServicePublishIDListResult {
-	return &VideoServicePublishIDListResult{}
+func NewVideoServiceVideoInfoResult() *VideoServiceVideoInfoResult {
+	return &VideoServiceVideoInfoResult{}
 }
 
-func (p *VideoServicePublishIDListResult) InitDefault() {
-	*p = VideoServicePublishIDListResult{}
+func (p *VideoServiceVideoInfoResult) InitDefault() {
+	*p = VideoServiceVideoInfoResult{}
 }
 
-var VideoServicePublishIDListResult_Success_DEFAULT []int64
+var VideoServiceVideoInfoResult_Success_DEFAULT *Video
 
-func (p *VideoServicePublishIDListResult) GetSuccess() (v []int64) {
+func (p *VideoServiceVideoInfoResult) GetSuccess() (v *Video) {
 	if !p.IsSetSuccess() {
-		return VideoServicePublishIDListResult_Success_DEFAULT
+		return VideoServiceVideoInfoResult_Success_DEFAULT
 	}
 	return p.Success
 }
-func (p *VideoServicePublishIDListResult) SetSuccess(x interface{}) {
-	p.Success = x.([]int64)
+func (p *VideoServiceVideoInfoResult) SetSuccess(x interface{}) {
+	p.Success = x.(*Video)
 }
 
-var fieldIDToName_VideoServicePublishIDListResult = map[int16]string{
+var fieldIDToName_VideoServiceVideoInfoResult = map[int16]string{
 	0: "success",
 }
 
-func (p *VideoServicePublishIDListResult) IsSetSuccess() bool {
+func (p *VideoServiceVideoInfoResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *VideoServicePublishIDListResult) Read(iprot thrift.TProtocol) (err error) {
+func (p *VideoServiceVideoInfoResult) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -4728,7 +14653,7 @@ func (p *VideoServicePublishIDListResult) Read(iprot thrift.TProtocol) (err erro
 
 		switch fieldId {
 		case 0:
-			if fieldTypeId == thrift.LIST {
+			if fieldTypeId == thrift.STRUCT {
 				if err = p.ReadField0(iprot); err != nil {
 					goto ReadFieldError
 				}
@@ -4754,7 +14679,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_VideoServicePublishIDListResult[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_VideoServiceVideoInfoResult[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -4764,33 +14689,18 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *VideoServicePublishIDListResult) ReadField0(iprot thrift.TProtocol) error {
-	_, size, err := iprot.ReadListBegin()
-	if err != nil {
-		return err
-	}
-	_field := make([]int64, 0, size)
-	for i := 0; i < size; i++ {
-
-		var _elem int64
-		if v, err := iprot.ReadI64(); err != nil {
-			return err
-		} else {
-			_elem = v
-		}
-
-		_field = append(_field, _elem)
-	}
-	if err := iprot.ReadListEnd(); err != nil {
+func (p *VideoServiceVideoInfoResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := NewVideo()
+	if err := _field.Read(iprot); err != nil {
 		return err
 	}
 	p.Success = _field
 	return nil
 }
 
-func (p *VideoServicePublishIDListResult) Write(oprot thrift.TProtocol) (err error) {
+func (p *VideoServiceVideoInfoResult) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("PublishIDList_result"); err != nil {
+	if err = oprot.WriteStructBegin("VideoInfo_result"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -4816,20 +14726,12 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *VideoServicePublishIDListResult) writeField0(oprot thrift.TProtocol) (err error) {
+func (p *VideoServiceVideoInfoResult) writeField0(oprot thrift.TProtocol) (err error) {
 	if p.IsSetSuccess() {
-		if err = oprot.WriteFieldBegin("success", thrift.LIST, 0); err != nil {
+		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
 			goto WriteFieldBeginError
 		}
-		if err := oprot.WriteListBegin(thrift.I64, len(p.Success)); err != nil {
-			return err
-		}
-		for _, v := range p.Success {
-			if err := oprot.WriteI64(v); err != nil {
-				return err
-			}
-		}
-		if err := oprot.WriteListEnd(); err != nil {
+		if err := p.Success.Write(oprot); err != nil {
 			return err
 		}
 		if err = oprot.WriteFieldEnd(); err != nil {
@@ -4843,15 +14745,15 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
 }
 
-func (p *VideoServicePublishIDListResult) String() string {
+func (p *VideoServiceVideoInfoResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("VideoServicePublishIDListResult(%+v)", *p)
+	return fmt.Sprintf("VideoServiceVideoInfoResult(%+v)", *p)
 
 }
 
-func (p *VideoServicePublishIDListResult) DeepEqual(ano *VideoServicePublishIDListResult) bool {
+func (p *VideoServiceVideoInfoResult) DeepEqual(ano *VideoServiceVideoInfoResult) bool {
 	if p == ano {
 		return true
 	} else if p == nil || ano == nil {
@@ -4863,53 +14765,47 @@ func (p *VideoServicePublishIDListResult) DeepEqual(ano *VideoServicePublishIDLi
 	return true
 }
 
-func (p *VideoServicePublishIDListResult) Field0DeepEqual(src []int64) bool {
+func (p *VideoServiceVideoInfoResult) Field0DeepEqual(src *Video) bool {
 
-	if len(p.Success) != len(src) {
+	if !p.Success.DeepEqual(src) {
 		return false
 	}
-	for i, v := range p.Success {
-		_src := src[i]
-		if v != _src {
-			return false
-		}
-	}
 	return true
 }
 
-type VideoServiceVideoInfoArgs struct {
-	Req *VideoInfoRequest `thrift:"req,1" frugal:"1,default,VideoInfoRequest" json:"req"`
+type VideoServiceVideoInfoListArgs struct {
+	Req *VideoInfoListRequest `thrift:"req,1" frugal:"1,default,VideoInfoListRequest" json:"req"`
 }
 
-func NewVideoServiceVideoInfoArgs() *VideoServiceVideoInfoArgs {
-	return &VideoServiceVideoInfoArgs{}
+func NewVideoServiceVideoInfoListArgs() *VideoServiceVideoInfoListArgs {
+	return &VideoServiceVideoInfoListArgs{}
 }
 
-func (p *VideoServiceVideoInfoArgs) InitDefault() {
-	*p = VideoServiceVideoInfoArgs{}
+func (p *VideoServiceVideoInfoListArgs) InitDefault() {
+	*p = VideoServiceVideoInfoListArgs{}
 }
 
-var VideoServiceVideoInfoArgs_Req_DEFAULT *VideoInfoRequest
+var VideoServiceVideoInfoListArgs_Req_DEFAULT *VideoInfoListRequest
 
-func (p *VideoServiceVideoInfoArgs) GetReq() (v *VideoInfoRequest) {
+func (p *VideoServiceVideoInfoListArgs) GetReq() (v *VideoInfoListRequest) {
 	if !p.IsSetReq() {
-		return VideoServiceVideoInfoArgs_Req_DEFAULT
+		return VideoServiceVideoInfoListArgs_Req_DEFAULT
 	}
 	return p.Req
 }
-func (p *VideoServiceVideoInfoArgs) SetReq(val *VideoInfoRequest) {
+func (p *VideoServiceVideoInfoListArgs) SetReq(val *VideoInfoListRequest) {
 	p.Req = val
 }
 
-var fieldIDToName_VideoServiceVideoInfoArgs = map[int16]string{
+var fieldIDToName_VideoServiceVideoInfoListArgs = map[int16]string{
 	1: "req",
 }
 
-func (p *VideoServiceVideoInfoArgs) IsSetReq() bool {
+func (p *VideoServiceVideoInfoListArgs) IsSetReq() bool {
 	return p.Req != nil
 }
 
-func (p *VideoServiceVideoInfoArgs) Read(iprot thrift.TProtocol) (err error) {
+func (p *VideoServiceVideoInfoListArgs) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -4955,7 +14851,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_VideoServiceVideoInfoArgs[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_VideoServiceVideoInfoListArgs[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -4965,8 +14861,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *VideoServiceVideoInfoArgs) ReadField1(iprot thrift.TProtocol) error {
-	_field := NewVideoInfoRequest()
+func (p *VideoServiceVideoInfoListArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := NewVideoInfoListRequest()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -4974,9 +14870,9 @@ func (p *VideoServiceVideoInfoArgs) ReadField1(iprot thrift.TProtocol) error {
 	return nil
 }
 
-func (p *VideoServiceVideoInfoArgs) Write(oprot thrift.TProtocol) (err error) {
+func (p *VideoServiceVideoInfoListArgs) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("VideoInfo_args"); err != nil {
+	if err = oprot.WriteStructBegin("VideoInfoList_args"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -5002,7 +14898,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *VideoServiceVideoInfoArgs) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *VideoServiceVideoInfoListArgs) writeField1(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
 		goto WriteFieldBeginError
 	}
@@ -5019,15 +14915,15 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *VideoServiceVideoInfoArgs) String() string {
+func (p *VideoServiceVideoInfoListArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("VideoServiceVideoInfoArgs(%+v)", *p)
+	return fmt.Sprintf("VideoServiceVideoInfoListArgs(%+v)", *p)
 
 }
 
-func (p *VideoServiceVideoInfoArgs) DeepEqual(ano *VideoServiceVideoInfoArgs) bool {
+func (p *VideoServiceVideoInfoListArgs) DeepEqual(ano *VideoServiceVideoInfoListArgs) bool {
 	if p == ano {
 		return true
 	} else if p == nil || ano == nil {
@@ -5039,7 +14935,7 @@ func (p *VideoServiceVideoInfoArgs) DeepEqual(ano *VideoServiceVideoInfoArgs) bo
 	return true
 }
 
-func (p *VideoServiceVideoInfoArgs) Field1DeepEqual(src *VideoInfoRequest) bool {
+func (p *VideoServiceVideoInfoListArgs) Field1DeepEqual(src *VideoInfoListRequest) bool {
 
 	if !p.Req.DeepEqual(src) {
 		return false
@@ -5047,39 +14943,39 @@ func (p *VideoServiceVideoInfoArgs) Field1DeepEqual(src *VideoInfoRequest) bool
 	return true
 }
 
-type VideoServiceVideoInfoResult struct {
-	Success *Video `thrift:"success,0,optional" frugal:"0,optional,Video" json:"success,omitempty"`
+type VideoServiceVideoInfoListResult struct {
+	Success []*Video `thrift:"success,0,optional" frugal:"0,optional,list<Video>" json:"success,omitempty"`
 }
 
-func NewVideoServiceVideoInfoResult() *VideoServiceVideoInfoResult {
-	return &VideoServiceVideoInfoResult{}
+func NewVideoServiceVideoInfoListResult() *VideoServiceVideoInfoListResult {
+	return &VideoServiceVideoInfoListResult{}
 }
 
-func (p *VideoServiceVideoInfoResult) InitDefault() {
-	*p = VideoServiceVideoInfoResult{}
+func (p *VideoServiceVideoInfoListResult) InitDefault() {
+	*p = VideoServiceVideoInfoListResult{}
 }
 
-var VideoServiceVideoInfoResult_Success_DEFAULT *Video
+var VideoServiceVideoInfoListResult_Success_DEFAULT []*Video
 
-func (p *VideoServiceVideoInfoResult) GetSuccess() (v *Video) {
+func (p *VideoServiceVideoInfoListResult) GetSuccess() (v []*Video) {
 	if !p.IsSetSuccess() {
-		return VideoServiceVideoInfoResult_Success_DEFAULT
+		return VideoServiceVideoInfoListResult_Success_DEFAULT
 	}
 	return p.Success
 }
-func (p *VideoServiceVideoInfoResult) SetSuccess(x interface{}) {
-	p.Success = x.(*Video)
+func (p *VideoServiceVideoInfoListResult) SetSuccess(x interface{}) {
+	p.Success = x.([]*Video)
 }
 
-var fieldIDToName_VideoServiceVideoInfoResult = map[int16]string{
+var fieldIDToName_VideoServiceVideoInfoListResult = map[int16]string{
 	0: "success",
 }
 
-func (p *VideoServiceVideoInfoResult) IsSetSuccess() bool {
+func (p *VideoServiceVideoInfoListResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *VideoServiceVideoInfoResult) Read(iprot thrift.TProtocol) (err error) {
+func (p *VideoServiceVideoInfoListResult) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -5099,7 +14995,7 @@ func (p *VideoServiceVideoInfoResult) Read(iprot thrift.TProtocol) (err error) {
 
 		switch fieldId {
 		case 0:
-			if fieldTypeId == thrift.STRUCT {
+			if fieldTypeId == thrift.LIST {
 				if err = p.ReadField0(iprot); err != nil {
 					goto ReadFieldError
 				}
@@ -5125,7 +15021,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_VideoServiceVideoInfoResult[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_VideoServiceVideoInfoListResult[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -5135,18 +15031,32 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *VideoServiceVideoInfoResult) ReadField0(iprot thrift.TProtocol) error {
-	_field := NewVideo()
-	if err := _field.Read(iprot); err != nil {
+func (p *VideoServiceVideoInfoListResult) ReadField0(iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin()
+	if err != nil {
+		return err
+	}
+	_field := make([]*Video, 0, size)
+	values := make([]Video, size)
+	for i := 0; i < size; i++ {
+		_elem := &values[i]
+
+		if err := _elem.Read(iprot); err != nil {
+			return err
+		}
+
+		_field = append(_field, _elem)
+	}
+	if err := iprot.ReadListEnd(); err != nil {
 		return err
 	}
 	p.Success = _field
 	return nil
 }
 
-func (p *VideoServiceVideoInfoResult) Write(oprot thrift.TProtocol) (err error) {
+func (p *VideoServiceVideoInfoListResult) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("VideoInfo_result"); err != nil {
+	if err = oprot.WriteStructBegin("VideoInfoList_result"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -5172,12 +15082,20 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *VideoServiceVideoInfoResult) writeField0(oprot thrift.TProtocol) (err error) {
+func (p *VideoServiceVideoInfoListResult) writeField0(oprot thrift.TProtocol) (err error) {
 	if p.IsSetSuccess() {
-		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
+		if err = oprot.WriteFieldBegin("success", thrift.LIST, 0); err != nil {
 			goto WriteFieldBeginError
 		}
-		if err := p.Success.Write(oprot); err != nil {
+		if err := oprot.WriteListBegin(thrift.STRUCT, len(p.Success)); err != nil {
+			return err
+		}
+		for _, v := range p.Success {
+			if err := v.Write(oprot); err != nil {
+				return err
+			}
+		}
+		if err := oprot.WriteListEnd(); err != nil {
 			return err
 		}
 		if err = oprot.WriteFieldEnd(); err != nil {
@@ -5191,15 +15109,15 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
 }
 
-func (p *VideoServiceVideoInfoResult) String() string {
+func (p *VideoServiceVideoInfoListResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("VideoServiceVideoInfoResult(%+v)", *p)
+	return fmt.Sprintf("VideoServiceVideoInfoListResult(%+v)", *p)
 
 }
 
-func (p *VideoServiceVideoInfoResult) DeepEqual(ano *VideoServiceVideoInfoResult) bool {
+func (p *VideoServiceVideoInfoListResult) DeepEqual(ano *VideoServiceVideoInfoListResult) bool {
 	if p == ano {
 		return true
 	} else if p == nil || ano == nil {
@@ -5211,47 +15129,44 @@ func (p *VideoServiceVideoInfoResult) DeepEqual(ano *VideoServiceVideoInfoResult
 	return true
 }
 
-func (p *VideoServiceVideoInfoResult) Field0DeepEqual(src *Video) bool {
+func (p *VideoServiceVideoInfoListResult) Field0DeepEqual(src []*Video) bool {
 
-	if !p.Success.DeepEqual(src) {
+	if len(p.Success) != len(src) {
 		return false
 	}
+	for i, v := range p.Success {
+		_src := src[i]
+		if !v.DeepEqual(_src) {
+			return false
+		}
+	}
 	return true
 }
 
-type VideoServiceVideoInfoListArgs struct {
-	Req *VideoInfoListRequest `thrift:"req,1" frugal:"1,default,VideoInfoListRequest" json:"req"`
+type VideoServiceWorkCountArgs struct {
+	UserId int64 `thrift:"user_id,1" frugal:"1,default,i64" json:"user_id"`
 }
 
-func NewVideoServiceVideoInfoListArgs() *VideoServiceVideoInfoListArgs {
-	return &VideoServiceVideoInfoListArgs{}
+func NewVideoServiceWorkCountArgs() *VideoServiceWorkCountArgs {
+	return &VideoServiceWorkCountArgs{}
 }
 
-func (p *VideoServiceVideoInfoListArgs) InitDefault() {
-	*p = VideoServiceVideoInfoListArgs{}
+func (p *VideoServiceWorkCountArgs) InitDefault() {
+	*p = VideoServiceWorkCountArgs{}
 }
 
-var VideoServiceVideoInfoListArgs_Req_DEFAULT *VideoInfoListRequest
-
-func (p *VideoServiceVideoInfoListArgs) GetReq() (v *VideoInfoListRequest) {
-	if !p.IsSetReq() {
-		return VideoServiceVideoInfoListArgs_Req_DEFAULT
-	}
-	return p.Req
-}
-func (p *VideoServiceVideoInfoListArgs) SetReq(val *VideoInfoListRequest) {
-	p.Req = val
+func (p *VideoServiceWorkCountArgs) GetUserId() (v int64) {
+	return p.UserId
 }
-
-var fieldIDToName_VideoServiceVideoInfoListArgs = map[int16]string{
-	1: "req",
+func (p *VideoServiceWorkCountArgs) SetUserId(val int64) {
+	p.UserId = val
 }
 
-func (p *VideoServiceVideoInfoListArgs) IsSetReq() bool {
-	return p.Req != nil
+var fieldIDToName_VideoServiceWorkCountArgs = map[int16]string{
+	1: "user_id",
 }
 
-func (p *VideoServiceVideoInfoListArgs) Read(iprot thrift.TProtocol) (err error) {
+func (p *VideoServiceWorkCountArgs) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -5271,7 +15186,7 @@ func (p *VideoServiceVideoInfoListArgs) Read(iprot thrift.TProtocol) (err error)
 
 		switch fieldId {
 		case 1:
-			if fieldTypeId == thrift.STRUCT {
+			if fieldTypeId == thrift.I64 {
 				if err = p.ReadField1(iprot); err != nil {
 					goto ReadFieldError
 				}
@@ -5297,7 +15212,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_VideoServiceVideoInfoListArgs[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_VideoServiceWorkCountArgs[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -5307,18 +15222,21 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *VideoServiceVideoInfoListArgs) ReadField1(iprot thrift.TProtocol) error {
-	_field := NewVideoInfoListRequest()
-	if err := _field.Read(iprot); err != nil {
+func (p *VideoServiceWorkCountArgs) ReadField1(iprot thrift.TProtocol) error {
+
+	var _field int64
+	if v, err := iprot.ReadI64(); err != nil {
 		return err
+	} else {
+		_field = v
 	}
-	p.Req = _field
+	p.UserId = _field
 	return nil
 }
 
-func (p *VideoServiceVideoInfoListArgs) Write(oprot thrift.TProtocol) (err error) {
+func (p *VideoServiceWorkCountArgs) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("VideoInfoList_args"); err != nil {
+	if err = oprot.WriteStructBegin("WorkCount_args"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -5344,11 +15262,11 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *VideoServiceVideoInfoListArgs) writeField1(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
+func (p *VideoServiceWorkCountArgs) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("user_id", thrift.I64, 1); err != nil {
 		goto WriteFieldBeginError
 	}
-	if err := p.Req.Write(oprot); err != nil {
+	if err := oprot.WriteI64(p.UserId); err != nil {
 		return err
 	}
 	if err = oprot.WriteFieldEnd(); err != nil {
@@ -5361,67 +15279,67 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *VideoServiceVideoInfoListArgs) String() string {
+func (p *VideoServiceWorkCountArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("VideoServiceVideoInfoListArgs(%+v)", *p)
+	return fmt.Sprintf("VideoServiceWorkCountArgs(%+v)", *p)
 
 }
 
-func (p *VideoServiceVideoInfoListArgs) DeepEqual(ano *VideoServiceVideoInfoListArgs) bool {
+func (p *VideoServiceWorkCountArgs) DeepEqual(ano *VideoServiceWorkCountArgs) bool {
 	if p == ano {
 		return true
 	} else if p == nil || ano == nil {
 		return false
 	}
-	if !p.Field1DeepEqual(ano.Req) {
+	if !p.Field1DeepEqual(ano.UserId) {
 		return false
 	}
 	return true
 }
 
-func (p *VideoServiceVideoInfoListArgs) Field1DeepEqual(src *VideoInfoListRequest) bool {
+func (p *VideoServiceWorkCountArgs) Field1DeepEqual(src int64) bool {
 
-	if !p.Req.DeepEqual(src) {
+	if p.UserId != src {
 		return false
 	}
 	return true
 }
 
-type VideoServiceVideoInfoListResult struct {
-	Success []*Video `thrift:"success,0,optional" frugal:"0,optional,list<Video>" json:"success,omitempty"`
+type VideoServiceWorkCountResult struct {
+	Success *int64 `thrift:"success,0,optional" frugal:"0,optional,i64" json:"success,omitempty"`
 }
 
-func NewVideoServiceVideoInfoListResult() *VideoServiceVideoInfoListResult {
-	return &VideoServiceVideoInfoListResult{}
+func NewVideoServiceWorkCountResult() *VideoServiceWorkCountResult {
+	return &VideoServiceWorkCountResult{}
 }
 
-func (p *VideoServiceVideoInfoListResult) InitDefault() {
-	*p = VideoServiceVideoInfoListResult{}
+func (p *VideoServiceWorkCountResult) InitDefault() {
+	*p = VideoServiceWorkCountResult{}
 }
 
-var VideoServiceVideoInfoListResult_Success_DEFAULT []*Video
+var VideoServiceWorkCountResult_Success_DEFAULT int64
 
-func (p *VideoServiceVideoInfoListResult) GetSuccess() (v []*Video) {
+func (p *VideoServiceWorkCountResult) GetSuccess() (v int64) {
 	if !p.IsSetSuccess() {
-		return VideoServiceVideoInfoListResult_Success_DEFAULT
+		return VideoServiceWorkCountResult_Success_DEFAULT
 	}
-	return p.Success
+	return *p.Success
 }
-func (p *VideoServiceVideoInfoListResult) SetSuccess(x interface{}) {
-	p.Success = x.([]*Video)
+func (p *VideoServiceWorkCountResult) SetSuccess(x interface{}) {
+	p.Success = x.(*int64)
 }
 
-var fieldIDToName_VideoServiceVideoInfoListResult = map[int16]string{
+var fieldIDToName_VideoServiceWorkCountResult = map[int16]string{
 	0: "success",
 }
 
-func (p *VideoServiceVideoInfoListResult) IsSetSuccess() bool {
+func (p *VideoServiceWorkCountResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *VideoServiceVideoInfoListResult) Read(iprot thrift.TProtocol) (err error) {
+func (p *VideoServiceWorkCountResult) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -5441,7 +15359,7 @@ func (p *VideoServiceVideoInfoListResult) Read(iprot thrift.TProtocol) (err erro
 
 		switch fieldId {
 		case 0:
-			if fieldTypeId == thrift.LIST {
+			if fieldTypeId == thrift.I64 {
 				if err = p.ReadField0(iprot); err != nil {
 					goto ReadFieldError
 				}
@@ -5467,7 +15385,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_VideoServiceVideoInfoListResult[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_VideoServiceWorkCountResult[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -5477,32 +15395,21 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *VideoServiceVideoInfoListResult) ReadField0(iprot thrift.TProtocol) error {
-	_, size, err := iprot.ReadListBegin()
-	if err != nil {
-		return err
-	}
-	_field := make([]*Video, 0, size)
-	values := make([]Video, size)
-	for i := 0; i < size; i++ {
-		_elem := &values[i]
-
-		if err := _elem.Read(iprot); err != nil {
-			return err
-		}
+func (p *VideoServiceWorkCountResult) ReadField0(iprot thrift.TProtocol) error {
 
-		_field = append(_field, _elem)
-	}
-	if err := iprot.ReadListEnd(); err != nil {
+	var _field *int64
+	if v, err := iprot.ReadI64(); err != nil {
 		return err
+	} else {
+		_field = &v
 	}
 	p.Success = _field
 	return nil
 }
 
-func (p *VideoServiceVideoInfoListResult) Write(oprot thrift.TProtocol) (err error) {
+func (p *VideoServiceWorkCountResult) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("VideoInfoList_result"); err != nil {
+	if err = oprot.WriteStructBegin("WorkCount_result"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -5528,20 +15435,12 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *VideoServiceVideoInfoListResult) writeField0(oprot thrift.TProtocol) (err error) {
+func (p *VideoServiceWorkCountResult) writeField0(oprot thrift.TProtocol) (err error) {
 	if p.IsSetSuccess() {
-		if err = oprot.WriteFieldBegin("success", thrift.LIST, 0); err != nil {
+		if err = oprot.WriteFieldBegin("success", thrift.I64, 0); err != nil {
 			goto WriteFieldBeginError
 		}
-		if err := oprot.WriteListBegin(thrift.STRUCT, len(p.Success)); err != nil {
-			return err
-		}
-		for _, v := range p.Success {
-			if err := v.Write(oprot); err != nil {
-				return err
-			}
-		}
-		if err := oprot.WriteListEnd(); err != nil {
+		if err := oprot.WriteI64(*p.Success); err != nil {
 			return err
 		}
 		if err = oprot.WriteFieldEnd(); err != nil {
@@ -5555,15 +15454,15 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
 }
 
-func (p *VideoServiceVideoInfoListResult) String() string {
+func (p *VideoServiceWorkCountResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("VideoServiceVideoInfoListResult(%+v)", *p)
+	return fmt.Sprintf("VideoServiceWorkCountResult(%+v)", *p)
 
 }
 
-func (p *VideoServiceVideoInfoListResult) DeepEqual(ano *VideoServiceVideoInfoListResult) bool {
+func (p *VideoServiceWorkCountResult) DeepEqual(ano *VideoServiceWorkCountResult) bool {
 	if p == ano {
 		return true
 	} else if p == nil || ano == nil {
@@ -5575,44 +15474,43 @@ func (p *VideoServiceVideoInfoListResult) DeepEqual(ano *VideoServiceVideoInfoLi
 	return true
 }
 
-func (p *VideoServiceVideoInfoListResult) Field0DeepEqual(src []*Video) bool {
+func (p *VideoServiceWorkCountResult) Field0DeepEqual(src *int64) bool {
 
-	if len(p.Success) != len(src) {
+	if p.Success == src {
+		return true
+	} else if p.Success == nil || src == nil {
 		return false
 	}
-	for i, v := range p.Success {
-		_src := src[i]
-		if !v.DeepEqual(_src) {
-			return false
-		}
+	if *p.Success != *src {
+		return false
 	}
 	return true
 }
 
-type VideoServiceWorkCountArgs struct {
-	UserId int64 `thrift:"user_id,1" frugal:"1,default,i64" json:"user_id"`
+type VideoServiceBatchWorkCountArgs struct {
+	UserIds []int64 `thrift:"user_ids,1" frugal:"1,default,list<i64>" json:"user_ids"`
 }
 
-func NewVideoServiceWorkCountArgs() *VideoServiceWorkCountArgs {
-	return &VideoServiceWorkCountArgs{}
+func NewVideoServiceBatchWorkCountArgs() *VideoServiceBatchWorkCountArgs {
+	return &VideoServiceBatchWorkCountArgs{}
 }
 
-func (p *VideoServiceWorkCountArgs) InitDefault() {
-	*p = VideoServiceWorkCountArgs{}
+func (p *VideoServiceBatchWorkCountArgs) InitDefault() {
+	*p = VideoServiceBatchWorkCountArgs{}
 }
 
-func (p *VideoServiceWorkCountArgs) GetUserId() (v int64) {
-	return p.UserId
+func (p *VideoServiceBatchWorkCountArgs) GetUserIds() (v []int64) {
+	return p.UserIds
 }
-func (p *VideoServiceWorkCountArgs) SetUserId(val int64) {
-	p.UserId = val
+func (p *VideoServiceBatchWorkCountArgs) SetUserIds(val []int64) {
+	p.UserIds = val
 }
 
-var fieldIDToName_VideoServiceWorkCountArgs = map[int16]string{
-	1: "user_id",
+var fieldIDToName_VideoServiceBatchWorkCountArgs = map[int16]string{
+	1: "user_ids",
 }
 
-func (p *VideoServiceWorkCountArgs) Read(iprot thrift.TProtocol) (err error) {
+func (p *VideoServiceBatchWorkCountArgs) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -5632,7 +15530,7 @@ func (p *VideoServiceWorkCountArgs) Read(iprot thrift.TProtocol) (err error) {
 
 		switch fieldId {
 		case 1:
-			if fieldTypeId == thrift.I64 {
+			if fieldTypeId == thrift.LIST {
 				if err = p.ReadField1(iprot); err != nil {
 					goto ReadFieldError
 				}
@@ -5658,7 +15556,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_VideoServiceWorkCountArgs[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_VideoServiceBatchWorkCountArgs[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -5668,21 +15566,33 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *VideoServiceWorkCountArgs) ReadField1(iprot thrift.TProtocol) error {
+func (p *VideoServiceBatchWorkCountArgs) ReadField1(iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin()
+	if err != nil {
+		return err
+	}
+	_field := make([]int64, 0, size)
+	for i := 0; i < size; i++ {
 
-	var _field int64
-	if v, err := iprot.ReadI64(); err != nil {
+		var _elem int64
+		if v, err := iprot.ReadI64(); err != nil {
+			return err
+		} else {
+			_elem = v
+		}
+
+		_field = append(_field, _elem)
+	}
+	if err := iprot.ReadListEnd(); err != nil {
 		return err
-	} else {
-		_field = v
 	}
-	p.UserId = _field
+	p.UserIds = _field
 	return nil
 }
 
-func (p *VideoServiceWorkCountArgs) Write(oprot thrift.TProtocol) (err error) {
+func (p *VideoServiceBatchWorkCountArgs) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("WorkCount_args"); err != nil {
+	if err = oprot.WriteStructBegin("BatchWorkCount_args"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -5708,11 +15618,19 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *VideoServiceWorkCountArgs) writeField1(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("user_id", thrift.I64, 1); err != nil {
+func (p *VideoServiceBatchWorkCountArgs) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("user_ids", thrift.LIST, 1); err != nil {
 		goto WriteFieldBeginError
 	}
-	if err := oprot.WriteI64(p.UserId); err != nil {
+	if err := oprot.WriteListBegin(thrift.I64, len(p.UserIds)); err != nil {
+		return err
+	}
+	for _, v := range p.UserIds {
+		if err := oprot.WriteI64(v); err != nil {
+			return err
+		}
+	}
+	if err := oprot.WriteListEnd(); err != nil {
 		return err
 	}
 	if err = oprot.WriteFieldEnd(); err != nil {
@@ -5725,67 +15643,73 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *VideoServiceWorkCountArgs) String() string {
+func (p *VideoServiceBatchWorkCountArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("VideoServiceWorkCountArgs(%+v)", *p)
+	return fmt.Sprintf("VideoServiceBatchWorkCountArgs(%+v)", *p)
 
 }
 
-func (p *VideoServiceWorkCountArgs) DeepEqual(ano *VideoServiceWorkCountArgs) bool {
+func (p *VideoServiceBatchWorkCountArgs) DeepEqual(ano *VideoServiceBatchWorkCountArgs) bool {
 	if p == ano {
 		return true
 	} else if p == nil || ano == nil {
 		return false
 	}
-	if !p.Field1DeepEqual(ano.UserId) {
+	if !p.Field1DeepEqual(ano.UserIds) {
 		return false
 	}
 	return true
 }
 
-func (p *VideoServiceWorkCountArgs) Field1DeepEqual(src int64) bool {
+func (p *VideoServiceBatchWorkCountArgs) Field1DeepEqual(src []int64) bool {
 
-	if p.UserId != src {
+	if len(p.UserIds) != len(src) {
 		return false
 	}
+	for i, v := range p.UserIds {
+		_src := src[i]
+		if v != _src {
+			return false
+		}
+	}
 	return true
 }
 
-type VideoServiceWorkCountResult struct {
-	Success *int64 `thrift:"success,0,optional" frugal:"0,optional,i64" json:"success,omitempty"`
+type VideoServiceBatchWorkCountResult struct {
+	Success map[int64]int64 `thrift:"success,0,optional" frugal:"0,optional,map<i64:i64>" json:"success,omitempty"`
 }
 
-func NewVideoServiceWorkCountResult() *VideoServiceWorkCountResult {
-	return &VideoServiceWorkCountResult{}
+func NewVideoServiceBatchWorkCountResult() *VideoServiceBatchWorkCountResult {
+	return &VideoServiceBatchWorkCountResult{}
 }
 
-func (p *VideoServiceWorkCountResult) InitDefault() {
-	*p = VideoServiceWorkCountResult{}
+func (p *VideoServiceBatchWorkCountResult) InitDefault() {
+	*p = VideoServiceBatchWorkCountResult{}
 }
 
-var VideoServiceWorkCountResult_Success_DEFAULT int64
+var VideoServiceBatchWorkCountResult_Success_DEFAULT map[int64]int64
 
-func (p *VideoServiceWorkCountResult) GetSuccess() (v int64) {
+func (p *VideoServiceBatchWorkCountResult) GetSuccess() (v map[int64]int64) {
 	if !p.IsSetSuccess() {
-		return VideoServiceWorkCountResult_Success_DEFAULT
+		return VideoServiceBatchWorkCountResult_Success_DEFAULT
 	}
-	return *p.Success
+	return p.Success
 }
-func (p *VideoServiceWorkCountResult) SetSuccess(x interface{}) {
-	p.Success = x.(*int64)
+func (p *VideoServiceBatchWorkCountResult) SetSuccess(x interface{}) {
+	p.Success = x.(map[int64]int64)
 }
 
-var fieldIDToName_VideoServiceWorkCountResult = map[int16]string{
+var fieldIDToName_VideoServiceBatchWorkCountResult = map[int16]string{
 	0: "success",
 }
 
-func (p *VideoServiceWorkCountResult) IsSetSuccess() bool {
+func (p *VideoServiceBatchWorkCountResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *VideoServiceWorkCountResult) Read(iprot thrift.TProtocol) (err error) {
+func (p *VideoServiceBatchWorkCountResult) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -5805,7 +15729,7 @@ func (p *VideoServiceWorkCountResult) Read(iprot thrift.TProtocol) (err error) {
 
 		switch fieldId {
 		case 0:
-			if fieldTypeId == thrift.I64 {
+			if fieldTypeId == thrift.MAP {
 				if err = p.ReadField0(iprot); err != nil {
 					goto ReadFieldError
 				}
@@ -5831,7 +15755,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_VideoServiceWorkCountResult[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_VideoServiceBatchWorkCountResult[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -5841,21 +15765,39 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *VideoServiceWorkCountResult) ReadField0(iprot thrift.TProtocol) error {
+func (p *VideoServiceBatchWorkCountResult) ReadField0(iprot thrift.TProtocol) error {
+	_, _, size, err := iprot.ReadMapBegin()
+	if err != nil {
+		return err
+	}
+	_field := make(map[int64]int64, size)
+	for i := 0; i < size; i++ {
+		var _key int64
+		if v, err := iprot.ReadI64(); err != nil {
+			return err
+		} else {
+			_key = v
+		}
+
+		var _val int64
+		if v, err := iprot.ReadI64(); err != nil {
+			return err
+		} else {
+			_val = v
+		}
 
-	var _field *int64
-	if v, err := iprot.ReadI64(); err != nil {
+		_field[_key] = _val
+	}
+	if err := iprot.ReadMapEnd(); err != nil {
 		return err
-	} else {
-		_field = &v
 	}
 	p.Success = _field
 	return nil
 }
 
-func (p *VideoServiceWorkCountResult) Write(oprot thrift.TProtocol) (err error) {
+func (p *VideoServiceBatchWorkCountResult) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("WorkCount_result"); err != nil {
+	if err = oprot.WriteStructBegin("BatchWorkCount_result"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -5881,12 +15823,23 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *VideoServiceWorkCountResult) writeField0(oprot thrift.TProtocol) (err error) {
+func (p *VideoServiceBatchWorkCountResult) writeField0(oprot thrift.TProtocol) (err error) {
 	if p.IsSetSuccess() {
-		if err = oprot.WriteFieldBegin("success", thrift.I64, 0); err != nil {
+		if err = oprot.WriteFieldBegin("success", thrift.MAP, 0); err != nil {
 			goto WriteFieldBeginError
 		}
-		if err := oprot.WriteI64(*p.Success); err != nil {
+		if err := oprot.WriteMapBegin(thrift.I64, thrift.I64, len(p.Success)); err != nil {
+			return err
+		}
+		for k, v := range p.Success {
+			if err := oprot.WriteI64(k); err != nil {
+				return err
+			}
+			if err := oprot.WriteI64(v); err != nil {
+				return err
+			}
+		}
+		if err := oprot.WriteMapEnd(); err != nil {
 			return err
 		}
 		if err = oprot.WriteFieldEnd(); err != nil {
@@ -5900,15 +15853,15 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
 }
 
-func (p *VideoServiceWorkCountResult) String() string {
+func (p *VideoServiceBatchWorkCountResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("VideoServiceWorkCountResult(%+v)", *p)
+	return fmt.Sprintf("VideoServiceBatchWorkCountResult(%+v)", *p)
 
 }
 
-func (p *VideoServiceWorkCountResult) DeepEqual(ano *VideoServiceWorkCountResult) bool {
+func (p *VideoServiceBatchWorkCountResult) DeepEqual(ano *VideoServiceBatchWorkCountResult) bool {
 	if p == ano {
 		return true
 	} else if p == nil || ano == nil {
@@ -5920,15 +15873,16 @@ func (p *VideoServiceWorkCountResult) DeepEqual(ano *VideoServiceWorkCountResult
 	return true
 }
 
-func (p *VideoServiceWorkCountResult) Field0DeepEqual(src *int64) bool {
+func (p *VideoServiceBatchWorkCountResult) Field0DeepEqual(src map[int64]int64) bool {
 
-	if p.Success == src {
-		return true
-	} else if p.Success == nil || src == nil {
+	if len(p.Success) != len(src) {
 		return false
 	}
-	if *p.Success != *src {
-		return false
+	for k, v := range p.Success {
+		_src := src[k]
+		if v != _src {
+			return false
+		}
 	}
 	return true
 }
@@ -6620,3 +16574,260 @@ func (p *VideoServiceVideoExistResult) Field0DeepEqual(src *bool) bool {
 	}
 	return true
 }
+
+type VideoServiceClearSeenArgs struct {
+	UserId int64 `thrift:"user_id,1" frugal:"1,default,i64" json:"user_id"`
+}
+
+func NewVideoServiceClearSeenArgs() *VideoServiceClearSeenArgs {
+	return &VideoServiceClearSeenArgs{}
+}
+
+func (p *VideoServiceClearSeenArgs) InitDefault() {
+	*p = VideoServiceClearSeenArgs{}
+}
+
+func (p *VideoServiceClearSeenArgs) GetUserId() (v int64) {
+	return p.UserId
+}
+func (p *VideoServiceClearSeenArgs) SetUserId(val int64) {
+	p.UserId = val
+}
+
+var fieldIDToName_VideoServiceClearSeenArgs = map[int16]string{
+	1: "user_id",
+}
+
+func (p *VideoServiceClearSeenArgs) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_VideoServiceClearSeenArgs[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *VideoServiceClearSeenArgs) ReadField1(iprot thrift.TProtocol) error {
+
+	var _field int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.UserId = _field
+	return nil
+}
+
+func (p *VideoServiceClearSeenArgs) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("ClearSeen_args"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *VideoServiceClearSeenArgs) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("user_id", thrift.I64, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI64(p.UserId); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *VideoServiceClearSeenArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("VideoServiceClearSeenArgs(%+v)", *p)
+
+}
+
+func (p *VideoServiceClearSeenArgs) DeepEqual(ano *VideoServiceClearSeenArgs) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.UserId) {
+		return false
+	}
+	return true
+}
+
+func (p *VideoServiceClearSeenArgs) Field1DeepEqual(src int64) bool {
+
+	if p.UserId != src {
+		return false
+	}
+	return true
+}
+
+type VideoServiceClearSeenResult struct {
+}
+
+func NewVideoServiceClearSeenResult() *VideoServiceClearSeenResult {
+	return &VideoServiceClearSeenResult{}
+}
+
+func (p *VideoServiceClearSeenResult) InitDefault() {
+	*p = VideoServiceClearSeenResult{}
+}
+
+var fieldIDToName_VideoServiceClearSeenResult = map[int16]string{}
+
+func (p *VideoServiceClearSeenResult) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		if err = iprot.Skip(fieldTypeId); err != nil {
+			goto SkipFieldTypeError
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+SkipFieldTypeError:
+	return thrift.PrependError(fmt.Sprintf("%T skip field type %d error", p, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *VideoServiceClearSeenResult) Write(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteStructBegin("ClearSeen_result"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *VideoServiceClearSeenResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("VideoServiceClearSeenResult(%+v)", *p)
+
+}
+
+func (p *VideoServiceClearSeenResult) DeepEqual(ano *VideoServiceClearSeenResult) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	return true
+}
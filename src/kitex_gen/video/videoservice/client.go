@@ -12,14 +12,24 @@ import (
 // Client is designed to provide IDL-compatible methods with call-option parameter for kitex framework.
 type Client interface {
 	Feed(ctx context.Context, req *video.FeedRequest, callOptions ...callopt.Option) (r *video.FeedResponse, err error)
+	FollowFeed(ctx context.Context, req *video.FollowFeedRequest, callOptions ...callopt.Option) (r *video.FollowFeedResponse, err error)
 	PublishAction(ctx context.Context, req *video.PublishActionRequest, callOptions ...callopt.Option) (r *video.PublishActionResponse, err error)
 	PublishList(ctx context.Context, req *video.PublishListRequest, callOptions ...callopt.Option) (r *video.PublishListResponse, err error)
+	PublishStatus(ctx context.Context, req *video.PublishStatusRequest, callOptions ...callopt.Option) (r *video.PublishStatusResponse, err error)
+	UpdateCover(ctx context.Context, req *video.UpdateCoverRequest, callOptions ...callopt.Option) (r *video.UpdateCoverResponse, err error)
+	DeleteVideo(ctx context.Context, req *video.DeleteVideoRequest, callOptions ...callopt.Option) (r *video.DeleteVideoResponse, err error)
+	UpdateVideo(ctx context.Context, req *video.UpdateVideoRequest, callOptions ...callopt.Option) (r *video.UpdateVideoResponse, err error)
+	TopicFeed(ctx context.Context, req *video.TopicFeedRequest, callOptions ...callopt.Option) (r *video.TopicFeedResponse, err error)
+	TrendingTopics(ctx context.Context, req *video.TrendingTopicsRequest, callOptions ...callopt.Option) (r *video.TrendingTopicsResponse, err error)
+	HotVideos(ctx context.Context, req *video.HotVideosRequest, callOptions ...callopt.Option) (r *video.HotVideosResponse, err error)
 	PublishIDList(ctx context.Context, userId int64, callOptions ...callopt.Option) (r []int64, err error)
 	VideoInfo(ctx context.Context, req *video.VideoInfoRequest, callOptions ...callopt.Option) (r *video.Video, err error)
 	VideoInfoList(ctx context.Context, req *video.VideoInfoListRequest, callOptions ...callopt.Option) (r []*video.Video, err error)
 	WorkCount(ctx context.Context, userId int64, callOptions ...callopt.Option) (r int64, err error)
+	BatchWorkCount(ctx context.Context, userIds []int64, callOptions ...callopt.Option) (r map[int64]int64, err error)
 	AuthorId(ctx context.Context, videoId int64, callOptions ...callopt.Option) (r int64, err error)
 	VideoExist(ctx context.Context, videoId int64, callOptions ...callopt.Option) (r bool, err error)
+	ClearSeen(ctx context.Context, userId int64, callOptions ...callopt.Option) (err error)
 }
 
 // NewClient creates a client for the service defined in IDL.
@@ -56,6 +66,11 @@ func (p *kVideoServiceClient) Feed(ctx context.Context, req *video.FeedRequest,
 	return p.kClient.Feed(ctx, req)
 }
 
+func (p *kVideoServiceClient) FollowFeed(ctx context.Context, req *video.FollowFeedRequest, callOptions ...callopt.Option) (r *video.FollowFeedResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.FollowFeed(ctx, req)
+}
+
 func (p *kVideoServiceClient) PublishAction(ctx context.Context, req *video.PublishActionRequest, callOptions ...callopt.Option) (r *video.PublishActionResponse, err error) {
 	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
 	return p.kClient.PublishAction(ctx, req)
@@ -66,6 +81,41 @@ func (p *kVideoServiceClient) PublishList(ctx context.Context, req *video.Publis
 	return p.kClient.PublishList(ctx, req)
 }
 
+func (p *kVideoServiceClient) PublishStatus(ctx context.Context, req *video.PublishStatusRequest, callOptions ...callopt.Option) (r *video.PublishStatusResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.PublishStatus(ctx, req)
+}
+
+func (p *kVideoServiceClient) UpdateCover(ctx context.Context, req *video.UpdateCoverRequest, callOptions ...callopt.Option) (r *video.UpdateCoverResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.UpdateCover(ctx, req)
+}
+
+func (p *kVideoServiceClient) DeleteVideo(ctx context.Context, req *video.DeleteVideoRequest, callOptions ...callopt.Option) (r *video.DeleteVideoResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.DeleteVideo(ctx, req)
+}
+
+func (p *kVideoServiceClient) UpdateVideo(ctx context.Context, req *video.UpdateVideoRequest, callOptions ...callopt.Option) (r *video.UpdateVideoResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.UpdateVideo(ctx, req)
+}
+
+func (p *kVideoServiceClient) TopicFeed(ctx context.Context, req *video.TopicFeedRequest, callOptions ...callopt.Option) (r *video.TopicFeedResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.TopicFeed(ctx, req)
+}
+
+func (p *kVideoServiceClient) TrendingTopics(ctx context.Context, req *video.TrendingTopicsRequest, callOptions ...callopt.Option) (r *video.TrendingTopicsResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.TrendingTopics(ctx, req)
+}
+
+func (p *kVideoServiceClient) HotVideos(ctx context.Context, req *video.HotVideosRequest, callOptions ...callopt.Option) (r *video.HotVideosResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.HotVideos(ctx, req)
+}
+
 func (p *kVideoServiceClient) PublishIDList(ctx context.Context, userId int64, callOptions ...callopt.Option) (r []int64, err error) {
 	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
 	return p.kClient.PublishIDList(ctx, userId)
@@ -86,6 +136,11 @@ func (p *kVideoServiceClient) WorkCount(ctx context.Context, userId int64, callO
 	return p.kClient.WorkCount(ctx, userId)
 }
 
+func (p *kVideoServiceClient) BatchWorkCount(ctx context.Context, userIds []int64, callOptions ...callopt.Option) (r map[int64]int64, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.BatchWorkCount(ctx, userIds)
+}
+
 func (p *kVideoServiceClient) AuthorId(ctx context.Context, videoId int64, callOptions ...callopt.Option) (r int64, err error) {
 	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
 	return p.kClient.AuthorId(ctx, videoId)
@@ -95,3 +150,8 @@ func (p *kVideoServiceClient) VideoExist(ctx context.Context, videoId int64, cal
 	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
 	return p.kClient.VideoExist(ctx, videoId)
 }
+
+func (p *kVideoServiceClient) ClearSeen(ctx context.Context, userId int64, callOptions ...callopt.Option) (err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.ClearSeen(ctx, userId)
+}
@@ -19,15 +19,25 @@ func NewServiceInfo() *kitex.ServiceInfo {
 	serviceName := "VideoService"
 	handlerType := (*video.VideoService)(nil)
 	methods := map[string]kitex.MethodInfo{
-		"Feed":          kitex.NewMethodInfo(feedHandler, newVideoServiceFeedArgs, newVideoServiceFeedResult, false),
-		"PublishAction": kitex.NewMethodInfo(publishActionHandler, newVideoServicePublishActionArgs, newVideoServicePublishActionResult, false),
-		"PublishList":   kitex.NewMethodInfo(publishListHandler, newVideoServicePublishListArgs, newVideoServicePublishListResult, false),
-		"PublishIDList": kitex.NewMethodInfo(publishIDListHandler, newVideoServicePublishIDListArgs, newVideoServicePublishIDListResult, false),
-		"VideoInfo":     kitex.NewMethodInfo(videoInfoHandler, newVideoServiceVideoInfoArgs, newVideoServiceVideoInfoResult, false),
-		"VideoInfoList": kitex.NewMethodInfo(videoInfoListHandler, newVideoServiceVideoInfoListArgs, newVideoServiceVideoInfoListResult, false),
-		"WorkCount":     kitex.NewMethodInfo(workCountHandler, newVideoServiceWorkCountArgs, newVideoServiceWorkCountResult, false),
-		"AuthorId":      kitex.NewMethodInfo(authorIdHandler, newVideoServiceAuthorIdArgs, newVideoServiceAuthorIdResult, false),
-		"VideoExist":    kitex.NewMethodInfo(videoExistHandler, newVideoServiceVideoExistArgs, newVideoServiceVideoExistResult, false),
+		"Feed":           kitex.NewMethodInfo(feedHandler, newVideoServiceFeedArgs, newVideoServiceFeedResult, false),
+		"FollowFeed":     kitex.NewMethodInfo(followFeedHandler, newVideoServiceFollowFeedArgs, newVideoServiceFollowFeedResult, false),
+		"PublishAction":  kitex.NewMethodInfo(publishActionHandler, newVideoServicePublishActionArgs, newVideoServicePublishActionResult, false),
+		"PublishList":    kitex.NewMethodInfo(publishListHandler, newVideoServicePublishListArgs, newVideoServicePublishListResult, false),
+		"PublishStatus":  kitex.NewMethodInfo(publishStatusHandler, newVideoServicePublishStatusArgs, newVideoServicePublishStatusResult, false),
+		"UpdateCover":    kitex.NewMethodInfo(updateCoverHandler, newVideoServiceUpdateCoverArgs, newVideoServiceUpdateCoverResult, false),
+		"DeleteVideo":    kitex.NewMethodInfo(deleteVideoHandler, newVideoServiceDeleteVideoArgs, newVideoServiceDeleteVideoResult, false),
+		"UpdateVideo":    kitex.NewMethodInfo(updateVideoHandler, newVideoServiceUpdateVideoArgs, newVideoServiceUpdateVideoResult, false),
+		"TopicFeed":      kitex.NewMethodInfo(topicFeedHandler, newVideoServiceTopicFeedArgs, newVideoServiceTopicFeedResult, false),
+		"TrendingTopics": kitex.NewMethodInfo(trendingTopicsHandler, newVideoServiceTrendingTopicsArgs, newVideoServiceTrendingTopicsResult, false),
+		"HotVideos":      kitex.NewMethodInfo(hotVideosHandler, newVideoServiceHotVideosArgs, newVideoServiceHotVideosResult, false),
+		"PublishIDList":  kitex.NewMethodInfo(publishIDListHandler, newVideoServicePublishIDListArgs, newVideoServicePublishIDListResult, false),
+		"VideoInfo":      kitex.NewMethodInfo(videoInfoHandler, newVideoServiceVideoInfoArgs, newVideoServiceVideoInfoResult, false),
+		"VideoInfoList":  kitex.NewMethodInfo(videoInfoListHandler, newVideoServiceVideoInfoListArgs, newVideoServiceVideoInfoListResult, false),
+		"WorkCount":      kitex.NewMethodInfo(workCountHandler, newVideoServiceWorkCountArgs, newVideoServiceWorkCountResult, false),
+		"BatchWorkCount": kitex.NewMethodInfo(batchWorkCountHandler, newVideoServiceBatchWorkCountArgs, newVideoServiceBatchWorkCountResult, false),
+		"AuthorId":       kitex.NewMethodInfo(authorIdHandler, newVideoServiceAuthorIdArgs, newVideoServiceAuthorIdResult, false),
+		"VideoExist":     kitex.NewMethodInfo(videoExistHandler, newVideoServiceVideoExistArgs, newVideoServiceVideoExistResult, false),
+		"ClearSeen":      kitex.NewMethodInfo(clearSeenHandler, newVideoServiceClearSeenArgs, newVideoServiceClearSeenResult, false),
 	}
 	extra := map[string]interface{}{
 		"PackageName":     "video",
@@ -62,6 +72,24 @@ func newVideoServiceFeedResult() interface{} {
 	return video.NewVideoServiceFeedResult()
 }
 
+func followFeedHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*video.VideoServiceFollowFeedArgs)
+	realResult := result.(*video.VideoServiceFollowFeedResult)
+	success, err := handler.(video.VideoService).FollowFeed(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newVideoServiceFollowFeedArgs() interface{} {
+	return video.NewVideoServiceFollowFeedArgs()
+}
+
+func newVideoServiceFollowFeedResult() interface{} {
+	return video.NewVideoServiceFollowFeedResult()
+}
+
 func publishActionHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
 	realArg := arg.(*video.VideoServicePublishActionArgs)
 	realResult := result.(*video.VideoServicePublishActionResult)
@@ -98,6 +126,132 @@ func newVideoServicePublishListResult() interface{} {
 	return video.NewVideoServicePublishListResult()
 }
 
+func publishStatusHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*video.VideoServicePublishStatusArgs)
+	realResult := result.(*video.VideoServicePublishStatusResult)
+	success, err := handler.(video.VideoService).PublishStatus(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newVideoServicePublishStatusArgs() interface{} {
+	return video.NewVideoServicePublishStatusArgs()
+}
+
+func newVideoServicePublishStatusResult() interface{} {
+	return video.NewVideoServicePublishStatusResult()
+}
+
+func updateCoverHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*video.VideoServiceUpdateCoverArgs)
+	realResult := result.(*video.VideoServiceUpdateCoverResult)
+	success, err := handler.(video.VideoService).UpdateCover(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newVideoServiceUpdateCoverArgs() interface{} {
+	return video.NewVideoServiceUpdateCoverArgs()
+}
+
+func newVideoServiceUpdateCoverResult() interface{} {
+	return video.NewVideoServiceUpdateCoverResult()
+}
+
+func deleteVideoHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*video.VideoServiceDeleteVideoArgs)
+	realResult := result.(*video.VideoServiceDeleteVideoResult)
+	success, err := handler.(video.VideoService).DeleteVideo(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newVideoServiceDeleteVideoArgs() interface{} {
+	return video.NewVideoServiceDeleteVideoArgs()
+}
+
+func newVideoServiceDeleteVideoResult() interface{} {
+	return video.NewVideoServiceDeleteVideoResult()
+}
+
+func updateVideoHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*video.VideoServiceUpdateVideoArgs)
+	realResult := result.(*video.VideoServiceUpdateVideoResult)
+	success, err := handler.(video.VideoService).UpdateVideo(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newVideoServiceUpdateVideoArgs() interface{} {
+	return video.NewVideoServiceUpdateVideoArgs()
+}
+
+func newVideoServiceUpdateVideoResult() interface{} {
+	return video.NewVideoServiceUpdateVideoResult()
+}
+
+func topicFeedHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*video.VideoServiceTopicFeedArgs)
+	realResult := result.(*video.VideoServiceTopicFeedResult)
+	success, err := handler.(video.VideoService).TopicFeed(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newVideoServiceTopicFeedArgs() interface{} {
+	return video.NewVideoServiceTopicFeedArgs()
+}
+
+func newVideoServiceTopicFeedResult() interface{} {
+	return video.NewVideoServiceTopicFeedResult()
+}
+
+func trendingTopicsHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*video.VideoServiceTrendingTopicsArgs)
+	realResult := result.(*video.VideoServiceTrendingTopicsResult)
+	success, err := handler.(video.VideoService).TrendingTopics(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newVideoServiceTrendingTopicsArgs() interface{} {
+	return video.NewVideoServiceTrendingTopicsArgs()
+}
+
+func newVideoServiceTrendingTopicsResult() interface{} {
+	return video.NewVideoServiceTrendingTopicsResult()
+}
+
+func hotVideosHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*video.VideoServiceHotVideosArgs)
+	realResult := result.(*video.VideoServiceHotVideosResult)
+	success, err := handler.(video.VideoService).HotVideos(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newVideoServiceHotVideosArgs() interface{} {
+	return video.NewVideoServiceHotVideosArgs()
+}
+
+func newVideoServiceHotVideosResult() interface{} {
+	return video.NewVideoServiceHotVideosResult()
+}
+
 func publishIDListHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
 	realArg := arg.(*video.VideoServicePublishIDListArgs)
 	realResult := result.(*video.VideoServicePublishIDListResult)
@@ -170,6 +324,24 @@ func newVideoServiceWorkCountResult() interface{} {
 	return video.NewVideoServiceWorkCountResult()
 }
 
+func batchWorkCountHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*video.VideoServiceBatchWorkCountArgs)
+	realResult := result.(*video.VideoServiceBatchWorkCountResult)
+	success, err := handler.(video.VideoService).BatchWorkCount(ctx, realArg.UserIds)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newVideoServiceBatchWorkCountArgs() interface{} {
+	return video.NewVideoServiceBatchWorkCountArgs()
+}
+
+func newVideoServiceBatchWorkCountResult() interface{} {
+	return video.NewVideoServiceBatchWorkCountResult()
+}
+
 func authorIdHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
 	realArg := arg.(*video.VideoServiceAuthorIdArgs)
 	realResult := result.(*video.VideoServiceAuthorIdResult)
@@ -206,6 +378,24 @@ func newVideoServiceVideoExistResult() interface{} {
 	return video.NewVideoServiceVideoExistResult()
 }
 
+func clearSeenHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*video.VideoServiceClearSeenArgs)
+
+	err := handler.(video.VideoService).ClearSeen(ctx, realArg.UserId)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+func newVideoServiceClearSeenArgs() interface{} {
+	return video.NewVideoServiceClearSeenArgs()
+}
+
+func newVideoServiceClearSeenResult() interface{} {
+	return video.NewVideoServiceClearSeenResult()
+}
+
 type kClient struct {
 	c client.Client
 }
@@ -226,6 +416,16 @@ func (p *kClient) Feed(ctx context.Context, req *video.FeedRequest) (r *video.Fe
 	return _result.GetSuccess(), nil
 }
 
+func (p *kClient) FollowFeed(ctx context.Context, req *video.FollowFeedRequest) (r *video.FollowFeedResponse, err error) {
+	var _args video.VideoServiceFollowFeedArgs
+	_args.Req = req
+	var _result video.VideoServiceFollowFeedResult
+	if err = p.c.Call(ctx, "FollowFeed", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
 func (p *kClient) PublishAction(ctx context.Context, req *video.PublishActionRequest) (r *video.PublishActionResponse, err error) {
 	var _args video.VideoServicePublishActionArgs
 	_args.Req = req
@@ -246,6 +446,76 @@ func (p *kClient) PublishList(ctx context.Context, req *video.PublishListRequest
 	return _result.GetSuccess(), nil
 }
 
+func (p *kClient) PublishStatus(ctx context.Context, req *video.PublishStatusRequest) (r *video.PublishStatusResponse, err error) {
+	var _args video.VideoServicePublishStatusArgs
+	_args.Req = req
+	var _result video.VideoServicePublishStatusResult
+	if err = p.c.Call(ctx, "PublishStatus", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) UpdateCover(ctx context.Context, req *video.UpdateCoverRequest) (r *video.UpdateCoverResponse, err error) {
+	var _args video.VideoServiceUpdateCoverArgs
+	_args.Req = req
+	var _result video.VideoServiceUpdateCoverResult
+	if err = p.c.Call(ctx, "UpdateCover", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) DeleteVideo(ctx context.Context, req *video.DeleteVideoRequest) (r *video.DeleteVideoResponse, err error) {
+	var _args video.VideoServiceDeleteVideoArgs
+	_args.Req = req
+	var _result video.VideoServiceDeleteVideoResult
+	if err = p.c.Call(ctx, "DeleteVideo", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) UpdateVideo(ctx context.Context, req *video.UpdateVideoRequest) (r *video.UpdateVideoResponse, err error) {
+	var _args video.VideoServiceUpdateVideoArgs
+	_args.Req = req
+	var _result video.VideoServiceUpdateVideoResult
+	if err = p.c.Call(ctx, "UpdateVideo", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) TopicFeed(ctx context.Context, req *video.TopicFeedRequest) (r *video.TopicFeedResponse, err error) {
+	var _args video.VideoServiceTopicFeedArgs
+	_args.Req = req
+	var _result video.VideoServiceTopicFeedResult
+	if err = p.c.Call(ctx, "TopicFeed", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) TrendingTopics(ctx context.Context, req *video.TrendingTopicsRequest) (r *video.TrendingTopicsResponse, err error) {
+	var _args video.VideoServiceTrendingTopicsArgs
+	_args.Req = req
+	var _result video.VideoServiceTrendingTopicsResult
+	if err = p.c.Call(ctx, "TrendingTopics", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) HotVideos(ctx context.Context, req *video.HotVideosRequest) (r *video.HotVideosResponse, err error) {
+	var _args video.VideoServiceHotVideosArgs
+	_args.Req = req
+	var _result video.VideoServiceHotVideosResult
+	if err = p.c.Call(ctx, "HotVideos", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
 func (p *kClient) PublishIDList(ctx context.Context, userId int64) (r []int64, err error) {
 	var _args video.VideoServicePublishIDListArgs
 	_args.UserId = userId
@@ -286,6 +556,16 @@ func (p *kClient) WorkCount(ctx context.Context, userId int64) (r int64, err err
 	return _result.GetSuccess(), nil
 }
 
+func (p *kClient) BatchWorkCount(ctx context.Context, userIds []int64) (r map[int64]int64, err error) {
+	var _args video.VideoServiceBatchWorkCountArgs
+	_args.UserIds = userIds
+	var _result video.VideoServiceBatchWorkCountResult
+	if err = p.c.Call(ctx, "BatchWorkCount", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
 func (p *kClient) AuthorId(ctx context.Context, videoId int64) (r int64, err error) {
 	var _args video.VideoServiceAuthorIdArgs
 	_args.VideoId = videoId
@@ -305,3 +585,13 @@ func (p *kClient) VideoExist(ctx context.Context, videoId int64) (r bool, err er
 	}
 	return _result.GetSuccess(), nil
 }
+
+func (p *kClient) ClearSeen(ctx context.Context, userId int64) (err error) {
+	var _args video.VideoServiceClearSeenArgs
+	_args.UserId = userId
+	var _result video.VideoServiceClearSeenResult
+	if err = p.c.Call(ctx, "ClearSeen", &_args, &_result); err != nil {
+		return
+	}
+	return nil
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 
 	"douyin/src/client"
+	"douyin/src/common/cursor"
 	"douyin/src/common/jwt"
 	"douyin/src/dal"
 	"douyin/src/kitex_gen/comment"
@@ -22,11 +23,27 @@ type CommentActionRequest struct {
 	ActionType  int64  `query:"action_type,string" vd:"$==1||$==2"`                                     // 1-发布评论，2-删除评论
 	CommentID   int64  `query:"comment_id,string"  vd:"((ActionType)$==2&&$>0)||(ActionType)$==1"`      // 要删除的评论id，在action_type=2的时候使用
 	CommentText string `query:"comment_text"       vd:"((ActionType)$==1&&len($)>0)||(ActionType)$==2"` // 用户填写的评论内容，在action_type=1的时候使用
+	ParentID    int64  `query:"parent_id,string"   vd:"$>=0"`                                           // 回复的评论id，在action_type=1且回复评论的时候使用
 }
 
 type CommentListRequest struct {
-	VideoID int64  `query:"video_id,string" vd:"$>0"` // 视频id
-	Token   string `query:"token"`                    // 用户登录状态下设置
+	VideoID int64  `query:"video_id,string" vd:"$>0"`                           // 视频id
+	Token   string `query:"token"`                                              // 用户登录状态下设置
+	Cursor  string `query:"cursor"`                                             // 分页游标，不填表示第一页
+	Count   int32  `query:"count,string"`                                       // 每页数量，不填默认30，最大100
+	Sort    string `query:"sort"            vd:"len($)==0||$=='hot'||$=='new'"` // 排序方式，hot-按热度，new-按发布时间(默认)
+}
+
+type CommentFavoriteActionRequest struct {
+	CommentID  int64 `query:"comment_id,string"  vd:"$>0"`        // 评论id
+	ActionType int64 `query:"action_type,string" vd:"$==1||$==2"` // 1-点赞，2-取消点赞
+}
+
+type CommentReplyListRequest struct {
+	CommentID int64  `query:"comment_id,string" vd:"$>0"` // 根评论id
+	Token     string `query:"token"`                      // 用户登录状态下设置
+	Cursor    string `query:"cursor"`                     // 分页游标，不填表示第一页
+	Count     int32  `query:"count,string"`               // 每页数量，不填默认30，最大100
 }
 
 func NewCommentController() *CommentController {
@@ -69,6 +86,7 @@ func (cc *CommentController) Action(c context.Context, ctx *app.RequestContext)
 		ActionType:  req.ActionType,
 		CommentId:   &req.CommentID,
 		CommentText: &req.CommentText,
+		ParentId:    &req.ParentID,
 	})
 	if err != nil {
 		span.RecordError(err)
@@ -85,6 +103,18 @@ func (cc *CommentController) Action(c context.Context, ctx *app.RequestContext)
 			hlog.Error("评论不存在")
 			return
 		}
+		if errorIs(err, dal.ErrContentRejected) {
+			Error(ctx, CodeContentRejected)
+			span.SetStatus(codes.Error, "内容包含违规信息")
+			hlog.Warn("内容包含违规信息")
+			return
+		}
+		if errorIs(err, dal.ErrBlocked) {
+			Error(ctx, CodeBlocked)
+			span.SetStatus(codes.Error, "存在拉黑关系")
+			hlog.Warn("存在拉黑关系")
+			return
+		}
 		Error(ctx, CodeServerBusy)
 		span.SetStatus(codes.Error, "业务逻辑处理失败")
 		hlog.Error("业务逻辑处理失败, err: ", err)
@@ -117,9 +147,18 @@ func (cc *CommentController) List(c context.Context, ctx *app.RequestContext) {
 	resp, err := client.CommentClient.CommentList(c, &comment.CommentListRequest{
 		UserId:  userID,
 		VideoId: req.VideoID,
+		Cursor:  &req.Cursor,
+		Count:   req.Count,
+		Sort:    &req.Sort,
 	})
 	if err != nil {
 		span.RecordError(err)
+		if errorIs(err, cursor.ErrInvalid) {
+			Error(ctx, CodeInvalidParam)
+			span.SetStatus(codes.Error, "分页游标无效")
+			hlog.Error("分页游标无效")
+			return
+		}
 		if errorIs(err, dal.ErrVideoNotExist) {
 			Error(ctx, CodeVideoNotExist)
 			span.SetStatus(codes.Error, "视频不存在")
@@ -135,3 +174,111 @@ func (cc *CommentController) List(c context.Context, ctx *app.RequestContext) {
 	// 返回响应
 	Success(ctx, resp)
 }
+
+func (cc *CommentController) ReplyList(c context.Context, ctx *app.RequestContext) {
+	c, span := otel.Tracer("comment").Start(c, "CommentReplyList")
+	defer span.End()
+
+	// 获取参数
+	req := &CommentReplyListRequest{}
+	err := ctx.BindAndValidate(req)
+	if err != nil {
+		Error(ctx, CodeInvalidParam)
+		span.RecordError(err)
+		span.SetStatus(codes.Error, "参数校验失败")
+		hlog.Error("参数校验失败, err: ", err)
+		return
+	}
+
+	// 验证token
+	userID := jwt.ParseAccessToken(req.Token)
+
+	// 业务逻辑处理
+	resp, err := client.CommentClient.CommentReplyList(c, &comment.CommentReplyListRequest{
+		UserId:    userID,
+		CommentId: req.CommentID,
+		Cursor:    &req.Cursor,
+		Count:     req.Count,
+	})
+	if err != nil {
+		span.RecordError(err)
+		if errorIs(err, cursor.ErrInvalid) {
+			Error(ctx, CodeInvalidParam)
+			span.SetStatus(codes.Error, "分页游标无效")
+			hlog.Error("分页游标无效")
+			return
+		}
+		if errorIs(err, dal.ErrCommentNotExist) {
+			Error(ctx, CodeCommentNotExist)
+			span.SetStatus(codes.Error, "评论不存在")
+			hlog.Error("评论不存在")
+			return
+		}
+		Error(ctx, CodeServerBusy)
+		span.SetStatus(codes.Error, "业务逻辑处理失败")
+		hlog.Error("业务逻辑处理失败, err: ", err)
+		return
+	}
+
+	// 返回响应
+	Success(ctx, resp)
+}
+
+func (cc *CommentController) FavoriteAction(c context.Context, ctx *app.RequestContext) {
+	c, span := otel.Tracer("comment").Start(c, "CommentFavoriteAction")
+	defer span.End()
+
+	// 获取参数
+	req := &CommentFavoriteActionRequest{}
+	err := ctx.BindAndValidate(req)
+	if err != nil {
+		Error(ctx, CodeInvalidParam)
+		span.RecordError(err)
+		span.SetStatus(codes.Error, "参数校验失败")
+		hlog.Error("参数校验失败, err: ", err)
+		return
+	}
+
+	// 解析评论点赞类型
+	if req.ActionType == 2 {
+		req.ActionType = -1
+	}
+
+	// 从认证中间件中获取userID
+	userID := ctx.MustGet(CtxUserIDKey).(int64)
+
+	// 业务逻辑处理
+	resp, err := client.CommentClient.CommentFavoriteAction(c, &comment.CommentFavoriteActionRequest{
+		UserId:     userID,
+		CommentId:  req.CommentID,
+		ActionType: req.ActionType,
+	})
+	if err != nil {
+		span.RecordError(err)
+		if errorIs(err, dal.ErrCommentNotExist) {
+			Error(ctx, CodeCommentNotExist)
+			span.SetStatus(codes.Error, "评论不存在")
+			hlog.Error("评论不存在")
+			return
+		}
+		if errorIs(err, dal.ErrAlreadyFavorite) {
+			Error(ctx, CodeAlreadyFavorite)
+			span.SetStatus(codes.Error, "已经点赞过了")
+			hlog.Error("已经点赞过了")
+			return
+		}
+		if errorIs(err, dal.ErrNotFavorite) {
+			Error(ctx, CodeNotFavorite)
+			span.SetStatus(codes.Error, "还没有点赞过")
+			hlog.Error("还没有点赞过")
+			return
+		}
+		Error(ctx, CodeServerBusy)
+		span.SetStatus(codes.Error, "业务逻辑处理失败")
+		hlog.Error("业务逻辑处理失败, err: ", err)
+		return
+	}
+
+	// 返回响应
+	Success(ctx, resp)
+}
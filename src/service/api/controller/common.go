@@ -30,26 +30,64 @@ const (
 	CodeNotFollow
 	CodeFollowLimit
 	CodeServerBusy
+	CodeContentRejected
+	CodeUploadNotExist
+	CodeUploadIncomplete
+	CodeChecksumMismatch
+	CodeVideoStatus
+	CodeTagNotExist
+	CodeMessageNotExist
+	CodeRecallExpired
+	CodeGroupNotExist
+	CodeNotGroupMember
+	CodeAlreadyInGroup
+	CodeGroupFull
+	CodeGroupPermission
+	CodeGroupBanned
+	CodeAlreadyBlock
+	CodeNotBlock
+	CodeBlocked
+	CodeFriendOnly
+	CodeGroupNotJoinable
 )
 
 var codeMsgMap = map[respCode]string{
-	CodeSuccess:         "请求成功",
-	CodeNoAuthority:     "权限不足",
-	CodeInvalidParam:    "请求参数错误",
-	CodeUserExist:       "用户名已存在",
-	CodeUserNotExist:    "用户名不存在",
-	CodeInvalidPassword: "密码错误",
-	CodeFileTooSmall:    "文件太小",
-	CodeFileTooLarge:    "文件太大",
-	codeLengthLimit:     "字数超过限制",
-	CodeAlreadyFavorite: "已经点赞过了",
-	CodeNotFavorite:     "还没有点赞过",
-	CodeVideoNotExist:   "视频不存在",
-	CodeCommentNotExist: "评论不存在",
-	CodeAlreadyFollow:   "已经关注过了",
-	CodeNotFollow:       "还没有关注过",
-	CodeFollowLimit:     "关注数超过限制",
-	CodeServerBusy:      "服务器繁忙",
+	CodeSuccess:          "请求成功",
+	CodeNoAuthority:      "权限不足",
+	CodeInvalidParam:     "请求参数错误",
+	CodeUserExist:        "用户名已存在",
+	CodeUserNotExist:     "用户名不存在",
+	CodeInvalidPassword:  "密码错误",
+	CodeFileTooSmall:     "文件太小",
+	CodeFileTooLarge:     "文件太大",
+	codeLengthLimit:      "字数超过限制",
+	CodeAlreadyFavorite:  "已经点赞过了",
+	CodeNotFavorite:      "还没有点赞过",
+	CodeVideoNotExist:    "视频不存在",
+	CodeCommentNotExist:  "评论不存在",
+	CodeAlreadyFollow:    "已经关注过了",
+	CodeNotFollow:        "还没有关注过",
+	CodeFollowLimit:      "关注数超过限制",
+	CodeServerBusy:       "服务器繁忙",
+	CodeContentRejected:  "内容包含违规信息",
+	CodeUploadNotExist:   "上传任务不存在",
+	CodeUploadIncomplete: "分片未全部上传",
+	CodeChecksumMismatch: "文件校验失败",
+	CodeVideoStatus:      "视频当前状态不允许该操作",
+	CodeTagNotExist:      "话题不存在",
+	CodeMessageNotExist:  "消息不存在",
+	CodeRecallExpired:    "超过撤回时限",
+	CodeGroupNotExist:    "群聊不存在",
+	CodeNotGroupMember:   "不是群聊成员",
+	CodeAlreadyInGroup:   "已经是群聊成员",
+	CodeGroupFull:        "群聊成员数超过限制",
+	CodeGroupPermission:  "没有群聊操作权限",
+	CodeGroupBanned:      "已被移出群聊",
+	CodeAlreadyBlock:     "已经拉黑过了",
+	CodeNotBlock:         "还没有拉黑过",
+	CodeBlocked:          "存在拉黑关系",
+	CodeFriendOnly:       "对方仅接收好友私信",
+	CodeGroupNotJoinable: "群聊不允许自由加入",
 }
 
 type Response struct {
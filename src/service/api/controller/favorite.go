@@ -4,6 +4,7 @@ import (
 	"context"
 
 	"douyin/src/client"
+	"douyin/src/common/cursor"
 	"douyin/src/common/jwt"
 	"douyin/src/dal"
 	"douyin/src/kitex_gen/favorite"
@@ -24,6 +25,8 @@ type FavoriteActionRequest struct {
 type FavoriteListRequest struct {
 	ToUserID int64  `query:"user_id,string" vd:"$>0"` // 用户id
 	Token    string `query:"token"`                   // 用户登录状态下设置
+	Cursor   string `query:"cursor"`                  // 分页游标，不填表示第一页
+	Count    int32  `query:"count,string"`            // 每页数量，不填默认30，最大100
 }
 
 func NewFavoriteController() *FavoriteController {
@@ -105,8 +108,24 @@ func (fc *FavoriteController) List(c context.Context, ctx *app.RequestContext) {
 	resp, err := client.FavoriteClient.FavoriteList(c, &favorite.FavoriteListRequest{
 		UserId:   userID,
 		AuthorId: req.ToUserID,
+		Cursor:   &req.Cursor,
+		Count:    req.Count,
 	})
 	if err != nil {
+		if errorIs(err, cursor.ErrInvalid) {
+			Error(ctx, CodeInvalidParam)
+			span.RecordError(err)
+			span.SetStatus(codes.Error, "分页游标无效")
+			hlog.Error("分页游标无效")
+			return
+		}
+		if errorIs(err, dal.ErrBlocked) {
+			Error(ctx, CodeBlocked)
+			span.RecordError(err)
+			span.SetStatus(codes.Error, "存在拉黑关系")
+			hlog.Warn("存在拉黑关系")
+			return
+		}
 		Error(ctx, CodeServerBusy)
 		span.RecordError(err)
 		span.SetStatus(codes.Error, "业务处理失败")
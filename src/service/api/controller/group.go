@@ -0,0 +1,285 @@
+package controller
+
+import (
+	"context"
+
+	"douyin/src/client"
+	"douyin/src/dal"
+	"douyin/src/kitex_gen/message"
+
+	"github.com/cloudwego/hertz/pkg/app"
+	"github.com/cloudwego/hertz/pkg/common/hlog"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// groupErrors 群聊业务错误对应的状态码
+var groupErrors = []struct {
+	err  error
+	code respCode
+}{
+	{dal.ErrGroupNotExist, CodeGroupNotExist},
+	{dal.ErrNotGroupMember, CodeNotGroupMember},
+	{dal.ErrAlreadyInGroup, CodeAlreadyInGroup},
+	{dal.ErrGroupFull, CodeGroupFull},
+	{dal.ErrGroupPermission, CodeGroupPermission},
+	{dal.ErrGroupBanned, CodeGroupBanned},
+	{dal.ErrGroupNotJoinable, CodeGroupNotJoinable},
+	{dal.ErrUserNotExist, CodeUserNotExist},
+	{dal.ErrBlocked, CodeBlocked},
+	{dal.ErrFriendOnly, CodeFriendOnly},
+	{dal.ErrContentRejected, CodeContentRejected},
+}
+
+type GroupController struct{}
+
+type CreateGroupRequest struct {
+	Name      string  `query:"name" vd:"len($)>0&&len($)<=90"` // 群聊名称
+	MemberIDs []int64 `query:"member_ids"`                     // 可选参数，初始成员id，可重复传入
+	Joinable  bool    `query:"joinable"`                       // 可选参数，是否允许任何人自由加入，默认不允许
+}
+
+type JoinGroupRequest struct {
+	GroupID int64 `query:"group_id,string" vd:"$>0"` // 群聊id
+}
+
+type LeaveGroupRequest struct {
+	GroupID int64 `query:"group_id,string" vd:"$>0"` // 群聊id
+}
+
+type KickGroupMemberRequest struct {
+	GroupID  int64 `query:"group_id,string"  vd:"$>0"` // 群聊id
+	MemberID int64 `query:"member_id,string" vd:"$>0"` // 被移出的成员id
+}
+
+type SetGroupRoleRequest struct {
+	GroupID  int64 `query:"group_id,string"  vd:"$>0"`        // 群聊id
+	MemberID int64 `query:"member_id,string" vd:"$>0"`        // 成员id
+	Role     int32 `query:"role,string"      vd:"$>=0&&$<=2"` // 0-成员，1-管理员，2-群主(转让群主)
+}
+
+type GroupMemberListRequest struct {
+	GroupID int64  `query:"group_id,string" vd:"$>0"` // 群聊id
+	Cursor  string `query:"cursor"`                   // 分页游标，不填表示第一页
+	Count   int32  `query:"count,string"`             // 每页数量，不填默认30，最大100
+}
+
+func NewGroupController() *GroupController {
+	return &GroupController{}
+}
+
+func (gc *GroupController) Create(c context.Context, ctx *app.RequestContext) {
+	c, span := otel.Tracer("message").Start(c, "CreateGroup")
+	defer span.End()
+
+	// 获取参数
+	req := &CreateGroupRequest{}
+	err := ctx.BindAndValidate(req)
+	if err != nil {
+		Error(ctx, CodeInvalidParam)
+		span.RecordError(err)
+		span.SetStatus(codes.Error, "参数校验失败")
+		hlog.Error("参数校验失败, err: ", err)
+		return
+	}
+
+	// 从认证中间件中获取userID
+	userID := ctx.MustGet(CtxUserIDKey).(int64)
+
+	// 业务逻辑处理
+	resp, err := client.MessageClient.CreateGroup(c, &message.CreateGroupRequest{
+		UserId:    userID,
+		Name:      req.Name,
+		MemberIds: req.MemberIDs,
+		Joinable:  &req.Joinable,
+	})
+	if err != nil {
+		groupError(ctx, span, err)
+		return
+	}
+
+	// 返回响应
+	Success(ctx, resp)
+}
+
+func (gc *GroupController) Join(c context.Context, ctx *app.RequestContext) {
+	c, span := otel.Tracer("message").Start(c, "JoinGroup")
+	defer span.End()
+
+	// 获取参数
+	req := &JoinGroupRequest{}
+	err := ctx.BindAndValidate(req)
+	if err != nil {
+		Error(ctx, CodeInvalidParam)
+		span.RecordError(err)
+		span.SetStatus(codes.Error, "参数校验失败")
+		hlog.Error("参数校验失败, err: ", err)
+		return
+	}
+
+	// 从认证中间件中获取userID
+	userID := ctx.MustGet(CtxUserIDKey).(int64)
+
+	// 业务逻辑处理
+	resp, err := client.MessageClient.JoinGroup(c, &message.JoinGroupRequest{
+		UserId:  userID,
+		GroupId: req.GroupID,
+	})
+	if err != nil {
+		groupError(ctx, span, err)
+		return
+	}
+
+	// 返回响应
+	Success(ctx, resp)
+}
+
+func (gc *GroupController) Leave(c context.Context, ctx *app.RequestContext) {
+	c, span := otel.Tracer("message").Start(c, "LeaveGroup")
+	defer span.End()
+
+	// 获取参数
+	req := &LeaveGroupRequest{}
+	err := ctx.BindAndValidate(req)
+	if err != nil {
+		Error(ctx, CodeInvalidParam)
+		span.RecordError(err)
+		span.SetStatus(codes.Error, "参数校验失败")
+		hlog.Error("参数校验失败, err: ", err)
+		return
+	}
+
+	// 从认证中间件中获取userID
+	userID := ctx.MustGet(CtxUserIDKey).(int64)
+
+	// 业务逻辑处理
+	resp, err := client.MessageClient.LeaveGroup(c, &message.LeaveGroupRequest{
+		UserId:  userID,
+		GroupId: req.GroupID,
+	})
+	if err != nil {
+		groupError(ctx, span, err)
+		return
+	}
+
+	// 返回响应
+	Success(ctx, resp)
+}
+
+func (gc *GroupController) Kick(c context.Context, ctx *app.RequestContext) {
+	c, span := otel.Tracer("message").Start(c, "KickGroupMember")
+	defer span.End()
+
+	// 获取参数
+	req := &KickGroupMemberRequest{}
+	err := ctx.BindAndValidate(req)
+	if err != nil {
+		Error(ctx, CodeInvalidParam)
+		span.RecordError(err)
+		span.SetStatus(codes.Error, "参数校验失败")
+		hlog.Error("参数校验失败, err: ", err)
+		return
+	}
+
+	// 从认证中间件中获取userID
+	userID := ctx.MustGet(CtxUserIDKey).(int64)
+
+	// 业务逻辑处理
+	resp, err := client.MessageClient.KickGroupMember(c, &message.KickGroupMemberRequest{
+		UserId:   userID,
+		GroupId:  req.GroupID,
+		MemberId: req.MemberID,
+	})
+	if err != nil {
+		groupError(ctx, span, err)
+		return
+	}
+
+	// 返回响应
+	Success(ctx, resp)
+}
+
+func (gc *GroupController) SetRole(c context.Context, ctx *app.RequestContext) {
+	c, span := otel.Tracer("message").Start(c, "SetGroupRole")
+	defer span.End()
+
+	// 获取参数
+	req := &SetGroupRoleRequest{}
+	err := ctx.BindAndValidate(req)
+	if err != nil {
+		Error(ctx, CodeInvalidParam)
+		span.RecordError(err)
+		span.SetStatus(codes.Error, "参数校验失败")
+		hlog.Error("参数校验失败, err: ", err)
+		return
+	}
+
+	// 从认证中间件中获取userID
+	userID := ctx.MustGet(CtxUserIDKey).(int64)
+
+	// 业务逻辑处理
+	resp, err := client.MessageClient.SetGroupRole(c, &message.SetGroupRoleRequest{
+		UserId:   userID,
+		GroupId:  req.GroupID,
+		MemberId: req.MemberID,
+		Role:     req.Role,
+	})
+	if err != nil {
+		groupError(ctx, span, err)
+		return
+	}
+
+	// 返回响应
+	Success(ctx, resp)
+}
+
+func (gc *GroupController) MemberList(c context.Context, ctx *app.RequestContext) {
+	c, span := otel.Tracer("message").Start(c, "GroupMemberList")
+	defer span.End()
+
+	// 获取参数
+	req := &GroupMemberListRequest{}
+	err := ctx.BindAndValidate(req)
+	if err != nil {
+		Error(ctx, CodeInvalidParam)
+		span.RecordError(err)
+		span.SetStatus(codes.Error, "参数校验失败")
+		hlog.Error("参数校验失败, err: ", err)
+		return
+	}
+
+	// 从认证中间件中获取userID
+	userID := ctx.MustGet(CtxUserIDKey).(int64)
+
+	// 业务逻辑处理
+	resp, err := client.MessageClient.GroupMemberList(c, &message.GroupMemberListRequest{
+		UserId:  userID,
+		GroupId: req.GroupID,
+		Cursor:  &req.Cursor,
+		Count:   req.Count,
+	})
+	if err != nil {
+		groupError(ctx, span, err)
+		return
+	}
+
+	// 返回响应
+	Success(ctx, resp)
+}
+
+// groupError 将群聊业务错误转换为对应的状态码写入响应，其他错误返回服务器繁忙
+func groupError(ctx *app.RequestContext, span trace.Span, err error) {
+	span.RecordError(err)
+	for _, e := range groupErrors {
+		if errorIs(err, e.err) {
+			Error(ctx, e.code)
+			span.SetStatus(codes.Error, StatusMsg(e.code))
+			hlog.Warn(StatusMsg(e.code))
+			return
+		}
+	}
+	Error(ctx, CodeServerBusy)
+	span.SetStatus(codes.Error, "业务处理失败")
+	hlog.Error("业务处理失败, err: ", err)
+}
@@ -2,10 +2,13 @@ package controller
 
 import (
 	"context"
+	"mime/multipart"
 
 	"douyin/src/client"
-
+	"douyin/src/common/oss"
+	"douyin/src/dal"
 	"douyin/src/kitex_gen/message"
+	"douyin/src/service/api/hub"
 
 	"github.com/cloudwego/hertz/pkg/app"
 	"github.com/cloudwego/hertz/pkg/common/hlog"
@@ -16,14 +19,55 @@ import (
 type MessageController struct{}
 
 type MessageActionRequest struct {
-	ToUserID   int64  `query:"to_user_id,string"  vd:"$>0"`      // 对方用户id
-	ActionType int64  `query:"action_type,string" vd:"$==1"`     // 1-发送消息
-	Content    string `query:"content"            vd:"len($)>0"` // 消息内容
+	ToUserID    int64  `query:"to_user_id,string"`                    // 对方用户id，单聊消息必填
+	GroupID     int64  `query:"group_id,string"`                      // 群聊id，填写时发送到群聊
+	ActionType  int64  `query:"action_type,string"   vd:"$==1"`       // 1-发送消息
+	Content     string `query:"content"`                              // 消息内容，文本消息必填
+	MsgType     int32  `query:"msg_type,string"      vd:"$>=0&&$<=4"` // 可选参数，0-文本(默认)，1-图片，2-视频分享，3-名片，4-表情
+	ImageURL    string `query:"image_url"`                            // 图片地址，图片消息必填，通过图片上传接口获取
+	Width       int32  `query:"width,string"`                         // 图片宽度
+	Height      int32  `query:"height,string"`                        // 图片高度
+	VideoID     int64  `query:"video_id,string"`                      // 分享的视频id，视频分享消息必填
+	ShareUserID int64  `query:"share_user_id,string"`                 // 分享的用户id，名片消息必填
+	StickerID   string `query:"sticker_id"`                           // 表情id，表情消息必填
+}
+
+type UploadImageRequest struct {
+	Image *multipart.FileHeader `form:"image"` // 图片数据
+}
+
+type UploadImageResponse struct {
+	Response
+	ImageURL string `json:"image_url"` // 图片地址，发送图片消息时使用
 }
 
 type MessageChatRequest struct {
-	ToUserID   int64 `query:"to_user_id,string"   vd:"$>0"` // 对方用户id
-	PreMsgTime int64 `query:"pre_msg_time,string"`          // 上一条消息时间
+	ToUserID   int64 `query:"to_user_id,string"`   // 对方用户id，单聊必填
+	GroupID    int64 `query:"group_id,string"`     // 群聊id，填写时查询群聊记录
+	PreMsgTime int64 `query:"pre_msg_time,string"` // 上一条消息时间
+}
+
+type ConversationListRequest struct {
+	Cursor string `query:"cursor"`       // 分页游标，不填表示第一页
+	Count  int32  `query:"count,string"` // 每页数量，不填默认30，最大100
+}
+
+type MarkReadRequest struct {
+	ToUserID int64 `query:"to_user_id,string"` // 对方用户id，单聊必填
+	GroupID  int64 `query:"group_id,string"`   // 群聊id，填写时标记群聊已读
+	ReadTime int64 `query:"read_time,string"`  // 已读到的消息时间，不填表示当前时间
+}
+
+type RecallMessageRequest struct {
+	ToUserID  int64 `query:"to_user_id,string"`          // 对方用户id，单聊必填
+	GroupID   int64 `query:"group_id,string"`            // 群聊id，撤回群聊消息时填写
+	MessageID int64 `query:"message_id,string" vd:"$>0"` // 消息id
+}
+
+type DeleteMessageRequest struct {
+	ToUserID  int64 `query:"to_user_id,string"`          // 对方用户id，单聊必填
+	GroupID   int64 `query:"group_id,string"`            // 群聊id，删除群聊消息时填写
+	MessageID int64 `query:"message_id,string" vd:"$>0"` // 消息id
 }
 
 func NewMessageController() *MessageController {
@@ -45,6 +89,13 @@ func (mc *MessageController) Action(c context.Context, ctx *app.RequestContext)
 		return
 	}
 
+	// 单聊和群聊至少指定一个
+	if req.ToUserID <= 0 && req.GroupID <= 0 {
+		Error(ctx, CodeInvalidParam)
+		hlog.Warn("未指定聊天对象")
+		return
+	}
+
 	// 检查评论字数
 	if len(req.Content) > 500 {
 		Error(ctx, codeLengthLimit)
@@ -52,6 +103,19 @@ func (mc *MessageController) Action(c context.Context, ctx *app.RequestContext)
 		return
 	}
 
+	// 非文本消息的结构化内容由消息服务按类型校验
+	var payload *message.MessagePayload
+	if req.MsgType != 0 {
+		payload = &message.MessagePayload{
+			ImageUrl:    &req.ImageURL,
+			Width:       &req.Width,
+			Height:      &req.Height,
+			VideoId:     &req.VideoID,
+			ShareUserId: &req.ShareUserID,
+			StickerId:   &req.StickerID,
+		}
+	}
+
 	// 从认证中间件中获取userID
 	userID := ctx.MustGet(CtxUserIDKey).(int64)
 
@@ -61,10 +125,61 @@ func (mc *MessageController) Action(c context.Context, ctx *app.RequestContext)
 		ToUserId:   req.ToUserID,
 		ActionType: req.ActionType,
 		Content:    req.Content,
+		MsgType:    req.MsgType,
+		Payload:    payload,
+		GroupId:    &req.GroupID,
 	})
 	if err != nil {
-		Error(ctx, CodeServerBusy)
 		span.RecordError(err)
+		if errorIs(err, dal.ErrContentRejected) {
+			Error(ctx, CodeContentRejected)
+			span.SetStatus(codes.Error, "内容包含违规信息")
+			hlog.Warn("内容包含违规信息")
+			return
+		}
+		if errorIs(err, dal.ErrInvalidMessage) {
+			Error(ctx, CodeInvalidParam)
+			span.SetStatus(codes.Error, "消息内容不合法")
+			hlog.Warn("消息内容不合法")
+			return
+		}
+		if errorIs(err, dal.ErrVideoNotExist) {
+			Error(ctx, CodeVideoNotExist)
+			span.SetStatus(codes.Error, "视频不存在")
+			hlog.Warn("视频不存在")
+			return
+		}
+		if errorIs(err, dal.ErrUserNotExist) {
+			Error(ctx, CodeUserNotExist)
+			span.SetStatus(codes.Error, "用户不存在")
+			hlog.Warn("用户不存在")
+			return
+		}
+		if errorIs(err, dal.ErrGroupNotExist) {
+			Error(ctx, CodeGroupNotExist)
+			span.SetStatus(codes.Error, "群聊不存在")
+			hlog.Warn("群聊不存在")
+			return
+		}
+		if errorIs(err, dal.ErrNotGroupMember) {
+			Error(ctx, CodeNotGroupMember)
+			span.SetStatus(codes.Error, "不是群聊成员")
+			hlog.Warn("不是群聊成员")
+			return
+		}
+		if errorIs(err, dal.ErrBlocked) {
+			Error(ctx, CodeBlocked)
+			span.SetStatus(codes.Error, "存在拉黑关系")
+			hlog.Warn("存在拉黑关系")
+			return
+		}
+		if errorIs(err, dal.ErrFriendOnly) {
+			Error(ctx, CodeFriendOnly)
+			span.SetStatus(codes.Error, "对方仅接收好友私信")
+			hlog.Warn("对方仅接收好友私信")
+			return
+		}
+		Error(ctx, CodeServerBusy)
 		span.SetStatus(codes.Error, "业务处理失败")
 		hlog.Error("业务处理失败, err: ", err)
 		return
@@ -89,6 +204,13 @@ func (mc *MessageController) Chat(c context.Context, ctx *app.RequestContext) {
 		return
 	}
 
+	// 单聊和群聊至少指定一个
+	if req.ToUserID <= 0 && req.GroupID <= 0 {
+		Error(ctx, CodeInvalidParam)
+		hlog.Warn("未指定聊天对象")
+		return
+	}
+
 	// 从认证中间件中获取userID
 	userID := ctx.MustGet(CtxUserIDKey).(int64)
 
@@ -97,6 +219,49 @@ func (mc *MessageController) Chat(c context.Context, ctx *app.RequestContext) {
 		UserId:   userID,
 		ToUserId: req.ToUserID,
 		LastTime: req.PreMsgTime,
+		GroupId:  &req.GroupID,
+	})
+	if err != nil {
+		span.RecordError(err)
+		if errorIs(err, dal.ErrNotGroupMember) {
+			Error(ctx, CodeNotGroupMember)
+			span.SetStatus(codes.Error, "不是群聊成员")
+			hlog.Warn("不是群聊成员")
+			return
+		}
+		Error(ctx, CodeServerBusy)
+		span.SetStatus(codes.Error, "业务处理失败")
+		hlog.Error("业务处理失败, err: ", err)
+		return
+	}
+
+	// 返回响应
+	Success(ctx, resp)
+}
+
+func (mc *MessageController) ConversationList(c context.Context, ctx *app.RequestContext) {
+	c, span := otel.Tracer("message").Start(c, "ConversationList")
+	defer span.End()
+
+	// 获取参数
+	req := &ConversationListRequest{}
+	err := ctx.BindAndValidate(req)
+	if err != nil {
+		Error(ctx, CodeInvalidParam)
+		span.RecordError(err)
+		span.SetStatus(codes.Error, "参数校验失败")
+		hlog.Error("参数校验失败, err: ", err)
+		return
+	}
+
+	// 从认证中间件中获取userID
+	userID := ctx.MustGet(CtxUserIDKey).(int64)
+
+	// 业务逻辑处理
+	resp, err := client.MessageClient.ConversationList(c, &message.ConversationListRequest{
+		UserId: userID,
+		Cursor: &req.Cursor,
+		Count:  req.Count,
 	})
 	if err != nil {
 		Error(ctx, CodeServerBusy)
@@ -109,3 +274,217 @@ func (mc *MessageController) Chat(c context.Context, ctx *app.RequestContext) {
 	// 返回响应
 	Success(ctx, resp)
 }
+
+func (mc *MessageController) MarkRead(c context.Context, ctx *app.RequestContext) {
+	c, span := otel.Tracer("message").Start(c, "MarkRead")
+	defer span.End()
+
+	// 获取参数
+	req := &MarkReadRequest{}
+	err := ctx.BindAndValidate(req)
+	if err != nil {
+		Error(ctx, CodeInvalidParam)
+		span.RecordError(err)
+		span.SetStatus(codes.Error, "参数校验失败")
+		hlog.Error("参数校验失败, err: ", err)
+		return
+	}
+
+	// 单聊和群聊至少指定一个
+	if req.ToUserID <= 0 && req.GroupID <= 0 {
+		Error(ctx, CodeInvalidParam)
+		hlog.Warn("未指定聊天对象")
+		return
+	}
+
+	// 从认证中间件中获取userID
+	userID := ctx.MustGet(CtxUserIDKey).(int64)
+
+	// 业务逻辑处理
+	resp, err := client.MessageClient.MarkRead(c, &message.MarkReadRequest{
+		UserId:   userID,
+		ToUserId: req.ToUserID,
+		ReadTime: req.ReadTime,
+		GroupId:  &req.GroupID,
+	})
+	if err != nil {
+		span.RecordError(err)
+		if errorIs(err, dal.ErrGroupNotExist) {
+			Error(ctx, CodeGroupNotExist)
+			span.SetStatus(codes.Error, "群聊不存在")
+			hlog.Warn("群聊不存在")
+			return
+		}
+		if errorIs(err, dal.ErrNotGroupMember) {
+			Error(ctx, CodeNotGroupMember)
+			span.SetStatus(codes.Error, "不是群聊成员")
+			hlog.Warn("不是群聊成员")
+			return
+		}
+		Error(ctx, CodeServerBusy)
+		span.SetStatus(codes.Error, "业务处理失败")
+		hlog.Error("业务处理失败, err: ", err)
+		return
+	}
+
+	// 返回响应
+	Success(ctx, resp)
+}
+
+func (mc *MessageController) Recall(c context.Context, ctx *app.RequestContext) {
+	c, span := otel.Tracer("message").Start(c, "RecallMessage")
+	defer span.End()
+
+	// 获取参数
+	req := &RecallMessageRequest{}
+	err := ctx.BindAndValidate(req)
+	if err != nil {
+		Error(ctx, CodeInvalidParam)
+		span.RecordError(err)
+		span.SetStatus(codes.Error, "参数校验失败")
+		hlog.Error("参数校验失败, err: ", err)
+		return
+	}
+
+	// 单聊和群聊至少指定一个
+	if req.ToUserID <= 0 && req.GroupID <= 0 {
+		Error(ctx, CodeInvalidParam)
+		hlog.Warn("未指定聊天对象")
+		return
+	}
+
+	// 从认证中间件中获取userID
+	userID := ctx.MustGet(CtxUserIDKey).(int64)
+
+	// 业务逻辑处理
+	resp, err := client.MessageClient.RecallMessage(c, &message.RecallMessageRequest{
+		UserId:    userID,
+		ToUserId:  req.ToUserID,
+		MessageId: req.MessageID,
+		GroupId:   &req.GroupID,
+	})
+	if err != nil {
+		span.RecordError(err)
+		if errorIs(err, dal.ErrMessageNotExist) {
+			Error(ctx, CodeMessageNotExist)
+			span.SetStatus(codes.Error, "消息不存在")
+			hlog.Warn("消息不存在")
+			return
+		}
+		if errorIs(err, dal.ErrRecallExpired) {
+			Error(ctx, CodeRecallExpired)
+			span.SetStatus(codes.Error, "超过撤回时限")
+			hlog.Warn("超过撤回时限")
+			return
+		}
+		if errorIs(err, dal.ErrNotGroupMember) {
+			Error(ctx, CodeNotGroupMember)
+			span.SetStatus(codes.Error, "不是群聊成员")
+			hlog.Warn("不是群聊成员")
+			return
+		}
+		Error(ctx, CodeServerBusy)
+		span.SetStatus(codes.Error, "业务处理失败")
+		hlog.Error("业务处理失败, err: ", err)
+		return
+	}
+
+	// 返回响应
+	Success(ctx, resp)
+}
+
+func (mc *MessageController) Delete(c context.Context, ctx *app.RequestContext) {
+	c, span := otel.Tracer("message").Start(c, "DeleteMessage")
+	defer span.End()
+
+	// 获取参数
+	req := &DeleteMessageRequest{}
+	err := ctx.BindAndValidate(req)
+	if err != nil {
+		Error(ctx, CodeInvalidParam)
+		span.RecordError(err)
+		span.SetStatus(codes.Error, "参数校验失败")
+		hlog.Error("参数校验失败, err: ", err)
+		return
+	}
+
+	// 单聊和群聊至少指定一个
+	if req.ToUserID <= 0 && req.GroupID <= 0 {
+		Error(ctx, CodeInvalidParam)
+		hlog.Warn("未指定聊天对象")
+		return
+	}
+
+	// 从认证中间件中获取userID
+	userID := ctx.MustGet(CtxUserIDKey).(int64)
+
+	// 业务逻辑处理
+	resp, err := client.MessageClient.DeleteMessage(c, &message.DeleteMessageRequest{
+		UserId:    userID,
+		ToUserId:  req.ToUserID,
+		MessageId: req.MessageID,
+		GroupId:   &req.GroupID,
+	})
+	if err != nil {
+		span.RecordError(err)
+		if errorIs(err, dal.ErrMessageNotExist) {
+			Error(ctx, CodeMessageNotExist)
+			span.SetStatus(codes.Error, "消息不存在")
+			hlog.Warn("消息不存在")
+			return
+		}
+		Error(ctx, CodeServerBusy)
+		span.SetStatus(codes.Error, "业务处理失败")
+		hlog.Error("业务处理失败, err: ", err)
+		return
+	}
+
+	// 返回响应
+	Success(ctx, resp)
+}
+
+// UploadImage 上传聊天图片，返回的地址用于发送图片消息
+func (mc *MessageController) UploadImage(c context.Context, ctx *app.RequestContext) {
+	c, span := otel.Tracer("message").Start(c, "UploadImage")
+	defer span.End()
+
+	// 获取参数
+	req := &UploadImageRequest{}
+	err := ctx.BindAndValidate(req)
+	if err != nil || req.Image == nil {
+		Error(ctx, CodeInvalidParam)
+		span.RecordError(err)
+		span.SetStatus(codes.Error, "参数校验失败")
+		hlog.Error("参数校验失败, err: ", err)
+		return
+	}
+
+	// 上传图片
+	imageName, ok := uploadImage(c, ctx, req.Image, oss.UploadChatImage)
+	if !ok {
+		return
+	}
+
+	// 返回响应
+	Success(ctx, &UploadImageResponse{
+		Response: Response{StatusCode: CodeSuccess},
+		ImageURL: oss.ChatImageURL(imageName),
+	})
+}
+
+// Connect 建立WebSocket连接，服务端主动推送新消息，断线期间的消息通过Chat接口拉取
+func (mc *MessageController) Connect(c context.Context, ctx *app.RequestContext) {
+	_, span := otel.Tracer("message").Start(c, "MessageConnect")
+	defer span.End()
+
+	// 从认证中间件中获取userID
+	userID := ctx.MustGet(CtxUserIDKey).(int64)
+
+	// 升级为WebSocket连接，失败时已写入响应
+	if err := hub.Serve(ctx, userID); err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, "建立WebSocket连接失败")
+		hlog.Warn("建立WebSocket连接失败, err: ", err)
+		return
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 
 	"douyin/src/client"
+	"douyin/src/common/cursor"
 	"douyin/src/common/jwt"
 	"douyin/src/dal"
 
@@ -25,6 +26,18 @@ type RelationActionRequest struct {
 type RelationListRequest struct {
 	UserID int64  `query:"user_id,string" vd:"$>0"` // 用户id
 	Token  string `query:"token"`                   // 用户登录状态下设置
+	Cursor string `query:"cursor"`                  // 分页游标，不填表示第一页
+	Count  int32  `query:"count,string"`            // 每页数量，不填默认30，最大100
+}
+
+type RelationBlockRequest struct {
+	Author     int64 `query:"to_user_id,string"  vd:"$>0"`        // 对方用户id
+	ActionType int64 `query:"action_type,string" vd:"$==1||$==2"` // 1-拉黑，2-取消拉黑
+}
+
+type RelationBlockListRequest struct {
+	Cursor string `query:"cursor"`       // 分页游标，不填表示第一页
+	Count  int32  `query:"count,string"` // 每页数量，不填默认30，最大100
 }
 
 func NewRelationController() *RelationController {
@@ -87,6 +100,12 @@ func (rc *RelationController) Action(c context.Context, ctx *app.RequestContext)
 			hlog.Error("关注数超过上限, err: ", err)
 			return
 		}
+		if errorIs(err, dal.ErrBlocked) {
+			Error(ctx, CodeBlocked)
+			span.SetStatus(codes.Error, "存在拉黑关系")
+			hlog.Warn("存在拉黑关系")
+			return
+		}
 		Error(ctx, CodeServerBusy)
 		span.SetStatus(codes.Error, "业务逻辑处理失败")
 		hlog.Error("业务逻辑处理失败, err: ", err)
@@ -119,8 +138,17 @@ func (rc *RelationController) FollowList(c context.Context, ctx *app.RequestCont
 	resp, err := client.RelationClient.RelationFollowList(c, &relation.RelationFollowListRequest{
 		UserId:   userID,
 		AuthorId: req.UserID,
+		Cursor:   &req.Cursor,
+		Count:    req.Count,
 	})
 	if err != nil {
+		if errorIs(err, cursor.ErrInvalid) {
+			Error(ctx, CodeInvalidParam)
+			span.RecordError(err)
+			span.SetStatus(codes.Error, "分页游标无效")
+			hlog.Error("分页游标无效")
+			return
+		}
 		Error(ctx, CodeServerBusy)
 		span.RecordError(err)
 		span.SetStatus(codes.Error, "业务逻辑处理失败")
@@ -154,8 +182,17 @@ func (rc *RelationController) FollowerList(c context.Context, ctx *app.RequestCo
 	resp, err := client.RelationClient.RelationFollowerList(c, &relation.RelationFollowerListRequest{
 		UserId:   userID,
 		AuthorId: req.UserID,
+		Cursor:   &req.Cursor,
+		Count:    req.Count,
 	})
 	if err != nil {
+		if errorIs(err, cursor.ErrInvalid) {
+			Error(ctx, CodeInvalidParam)
+			span.RecordError(err)
+			span.SetStatus(codes.Error, "分页游标无效")
+			hlog.Error("分页游标无效")
+			return
+		}
 		Error(ctx, CodeServerBusy)
 		span.RecordError(err)
 		span.SetStatus(codes.Error, "业务逻辑处理失败")
@@ -189,8 +226,117 @@ func (rc *RelationController) FriendList(c context.Context, ctx *app.RequestCont
 	resp, err := client.RelationClient.RelationFriendList(c, &relation.RelationFriendListRequest{
 		UserId:   userID,
 		AuthorId: req.UserID,
+		Cursor:   &req.Cursor,
+		Count:    req.Count,
 	})
 	if err != nil {
+		if errorIs(err, cursor.ErrInvalid) {
+			Error(ctx, CodeInvalidParam)
+			span.RecordError(err)
+			span.SetStatus(codes.Error, "分页游标无效")
+			hlog.Error("分页游标无效")
+			return
+		}
+		Error(ctx, CodeServerBusy)
+		span.RecordError(err)
+		span.SetStatus(codes.Error, "业务逻辑处理失败")
+		hlog.Error("业务逻辑处理失败, err: ", err)
+		return
+	}
+
+	// 返回响应
+	Success(ctx, resp)
+}
+
+// Block 拉黑或取消拉黑用户，拉黑时同时解除双方的关注关系
+func (rc *RelationController) Block(c context.Context, ctx *app.RequestContext) {
+	c, span := otel.Tracer("relation").Start(c, "RelationBlock")
+	defer span.End()
+
+	// 获取参数
+	req := &RelationBlockRequest{}
+	err := ctx.BindAndValidate(req)
+	if err != nil {
+		Error(ctx, CodeInvalidParam)
+		span.RecordError(err)
+		span.SetStatus(codes.Error, "参数校验失败")
+		hlog.Error("参数校验失败, err: ", err)
+		return
+	}
+
+	// 从认证中间件中获取userID
+	userID := ctx.MustGet(CtxUserIDKey).(int64)
+
+	// 不能拉黑自己
+	if userID == req.Author {
+		Error(ctx, CodeInvalidParam)
+		hlog.Warn("不能拉黑自己")
+		return
+	}
+
+	// 业务逻辑处理
+	resp, err := client.RelationClient.RelationBlock(c, &relation.RelationBlockRequest{
+		UserId:     userID,
+		AuthorId:   req.Author,
+		ActionType: req.ActionType,
+	})
+	if err != nil {
+		span.RecordError(err)
+		if errorIs(err, dal.ErrAlreadyBlock) {
+			Error(ctx, CodeAlreadyBlock)
+			span.SetStatus(codes.Error, "已经拉黑过了")
+			hlog.Warn("已经拉黑过了")
+			return
+		}
+		if errorIs(err, dal.ErrNotBlock) {
+			Error(ctx, CodeNotBlock)
+			span.SetStatus(codes.Error, "还没有拉黑过")
+			hlog.Warn("还没有拉黑过")
+			return
+		}
+		Error(ctx, CodeServerBusy)
+		span.SetStatus(codes.Error, "业务逻辑处理失败")
+		hlog.Error("业务逻辑处理失败, err: ", err)
+		return
+	}
+
+	// 返回响应
+	Success(ctx, resp)
+}
+
+// BlockList 当前用户的黑名单
+func (rc *RelationController) BlockList(c context.Context, ctx *app.RequestContext) {
+	c, span := otel.Tracer("relation").Start(c, "RelationBlockList")
+	defer span.End()
+
+	// 获取参数
+	req := &RelationBlockListRequest{}
+	err := ctx.BindAndValidate(req)
+	if err != nil {
+		Error(ctx, CodeInvalidParam)
+		span.RecordError(err)
+		span.SetStatus(codes.Error, "参数校验失败")
+		hlog.Error("参数校验失败, err: ", err)
+		return
+	}
+
+	// 从认证中间件中获取userID
+	userID := ctx.MustGet(CtxUserIDKey).(int64)
+
+	// 业务逻辑处理
+	resp, err := client.RelationClient.RelationBlockList(c, &relation.RelationBlockListRequest{
+		UserId: userID,
+		Cursor: &req.Cursor,
+		Count:  req.Count,
+	})
+	if err != nil {
+		if errorIs(err, cursor.ErrInvalid) {
+			Error(ctx, CodeInvalidParam)
+			span.RecordError(err)
+			span.SetStatus(codes.Error, "分页游标无效")
+			hlog.Error("分页游标无效")
+			return
+		}
 		Error(ctx, CodeServerBusy)
 		span.RecordError(err)
 		span.SetStatus(codes.Error, "业务逻辑处理失败")
@@ -0,0 +1,86 @@
+package controller
+
+import (
+	"context"
+
+	"douyin/src/client"
+	"douyin/src/common/cursor"
+	"douyin/src/common/jwt"
+	"douyin/src/kitex_gen/search"
+
+	"github.com/cloudwego/hertz/pkg/app"
+	"github.com/cloudwego/hertz/pkg/common/hlog"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+)
+
+const searchTypeUser = "user" // 搜索用户，默认搜索视频
+
+type SearchController struct{}
+
+type SearchRequest struct {
+	Keyword string `query:"keyword" vd:"len($)>0&&len($)<=100"`            // 搜索关键词
+	Type    string `query:"type"    vd:"len($)==0||$=='video'||$=='user'"` // 搜索类型，video-视频(默认)，user-用户
+	Token   string `query:"token"`                                         // 用户登录状态下设置
+	Cursor  string `query:"cursor"`                                        // 分页游标，不填表示第一页
+	Count   int32  `query:"count,string"`                                  // 每页数量，不填默认30，最大100
+}
+
+func NewSearchController() *SearchController {
+	return &SearchController{}
+}
+
+// Search 不限制登录状态，按相关度返回匹配关键词的视频或用户
+func (sc *SearchController) Search(c context.Context, ctx *app.RequestContext) {
+	c, span := otel.Tracer("search").Start(c, "Search")
+	defer span.End()
+
+	// 获取参数
+	req := &SearchRequest{}
+	err := ctx.BindAndValidate(req)
+	if err != nil {
+		Error(ctx, CodeInvalidParam)
+		span.RecordError(err)
+		span.SetStatus(codes.Error, "参数校验失败")
+		hlog.Error("参数校验失败, err: ", err)
+		return
+	}
+
+	// 验证token
+	userID := jwt.ParseAccessToken(req.Token)
+
+	// 业务逻辑处理
+	var resp any
+	if req.Type == searchTypeUser {
+		resp, err = client.SearchClient.SearchUsers(c, &search.SearchUsersRequest{
+			UserId:  userID,
+			Keyword: req.Keyword,
+			Cursor:  &req.Cursor,
+			Count:   req.Count,
+		})
+	} else {
+		resp, err = client.SearchClient.SearchVideos(c, &search.SearchVideosRequest{
+			UserId:  userID,
+			Keyword: req.Keyword,
+			Cursor:  &req.Cursor,
+			Count:   req.Count,
+		})
+	}
+	if err != nil {
+		if errorIs(err, cursor.ErrInvalid) {
+			Error(ctx, CodeInvalidParam)
+			span.RecordError(err)
+			span.SetStatus(codes.Error, "分页游标无效")
+			hlog.Error("分页游标无效")
+			return
+		}
+		Error(ctx, CodeServerBusy)
+		span.RecordError(err)
+		span.SetStatus(codes.Error, "业务处理失败")
+		hlog.Error("业务处理失败, err: ", err)
+		return
+	}
+
+	// 返回响应
+	Success(ctx, resp)
+}
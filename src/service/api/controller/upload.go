@@ -0,0 +1,346 @@
+package controller
+
+import (
+	"bytes"
+	"context"
+
+	"douyin/src/client"
+	"douyin/src/common/oss"
+	"douyin/src/dal"
+	"douyin/src/kitex_gen/video"
+
+	"github.com/cloudwego/hertz/pkg/app"
+	"github.com/cloudwego/hertz/pkg/common/hlog"
+	"github.com/google/uuid"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+)
+
+const uploadChunkSize = 5 * 1024 * 1024 // 5MB
+
+type UploadInitRequest struct {
+	FileSize int64  `query:"file_size,string" vd:"$>0"`                      // 文件大小，单位字节
+	Checksum string `query:"checksum"         vd:"regexp('^[0-9a-f]{64}$')"` // 文件的sha256，小写十六进制
+}
+
+type UploadInitResponse struct {
+	Response
+	UploadID       string  `json:"upload_id"`       // 上传任务id
+	ChunkSize      int64   `json:"chunk_size"`      // 分片大小，最后一个分片可以更小
+	ChunkCount     int64   `json:"chunk_count"`     // 分片数量
+	UploadedChunks []int64 `json:"uploaded_chunks"` // 已上传的分片序号，断点续传时跳过
+}
+
+type UploadChunkRequest struct {
+	UploadID string `query:"upload_id"    vd:"len($)>0"` // 上传任务id
+	Index    int64  `query:"index,string" vd:"$>=0"`     // 分片序号，从0开始
+}
+
+type UploadCompleteRequest struct {
+	UploadID   string `query:"upload_id"  vd:"len($)>0"`   // 上传任务id
+	Title      string `query:"title"      vd:"len($)>0"`   // 视频标题
+	Visibility int32  `query:"visibility" vd:"$>=0&&$<=2"` // 可选参数，0-公开(默认)，1-好友可见，2-仅自己可见
+}
+
+// UploadInit 创建分片上传任务，同一用户对同一文件未完成的任务会被复用以支持断点续传
+func (vc *VideoController) UploadInit(c context.Context, ctx *app.RequestContext) {
+	c, span := otel.Tracer("video").Start(c, "UploadInit")
+	defer span.End()
+
+	// 获取参数
+	req := &UploadInitRequest{}
+	err := ctx.BindAndValidate(req)
+	if err != nil {
+		Error(ctx, CodeInvalidParam)
+		span.RecordError(err)
+		span.SetStatus(codes.Error, "参数校验失败")
+		hlog.Error("参数校验失败, err: ", err)
+		return
+	}
+
+	// 验证大小
+	if req.FileSize < minFileSize {
+		Error(ctx, CodeFileTooSmall)
+		hlog.Warn("文件太小")
+		return
+	}
+	if req.FileSize > maxFileSize {
+		Error(ctx, CodeFileTooLarge)
+		hlog.Warn("文件太大")
+		return
+	}
+
+	// 从认证中间件中获取userID
+	userID := ctx.MustGet(CtxUserIDKey).(int64)
+
+	// 查找未完成的上传任务
+	session, err := dal.FindUploadSession(c, userID, req.Checksum)
+	if err == nil && session.FileSize != req.FileSize {
+		err = dal.ErrUploadNotExist
+	}
+	if err == dal.ErrUploadNotExist {
+		// 创建新的上传任务
+		session = &dal.UploadSession{
+			UploadID:   uuid.New().String(),
+			UserID:     userID,
+			FileSize:   req.FileSize,
+			ChunkSize:  uploadChunkSize,
+			ChunkCount: (req.FileSize + uploadChunkSize - 1) / uploadChunkSize,
+			Checksum:   req.Checksum,
+		}
+		err = dal.CreateUploadSession(c, session)
+	}
+	if err != nil {
+		Error(ctx, CodeServerBusy)
+		span.RecordError(err)
+		span.SetStatus(codes.Error, "创建上传任务失败")
+		hlog.Error("创建上传任务失败, err: ", err)
+		return
+	}
+
+	// 获取已上传的分片
+	uploadedChunks, err := dal.GetUploadedChunks(c, session.UploadID)
+	if err != nil {
+		Error(ctx, CodeServerBusy)
+		span.RecordError(err)
+		span.SetStatus(codes.Error, "获取已上传分片失败")
+		hlog.Error("获取已上传分片失败, err: ", err)
+		return
+	}
+
+	// 返回响应
+	Success(ctx, &UploadInitResponse{
+		Response:       Response{StatusCode: CodeSuccess},
+		UploadID:       session.UploadID,
+		ChunkSize:      session.ChunkSize,
+		ChunkCount:     session.ChunkCount,
+		UploadedChunks: uploadedChunks,
+	})
+}
+
+// UploadChunk 上传一个分片，请求体为分片的原始数据，重复上传同一分片会覆盖
+func (vc *VideoController) UploadChunk(c context.Context, ctx *app.RequestContext) {
+	c, span := otel.Tracer("video").Start(c, "UploadChunk")
+	defer span.End()
+
+	// 获取参数
+	req := &UploadChunkRequest{}
+	err := ctx.BindAndValidate(req)
+	if err != nil {
+		Error(ctx, CodeInvalidParam)
+		span.RecordError(err)
+		span.SetStatus(codes.Error, "参数校验失败")
+		hlog.Error("参数校验失败, err: ", err)
+		return
+	}
+
+	// 从认证中间件中获取userID
+	userID := ctx.MustGet(CtxUserIDKey).(int64)
+
+	// 获取上传任务
+	session, ok := getUploadSession(c, ctx, req.UploadID, userID)
+	if !ok {
+		return
+	}
+
+	// 校验分片序号和长度
+	if req.Index >= session.ChunkCount {
+		Error(ctx, CodeInvalidParam)
+		hlog.Warn("分片序号超出范围")
+		return
+	}
+	chunkLength := session.ChunkLength(req.Index)
+	if contentLength := ctx.Request.Header.ContentLength(); contentLength >= 0 && int64(contentLength) != chunkLength {
+		Error(ctx, CodeInvalidParam)
+		hlog.Warn("分片长度不正确")
+		return
+	}
+	body := ctx.Request.Body()
+	if int64(len(body)) != chunkLength {
+		Error(ctx, CodeInvalidParam)
+		hlog.Warn("分片长度不正确")
+		return
+	}
+
+	// 第一个分片判断MIME类型是否是视频
+	if req.Index == 0 && !isVideo(body[:min(len(body), sniffLen)]) {
+		Error(ctx, CodeInvalidParam)
+		hlog.Warn("文件类型不是视频")
+		return
+	}
+
+	// 暂存分片
+	if err := oss.PutChunk(c, session.UploadID, req.Index, bytes.NewReader(body)); err != nil {
+		Error(ctx, CodeServerBusy)
+		span.RecordError(err)
+		span.SetStatus(codes.Error, "暂存分片失败")
+		hlog.Error("暂存分片失败, err: ", err)
+		return
+	}
+	if err := dal.MarkChunkUploaded(c, session, req.Index); err != nil {
+		Error(ctx, CodeServerBusy)
+		span.RecordError(err)
+		span.SetStatus(codes.Error, "记录分片失败")
+		hlog.Error("记录分片失败, err: ", err)
+		return
+	}
+
+	// 返回响应
+	Success(ctx, &Response{StatusCode: CodeSuccess})
+}
+
+// UploadComplete 合并分片并校验文件，通过后发布视频
+func (vc *VideoController) UploadComplete(c context.Context, ctx *app.RequestContext) {
+	c, span := otel.Tracer("video").Start(c, "UploadComplete")
+	defer span.End()
+
+	// 获取参数
+	req := &UploadCompleteRequest{}
+	err := ctx.BindAndValidate(req)
+	if err != nil {
+		Error(ctx, CodeInvalidParam)
+		span.RecordError(err)
+		span.SetStatus(codes.Error, "参数校验失败")
+		hlog.Error("参数校验失败, err: ", err)
+		return
+	}
+
+	// 检查标题字数
+	if len(req.Title) > 30 {
+		Error(ctx, codeLengthLimit)
+		hlog.Warn("标题字数超过限制")
+		return
+	}
+
+	// 从认证中间件中获取userID
+	userID := ctx.MustGet(CtxUserIDKey).(int64)
+
+	// 获取上传任务
+	session, ok := getUploadSession(c, ctx, req.UploadID, userID)
+	if !ok {
+		return
+	}
+
+	// 检查分片是否全部上传
+	uploadedChunks, err := dal.GetUploadedChunks(c, session.UploadID)
+	if err != nil {
+		Error(ctx, CodeServerBusy)
+		span.RecordError(err)
+		span.SetStatus(codes.Error, "获取已上传分片失败")
+		hlog.Error("获取已上传分片失败, err: ", err)
+		return
+	}
+	if int64(len(uploadedChunks)) != session.ChunkCount {
+		Error(ctx, CodeUploadIncomplete)
+		hlog.Warn("分片未全部上传")
+		return
+	}
+
+	// 加锁防止重复提交
+	locked, err := dal.LockUpload(c, session.UploadID)
+	if err != nil {
+		Error(ctx, CodeServerBusy)
+		span.RecordError(err)
+		span.SetStatus(codes.Error, "获取上传任务锁失败")
+		hlog.Error("获取上传任务锁失败, err: ", err)
+		return
+	}
+	if !locked {
+		Error(ctx, CodeServerBusy)
+		hlog.Warn("上传任务正在合并")
+		return
+	}
+
+	// 合并分片并校验
+	videoName := uuid.New().String() + ".mp4"
+	checksum, err := oss.ComposeChunks(c, session.UploadID, session.ChunkCount, videoName)
+	if err != nil {
+		releaseUpload(c, session.UploadID)
+		Error(ctx, CodeServerBusy)
+		span.RecordError(err)
+		span.SetStatus(codes.Error, "合并分片失败")
+		hlog.Error("合并分片失败, err: ", err)
+		return
+	}
+	if checksum != session.Checksum {
+		// 无法确定损坏的分片，删除任务让客户端重新上传
+		discardVideo(c, videoName)
+		if err := oss.DeleteChunks(c, session.UploadID, session.ChunkCount); err != nil {
+			hlog.Error("删除分片失败, err: ", err)
+		}
+		if err := dal.DeleteUploadSession(c, session); err != nil {
+			hlog.Error("删除上传任务失败, err: ", err)
+		}
+		Error(ctx, CodeChecksumMismatch)
+		span.SetStatus(codes.Error, "文件校验失败")
+		hlog.Warn("文件校验失败")
+		return
+	}
+
+	// 业务逻辑处理
+	resp, err := client.VideoClient.PublishAction(c, &video.PublishActionRequest{
+		UserId:     userID,
+		Title:      req.Title,
+		VideoName:  videoName,
+		Visibility: req.Visibility,
+	})
+	if err != nil {
+		discardVideo(c, videoName)
+		releaseUpload(c, session.UploadID)
+		span.RecordError(err)
+		if errorIs(err, dal.ErrContentRejected) {
+			Error(ctx, CodeContentRejected)
+			span.SetStatus(codes.Error, "内容包含违规信息")
+			hlog.Warn("内容包含违规信息")
+			return
+		}
+		Error(ctx, CodeServerBusy)
+		span.SetStatus(codes.Error, "业务处理失败")
+		hlog.Error("业务处理失败, err: ", err)
+		return
+	}
+
+	// 清理暂存的分片和上传任务
+	if err := oss.DeleteChunks(c, session.UploadID, session.ChunkCount); err != nil {
+		hlog.Error("删除分片失败, err: ", err)
+	}
+	if err := dal.DeleteUploadSession(c, session); err != nil {
+		hlog.Error("删除上传任务失败, err: ", err)
+	}
+
+	// 返回响应
+	Success(ctx, resp)
+}
+
+// getUploadSession 获取当前用户的上传任务，失败时直接写入错误响应
+func getUploadSession(c context.Context, ctx *app.RequestContext, uploadID string, userID int64) (*dal.UploadSession, bool) {
+	session, err := dal.GetUploadSession(c, uploadID)
+	if err == nil && session.UserID != userID {
+		err = dal.ErrUploadNotExist
+	}
+	if err == dal.ErrUploadNotExist {
+		Error(ctx, CodeUploadNotExist)
+		hlog.Warn("上传任务不存在")
+		return nil, false
+	}
+	if err != nil {
+		Error(ctx, CodeServerBusy)
+		hlog.Error("获取上传任务失败, err: ", err)
+		return nil, false
+	}
+	return session, true
+}
+
+// releaseUpload 合并失败时释放锁，允许客户端重试
+func releaseUpload(c context.Context, uploadID string) {
+	if err := dal.UnlockUpload(c, uploadID); err != nil {
+		hlog.Error("释放上传任务锁失败, err: ", err)
+	}
+}
+
+// discardVideo 发布失败时删除已上传的视频
+func discardVideo(c context.Context, videoName string) {
+	if err := oss.DeleteVideo(c, videoName); err != nil {
+		hlog.Error("删除视频失败, err: ", err)
+	}
+}
@@ -28,6 +28,10 @@ type UserRequest struct {
 	Password string `query:"password" vd:"5<len($)&&len($)<33"` // 密码，最长32个字符
 }
 
+type UserPrivacyRequest struct {
+	MessagePrivacy int32 `query:"message_privacy,string" vd:"$==0||$==1"` // 私信权限，0-所有人，1-仅好友(互相关注)
+}
+
 func NewUserController() *UserController {
 	return &UserController{}
 }
@@ -157,3 +161,66 @@ func (uc *UserController) Login(c context.Context, ctx *app.RequestContext) {
 	// 返回响应
 	Success(ctx, resp)
 }
+
+// Logout 登出，token无状态无需处理，只清理服务端的用户会话数据
+func (uc *UserController) Logout(c context.Context, ctx *app.RequestContext) {
+	c, span := otel.Tracer("user").Start(c, "Logout")
+	defer span.End()
+
+	// 从认证中间件中获取userID
+	userID := ctx.MustGet(CtxUserIDKey).(int64)
+
+	// 清空已看视频记录
+	if err := client.VideoClient.ClearSeen(c, userID); err != nil {
+		Error(ctx, CodeServerBusy)
+		span.RecordError(err)
+		span.SetStatus(codes.Error, "清空已看视频记录失败")
+		hlog.Error("清空已看视频记录失败, err: ", err)
+		return
+	}
+
+	// 返回响应
+	Success(ctx, &Response{StatusCode: CodeSuccess})
+}
+
+// Privacy 更新当前用户的私信权限
+func (uc *UserController) Privacy(c context.Context, ctx *app.RequestContext) {
+	c, span := otel.Tracer("user").Start(c, "UpdatePrivacy")
+	defer span.End()
+
+	// 获取参数
+	req := &UserPrivacyRequest{}
+	err := ctx.BindAndValidate(req)
+	if err != nil {
+		Error(ctx, CodeInvalidParam)
+		span.RecordError(err)
+		span.SetStatus(codes.Error, "参数校验失败")
+		hlog.Error("参数校验失败, err: ", err)
+		return
+	}
+
+	// 从认证中间件中获取userID
+	userID := ctx.MustGet(CtxUserIDKey).(int64)
+
+	// 业务逻辑处理
+	resp, err := client.UserClient.UpdatePrivacy(c, &user.UserPrivacyRequest{
+		UserId:         userID,
+		MessagePrivacy: req.MessagePrivacy,
+	})
+	if err != nil {
+		span.RecordError(err)
+		if errorIs(err, dal.ErrUserNotExist) {
+			Error(ctx, CodeUserNotExist)
+			span.SetStatus(codes.Error, "用户不存在")
+			hlog.Warn("用户不存在")
+			return
+		}
+		Error(ctx, CodeServerBusy)
+		span.SetStatus(codes.Error, "业务处理失败")
+		hlog.Error("业务处理失败, err: ", err)
+		return
+	}
+
+	// 返回响应
+	Success(ctx, resp)
+}
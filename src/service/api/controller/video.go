@@ -1,42 +1,105 @@
 package controller
 
 import (
+	"bytes"
 	"context"
+	"io"
 	"mime/multipart"
 	"net/http"
+	"strings"
 	"time"
 
 	"douyin/src/client"
+	"douyin/src/common/cursor"
 	"douyin/src/common/jwt"
-
+	"douyin/src/common/oss"
+	"douyin/src/dal"
 	"douyin/src/kitex_gen/video"
 
 	"github.com/cloudwego/hertz/pkg/app"
 	"github.com/cloudwego/hertz/pkg/common/hlog"
+	"github.com/google/uuid"
 	"go.opentelemetry.io/otel"
 	"go.opentelemetry.io/otel/codes"
 )
 
 const (
-	minFileSize = 1 * 1024 * 1024   // 1MB
-	maxFileSize = 500 * 1024 * 1024 // 500MB
+	minFileSize  = 1 * 1024 * 1024   // 1MB
+	maxFileSize  = 500 * 1024 * 1024 // 500MB
+	sniffLen     = 512               // 判断MIME类型需要读取的字节数
+	maxCoverSize = 5 * 1024 * 1024   // 5MB
 )
 
+// coverExts 支持的封面图片类型及对应的扩展名
+var coverExts = map[string]string{
+	"image/jpeg": ".jpeg",
+	"image/png":  ".png",
+	"image/webp": ".webp",
+}
+
 type VideoController struct{}
 
 type FeedRequest struct {
 	LatestTime int64  `query:"latest_time,string"` // 可选参数，限制返回视频的最新投稿时间戳，精确到秒，不填表示当前时间
 	Token      string `query:"token"`              // 用户登录状态下设置
+	Mode       int32  `query:"mode,string"`        // 可选参数，0-按投稿时间倒序，1-推荐排序
+	Cursor     string `query:"cursor"`             // 可选参数，推荐排序时候选窗口内的分页游标
+}
+
+type FollowFeedRequest struct {
+	LatestTime int64 `query:"latest_time,string"` // 可选参数，限制返回视频的最新投稿时间戳，精确到秒，不填表示当前时间
+	LastID     int64 `query:"last_id,string"`     // 可选参数，上一页返回的next_id，与latest_time一起定位下一页的起点
 }
 
 type PublishActionRequest struct {
-	Data  *multipart.FileHeader `form:"data"`                // 视频数据
-	Title string                `form:"title" vd:"len($)>0"` // 视频标题
+	Data       *multipart.FileHeader `form:"data"`                       // 视频数据
+	Title      string                `form:"title" vd:"len($)>0"`        // 视频标题
+	Cover      *multipart.FileHeader `form:"cover"`                      // 可选参数，自定义封面图片，不填则从视频中自动挑选
+	Visibility int32                 `form:"visibility" vd:"$>=0&&$<=2"` // 可选参数，0-公开(默认)，1-好友可见，2-仅自己可见
 }
 
 type PublishListRequest struct {
 	UserID int64  `query:"user_id,string" vd:"$>0"` // 用户id
 	Token  string `query:"token"`                   // 用户登录状态下设置
+	Cursor string `query:"cursor"`                  // 分页游标，不填表示第一页
+	Count  int32  `query:"count,string"`            // 每页数量，不填默认30，最大100
+}
+
+type UpdateCoverRequest struct {
+	VideoID int64                 `form:"video_id" vd:"$>0"` // 视频id
+	Cover   *multipart.FileHeader `form:"cover"`             // 封面图片
+}
+
+type DeleteVideoRequest struct {
+	VideoID int64 `query:"video_id,string" vd:"$>0"` // 视频id
+}
+
+type UpdateVideoRequest struct {
+	VideoID    int64   `query:"video_id,string" vd:"$>0"` // 视频id
+	Title      *string `query:"title"`                    // 可选参数，新标题
+	Visibility *int32  `query:"visibility"`               // 可选参数，0-公开，1-好友可见，2-仅自己可见
+}
+
+type PublishStatusRequest struct {
+	VideoID int64 `query:"video_id,string" vd:"$>0"` // 视频id
+}
+
+type TopicFeedRequest struct {
+	Tag    string `query:"tag"             vd:"len($)>0"`                      // 话题名称，不含#
+	Token  string `query:"token"`                                              // 用户登录状态下设置
+	Sort   string `query:"sort"            vd:"len($)==0||$=='hot'||$=='new'"` // 排序方式，hot-按热度，new-按发布时间(默认)
+	Cursor string `query:"cursor"`                                             // 分页游标，不填表示第一页
+	Count  int32  `query:"count,string"`                                       // 每页数量，不填默认30，最大100
+}
+
+type HotVideosRequest struct {
+	Window string `query:"window" vd:"len($)==0||$=='hour'||$=='day'||$=='week'"` // 统计窗口，hour-最近1小时，day-最近1天(默认)，week-最近1周
+	Token  string `query:"token"`                                                 // 用户登录状态下设置
+	Count  int32  `query:"count,string"`                                          // 返回数量，不填默认10，最大50
+}
+
+type TrendingTopicsRequest struct {
+	Count int32 `query:"count,string"` // 返回数量，不填默认10，最大50
 }
 
 func NewVideoController() *VideoController {
@@ -66,8 +129,78 @@ func (vc *VideoController) Feed(c context.Context, ctx *app.RequestContext) {
 	resp, err := client.VideoClient.Feed(c, &video.FeedRequest{
 		LatestTime: req.LatestTime,
 		UserId:     userID,
+		Mode:       req.Mode,
+		Cursor:     &req.Cursor,
 	})
 	if err != nil {
+		if errorIs(err, cursor.ErrInvalid) {
+			Error(ctx, CodeInvalidParam)
+			span.RecordError(err)
+			span.SetStatus(codes.Error, "分页游标无效")
+			hlog.Error("分页游标无效")
+			return
+		}
+		Error(ctx, CodeServerBusy)
+		span.RecordError(err)
+		span.SetStatus(codes.Error, "业务逻辑处理失败")
+		hlog.Error("业务逻辑处理失败, err: ", err)
+		return
+	}
+
+	// 返回结果
+	Success(ctx, resp)
+}
+
+// FollowFeed 需要登录，返回关注用户按投稿时间倒序的视频列表，单次最多30个
+func (vc *VideoController) FollowFeed(c context.Context, ctx *app.RequestContext) {
+	c, span := otel.Tracer("video").Start(c, "FollowFeed")
+	defer span.End()
+
+	// 获取参数
+	req := &FollowFeedRequest{LatestTime: time.Now().Unix()}
+	err := ctx.Bind(req)
+	if err != nil {
+		Error(ctx, CodeInvalidParam)
+		span.RecordError(err)
+		span.SetStatus(codes.Error, "参数解析失败")
+		hlog.Error("参数解析失败, err: ", err)
+		return
+	}
+
+	// 从认证中间件中获取userID
+	userID := ctx.MustGet(CtxUserIDKey).(int64)
+
+	// 业务逻辑处理
+	feedReq := &video.FollowFeedRequest{
+		LatestTime: req.LatestTime,
+		UserId:     userID,
+	}
+	if req.LastID > 0 {
+		feedReq.LastId = &req.LastID
+	}
+	resp, err := client.VideoClient.FollowFeed(c, feedReq)
+	if err != nil {
+		Error(ctx, CodeServerBusy)
+		span.RecordError(err)
+		span.SetStatus(codes.Error, "业务逻辑处理失败")
+		hlog.Error("业务逻辑处理失败, err: ", err)
+		return
+	}
+
+	// 返回结果
+	Success(ctx, resp)
+}
+
+// FeedReset 清空已看视频记录，之后的Feed会重新返回看过的视频
+func (vc *VideoController) FeedReset(c context.Context, ctx *app.RequestContext) {
+	c, span := otel.Tracer("video").Start(c, "FeedReset")
+	defer span.End()
+
+	// 从认证中间件中获取userID
+	userID := ctx.MustGet(CtxUserIDKey).(int64)
+
+	// 业务逻辑处理
+	if err := client.VideoClient.ClearSeen(c, userID); err != nil {
 		Error(ctx, CodeServerBusy)
 		span.RecordError(err)
 		span.SetStatus(codes.Error, "业务逻辑处理失败")
@@ -76,6 +209,43 @@ func (vc *VideoController) Feed(c context.Context, ctx *app.RequestContext) {
 	}
 
 	// 返回结果
+	Success(ctx, &Response{StatusCode: CodeSuccess})
+}
+
+// HotVideos 不限制登录状态，返回统计窗口内点赞和评论最活跃的视频
+func (vc *VideoController) HotVideos(c context.Context, ctx *app.RequestContext) {
+	c, span := otel.Tracer("video").Start(c, "HotVideos")
+	defer span.End()
+
+	// 获取参数
+	req := &HotVideosRequest{}
+	err := ctx.BindAndValidate(req)
+	if err != nil {
+		Error(ctx, CodeInvalidParam)
+		span.RecordError(err)
+		span.SetStatus(codes.Error, "参数校验失败")
+		hlog.Error("参数校验失败, err: ", err)
+		return
+	}
+
+	// 验证token
+	userID := jwt.ParseAccessToken(req.Token)
+
+	// 业务逻辑处理
+	resp, err := client.VideoClient.HotVideos(c, &video.HotVideosRequest{
+		UserId: userID,
+		Window: &req.Window,
+		Count:  req.Count,
+	})
+	if err != nil {
+		Error(ctx, CodeServerBusy)
+		span.RecordError(err)
+		span.SetStatus(codes.Error, "业务处理失败")
+		hlog.Error("业务处理失败, err: ", err)
+		return
+	}
+
+	// 返回响应
 	Success(ctx, resp)
 }
 
@@ -124,36 +294,68 @@ func (vc *VideoController) PublishAction(c context.Context, ctx *app.RequestCont
 	}
 	defer file.Close()
 
-	// 将文件转换为[]byte
-	buf := make([]byte, req.Data.Size)
-	if _, err := file.Read(buf); err != nil {
+	// 读取文件头判断MIME类型是否是视频
+	head := make([]byte, sniffLen)
+	n, err := io.ReadFull(file, head)
+	if err != nil && err != io.ErrUnexpectedEOF {
 		Error(ctx, CodeServerBusy)
 		span.RecordError(err)
 		span.SetStatus(codes.Error, "文件读取失败")
 		hlog.Error("文件读取失败, err: ", err)
 		return
 	}
-
-	// 判断文件MIME类型是否是视频
-	contentType := http.DetectContentType(buf)
-	if contentType[:5] != "video" {
+	head = head[:n]
+	if !isVideo(head) {
 		Error(ctx, CodeInvalidParam)
 		hlog.Warn("文件类型不是视频")
 		return
 	}
 
+	// 流式上传视频到oss
+	videoName := uuid.New().String() + ".mp4"
+	if err := oss.UploadVideo(c, io.MultiReader(bytes.NewReader(head), file), videoName); err != nil {
+		Error(ctx, CodeServerBusy)
+		span.RecordError(err)
+		span.SetStatus(codes.Error, "上传视频失败")
+		hlog.Error("上传视频失败, err: ", err)
+		return
+	}
+
+	// 上传自定义封面
+	var coverName *string
+	if req.Cover != nil {
+		name, ok := uploadCover(c, ctx, req.Cover)
+		if !ok {
+			discardVideo(c, videoName)
+			return
+		}
+		coverName = &name
+	}
+
 	// 从认证中间件中获取userID
 	userID := ctx.MustGet(CtxUserIDKey).(int64)
 
 	// 业务逻辑处理
 	resp, err := client.VideoClient.PublishAction(c, &video.PublishActionRequest{
-		UserId: userID,
-		Data:   buf,
-		Title:  req.Title,
+		UserId:     userID,
+		Title:      req.Title,
+		VideoName:  videoName,
+		CoverName:  coverName,
+		Visibility: req.Visibility,
 	})
 	if err != nil {
-		Error(ctx, CodeServerBusy)
+		discardVideo(c, videoName)
+		if coverName != nil {
+			discardCover(c, *coverName)
+		}
 		span.RecordError(err)
+		if errorIs(err, dal.ErrContentRejected) {
+			Error(ctx, CodeContentRejected)
+			span.SetStatus(codes.Error, "内容包含违规信息")
+			hlog.Warn("内容包含违规信息")
+			return
+		}
+		Error(ctx, CodeServerBusy)
 		span.SetStatus(codes.Error, "业务处理失败")
 		hlog.Error("业务处理失败, err: ", err)
 		return
@@ -186,8 +388,17 @@ func (vc *VideoController) PublishList(c context.Context, ctx *app.RequestContex
 	resp, err := client.VideoClient.PublishList(c, &video.PublishListRequest{
 		UserId:   userID,
 		AuthorId: authorID,
+		Cursor:   &req.Cursor,
+		Count:    req.Count,
 	})
 	if err != nil {
+		if errorIs(err, cursor.ErrInvalid) {
+			Error(ctx, CodeInvalidParam)
+			span.RecordError(err)
+			span.SetStatus(codes.Error, "分页游标无效")
+			hlog.Error("分页游标无效")
+			return
+		}
 		Error(ctx, CodeServerBusy)
 		span.RecordError(err)
 		span.SetStatus(codes.Error, "业务处理失败")
@@ -198,3 +409,352 @@ func (vc *VideoController) PublishList(c context.Context, ctx *app.RequestContex
 	// 返回响应
 	Success(ctx, resp)
 }
+
+// UpdateCover 作者修改已发布视频的封面
+func (vc *VideoController) UpdateCover(c context.Context, ctx *app.RequestContext) {
+	c, span := otel.Tracer("video").Start(c, "UpdateCover")
+	defer span.End()
+
+	// 获取参数
+	req := &UpdateCoverRequest{}
+	err := ctx.BindAndValidate(req)
+	if err != nil || req.Cover == nil {
+		Error(ctx, CodeInvalidParam)
+		span.RecordError(err)
+		span.SetStatus(codes.Error, "参数校验失败")
+		hlog.Error("参数校验失败, err: ", err)
+		return
+	}
+
+	// 上传封面
+	coverName, ok := uploadCover(c, ctx, req.Cover)
+	if !ok {
+		return
+	}
+
+	// 从认证中间件中获取userID
+	userID := ctx.MustGet(CtxUserIDKey).(int64)
+
+	// 业务逻辑处理
+	resp, err := client.VideoClient.UpdateCover(c, &video.UpdateCoverRequest{
+		UserId:    userID,
+		VideoId:   req.VideoID,
+		CoverName: coverName,
+	})
+	if err != nil {
+		discardCover(c, coverName)
+		span.RecordError(err)
+		if errorIs(err, dal.ErrVideoNotExist) {
+			Error(ctx, CodeVideoNotExist)
+			span.SetStatus(codes.Error, "视频不存在")
+			hlog.Warn("视频不存在")
+			return
+		}
+		if errorIs(err, dal.ErrVideoStatus) {
+			Error(ctx, CodeVideoStatus)
+			span.SetStatus(codes.Error, "视频当前状态不允许该操作")
+			hlog.Warn("视频当前状态不允许该操作")
+			return
+		}
+		Error(ctx, CodeServerBusy)
+		span.SetStatus(codes.Error, "业务处理失败")
+		hlog.Error("业务处理失败, err: ", err)
+		return
+	}
+
+	// 返回响应
+	Success(ctx, resp)
+}
+
+// DeleteVideo 作者删除视频，视频立即隐藏，保留期结束后彻底清理
+func (vc *VideoController) DeleteVideo(c context.Context, ctx *app.RequestContext) {
+	c, span := otel.Tracer("video").Start(c, "DeleteVideo")
+	defer span.End()
+
+	// 获取参数
+	req := &DeleteVideoRequest{}
+	err := ctx.BindAndValidate(req)
+	if err != nil {
+		Error(ctx, CodeInvalidParam)
+		span.RecordError(err)
+		span.SetStatus(codes.Error, "参数校验失败")
+		hlog.Error("参数校验失败, err: ", err)
+		return
+	}
+
+	// 从认证中间件中获取userID
+	userID := ctx.MustGet(CtxUserIDKey).(int64)
+
+	// 业务逻辑处理
+	resp, err := client.VideoClient.DeleteVideo(c, &video.DeleteVideoRequest{
+		UserId:  userID,
+		VideoId: req.VideoID,
+	})
+	if err != nil {
+		span.RecordError(err)
+		if errorIs(err, dal.ErrVideoNotExist) {
+			Error(ctx, CodeVideoNotExist)
+			span.SetStatus(codes.Error, "视频不存在")
+			hlog.Warn("视频不存在")
+			return
+		}
+		Error(ctx, CodeServerBusy)
+		span.SetStatus(codes.Error, "业务处理失败")
+		hlog.Error("业务处理失败, err: ", err)
+		return
+	}
+
+	// 返回响应
+	Success(ctx, resp)
+}
+
+// UpdateVideo 作者修改视频标题
+func (vc *VideoController) UpdateVideo(c context.Context, ctx *app.RequestContext) {
+	c, span := otel.Tracer("video").Start(c, "UpdateVideo")
+	defer span.End()
+
+	// 获取参数
+	req := &UpdateVideoRequest{}
+	err := ctx.BindAndValidate(req)
+	if err != nil {
+		Error(ctx, CodeInvalidParam)
+		span.RecordError(err)
+		span.SetStatus(codes.Error, "参数校验失败")
+		hlog.Error("参数校验失败, err: ", err)
+		return
+	}
+
+	// 标题和可见范围至少修改一项
+	if (req.Title == nil && req.Visibility == nil) || (req.Title != nil && len(*req.Title) == 0) ||
+		(req.Visibility != nil && !validVisibility(*req.Visibility)) {
+		Error(ctx, CodeInvalidParam)
+		hlog.Warn("参数校验失败")
+		return
+	}
+
+	// 检查标题字数
+	if req.Title != nil && len(*req.Title) > 30 {
+		Error(ctx, codeLengthLimit)
+		hlog.Warn("标题字数超过限制")
+		return
+	}
+
+	// 从认证中间件中获取userID
+	userID := ctx.MustGet(CtxUserIDKey).(int64)
+
+	// 业务逻辑处理
+	resp, err := client.VideoClient.UpdateVideo(c, &video.UpdateVideoRequest{
+		UserId:     userID,
+		VideoId:    req.VideoID,
+		Title:      req.Title,
+		Visibility: req.Visibility,
+	})
+	if err != nil {
+		span.RecordError(err)
+		if errorIs(err, dal.ErrVideoNotExist) {
+			Error(ctx, CodeVideoNotExist)
+			span.SetStatus(codes.Error, "视频不存在")
+			hlog.Warn("视频不存在")
+			return
+		}
+		if errorIs(err, dal.ErrContentRejected) {
+			Error(ctx, CodeContentRejected)
+			span.SetStatus(codes.Error, "内容包含违规信息")
+			hlog.Warn("内容包含违规信息")
+			return
+		}
+		Error(ctx, CodeServerBusy)
+		span.SetStatus(codes.Error, "业务处理失败")
+		hlog.Error("业务处理失败, err: ", err)
+		return
+	}
+
+	// 返回响应
+	Success(ctx, resp)
+}
+
+// PublishStatus 查询视频发布状态和转码进度，仅作者可查询
+func (vc *VideoController) PublishStatus(c context.Context, ctx *app.RequestContext) {
+	c, span := otel.Tracer("video").Start(c, "PublishStatus")
+	defer span.End()
+
+	// 获取参数
+	req := &PublishStatusRequest{}
+	err := ctx.BindAndValidate(req)
+	if err != nil {
+		Error(ctx, CodeInvalidParam)
+		span.RecordError(err)
+		span.SetStatus(codes.Error, "参数校验失败")
+		hlog.Error("参数校验失败, err: ", err)
+		return
+	}
+
+	// 从认证中间件中获取userID
+	userID := ctx.MustGet(CtxUserIDKey).(int64)
+
+	// 业务逻辑处理
+	resp, err := client.VideoClient.PublishStatus(c, &video.PublishStatusRequest{
+		UserId:  userID,
+		VideoId: req.VideoID,
+	})
+	if err != nil {
+		span.RecordError(err)
+		if errorIs(err, dal.ErrVideoNotExist) {
+			Error(ctx, CodeVideoNotExist)
+			span.SetStatus(codes.Error, "视频不存在")
+			hlog.Warn("视频不存在")
+			return
+		}
+		Error(ctx, CodeServerBusy)
+		span.SetStatus(codes.Error, "业务处理失败")
+		hlog.Error("业务处理失败, err: ", err)
+		return
+	}
+
+	// 返回响应
+	Success(ctx, resp)
+}
+
+// TopicFeed 不限制登录状态，返回话题下的公开视频，支持按发布时间或热度排序
+func (vc *VideoController) TopicFeed(c context.Context, ctx *app.RequestContext) {
+	c, span := otel.Tracer("video").Start(c, "TopicFeed")
+	defer span.End()
+
+	// 获取参数
+	req := &TopicFeedRequest{}
+	err := ctx.BindAndValidate(req)
+	if err != nil {
+		Error(ctx, CodeInvalidParam)
+		span.RecordError(err)
+		span.SetStatus(codes.Error, "参数校验失败")
+		hlog.Error("参数校验失败, err: ", err)
+		return
+	}
+
+	// 验证token
+	userID := jwt.ParseAccessToken(req.Token)
+
+	// 业务逻辑处理
+	resp, err := client.VideoClient.TopicFeed(c, &video.TopicFeedRequest{
+		UserId: userID,
+		Tag:    req.Tag,
+		Sort:   &req.Sort,
+		Cursor: &req.Cursor,
+		Count:  req.Count,
+	})
+	if err != nil {
+		span.RecordError(err)
+		if errorIs(err, dal.ErrTagNotExist) {
+			Error(ctx, CodeTagNotExist)
+			span.SetStatus(codes.Error, "话题不存在")
+			hlog.Warn("话题不存在")
+			return
+		}
+		if errorIs(err, cursor.ErrInvalid) {
+			Error(ctx, CodeInvalidParam)
+			span.SetStatus(codes.Error, "分页游标无效")
+			hlog.Error("分页游标无效")
+			return
+		}
+		Error(ctx, CodeServerBusy)
+		span.SetStatus(codes.Error, "业务处理失败")
+		hlog.Error("业务处理失败, err: ", err)
+		return
+	}
+
+	// 返回响应
+	Success(ctx, resp)
+}
+
+// TrendingTopics 不限制登录状态，返回最近7天使用次数最多的话题
+func (vc *VideoController) TrendingTopics(c context.Context, ctx *app.RequestContext) {
+	c, span := otel.Tracer("video").Start(c, "TrendingTopics")
+	defer span.End()
+
+	// 获取参数
+	req := &TrendingTopicsRequest{}
+	err := ctx.BindAndValidate(req)
+	if err != nil {
+		Error(ctx, CodeInvalidParam)
+		span.RecordError(err)
+		span.SetStatus(codes.Error, "参数校验失败")
+		hlog.Error("参数校验失败, err: ", err)
+		return
+	}
+
+	// 业务逻辑处理
+	resp, err := client.VideoClient.TrendingTopics(c, &video.TrendingTopicsRequest{Count: req.Count})
+	if err != nil {
+		Error(ctx, CodeServerBusy)
+		span.RecordError(err)
+		span.SetStatus(codes.Error, "业务处理失败")
+		hlog.Error("业务处理失败, err: ", err)
+		return
+	}
+
+	// 返回响应
+	Success(ctx, resp)
+}
+
+// isVideo 根据文件头判断MIME类型是否是视频
+func isVideo(head []byte) bool {
+	return strings.HasPrefix(http.DetectContentType(head), "video")
+}
+
+// validVisibility 判断可见范围取值是否合法
+func validVisibility(visibility int32) bool {
+	return visibility >= 0 && visibility <= 2
+}
+
+// uploadCover 校验封面图片类型和大小后上传到oss，返回封面文件名，失败时直接写入错误响应
+func uploadCover(c context.Context, ctx *app.RequestContext, fh *multipart.FileHeader) (string, bool) {
+	return uploadImage(c, ctx, fh, oss.UploadCover)
+}
+
+// uploadImage 校验图片大小和类型后通过upload上传，返回生成的文件名，失败时已写入响应
+func uploadImage(c context.Context, ctx *app.RequestContext, fh *multipart.FileHeader, upload func(context.Context, io.Reader, string) error) (string, bool) {
+	if fh.Size > maxCoverSize {
+		Error(ctx, CodeFileTooLarge)
+		hlog.Warn("图片太大")
+		return "", false
+	}
+
+	file, err := fh.Open()
+	if err != nil {
+		Error(ctx, CodeServerBusy)
+		hlog.Error("图片打开失败, err: ", err)
+		return "", false
+	}
+	defer file.Close()
+
+	// 读取文件头判断MIME类型是否是支持的图片
+	head := make([]byte, sniffLen)
+	n, err := io.ReadFull(file, head)
+	if err != nil && err != io.ErrUnexpectedEOF {
+		Error(ctx, CodeServerBusy)
+		hlog.Error("图片读取失败, err: ", err)
+		return "", false
+	}
+	head = head[:n]
+	ext, ok := coverExts[http.DetectContentType(head)]
+	if !ok {
+		Error(ctx, CodeInvalidParam)
+		hlog.Warn("图片类型不支持")
+		return "", false
+	}
+
+	name := uuid.New().String() + ext
+	if err := upload(c, io.MultiReader(bytes.NewReader(head), file), name); err != nil {
+		Error(ctx, CodeServerBusy)
+		hlog.Error("上传图片失败, err: ", err)
+		return "", false
+	}
+	return name, true
+}
+
+// discardCover 发布或修改封面失败时删除已上传的封面
+func discardCover(c context.Context, coverName string) {
+	if err := oss.DeleteCover(c, coverName); err != nil {
+		hlog.Error("删除封面失败, err: ", err)
+	}
+}
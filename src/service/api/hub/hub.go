@@ -0,0 +1,213 @@
+package hub
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"sync"
+	"time"
+
+	"douyin/src/common/chat"
+	"douyin/src/dal"
+
+	"github.com/cloudwego/hertz/pkg/app"
+	"github.com/cloudwego/hertz/pkg/common/hlog"
+	"github.com/google/uuid"
+	"github.com/hertz-contrib/websocket"
+)
+
+const (
+	sendBufferSize    = 64               // 每个连接待发送事件的缓冲数，写满时断开慢连接
+	writeWait         = 10 * time.Second // 单次写入超时时间
+	pongWait          = 60 * time.Second // 等待客户端响应ping的时间
+	pingPeriod        = pongWait * 9 / 10
+	maxReadSize       = 512                 // 客户端只发送控制帧，限制读取大小
+	heartbeatInterval = dal.PresenceTTL / 3 // 在线状态续期间隔
+)
+
+var upgrader = websocket.HertzUpgrader{
+	CheckOrigin: func(ctx *app.RequestContext) bool { return true },
+}
+
+// client 一个WebSocket连接
+type client struct {
+	userID int64
+	conn   *websocket.Conn
+	send   chan []byte
+}
+
+// Hub 管理当前网关实例上的WebSocket连接，通过Redis订阅本实例的推送频道并转发给在线用户
+type Hub struct {
+	gatewayID string
+	mu        sync.RWMutex
+	clients   map[int64]map[*client]struct{}
+}
+
+var defaultHub *Hub
+
+// Init 生成网关实例ID，开始订阅推送频道并定时续期在线状态
+func Init() {
+	defaultHub = &Hub{
+		gatewayID: newGatewayID(),
+		clients:   make(map[int64]map[*client]struct{}),
+	}
+	go defaultHub.dispatch(context.Background())
+	go defaultHub.heartbeat(context.Background())
+}
+
+// Serve 将请求升级为WebSocket连接，连接断开前持续推送用户的事件
+func Serve(ctx *app.RequestContext, userID int64) error {
+	return upgrader.Upgrade(ctx, func(conn *websocket.Conn) {
+		c := &client{userID: userID, conn: conn, send: make(chan []byte, sendBufferSize)}
+		defaultHub.register(c)
+		defer defaultHub.unregister(c)
+
+		go c.writePump()
+		c.readPump()
+	})
+}
+
+// newGatewayID 生成网关实例ID，由主机名和UUID组成，实例重启后使用新的推送频道
+func newGatewayID() string {
+	host, _ := os.Hostname()
+	return host + "-" + uuid.New().String()
+}
+
+func (h *Hub) register(c *client) {
+	h.mu.Lock()
+	conns, ok := h.clients[c.userID]
+	if !ok {
+		conns = make(map[*client]struct{})
+		h.clients[c.userID] = conns
+	}
+	conns[c] = struct{}{}
+	h.mu.Unlock()
+
+	if err := dal.SetPresence(context.Background(), []int64{c.userID}, h.gatewayID); err != nil {
+		hlog.Error("记录在线状态失败, err: ", err)
+	}
+}
+
+func (h *Hub) unregister(c *client) {
+	h.mu.Lock()
+	conns := h.clients[c.userID]
+	if _, ok := conns[c]; !ok {
+		h.mu.Unlock()
+		return
+	}
+	delete(conns, c)
+	close(c.send)
+	offline := len(conns) == 0
+	if offline {
+		delete(h.clients, c.userID)
+	}
+	h.mu.Unlock()
+
+	// 用户在本实例上的连接全部断开后删除在线状态
+	if offline {
+		if err := dal.RemovePresence(context.Background(), c.userID, h.gatewayID); err != nil {
+			hlog.Error("删除在线状态失败, err: ", err)
+		}
+	}
+}
+
+// dispatch 接收推送频道中的事件并转发给本实例上的连接
+func (h *Hub) dispatch(ctx context.Context) {
+	pubsub := dal.SubscribeGateway(ctx, h.gatewayID)
+	defer pubsub.Close()
+
+	for msg := range pubsub.Channel() {
+		envelope := &chat.Envelope{}
+		if err := json.Unmarshal([]byte(msg.Payload), envelope); err != nil {
+			hlog.Error("解析推送事件失败, err: ", err)
+			continue
+		}
+		h.deliver(envelope.UserIDs, envelope.Event)
+	}
+}
+
+// deliver 将事件写入用户所有连接的发送缓冲，缓冲已满的连接视为异常并断开
+func (h *Hub) deliver(userIDs []int64, event []byte) {
+	var slow []*client
+	h.mu.RLock()
+	for _, userID := range userIDs {
+		for c := range h.clients[userID] {
+			select {
+			case c.send <- event:
+			default:
+				slow = append(slow, c)
+			}
+		}
+	}
+	h.mu.RUnlock()
+
+	for _, c := range slow {
+		h.unregister(c)
+	}
+}
+
+// heartbeat 定时续期本实例上所有在线用户的在线状态
+func (h *Hub) heartbeat(ctx context.Context) {
+	ticker := time.NewTicker(heartbeatInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+
+		h.mu.RLock()
+		userIDs := make([]int64, 0, len(h.clients))
+		for userID := range h.clients {
+			userIDs = append(userIDs, userID)
+		}
+		h.mu.RUnlock()
+
+		if err := dal.SetPresence(ctx, userIDs, h.gatewayID); err != nil {
+			hlog.Error("续期在线状态失败, err: ", err)
+		}
+	}
+}
+
+// readPump 读取客户端的控制帧，连接断开或超时未响应ping时返回
+func (c *client) readPump() {
+	c.conn.SetReadLimit(maxReadSize)
+	_ = c.conn.SetReadDeadline(time.Now().Add(pongWait))
+	c.conn.SetPongHandler(func(string) error {
+		return c.conn.SetReadDeadline(time.Now().Add(pongWait))
+	})
+	for {
+		if _, _, err := c.conn.ReadMessage(); err != nil {
+			return
+		}
+	}
+}
+
+// writePump 发送事件并定时ping客户端，发送缓冲关闭时结束连接
+func (c *client) writePump() {
+	ticker := time.NewTicker(pingPeriod)
+	defer func() {
+		ticker.Stop()
+		c.conn.Close()
+	}()
+
+	for {
+		select {
+		case event, ok := <-c.send:
+			_ = c.conn.SetWriteDeadline(time.Now().Add(writeWait))
+			if !ok {
+				_ = c.conn.WriteMessage(websocket.CloseMessage, []byte{})
+				return
+			}
+			if err := c.conn.WriteMessage(websocket.TextMessage, event); err != nil {
+				return
+			}
+		case <-ticker.C:
+			_ = c.conn.SetWriteDeadline(time.Now().Add(writeWait))
+			if err := c.conn.WriteMessage(websocket.PingMessage, nil); err != nil {
+				return
+			}
+		}
+	}
+}
@@ -4,8 +4,10 @@ import (
 	"douyin/src/client"
 	"douyin/src/common/jwt"
 	"douyin/src/common/mtl"
+	"douyin/src/common/oss"
 	"douyin/src/config"
 	"douyin/src/dal"
+	"douyin/src/service/api/hub"
 	"douyin/src/service/api/router"
 )
 
@@ -28,6 +30,12 @@ func main() {
 	dal.InitRedis()
 	defer dal.Close()
 
+	// 初始化消息推送
+	hub.Init()
+
+	// 初始化对象存储
+	oss.Init()
+
 	// 注册路由
 	h := router.Setup(config.Conf.HertzConfig)
 
@@ -48,6 +56,9 @@ func watchConfig() {
 
 		case <-config.NoticeRedis:
 			dal.InitRedis()
+
+		case <-config.NoticeOss:
+			oss.Init()
 		}
 	}
 }
@@ -5,6 +5,7 @@ import (
 	"fmt"
 
 	"douyin/src/common/mtl"
+	"douyin/src/common/oss"
 	"douyin/src/config"
 	"douyin/src/service/api/controller"
 	"douyin/src/service/api/mw"
@@ -37,6 +38,8 @@ func Setup(conf *config.HertzConfig) *server.Hertz {
 		tracer,
 	)
 	h.Use(hertztracing.ServerMiddleware(cfg))
+	// WebSocket连接需要接管底层连接
+	h.NoHijackConnPool = true
 
 	registerMiddleware(h)
 
@@ -44,11 +47,22 @@ func Setup(conf *config.HertzConfig) *server.Hertz {
 		ctx.JSON(consts.StatusOK, utils.H{"message": "pong"})
 	})
 
+	// 使用本地存储时由API服务提供视频和封面访问
+	if config.Conf.OssConfig.Backend == "local" {
+		h.StaticFS(oss.LocalURLPath, &app.FS{
+			Root:        oss.LocalRoot(config.Conf.OssConfig),
+			PathRewrite: app.NewPathSlashesStripper(1),
+		})
+	}
+
 	apiRouter := h.Group("/douyin")
 
 	// basic apis
 	videoController := controller.NewVideoController()
 	apiRouter.GET("/feed/", videoController.Feed)
+	apiRouter.GET("/feed/following/", mw.AuthMiddleware(), videoController.FollowFeed)
+	apiRouter.POST("/feed/reset/", mw.AuthMiddleware(), videoController.FeedReset)
+	apiRouter.GET("/feed/hot/", videoController.HotVideos)
 	apiRouter.GET("/refresh_token", mw.RefreshTokenMiddleware())
 
 	userRouter := apiRouter.Group("/user")
@@ -57,14 +71,32 @@ func Setup(conf *config.HertzConfig) *server.Hertz {
 		userRouter.GET("/", userController.Info)
 		userRouter.POST("/register/", userController.Register)
 		userRouter.POST("/login/", userController.Login)
+		userRouter.POST("/logout/", mw.AuthMiddleware(), userController.Logout)
+		userRouter.POST("/privacy/", mw.AuthMiddleware(), userController.Privacy)
 	}
 
 	publishRouter := apiRouter.Group("/publish")
 	{
 		publishRouter.POST("/action/", mw.AuthMiddleware(), videoController.PublishAction)
 		publishRouter.GET("/list/", videoController.PublishList)
+		publishRouter.GET("/status/", mw.AuthMiddleware(), videoController.PublishStatus)
+		publishRouter.POST("/cover/", mw.AuthMiddleware(), videoController.UpdateCover)
+		publishRouter.POST("/delete/", mw.AuthMiddleware(), videoController.DeleteVideo)
+		publishRouter.POST("/update/", mw.AuthMiddleware(), videoController.UpdateVideo)
+		publishRouter.POST("/upload/init/", mw.AuthMiddleware(), videoController.UploadInit)
+		publishRouter.PUT("/upload/chunk/", mw.AuthMiddleware(), videoController.UploadChunk)
+		publishRouter.POST("/upload/complete/", mw.AuthMiddleware(), videoController.UploadComplete)
+	}
+
+	topicRouter := apiRouter.Group("/topic")
+	{
+		topicRouter.GET("/feed/", videoController.TopicFeed)
+		topicRouter.GET("/trending/", videoController.TrendingTopics)
 	}
 
+	searchController := controller.NewSearchController()
+	apiRouter.GET("/search/", searchController.Search)
+
 	// interaction apis
 	favoriteRouter := apiRouter.Group("/favorite")
 	{
@@ -78,6 +110,8 @@ func Setup(conf *config.HertzConfig) *server.Hertz {
 		commentController := controller.NewCommentController()
 		commentRouter.POST("/action/", mw.AuthMiddleware(), commentController.Action)
 		commentRouter.GET("/list/", commentController.List)
+		commentRouter.GET("/reply/list/", commentController.ReplyList)
+		commentRouter.POST("/favorite/action/", mw.AuthMiddleware(), commentController.FavoriteAction)
 	}
 
 	// social apis
@@ -88,6 +122,8 @@ func Setup(conf *config.HertzConfig) *server.Hertz {
 		relationRouter.GET("/follow/list/", relationController.FollowList)
 		relationRouter.GET("/follower/list/", relationController.FollowerList)
 		relationRouter.GET("/friend/list/", relationController.FriendList)
+		relationRouter.POST("/block/", mw.AuthMiddleware(), relationController.Block)
+		relationRouter.GET("/block/list/", mw.AuthMiddleware(), relationController.BlockList)
 	}
 
 	messageRouter := apiRouter.Group("/message")
@@ -95,6 +131,23 @@ func Setup(conf *config.HertzConfig) *server.Hertz {
 		messageController := controller.NewMessageController()
 		messageRouter.POST("/action/", mw.AuthMiddleware(), messageController.Action)
 		messageRouter.GET("/chat/", mw.AuthMiddleware(), messageController.Chat)
+		messageRouter.GET("/ws/", mw.AuthMiddleware(), messageController.Connect)
+		messageRouter.GET("/conversation/list/", mw.AuthMiddleware(), messageController.ConversationList)
+		messageRouter.POST("/read/", mw.AuthMiddleware(), messageController.MarkRead)
+		messageRouter.POST("/recall/", mw.AuthMiddleware(), messageController.Recall)
+		messageRouter.POST("/delete/", mw.AuthMiddleware(), messageController.Delete)
+		messageRouter.POST("/image/", mw.AuthMiddleware(), messageController.UploadImage)
+	}
+
+	groupRouter := apiRouter.Group("/group")
+	{
+		groupController := controller.NewGroupController()
+		groupRouter.POST("/create/", mw.AuthMiddleware(), groupController.Create)
+		groupRouter.POST("/join/", mw.AuthMiddleware(), groupController.Join)
+		groupRouter.POST("/leave/", mw.AuthMiddleware(), groupController.Leave)
+		groupRouter.POST("/kick/", mw.AuthMiddleware(), groupController.Kick)
+		groupRouter.POST("/role/", mw.AuthMiddleware(), groupController.SetRole)
+		groupRouter.GET("/member/list/", mw.AuthMiddleware(), groupController.MemberList)
 	}
 
 	return h
@@ -2,10 +2,13 @@ package main
 
 import (
 	"context"
+	"strconv"
 	"time"
 
 	"douyin/src/client"
+	"douyin/src/common/cursor"
 	"douyin/src/common/kafka"
+	"douyin/src/common/moderation"
 	"douyin/src/dal"
 	"douyin/src/dal/model"
 	"douyin/src/kitex_gen/comment"
@@ -14,6 +17,7 @@ import (
 	"github.com/cloudwego/kitex/pkg/klog"
 	"go.opentelemetry.io/otel"
 	"go.opentelemetry.io/otel/codes"
+	"golang.org/x/sync/errgroup"
 )
 
 // CommentServiceImpl implements the last service interface defined in the IDL.
@@ -44,6 +48,50 @@ func (s *CommentServiceImpl) CommentAction(ctx context.Context, req *comment.Com
 		// 发布评论
 		mComment.CreateTime = time.Now()
 
+		// 回复评论时设置父评论和根评论
+		var replyUserID int64
+		if req.ParentId != nil && *req.ParentId != 0 {
+			parent, err := dal.GetCommentByID(ctx, *req.ParentId)
+			if err != nil {
+				span.RecordError(err)
+				span.SetStatus(codes.Error, "获取回复的评论失败")
+				klog.Error("获取回复的评论失败, err: ", err)
+				return nil, err
+			}
+			if parent.VideoID != req.VideoId {
+				span.SetStatus(codes.Error, "回复的评论不属于该视频")
+				klog.Error("回复的评论不属于该视频")
+				return nil, dal.ErrCommentNotExist
+			}
+			mComment.ParentID = parent.ID
+			replyUserID = parent.UserID
+			mComment.RootID = parent.RootID
+			if mComment.RootID == 0 {
+				mComment.RootID = parent.ID
+			}
+		}
+
+		// 与视频作者或被回复者存在拉黑关系时不能评论
+		err = checkCommentBlocked(ctx, req.UserId, req.VideoId, replyUserID)
+		if err == dal.ErrBlocked {
+			return nil, err
+		}
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, "检查拉黑关系失败")
+			klog.Error("检查拉黑关系失败, err: ", err)
+			return nil, err
+		}
+
+		// 审核评论内容
+		mComment.Content, err = moderation.Moderate(ctx, moderation.SceneComment, req.UserId, mComment.Content)
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, "评论内容审核未通过")
+			klog.Error("评论内容审核未通过, err: ", err)
+			return nil, err
+		}
+
 		// 通过kafka异步写入数据库
 		err := kafka.CreateComment(ctx, mComment)
 		if err != nil {
@@ -95,45 +143,109 @@ func (s *CommentServiceImpl) CommentAction(ctx context.Context, req *comment.Com
 			User:       user.User,
 			Content:    mComment.Content,
 			CreateDate: mComment.CreateTime.Format("01-02"),
+			ParentId:   mComment.ParentID,
+			RootId:     mComment.RootID,
 		},
 	}
 
 	return
 }
 
+// checkCommentBlocked 检查userID与视频作者、被回复的评论作者之间是否存在拉黑关系，replyUserID为0表示不是回复
+func checkCommentBlocked(ctx context.Context, userID, videoID, replyUserID int64) error {
+	authorID, err := dal.GetAuthorID(ctx, videoID)
+	if err != nil {
+		return err
+	}
+	targetIDs := []int64{authorID}
+	if replyUserID != 0 {
+		targetIDs = append(targetIDs, replyUserID)
+	}
+
+	blocked, err := dal.BatchCheckBlocked(ctx, userID, targetIDs)
+	if err != nil {
+		return err
+	}
+	for _, targetID := range targetIDs {
+		if blocked[targetID] {
+			return dal.ErrBlocked
+		}
+	}
+	return nil
+}
+
 // CommentList implements the CommentServiceImpl interface.
 func (s *CommentServiceImpl) CommentList(ctx context.Context, req *comment.CommentListRequest) (resp *comment.CommentListResponse, err error) {
 	ctx, span := otel.Tracer("comment").Start(ctx, "CommentList")
 	defer span.End()
 
-	// 获取评论列表
-	mCommentList, err := dal.GetCommentList(ctx, req.VideoId)
-	if err != nil {
-		span.RecordError(err)
-		span.SetStatus(codes.Error, "获取评论列表失败")
-		klog.Error("获取评论列表失败, err: ", err)
-		return nil, err
-	}
+	var (
+		mCommentList []*model.Comment
+		replyCnt     map[int64]int64
+		nextCursor   *string
+		hasMore      bool
+	)
+	if req.Sort != nil && *req.Sort == sortHot {
+		// 按热度排序，热度随时间变化，使用偏移量分页
+		offset, limit, err := cursor.ParseOffset(cursor.KindHotComment, req.VideoId, req.Cursor, req.Count)
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, "解析分页游标失败")
+			klog.Error("解析分页游标失败, err: ", err)
+			return nil, err
+		}
 
-	commentList := make([]*comment.Comment, len(mCommentList))
-	for i, c := range mCommentList {
-		user, err := client.UserClient.UserInfo(ctx, &user.UserInfoRequest{UserId: req.UserId, AuthorId: c.UserID})
+		mCommentList, replyCnt, err = hotCommentList(ctx, req.VideoId)
 		if err != nil {
 			span.RecordError(err)
-			span.SetStatus(codes.Error, "获取用户信息失败")
-			klog.Error("获取用户信息失败, err: ", err)
+			span.SetStatus(codes.Error, "获取热门评论列表失败")
+			klog.Error("获取热门评论列表失败, err: ", err)
 			return nil, err
 		}
-		commentList[i] = &comment.Comment{
-			Id:         c.ID,
-			User:       user.User,
-			Content:    c.Content,
-			CreateDate: c.CreateTime.Format("01-02"),
+		mCommentList, nextCursor, hasMore = cursor.PaginateOffset(cursor.KindHotComment, req.VideoId, mCommentList, offset, limit)
+	} else {
+		// 按发布时间倒序
+		lastID, limit, err := cursor.Parse(cursor.KindComment, req.VideoId, req.Cursor, req.Count)
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, "解析分页游标失败")
+			klog.Error("解析分页游标失败, err: ", err)
+			return nil, err
+		}
+
+		mCommentList, err = dal.GetCommentList(ctx, req.VideoId, lastID, limit+1)
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, "获取评论列表失败")
+			klog.Error("获取评论列表失败, err: ", err)
+			return nil, err
+		}
+		mCommentList, nextCursor, hasMore = cursor.Paginate(cursor.KindComment, req.VideoId, mCommentList, limit, func(c *model.Comment) int64 { return c.ID })
+
+		// 获取评论回复数
+		commentIDs := make([]int64, len(mCommentList))
+		for i, c := range mCommentList {
+			commentIDs[i] = c.ID
+		}
+		replyCnt, err = dal.BatchGetCommentReplyCount(ctx, commentIDs)
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, "获取评论回复数失败")
+			klog.Error("获取评论回复数失败, err: ", err)
+			return nil, err
 		}
 	}
 
+	commentList, err := buildCommentList(ctx, req.UserId, mCommentList, replyCnt)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, "组装评论列表失败")
+		klog.Error("组装评论列表失败, err: ", err)
+		return nil, err
+	}
+
 	// 返回响应
-	resp = &comment.CommentListResponse{CommentList: commentList}
+	resp = &comment.CommentListResponse{CommentList: commentList, NextCursor: nextCursor, HasMore: hasMore}
 
 	return
 }
@@ -153,3 +265,199 @@ func (s *CommentServiceImpl) CommentCnt(ctx context.Context, videoId int64) (res
 
 	return
 }
+
+// BatchCommentCnt implements the CommentServiceImpl interface.
+func (s *CommentServiceImpl) BatchCommentCnt(ctx context.Context, videoIds []int64) (resp map[int64]int64, err error) {
+	ctx, span := otel.Tracer("comment").Start(ctx, "BatchCommentCnt")
+	defer span.End()
+
+	resp, err = dal.BatchGetVideoCommentCount(ctx, videoIds)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, "批量获取评论数失败")
+		klog.Error("批量获取评论数失败, err: ", err)
+		return
+	}
+
+	return
+}
+
+// CommentReplyList implements the CommentServiceImpl interface.
+func (s *CommentServiceImpl) CommentReplyList(ctx context.Context, req *comment.CommentReplyListRequest) (resp *comment.CommentReplyListResponse, err error) {
+	ctx, span := otel.Tracer("comment").Start(ctx, "CommentReplyList")
+	defer span.End()
+
+	// 解析分页参数
+	lastID, limit, err := cursor.Parse(cursor.KindReply, req.CommentId, req.Cursor, req.Count)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, "解析分页游标失败")
+		klog.Error("解析分页游标失败, err: ", err)
+		return nil, err
+	}
+
+	// 判断根评论是否存在
+	root, err := dal.GetCommentByID(ctx, req.CommentId)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, "获取根评论失败")
+		klog.Error("获取根评论失败, err: ", err)
+		return nil, err
+	}
+	if root.RootID != 0 {
+		span.SetStatus(codes.Error, "评论不是根评论")
+		klog.Error("评论不是根评论")
+		return nil, dal.ErrCommentNotExist
+	}
+
+	// 获取回复列表
+	mReplyList, err := dal.GetCommentReplyList(ctx, req.CommentId, lastID, limit+1)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, "获取回复列表失败")
+		klog.Error("获取回复列表失败, err: ", err)
+		return nil, err
+	}
+	mReplyList, nextCursor, hasMore := cursor.Paginate(cursor.KindReply, req.CommentId, mReplyList, limit, func(c *model.Comment) int64 { return c.ID })
+
+	replyList, err := buildCommentList(ctx, req.UserId, mReplyList, nil)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, "组装回复列表失败")
+		klog.Error("组装回复列表失败, err: ", err)
+		return nil, err
+	}
+
+	// 返回响应
+	resp = &comment.CommentReplyListResponse{CommentList: replyList, NextCursor: nextCursor, HasMore: hasMore}
+
+	return
+}
+
+// buildCommentList 批量获取评论用户信息、点赞数和是否点赞，组装评论列表
+func buildCommentList(ctx context.Context, userID *int64, mCommentList []*model.Comment, replyCnt map[int64]int64) ([]*comment.Comment, error) {
+	if len(mCommentList) == 0 {
+		return []*comment.Comment{}, nil
+	}
+
+	userIDs := make([]int64, len(mCommentList))
+	commentIDs := make([]int64, len(mCommentList))
+	for i, c := range mCommentList {
+		userIDs[i] = c.UserID
+		commentIDs[i] = c.ID
+	}
+
+	var (
+		userList      []*user.User
+		favoriteCnt   map[int64]int64
+		favoriteExist map[int64]bool
+	)
+	g, gCtx := errgroup.WithContext(ctx)
+	g.Go(func() (err error) {
+		userList, err = client.UserClient.BatchUserInfo(gCtx, &user.BatchUserInfoRequest{
+			UserId:    userID,
+			AuthorIds: userIDs,
+		})
+		return
+	})
+	g.Go(func() (err error) {
+		favoriteCnt, err = dal.BatchGetCommentFavoriteCount(gCtx, commentIDs)
+		return
+	})
+	if userID != nil {
+		g.Go(func() (err error) {
+			favoriteExist, err = dal.BatchCheckCommentFavoriteExist(gCtx, *userID, commentIDs)
+			return
+		})
+	}
+	if err := g.Wait(); err != nil {
+		return nil, err
+	}
+
+	commentList := make([]*comment.Comment, len(mCommentList))
+	for i, c := range mCommentList {
+		commentList[i] = &comment.Comment{
+			Id:            c.ID,
+			User:          userList[i],
+			Content:       c.Content,
+			CreateDate:    c.CreateTime.Format("01-02"),
+			ParentId:      c.ParentID,
+			RootId:        c.RootID,
+			ReplyCount:    replyCnt[c.ID],
+			FavoriteCount: favoriteCnt[c.ID],
+			IsFavorite:    favoriteExist[c.ID],
+		}
+	}
+
+	return commentList, nil
+}
+
+// CommentFavoriteAction implements the CommentServiceImpl interface.
+func (s *CommentServiceImpl) CommentFavoriteAction(ctx context.Context, req *comment.CommentFavoriteActionRequest) (resp *comment.CommentFavoriteActionResponse, err error) {
+	ctx, span := otel.Tracer("comment").Start(ctx, "CommentFavoriteAction")
+	defer span.End()
+
+	// 判断评论是否存在
+	mComment, err := dal.GetCommentByID(ctx, req.CommentId)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, "获取评论失败")
+		klog.Error("获取评论失败, err: ", err)
+		return nil, err
+	}
+
+	// 检查是否已经点赞
+	exist, err := dal.CheckCommentFavoriteExist(ctx, req.UserId, req.CommentId)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, "检查是否已经点赞失败")
+		klog.Error("检查是否已经点赞失败, err: ", err)
+		return nil, err
+	}
+
+	// 已经点赞
+	if exist && req.ActionType == 1 {
+		return nil, dal.ErrAlreadyFavorite
+	}
+	// 未点赞
+	if !exist && req.ActionType == -1 {
+		return nil, dal.ErrNotFavorite
+	}
+
+	// 通过kafka异步写入数据库
+	err = kafka.CommentFavorite(ctx, &kafka.CommentFavoriteEvent{
+		UserID:     req.UserId,
+		CommentID:  req.CommentId,
+		VideoID:    mComment.VideoID,
+		RootID:     mComment.RootID,
+		ActionType: req.ActionType,
+	})
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, "通过kafka异步写入数据库失败")
+		klog.Error("通过kafka异步写入数据库失败, err: ", err)
+		return nil, err
+	}
+
+	keyUserCommentFavorite := dal.GetRedisKey(dal.KeyUserCommentFavoritePF, strconv.FormatInt(req.UserId, 10))
+
+	// 更新缓存，评论点赞数在写入数据库后由消费者更新
+	pipe := dal.RDB.Pipeline()
+	if req.ActionType == 1 {
+		pipe.SAdd(ctx, keyUserCommentFavorite, req.CommentId)
+		pipe.Expire(ctx, keyUserCommentFavorite, dal.ExpireTime+dal.GetRandomTime())
+	} else {
+		pipe.SRem(ctx, keyUserCommentFavorite, req.CommentId)
+	}
+	if _, err = pipe.Exec(ctx); err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, "更新缓存相关字段失败")
+		klog.Error("更新缓存相关字段失败, err: ", err)
+		return nil, err
+	}
+
+	// 返回响应
+	resp = &comment.CommentFavoriteActionResponse{}
+
+	return
+}
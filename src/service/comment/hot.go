@@ -0,0 +1,43 @@
+package main
+
+import (
+	"context"
+
+	"douyin/src/common/hotrank"
+	"douyin/src/dal"
+	"douyin/src/dal/model"
+)
+
+const (
+	sortHot          = "hot" // 按热度排序
+	hotCandidateSize = 500   // 互动最多和最新的候选评论各取的数量
+)
+
+// hotCommentList 获取视频互动最多和最新的一级评论并按热度倒序排列，同时返回评论回复数
+func hotCommentList(ctx context.Context, videoID int64) ([]*model.Comment, map[int64]int64, error) {
+	mCommentList, err := dal.GetHotCommentCandidates(ctx, videoID, hotCandidateSize)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	commentIDs := make([]int64, len(mCommentList))
+	for i, c := range mCommentList {
+		commentIDs[i] = c.ID
+	}
+	favoriteCnt, err := dal.BatchGetCommentFavoriteCount(ctx, commentIDs)
+	if err != nil {
+		return nil, nil, err
+	}
+	replyCnt, err := dal.BatchGetCommentReplyCount(ctx, commentIDs)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// 点赞数和回复数加权后按发布时长衰减
+	hotrank.Sort(mCommentList, func(c *model.Comment) float64 {
+		heat := dal.CommentHeatFavorite*float64(favoriteCnt[c.ID]) + dal.CommentHeatReply*float64(replyCnt[c.ID])
+		return hotrank.Score(heat, c.CreateTime)
+	})
+
+	return mCommentList, replyCnt, nil
+}
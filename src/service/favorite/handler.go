@@ -7,8 +7,10 @@ import (
 	"time"
 
 	"douyin/src/client"
+	"douyin/src/common/cursor"
 	"douyin/src/common/kafka"
 	"douyin/src/dal"
+	"douyin/src/dal/model"
 	"douyin/src/kitex_gen/favorite"
 	"douyin/src/kitex_gen/video"
 
@@ -110,14 +112,42 @@ func (s *FavoriteServiceImpl) FavoriteList(ctx context.Context, req *favorite.Fa
 	ctx, span := otel.Tracer("favorite").Start(ctx, "FavoriteList")
 	defer span.End()
 
+	// 解析分页参数
+	lastID, limit, err := cursor.Parse(cursor.KindFavorite, req.AuthorId, req.Cursor, req.Count)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, "解析分页游标失败")
+		klog.Error("解析分页游标失败, err: ", err)
+		return nil, err
+	}
+
+	// 与对方存在拉黑关系时不能查看其喜欢列表
+	if req.UserId != nil && *req.UserId != req.AuthorId {
+		blocked, err := dal.CheckBlocked(ctx, *req.UserId, req.AuthorId)
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, "检查拉黑关系失败")
+			klog.Error("检查拉黑关系失败, err: ", err)
+			return nil, err
+		}
+		if blocked {
+			return nil, dal.ErrBlocked
+		}
+	}
+
 	// 获取喜欢的视频ID列表
-	videoIDs, err := dal.GetFavoriteList(ctx, req.AuthorId)
+	favorites, err := dal.GetFavoriteList(ctx, req.AuthorId, lastID, limit+1)
 	if err != nil {
 		span.RecordError(err)
 		span.SetStatus(codes.Error, "获取喜欢的视频ID列表失败")
 		klog.Error("获取喜欢的视频ID列表失败, err: ", err)
 		return nil, err
 	}
+	favorites, nextCursor, hasMore := cursor.Paginate(cursor.KindFavorite, req.AuthorId, favorites, limit, func(f *model.Favorite) int64 { return f.ID })
+	videoIDs := make([]int64, len(favorites))
+	for i, f := range favorites {
+		videoIDs[i] = f.VideoID
+	}
 
 	// 获取视频列表
 	videoList, err := client.VideoClient.VideoInfoList(ctx, &video.VideoInfoListRequest{
@@ -131,7 +161,7 @@ func (s *FavoriteServiceImpl) FavoriteList(ctx context.Context, req *favorite.Fa
 		return nil, err
 	}
 	// 返回响应
-	resp = &favorite.FavoriteListResponse{VideoList: videoList}
+	resp = &favorite.FavoriteListResponse{VideoList: videoList, NextCursor: nextCursor, HasMore: hasMore}
 
 	return
 }
@@ -166,7 +196,7 @@ func (s *FavoriteServiceImpl) TotalFavoritedCnt(ctx context.Context, userId int6
 		span.SetStatus(codes.Error, "dal.Cache.Get failed")
 		klog.Error("dal.Cache.Get failed, err: ", err)
 	}
-	
+
 	// 使用singleflight解决缓存击穿并减少redis压力
 	_, err, _ = dal.G.Do(key, func() (interface{}, error) {
 		go func() {
@@ -235,3 +265,67 @@ func (s *FavoriteServiceImpl) FavoriteExist(ctx context.Context, userId int64, v
 
 	return
 }
+
+// BatchFavoriteCnt implements the FavoriteServiceImpl interface.
+func (s *FavoriteServiceImpl) BatchFavoriteCnt(ctx context.Context, videoIds []int64) (resp map[int64]int64, err error) {
+	ctx, span := otel.Tracer("favorite").Start(ctx, "BatchFavoriteCnt")
+	defer span.End()
+
+	resp, err = dal.BatchGetVideoFavoriteCount(ctx, videoIds)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, "批量获取视频点赞数失败")
+		klog.Error("批量获取视频点赞数失败, err: ", err)
+		return
+	}
+
+	return
+}
+
+// BatchFavoriteExist implements the FavoriteServiceImpl interface.
+func (s *FavoriteServiceImpl) BatchFavoriteExist(ctx context.Context, userId int64, videoIds []int64) (resp map[int64]bool, err error) {
+	ctx, span := otel.Tracer("favorite").Start(ctx, "BatchFavoriteExist")
+	defer span.End()
+
+	resp, err = dal.BatchCheckFavoriteExist(ctx, userId, videoIds)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, "批量查询是否已经点赞失败")
+		klog.Error("批量查询是否已经点赞失败, err: ", err)
+		return
+	}
+
+	return
+}
+
+// BatchUserFavoriteCnt implements the FavoriteServiceImpl interface.
+func (s *FavoriteServiceImpl) BatchUserFavoriteCnt(ctx context.Context, userIds []int64) (resp map[int64]int64, err error) {
+	ctx, span := otel.Tracer("favorite").Start(ctx, "BatchUserFavoriteCnt")
+	defer span.End()
+
+	resp, err = dal.BatchGetUserFavoriteCount(ctx, userIds)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, "批量获取用户喜欢的视频数失败")
+		klog.Error("批量获取用户喜欢的视频数失败, err: ", err)
+		return
+	}
+
+	return
+}
+
+// BatchTotalFavoritedCnt implements the FavoriteServiceImpl interface.
+func (s *FavoriteServiceImpl) BatchTotalFavoritedCnt(ctx context.Context, userIds []int64) (resp map[int64]int64, err error) {
+	ctx, span := otel.Tracer("favorite").Start(ctx, "BatchTotalFavoritedCnt")
+	defer span.End()
+
+	resp, err = dal.BatchGetUserTotalFavorited(ctx, userIds)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, "批量获取用户总获赞数失败")
+		klog.Error("批量获取用户总获赞数失败, err: ", err)
+		return
+	}
+
+	return
+}
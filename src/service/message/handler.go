@@ -2,19 +2,31 @@ package main
 
 import (
 	"context"
+	"encoding/json"
+	"slices"
+	"strings"
 	"sync"
 	"time"
 
+	"douyin/src/client"
+	"douyin/src/common/chat"
+	"douyin/src/common/cursor"
 	"douyin/src/common/kafka"
+	"douyin/src/common/moderation"
+	"douyin/src/common/snowflake"
+	"douyin/src/config"
 	"douyin/src/dal"
 	"douyin/src/dal/model"
 	"douyin/src/kitex_gen/message"
+	"douyin/src/kitex_gen/user"
 
 	"github.com/cloudwego/kitex/pkg/klog"
 	"go.opentelemetry.io/otel"
 	"go.opentelemetry.io/otel/codes"
 )
 
+const defaultRecallWindow = 2 * time.Minute
+
 // MessageServiceImpl implements the last service interface defined in the IDL.
 type MessageServiceImpl struct{}
 
@@ -23,8 +35,26 @@ func (s *MessageServiceImpl) MessageChat(ctx context.Context, req *message.Messa
 	ctx, span := otel.Tracer("message").Start(ctx, "MessageChat")
 	defer span.End()
 
-	// 操作数据库
-	mMessageList, err := dal.MessageList(ctx, req.UserId, req.ToUserId, req.LastTime)
+	// 操作数据库，群聊只返回加入之后的消息
+	var (
+		mMessageList []*model.Message
+		member       *model.GroupMember
+	)
+	if groupID := req.GetGroupId(); groupID > 0 {
+		member, err = dal.GetGroupMember(ctx, groupID, req.UserId)
+		if err == dal.ErrNotGroupMember {
+			return nil, err
+		}
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, "查询群聊成员失败")
+			klog.Error("查询群聊成员失败, err: ", err)
+			return nil, err
+		}
+		mMessageList, err = dal.GroupMessageList(ctx, req.UserId, groupID, max(req.LastTime, member.CreateTime.UnixMilli()))
+	} else {
+		mMessageList, err = dal.MessageList(ctx, req.UserId, req.ToUserId, req.LastTime)
+	}
 	if err != nil {
 		span.RecordError(err)
 		span.SetStatus(codes.Error, "操作数据库失败")
@@ -32,6 +62,20 @@ func (s *MessageServiceImpl) MessageChat(ctx context.Context, req *message.Messa
 		return nil, err
 	}
 
+	// 拉取到的单聊消息视为已送达，通知发送者
+	var deliverTime int64
+	for _, m := range mMessageList {
+		if m.ToUserID == req.UserId && m.Status == dal.MessageStatusSent {
+			deliverTime = max(deliverTime, m.CreateTime)
+			m.Status = dal.MessageStatusDelivered
+		}
+	}
+	if deliverTime > 0 {
+		if err := updateReceipt(ctx, req.UserId, req.ToUserId, dal.MessageStatusDelivered, deliverTime); err != nil {
+			klog.Error("更新消息送达状态失败, err: ", err)
+		}
+	}
+
 	// 将model.Message转换为message.Message
 	var wg sync.WaitGroup
 	wg.Add(len(mMessageList))
@@ -44,6 +88,14 @@ func (s *MessageServiceImpl) MessageChat(ctx context.Context, req *message.Messa
 	}
 	wg.Wait()
 
+	// 获取分享的视频和用户预览
+	if err := attachPreviews(ctx, req.UserId, messageList); err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, "获取消息预览失败")
+		klog.Error("获取消息预览失败, err: ", err)
+		return nil, err
+	}
+
 	// 返回响应
 	resp = &message.MessageChatResponse{MessageList: messageList}
 
@@ -55,15 +107,57 @@ func (s *MessageServiceImpl) MessageAction(ctx context.Context, req *message.Mes
 	ctx, span := otel.Tracer("message").Start(ctx, "MessageAction")
 	defer span.End()
 
-	convertID := dal.GetConvertID(req.UserId, req.ToUserId)
+	// 按消息类型校验内容，文本消息经过内容审核
+	content, payload, err := messageContent(ctx, req)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, "消息内容校验未通过")
+		klog.Error("消息内容校验未通过, err: ", err)
+		return nil, err
+	}
+
+	// 发送时生成消息ID和发送时间，发送者无需等待消息写入数据库
 	msg := &model.Message{
+		ID:         snowflake.GenerateID(),
 		ToUserID:   req.ToUserId,
 		FromUserID: req.UserId,
-		ConvertID:  convertID,
-		Content:    req.Content,
+		ConvertID:  dal.GetConvertID(req.UserId, req.ToUserId),
+		Content:    content,
 		CreateTime: time.Now().UnixMilli(),
+		MsgType:    int16(req.MsgType),
+		Payload:    payload,
 	}
-	
+	receiverIDs := []int64{req.ToUserId, req.UserId}
+
+	// 群聊消息以群聊ID作为会话ID，推送给全部成员
+	if groupID := req.GetGroupId(); groupID > 0 {
+		receiverIDs, err = groupMemberIDs(ctx, groupID, req.UserId)
+		if err == dal.ErrGroupNotExist || err == dal.ErrNotGroupMember {
+			return nil, err
+		}
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, "查询群聊成员失败")
+			klog.Error("查询群聊成员失败, err: ", err)
+			return nil, err
+		}
+		msg.ToUserID = 0
+		msg.GroupID = groupID
+		msg.ConvertID = dal.GetGroupConvertID(groupID)
+	} else {
+		// 单聊检查拉黑关系和接收者的私信权限
+		err = checkMessagePermission(ctx, req.UserId, req.ToUserId)
+		if err == dal.ErrBlocked || err == dal.ErrFriendOnly || err == dal.ErrUserNotExist {
+			return nil, err
+		}
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, "检查私信权限失败")
+			klog.Error("检查私信权限失败, err: ", err)
+			return nil, err
+		}
+	}
+
 	// 通过kafka更新数据库
 	if err := kafka.SendMessage(ctx, msg); err != nil {
 		span.RecordError(err)
@@ -72,18 +166,658 @@ func (s *MessageServiceImpl) MessageAction(ctx context.Context, req *message.Mes
 		return nil, err
 	}
 
+	// 推送给在线的接收者和发送者的其他设备，失败时客户端通过拉取接口获取
+	msgResp := toMessageResponse(msg)
+	pushMessage(ctx, msg, msgResp, receiverIDs)
+
+	// 返回响应
+	resp = &message.MessageActionResponse{Message: msgResp}
+
+	return
+}
+
+// ConversationList implements the MessageServiceImpl interface.
+func (s *MessageServiceImpl) ConversationList(ctx context.Context, req *message.ConversationListRequest) (resp *message.ConversationListResponse, err error) {
+	ctx, span := otel.Tracer("message").Start(ctx, "ConversationList")
+	defer span.End()
+
+	// 解析分页参数，游标为最后一条消息的时间和会话ID
+	lastTime, lastConvertID, limit, err := cursor.ParseKey(cursor.KindConversation, req.UserId, req.Cursor, req.Count)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, "解析分页游标失败")
+		klog.Error("解析分页游标失败, err: ", err)
+		return nil, err
+	}
+
+	// 查询会话列表
+	conversations, err := dal.GetConversationList(ctx, req.UserId, lastTime, lastConvertID, limit+1)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, "查询会话列表失败")
+		klog.Error("查询会话列表失败, err: ", err)
+		return nil, err
+	}
+	conversations, nextCursor, hasMore := cursor.PaginateKey(cursor.KindConversation, req.UserId, conversations, limit, func(c *dal.Conversation) (int64, string) {
+		return c.LastTime, c.ConvertID
+	})
+
+	// 获取单聊对方的用户信息和群聊信息
+	peerIDs := make([]int64, 0, len(conversations))
+	groupIDs := make([]int64, 0)
+	for _, c := range conversations {
+		if c.LastMessage.GroupID > 0 {
+			groupIDs = append(groupIDs, c.LastMessage.GroupID)
+			continue
+		}
+		peerIDs = append(peerIDs, peerID(c.LastMessage, req.UserId))
+	}
+	userList, err := client.UserClient.BatchUserInfo(ctx, &user.BatchUserInfoRequest{
+		UserId:    &req.UserId,
+		AuthorIds: peerIDs,
+	})
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, "获取用户信息失败")
+		klog.Error("获取用户信息失败, err: ", err)
+		return nil, err
+	}
+	users := make(map[int64]*user.User, len(userList))
+	for _, u := range userList {
+		users[u.Id] = u
+	}
+	groupList, err := dal.GetGroupList(ctx, groupIDs)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, "获取群聊信息失败")
+		klog.Error("获取群聊信息失败, err: ", err)
+		return nil, err
+	}
+	groups := make(map[int64]*model.ChatGroup, len(groupList))
+	for _, g := range groupList {
+		groups[g.ID] = g
+	}
+
+	conversationList := make([]*message.Conversation, len(conversations))
+	for i, c := range conversations {
+		conversation := &message.Conversation{
+			ConvertId:   c.ConvertID,
+			LastMessage: toMessageResponse(c.LastMessage),
+			LastTime:    c.LastMessage.CreateTime,
+			UnreadCount: c.UnreadCount,
+		}
+		if c.LastMessage.GroupID > 0 {
+			if g, ok := groups[c.LastMessage.GroupID]; ok {
+				conversation.Group = toGroupResponse(g)
+			}
+		} else {
+			conversation.User = users[peerID(c.LastMessage, req.UserId)]
+		}
+		conversationList[i] = conversation
+	}
+
+	// 返回响应
+	resp = &message.ConversationListResponse{ConversationList: conversationList, NextCursor: nextCursor, HasMore: hasMore}
+
+	return
+}
+
+// MarkRead implements the MessageServiceImpl interface.
+func (s *MessageServiceImpl) MarkRead(ctx context.Context, req *message.MarkReadRequest) (resp *message.MarkReadResponse, err error) {
+	ctx, span := otel.Tracer("message").Start(ctx, "MarkRead")
+	defer span.End()
+
+	readTime := req.ReadTime
+	if readTime <= 0 {
+		readTime = time.Now().UnixMilli()
+	}
+
+	// 群聊只记录已读位置，不更新消息状态
+	if groupID := req.GetGroupId(); groupID > 0 {
+		_, err := groupMemberIDs(ctx, groupID, req.UserId)
+		if err == dal.ErrGroupNotExist || err == dal.ErrNotGroupMember {
+			return nil, err
+		}
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, "查询群聊成员失败")
+			klog.Error("查询群聊成员失败, err: ", err)
+			return nil, err
+		}
+		if err := dal.MarkRead(ctx, req.UserId, dal.GetGroupConvertID(groupID), readTime); err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, "更新已读位置失败")
+			klog.Error("更新已读位置失败, err: ", err)
+			return nil, err
+		}
+		return &message.MarkReadResponse{}, nil
+	}
+
+	// 更新已读位置和未读数
+	convertID := dal.GetConvertID(req.UserId, req.ToUserId)
+	if err := dal.MarkRead(ctx, req.UserId, convertID, readTime); err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, "更新已读位置失败")
+		klog.Error("更新已读位置失败, err: ", err)
+		return nil, err
+	}
+
+	// 更新消息已读状态并通知发送者，未写入数据库的消息在写入时根据已读位置标记
+	if err := updateReceipt(ctx, req.UserId, req.ToUserId, dal.MessageStatusRead, readTime); err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, "更新消息已读状态失败")
+		klog.Error("更新消息已读状态失败, err: ", err)
+		return nil, err
+	}
+
+	// 返回响应
+	resp = &message.MarkReadResponse{}
+
+	return
+}
+
+// peerID 会话中对方的用户id
+func peerID(m *model.Message, userID int64) int64 {
+	if m.FromUserID == userID {
+		return m.ToUserID
+	}
+	return m.FromUserID
+}
+
+// RecallMessage implements the MessageServiceImpl interface.
+func (s *MessageServiceImpl) RecallMessage(ctx context.Context, req *message.RecallMessageRequest) (resp *message.RecallMessageResponse, err error) {
+	ctx, span := otel.Tracer("message").Start(ctx, "RecallMessage")
+	defer span.End()
+
+	// 撤回消息
+	convertID := dal.GetConvertID(req.UserId, req.ToUserId)
+	if groupID := req.GetGroupId(); groupID > 0 {
+		convertID = dal.GetGroupConvertID(groupID)
+	}
+	after := time.Now().Add(-recallWindow()).UnixMilli()
+	msg, err := dal.RecallMessage(ctx, req.UserId, convertID, req.MessageId, after)
+	if err == dal.ErrMessageNotExist || err == dal.ErrRecallExpired {
+		return nil, err
+	}
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, "撤回消息失败")
+		klog.Error("撤回消息失败, err: ", err)
+		return nil, err
+	}
+
+	// 同步会话列表中的最后一条消息
+	if err := dal.RefreshConversationLast(ctx, msg); err != nil {
+		klog.Error("更新会话最后一条消息失败, err: ", err)
+	}
+
+	// 推送给在线的双方或群聊成员，失败时客户端通过拉取接口获取
+	msgResp := toMessageResponse(msg)
+	receiverIDs := []int64{msg.ToUserID, msg.FromUserID}
+	if msg.GroupID > 0 {
+		memberIDs, err := dal.GetGroupMemberIDs(ctx, msg.GroupID)
+		if err != nil {
+			klog.Error("查询群聊成员失败, err: ", err)
+		}
+		receiverIDs = memberIDs
+	}
+	if _, err := chat.Push(ctx, receiverIDs, &chat.Event{Type: chat.EventRecall, Message: msgResp}); err != nil {
+		klog.Error("推送撤回消息失败, err: ", err)
+	}
+
+	// 返回响应
+	resp = &message.RecallMessageResponse{Message: msgResp}
+
+	return
+}
+
+// DeleteMessage implements the MessageServiceImpl interface.
+func (s *MessageServiceImpl) DeleteMessage(ctx context.Context, req *message.DeleteMessageRequest) (resp *message.DeleteMessageResponse, err error) {
+	ctx, span := otel.Tracer("message").Start(ctx, "DeleteMessage")
+	defer span.End()
+
+	// 仅对自己删除消息，群聊消息按成员单独记录
+	if groupID := req.GetGroupId(); groupID > 0 {
+		err = dal.DeleteGroupMessage(ctx, req.UserId, dal.GetGroupConvertID(groupID), req.MessageId)
+	} else {
+		err = dal.DeleteMessage(ctx, req.UserId, dal.GetConvertID(req.UserId, req.ToUserId), req.MessageId)
+	}
+	if err == dal.ErrMessageNotExist {
+		return nil, err
+	}
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, "删除消息失败")
+		klog.Error("删除消息失败, err: ", err)
+		return nil, err
+	}
+
+	// 返回响应
+	resp = &message.DeleteMessageResponse{}
+
+	return
+}
+
+// CreateGroup implements the MessageServiceImpl interface.
+func (s *MessageServiceImpl) CreateGroup(ctx context.Context, req *message.CreateGroupRequest) (resp *message.CreateGroupResponse, err error) {
+	ctx, span := otel.Tracer("message").Start(ctx, "CreateGroup")
+	defer span.End()
+
+	// 审核群聊名称
+	name, err := moderation.Moderate(ctx, moderation.SceneGroupName, req.UserId, req.Name)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, "群聊名称审核未通过")
+		klog.Error("群聊名称审核未通过, err: ", err)
+		return nil, err
+	}
+
+	// 检查初始成员是否存在
+	members, err := batchUserInfo(ctx, req.MemberIds)
+	if err == dal.ErrUserNotExist {
+		return nil, err
+	}
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, "查询成员信息失败")
+		klog.Error("查询成员信息失败, err: ", err)
+		return nil, err
+	}
+
+	// 检查能否将初始成员拉入群聊
+	err = checkGroupInvite(ctx, req.UserId, members)
+	if err == dal.ErrBlocked || err == dal.ErrFriendOnly {
+		return nil, err
+	}
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, "检查成员权限失败")
+		klog.Error("检查成员权限失败, err: ", err)
+		return nil, err
+	}
+
+	// 创建群聊
+	group, err := dal.CreateGroup(ctx, req.UserId, name, req.MemberIds, req.GetJoinable())
+	if err == dal.ErrGroupFull {
+		return nil, err
+	}
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, "创建群聊失败")
+		klog.Error("创建群聊失败, err: ", err)
+		return nil, err
+	}
+
+	// 返回响应
+	resp = &message.CreateGroupResponse{Group: toGroupResponse(group)}
+
+	return
+}
+
+// JoinGroup implements the MessageServiceImpl interface.
+func (s *MessageServiceImpl) JoinGroup(ctx context.Context, req *message.JoinGroupRequest) (resp *message.JoinGroupResponse, err error) {
+	ctx, span := otel.Tracer("message").Start(ctx, "JoinGroup")
+	defer span.End()
+
+	// 与群聊成员存在拉黑关系时不能加入
+	memberIDs, err := dal.GetGroupMemberIDs(ctx, req.GroupId)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, "查询群聊成员失败")
+		klog.Error("查询群聊成员失败, err: ", err)
+		return nil, err
+	}
+	blocked, err := dal.BatchCheckBlocked(ctx, req.UserId, memberIDs)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, "检查拉黑关系失败")
+		klog.Error("检查拉黑关系失败, err: ", err)
+		return nil, err
+	}
+	if len(blocked) > 0 {
+		return nil, dal.ErrBlocked
+	}
+
+	// 加入群聊
+	err = dal.JoinGroup(ctx, req.GroupId, req.UserId)
+	if err == dal.ErrGroupNotExist || err == dal.ErrGroupNotJoinable || err == dal.ErrAlreadyInGroup || err == dal.ErrGroupFull || err == dal.ErrGroupBanned {
+		return nil, err
+	}
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, "加入群聊失败")
+		klog.Error("加入群聊失败, err: ", err)
+		return nil, err
+	}
+
+	// 返回响应
+	resp = &message.JoinGroupResponse{}
+
+	return
+}
+
+// LeaveGroup implements the MessageServiceImpl interface.
+func (s *MessageServiceImpl) LeaveGroup(ctx context.Context, req *message.LeaveGroupRequest) (resp *message.LeaveGroupResponse, err error) {
+	ctx, span := otel.Tracer("message").Start(ctx, "LeaveGroup")
+	defer span.End()
+
+	// 退出群聊
+	err = dal.LeaveGroup(ctx, req.GroupId, req.UserId)
+	if err == dal.ErrGroupNotExist || err == dal.ErrNotGroupMember {
+		return nil, err
+	}
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, "退出群聊失败")
+		klog.Error("退出群聊失败, err: ", err)
+		return nil, err
+	}
+
+	// 从会话列表中移除群聊
+	if err := dal.RemoveConversation(ctx, req.UserId, dal.GetGroupConvertID(req.GroupId)); err != nil {
+		klog.Error("移除群聊会话失败, err: ", err)
+	}
+
+	// 返回响应
+	resp = &message.LeaveGroupResponse{}
+
+	return
+}
+
+// KickGroupMember implements the MessageServiceImpl interface.
+func (s *MessageServiceImpl) KickGroupMember(ctx context.Context, req *message.KickGroupMemberRequest) (resp *message.KickGroupMemberResponse, err error) {
+	ctx, span := otel.Tracer("message").Start(ctx, "KickGroupMember")
+	defer span.End()
+
+	// 移出群聊成员
+	err = dal.KickGroupMember(ctx, req.GroupId, req.UserId, req.MemberId)
+	if err == dal.ErrGroupNotExist || err == dal.ErrNotGroupMember || err == dal.ErrGroupPermission {
+		return nil, err
+	}
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, "移出群聊成员失败")
+		klog.Error("移出群聊成员失败, err: ", err)
+		return nil, err
+	}
+
+	// 从被移出成员的会话列表中移除群聊
+	if err := dal.RemoveConversation(ctx, req.MemberId, dal.GetGroupConvertID(req.GroupId)); err != nil {
+		klog.Error("移除群聊会话失败, err: ", err)
+	}
+
+	// 返回响应
+	resp = &message.KickGroupMemberResponse{}
+
+	return
+}
+
+// SetGroupRole implements the MessageServiceImpl interface.
+func (s *MessageServiceImpl) SetGroupRole(ctx context.Context, req *message.SetGroupRoleRequest) (resp *message.SetGroupRoleResponse, err error) {
+	ctx, span := otel.Tracer("message").Start(ctx, "SetGroupRole")
+	defer span.End()
+
+	// 设置成员角色
+	err = dal.SetGroupMemberRole(ctx, req.GroupId, req.UserId, req.MemberId, int16(req.Role))
+	if err == dal.ErrGroupNotExist || err == dal.ErrNotGroupMember || err == dal.ErrGroupPermission {
+		return nil, err
+	}
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, "设置成员角色失败")
+		klog.Error("设置成员角色失败, err: ", err)
+		return nil, err
+	}
+
+	// 返回响应
+	resp = &message.SetGroupRoleResponse{}
+
+	return
+}
+
+// GroupMemberList implements the MessageServiceImpl interface.
+func (s *MessageServiceImpl) GroupMemberList(ctx context.Context, req *message.GroupMemberListRequest) (resp *message.GroupMemberListResponse, err error) {
+	ctx, span := otel.Tracer("message").Start(ctx, "GroupMemberList")
+	defer span.End()
+
+	// 只有群聊成员可以查看成员列表
+	_, err = groupMemberIDs(ctx, req.GroupId, req.UserId)
+	if err == dal.ErrGroupNotExist || err == dal.ErrNotGroupMember {
+		return nil, err
+	}
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, "查询群聊成员失败")
+		klog.Error("查询群聊成员失败, err: ", err)
+		return nil, err
+	}
+
+	// 解析分页参数
+	lastID, limit, err := cursor.Parse(cursor.KindGroupMember, req.GroupId, req.Cursor, req.Count)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, "解析分页游标失败")
+		klog.Error("解析分页游标失败, err: ", err)
+		return nil, err
+	}
+
+	// 获取成员列表
+	members, err := dal.GetGroupMemberList(ctx, req.GroupId, lastID, limit+1)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, "获取群聊成员列表失败")
+		klog.Error("获取群聊成员列表失败, err: ", err)
+		return nil, err
+	}
+	members, nextCursor, hasMore := cursor.Paginate(cursor.KindGroupMember, req.GroupId, members, limit, func(m *model.GroupMember) int64 { return m.ID })
+
+	// 获取用户信息
+	userIDs := make([]int64, len(members))
+	for i, m := range members {
+		userIDs[i] = m.UserID
+	}
+	userList, err := client.UserClient.BatchUserInfo(ctx, &user.BatchUserInfoRequest{
+		UserId:    &req.UserId,
+		AuthorIds: userIDs,
+	})
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, "获取用户信息失败")
+		klog.Error("获取用户信息失败, err: ", err)
+		return nil, err
+	}
+	users := make(map[int64]*user.User, len(userList))
+	for _, u := range userList {
+		users[u.Id] = u
+	}
+
+	memberList := make([]*message.GroupMember, len(members))
+	for i, m := range members {
+		memberList[i] = &message.GroupMember{User: users[m.UserID], Role: int32(m.Role)}
+	}
+
 	// 返回响应
-	resp = &message.MessageActionResponse{}
+	resp = &message.GroupMemberListResponse{MemberList: memberList, NextCursor: nextCursor, HasMore: hasMore}
 
 	return
 }
 
+// groupMemberIDs 获取群聊全部成员的ID，userID不是成员时返回ErrNotGroupMember
+func groupMemberIDs(ctx context.Context, groupID, userID int64) ([]int64, error) {
+	memberIDs, err := dal.GetGroupMemberIDs(ctx, groupID)
+	if err != nil {
+		return nil, err
+	}
+	if len(memberIDs) == 0 {
+		return nil, dal.ErrGroupNotExist
+	}
+	if !slices.Contains(memberIDs, userID) {
+		return nil, dal.ErrNotGroupMember
+	}
+	return memberIDs, nil
+}
+
+// checkMessagePermission 检查userID能否给toUserID发送单聊消息，双方存在拉黑关系时不能发送，
+// 对方设置仅好友可私信时需要互相关注
+func checkMessagePermission(ctx context.Context, userID, toUserID int64) error {
+	if userID == toUserID {
+		return nil
+	}
+
+	blocked, err := dal.CheckBlocked(ctx, userID, toUserID)
+	if err != nil {
+		return err
+	}
+	if blocked {
+		return dal.ErrBlocked
+	}
+
+	receivers, err := batchUserInfo(ctx, []int64{toUserID})
+	if err != nil {
+		return err
+	}
+	if int16(receivers[0].MessagePrivacy) != dal.MessagePrivacyFriends {
+		return nil
+	}
+	friends, err := dal.BatchCheckFriend(ctx, userID, []int64{toUserID})
+	if err != nil {
+		return err
+	}
+	if !friends[toUserID] {
+		return dal.ErrFriendOnly
+	}
+	return nil
+}
+
+// checkGroupInvite 检查userID能否将members拉入群聊，与任一成员存在拉黑关系，
+// 或成员设置仅好友可私信而双方不是好友时不能拉入
+func checkGroupInvite(ctx context.Context, userID int64, members []*user.User) error {
+	memberIDs := make([]int64, 0, len(members))
+	var friendOnlyIDs []int64
+	for _, member := range members {
+		if member.Id == userID {
+			continue
+		}
+		memberIDs = append(memberIDs, member.Id)
+		if int16(member.MessagePrivacy) == dal.MessagePrivacyFriends {
+			friendOnlyIDs = append(friendOnlyIDs, member.Id)
+		}
+	}
+
+	blocked, err := dal.BatchCheckBlocked(ctx, userID, memberIDs)
+	if err != nil {
+		return err
+	}
+	if len(blocked) > 0 {
+		return dal.ErrBlocked
+	}
+
+	friends, err := dal.BatchCheckFriend(ctx, userID, friendOnlyIDs)
+	if err != nil {
+		return err
+	}
+	for _, id := range friendOnlyIDs {
+		if !friends[id] {
+			return dal.ErrFriendOnly
+		}
+	}
+	return nil
+}
+
+// batchUserInfo 通过用户服务批量查询用户信息，任一用户不存在时返回ErrUserNotExist。
+// 布隆过滤器只在用户服务中随注册更新，其他服务不能直接使用dal查询用户
+func batchUserInfo(ctx context.Context, userIDs []int64) ([]*user.User, error) {
+	userList, err := client.UserClient.BatchUserInfo(ctx, &user.BatchUserInfoRequest{AuthorIds: userIDs})
+	if err != nil && strings.HasSuffix(err.Error(), dal.ErrUserNotExist.Error()) {
+		return nil, dal.ErrUserNotExist
+	}
+	return userList, err
+}
+
+func toGroupResponse(group *model.ChatGroup) *message.Group {
+	return &message.Group{
+		Id:          group.ID,
+		Name:        group.Name,
+		OwnerId:     group.OwnerID,
+		MemberCount: group.MemberCount,
+	}
+}
+
+// updateReceipt 将对方发给userID且发送时间不晚于t的消息推进到status，有消息更新时推送回执给对方
+func updateReceipt(ctx context.Context, userID, toUserID int64, status int16, t int64) error {
+	convertID := dal.GetConvertID(userID, toUserID)
+	n, err := dal.UpdateMessageStatus(ctx, convertID, userID, status, t)
+	if err != nil {
+		return err
+	}
+	if n == 0 {
+		return nil
+	}
+	receipt := &chat.Receipt{ConvertID: convertID, UserID: userID, Status: status, Time: t}
+	if _, err := chat.Push(ctx, []int64{toUserID}, &chat.Event{Type: chat.EventReceipt, Receipt: receipt}); err != nil {
+		klog.Error("推送消息回执失败, err: ", err)
+	}
+	return nil
+}
+
+// pushMessage 推送新消息，单聊消息交给接收者在线的网关即视为已送达
+func pushMessage(ctx context.Context, msg *model.Message, msgResp *message.Message, receiverIDs []int64) {
+	handedIDs, err := chat.Push(ctx, receiverIDs, &chat.Event{Type: chat.EventMessage, Message: msgResp})
+	if err != nil {
+		klog.Error("推送消息失败, err: ", err)
+		return
+	}
+	if msg.GroupID != 0 || !slices.Contains(handedIDs, msg.ToUserID) {
+		return
+	}
+	if err := markDelivered(ctx, msg); err != nil {
+		klog.Error("更新消息送达状态失败, err: ", err)
+		return
+	}
+	msgResp.Status = int32(dal.MessageStatusDelivered)
+}
+
+// markDelivered 单聊消息已推送给接收者的网关，记录送达位置供消息写入时标记，已写入的消息直接更新，并推送回执给发送者
+func markDelivered(ctx context.Context, msg *model.Message) error {
+	if err := dal.SetDeliverCursor(ctx, msg.ToUserID, msg.ConvertID, msg.CreateTime); err != nil {
+		return err
+	}
+	if _, err := dal.UpdateMessageStatus(ctx, msg.ConvertID, msg.ToUserID, dal.MessageStatusDelivered, msg.CreateTime); err != nil {
+		return err
+	}
+	receipt := &chat.Receipt{ConvertID: msg.ConvertID, UserID: msg.ToUserID, Status: dal.MessageStatusDelivered, Time: msg.CreateTime}
+	if _, err := chat.Push(ctx, []int64{msg.FromUserID}, &chat.Event{Type: chat.EventReceipt, Receipt: receipt}); err != nil {
+		klog.Error("推送消息回执失败, err: ", err)
+	}
+	return nil
+}
+
+// recallWindow 消息发送后允许撤回的时间
+func recallWindow() time.Duration {
+	if config.Conf.MessageConfig == nil || config.Conf.MessageConfig.RecallWindow <= 0 {
+		return defaultRecallWindow
+	}
+	return config.Conf.MessageConfig.RecallWindow
+}
+
 func toMessageResponse(mMessage *model.Message) *message.Message {
-	return &message.Message{
+	msg := &message.Message{
 		Id:         mMessage.ID,
 		ToUserId:   mMessage.ToUserID,
 		FromUserId: mMessage.FromUserID,
 		Content:    mMessage.Content,
 		CreateTime: mMessage.CreateTime,
+		Status:     int32(mMessage.Status),
+		MsgType:    int32(mMessage.MsgType),
+		GroupId:    mMessage.GroupID,
+	}
+	if mMessage.Payload != "" {
+		payload := &message.MessagePayload{}
+		if err := json.Unmarshal([]byte(mMessage.Payload), payload); err != nil {
+			klog.Error("解析消息结构化内容失败, err: ", err)
+		} else {
+			msg.Payload = payload
+		}
 	}
+	return msg
 }
@@ -4,6 +4,7 @@ import (
 	"douyin/src/client"
 	"douyin/src/common/kafka"
 	"douyin/src/common/mtl"
+	"douyin/src/common/oss"
 	"douyin/src/common/serversuite"
 	"douyin/src/common/snowflake"
 	"douyin/src/config"
@@ -22,6 +23,7 @@ func main() {
 	snowflake.Init()
 	dal.Init()
 	defer dal.Close()
+	oss.Init()
 	kafka.Init()
 	client.Init()
 
@@ -50,6 +52,9 @@ func watchConfig() {
 		case <-config.NoticeSnowflake:
 			snowflake.Init()
 
+		case <-config.NoticeOss:
+			oss.Init()
+
 		case <-config.NoticeRedis:
 			dal.InitRedis()
 
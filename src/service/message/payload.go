@@ -0,0 +1,164 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"slices"
+	"strings"
+
+	"douyin/src/client"
+	"douyin/src/common/moderation"
+	"douyin/src/common/oss"
+	"douyin/src/config"
+	"douyin/src/dal"
+	"douyin/src/kitex_gen/message"
+	"douyin/src/kitex_gen/user"
+	"douyin/src/kitex_gen/video"
+
+	"golang.org/x/sync/errgroup"
+)
+
+// messageDigests 非文本消息保存在content中的摘要，用于会话列表展示和不支持该类型的客户端
+var messageDigests = map[int16]string{
+	dal.MessageTypeImage:   "[图片]",
+	dal.MessageTypeVideo:   "[视频]",
+	dal.MessageTypeUser:    "[名片]",
+	dal.MessageTypeSticker: "[表情]",
+}
+
+// messageContent 按消息类型校验内容，返回保存的content和payload，文本消息经过内容审核
+func messageContent(ctx context.Context, req *message.MessageActionRequest) (string, string, error) {
+	msgType := int16(req.MsgType)
+	if msgType == dal.MessageTypeText {
+		if req.Content == "" {
+			return "", "", dal.ErrInvalidMessage
+		}
+		content, err := moderation.Moderate(ctx, moderation.SceneMessage, req.UserId, req.Content)
+		return content, "", err
+	}
+
+	payload, err := validatePayload(ctx, msgType, req.Payload)
+	if err != nil {
+		return "", "", err
+	}
+	data, err := json.Marshal(payload)
+	if err != nil {
+		return "", "", err
+	}
+	return messageDigests[msgType], string(data), nil
+}
+
+// validatePayload 校验非文本消息的结构化内容，只保留对应类型的字段
+func validatePayload(ctx context.Context, msgType int16, payload *message.MessagePayload) (*message.MessagePayload, error) {
+	if payload == nil {
+		return nil, dal.ErrInvalidMessage
+	}
+
+	switch msgType {
+	case dal.MessageTypeImage:
+		// 图片需要先通过上传接口保存到存储后端
+		if !oss.IsChatImageURL(payload.GetImageUrl()) || payload.GetWidth() < 0 || payload.GetHeight() < 0 {
+			return nil, dal.ErrInvalidMessage
+		}
+		return &message.MessagePayload{ImageUrl: payload.ImageUrl, Width: payload.Width, Height: payload.Height}, nil
+
+	case dal.MessageTypeVideo:
+		if payload.GetVideoId() <= 0 {
+			return nil, dal.ErrInvalidMessage
+		}
+		exist, err := client.VideoClient.VideoExist(ctx, payload.GetVideoId())
+		if err != nil {
+			return nil, err
+		}
+		if !exist {
+			return nil, dal.ErrVideoNotExist
+		}
+		return &message.MessagePayload{VideoId: payload.VideoId}, nil
+
+	case dal.MessageTypeUser:
+		if payload.GetShareUserId() <= 0 {
+			return nil, dal.ErrInvalidMessage
+		}
+		if _, err := batchUserInfo(ctx, []int64{payload.GetShareUserId()}); err != nil {
+			return nil, err
+		}
+		return &message.MessagePayload{ShareUserId: payload.ShareUserId}, nil
+
+	case dal.MessageTypeSticker:
+		if config.Conf.MessageConfig == nil || !slices.Contains(config.Conf.MessageConfig.Stickers, payload.GetStickerId()) {
+			return nil, dal.ErrInvalidMessage
+		}
+		return &message.MessagePayload{StickerId: payload.StickerId}, nil
+	}
+
+	return nil, dal.ErrInvalidMessage
+}
+
+// attachPreviews 为视频分享和名片消息填充预览，当前用户无权查看或已被删除的视频不返回预览
+func attachPreviews(ctx context.Context, userID int64, messageList []*message.Message) error {
+	var videoIDs, userIDs []int64
+	for _, m := range messageList {
+		// 已撤回的消息不再有结构化内容
+		if m.Payload == nil {
+			continue
+		}
+		switch int16(m.MsgType) {
+		case dal.MessageTypeVideo:
+			videoIDs = append(videoIDs, m.Payload.GetVideoId())
+		case dal.MessageTypeUser:
+			userIDs = append(userIDs, m.Payload.GetShareUserId())
+		}
+	}
+
+	var (
+		videoList []*video.Video
+		userList  []*user.User
+	)
+	g, gCtx := errgroup.WithContext(ctx)
+	if len(videoIDs) > 0 {
+		g.Go(func() (err error) {
+			videoList, err = client.VideoClient.VideoInfoList(gCtx, &video.VideoInfoListRequest{
+				UserId:      &userID,
+				VideoIdList: videoIDs,
+			})
+			// 分享的视频已被彻底删除时不返回预览，不能影响整个聊天记录的读取
+			if err != nil && strings.HasSuffix(err.Error(), dal.ErrVideoNotExist.Error()) {
+				videoList, err = nil, nil
+			}
+			return
+		})
+	}
+	if len(userIDs) > 0 {
+		g.Go(func() (err error) {
+			userList, err = client.UserClient.BatchUserInfo(gCtx, &user.BatchUserInfoRequest{
+				UserId:    &userID,
+				AuthorIds: userIDs,
+			})
+			return
+		})
+	}
+	if err := g.Wait(); err != nil {
+		return err
+	}
+
+	videos := make(map[int64]*video.Video, len(videoList))
+	for _, v := range videoList {
+		videos[v.Id] = v
+	}
+	users := make(map[int64]*user.User, len(userList))
+	for _, u := range userList {
+		users[u.Id] = u
+	}
+	for _, m := range messageList {
+		if m.Payload == nil {
+			continue
+		}
+		switch int16(m.MsgType) {
+		case dal.MessageTypeVideo:
+			m.Video = videos[m.Payload.GetVideoId()]
+		case dal.MessageTypeUser:
+			m.ShareUser = users[m.Payload.GetShareUserId()]
+		}
+	}
+	return nil
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 
 	"douyin/src/client"
+	"douyin/src/common/cursor"
 	"douyin/src/common/kafka"
 	"douyin/src/dal"
 	"douyin/src/dal/model"
@@ -39,6 +40,20 @@ func (s *RelationServiceImpl) RelationAction(ctx context.Context, req *relation.
 		return nil, dal.ErrNotFollow
 	}
 
+	// 存在拉黑关系时不能关注
+	if req.ActionType == 1 {
+		blocked, err := dal.CheckBlocked(ctx, req.UserId, req.AuthorId)
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, "检查拉黑关系失败")
+			klog.Error("检查拉黑关系失败, err: ", err)
+			return nil, err
+		}
+		if blocked {
+			return nil, dal.ErrBlocked
+		}
+	}
+
 	// 检查关注数是否超过10k
 	followCnt, err := dal.GetUserFollowCount(ctx, req.UserId)
 	if err != nil {
@@ -73,33 +88,39 @@ func (s *RelationServiceImpl) RelationFollowList(ctx context.Context, req *relat
 	ctx, span := otel.Tracer("relation").Start(ctx, "RelationFollowList")
 	defer span.End()
 
+	// 解析分页参数
+	lastID, limit, err := cursor.Parse(cursor.KindFollow, req.AuthorId, req.Cursor, req.Count)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, "解析分页游标失败")
+		klog.Error("解析分页游标失败, err: ", err)
+		return nil, err
+	}
+
 	// 获取关注列表
-	followList, err := dal.FollowList(ctx, req.AuthorId)
+	followList, err := dal.FollowList(ctx, req.AuthorId, lastID, limit+1)
 	if err != nil {
 		span.RecordError(err)
 		span.SetStatus(codes.Error, "获取关注列表失败")
 		klog.Error("获取关注列表失败, err: ", err)
 		return nil, err
 	}
+	followList, nextCursor, hasMore := cursor.Paginate(cursor.KindFollow, req.AuthorId, followList, limit, func(id int64) int64 { return id })
 
 	// 获取用户信息
-	userList := make([]*user.User, len(followList))
-	for i, u := range followList {
-		user, err := client.UserClient.UserInfo(ctx, &user.UserInfoRequest{
-			UserId:   req.UserId,
-			AuthorId: u,
-		})
-		if err != nil {
-			span.RecordError(err)
-			span.SetStatus(codes.Error, "获取用户信息失败")
-			klog.Error("获取用户信息失败, err: ", err)
-			return nil, err
-		}
-		userList[i] = user.User
+	userList, err := client.UserClient.BatchUserInfo(ctx, &user.BatchUserInfoRequest{
+		UserId:    req.UserId,
+		AuthorIds: followList,
+	})
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, "获取用户信息失败")
+		klog.Error("获取用户信息失败, err: ", err)
+		return nil, err
 	}
 
 	// 返回响应
-	resp = &relation.RelationFollowListResponse{UserList: userList}
+	resp = &relation.RelationFollowListResponse{UserList: userList, NextCursor: nextCursor, HasMore: hasMore}
 
 	return
 }
@@ -109,34 +130,39 @@ func (s *RelationServiceImpl) RelationFollowerList(ctx context.Context, req *rel
 	ctx, span := otel.Tracer("relation").Start(ctx, "RelationFollowerList")
 	defer span.End()
 
+	// 解析分页参数
+	lastID, limit, err := cursor.Parse(cursor.KindFollower, req.AuthorId, req.Cursor, req.Count)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, "解析分页游标失败")
+		klog.Error("解析分页游标失败, err: ", err)
+		return nil, err
+	}
+
 	// 获取粉丝列表
-	followerList, err := dal.FollowerList(ctx, req.AuthorId)
+	followerList, err := dal.FollowerList(ctx, req.AuthorId, lastID, limit+1)
 	if err != nil {
 		span.RecordError(err)
 		span.SetStatus(codes.Error, "获取粉丝列表失败")
 		klog.Error("获取粉丝列表失败, err: ", err)
 		return nil, err
 	}
+	followerList, nextCursor, hasMore := cursor.Paginate(cursor.KindFollower, req.AuthorId, followerList, limit, func(id int64) int64 { return id })
 
 	// 获取用户信息
-	// 获取用户信息
-	userList := make([]*user.User, len(followerList))
-	for i, u := range followerList {
-		user, err := client.UserClient.UserInfo(ctx, &user.UserInfoRequest{
-			UserId:   req.UserId,
-			AuthorId: u,
-		})
-		if err != nil {
-			span.RecordError(err)
-			span.SetStatus(codes.Error, "获取用户信息失败")
-			klog.Error("获取用户信息失败, err: ", err)
-			return nil, err
-		}
-		userList[i] = user.User
+	userList, err := client.UserClient.BatchUserInfo(ctx, &user.BatchUserInfoRequest{
+		UserId:    req.UserId,
+		AuthorIds: followerList,
+	})
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, "获取用户信息失败")
+		klog.Error("获取用户信息失败, err: ", err)
+		return nil, err
 	}
 
 	// 返回响应
-	resp = &relation.RelationFollowerListResponse{UserList: userList}
+	resp = &relation.RelationFollowerListResponse{UserList: userList, NextCursor: nextCursor, HasMore: hasMore}
 
 	return
 }
@@ -146,33 +172,121 @@ func (s *RelationServiceImpl) RelationFriendList(ctx context.Context, req *relat
 	ctx, span := otel.Tracer("relation").Start(ctx, "RelationFriendList")
 	defer span.End()
 
+	// 解析分页参数
+	lastID, limit, err := cursor.Parse(cursor.KindFriend, req.AuthorId, req.Cursor, req.Count)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, "解析分页游标失败")
+		klog.Error("解析分页游标失败, err: ", err)
+		return nil, err
+	}
+
 	// 获取好友列表
-	friendList, err := dal.FriendList(ctx, req.AuthorId)
+	friendList, err := dal.FriendList(ctx, req.AuthorId, lastID, limit+1)
 	if err != nil {
 		span.RecordError(err)
 		span.SetStatus(codes.Error, "获取好友列表失败")
 		klog.Error("获取好友列表失败, err: ", err)
 		return nil, err
 	}
+	friendList, nextCursor, hasMore := cursor.Paginate(cursor.KindFriend, req.AuthorId, friendList, limit, func(id int64) int64 { return id })
 
 	// 获取用户信息
-	userList := make([]*user.User, len(friendList))
-	for i, u := range friendList {
-		user, err := client.UserClient.UserInfo(ctx, &user.UserInfoRequest{
-			UserId:   req.UserId,
-			AuthorId: u,
-		})
-		if err != nil {
-			span.RecordError(err)
-			span.SetStatus(codes.Error, "获取用户信息失败")
-			klog.Error("获取用户信息失败, err: ", err)
-			return nil, err
+	userList, err := client.UserClient.BatchUserInfo(ctx, &user.BatchUserInfoRequest{
+		UserId:    req.UserId,
+		AuthorIds: friendList,
+	})
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, "获取用户信息失败")
+		klog.Error("获取用户信息失败, err: ", err)
+		return nil, err
+	}
+
+	// 返回响应
+	resp = &relation.RelationFriendListResponse{UserList: userList, NextCursor: nextCursor, HasMore: hasMore}
+
+	return
+}
+
+// RelationBlock implements the RelationServiceImpl interface.
+func (s *RelationServiceImpl) RelationBlock(ctx context.Context, req *relation.RelationBlockRequest) (resp *relation.RelationBlockResponse, err error) {
+	ctx, span := otel.Tracer("relation").Start(ctx, "RelationBlock")
+	defer span.End()
+
+	// 检查是否拉黑
+	exist, err := dal.CheckBlockExist(ctx, req.UserId, req.AuthorId)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, "检查是否拉黑失败")
+		klog.Error("检查是否拉黑失败, err: ", err)
+		return nil, err
+	}
+
+	if req.ActionType == 1 {
+		// 拉黑，同时解除双方的关注关系
+		if exist {
+			return nil, dal.ErrAlreadyBlock
 		}
-		userList[i] = user.User
+		err = dal.Block(ctx, req.UserId, req.AuthorId)
+	} else {
+		// 取消拉黑
+		if !exist {
+			return nil, dal.ErrNotBlock
+		}
+		err = dal.UnBlock(ctx, req.UserId, req.AuthorId)
+	}
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, "更新黑名单失败")
+		klog.Error("更新黑名单失败, err: ", err)
+		return nil, err
+	}
+
+	// 返回响应
+	resp = &relation.RelationBlockResponse{}
+
+	return
+}
+
+// RelationBlockList implements the RelationServiceImpl interface.
+func (s *RelationServiceImpl) RelationBlockList(ctx context.Context, req *relation.RelationBlockListRequest) (resp *relation.RelationBlockListResponse, err error) {
+	ctx, span := otel.Tracer("relation").Start(ctx, "RelationBlockList")
+	defer span.End()
+
+	// 解析分页参数
+	lastID, limit, err := cursor.Parse(cursor.KindBlock, req.UserId, req.Cursor, req.Count)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, "解析分页游标失败")
+		klog.Error("解析分页游标失败, err: ", err)
+		return nil, err
+	}
+
+	// 获取黑名单
+	blockList, err := dal.BlockList(ctx, req.UserId, lastID, limit+1)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, "获取黑名单失败")
+		klog.Error("获取黑名单失败, err: ", err)
+		return nil, err
+	}
+	blockList, nextCursor, hasMore := cursor.Paginate(cursor.KindBlock, req.UserId, blockList, limit, func(id int64) int64 { return id })
+
+	// 获取用户信息
+	userList, err := client.UserClient.BatchUserInfo(ctx, &user.BatchUserInfoRequest{
+		UserId:    &req.UserId,
+		AuthorIds: blockList,
+	})
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, "获取用户信息失败")
+		klog.Error("获取用户信息失败, err: ", err)
+		return nil, err
 	}
 
 	// 返回响应
-	resp = &relation.RelationFriendListResponse{UserList: userList}
+	resp = &relation.RelationBlockListResponse{UserList: userList, NextCursor: nextCursor, HasMore: hasMore}
 
 	return
 }
@@ -224,3 +338,51 @@ func (s *RelationServiceImpl) FollowerCnt(ctx context.Context, userId int64) (re
 
 	return
 }
+
+// BatchRelationExist implements the RelationServiceImpl interface.
+func (s *RelationServiceImpl) BatchRelationExist(ctx context.Context, userId int64, authorIds []int64) (resp map[int64]bool, err error) {
+	ctx, span := otel.Tracer("relation").Start(ctx, "BatchRelationExist")
+	defer span.End()
+
+	resp, err = dal.BatchCheckRelationExist(ctx, userId, authorIds)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, "批量查询关注关系失败")
+		klog.Error("批量查询关注关系失败, err: ", err)
+		return
+	}
+
+	return
+}
+
+// BatchFollowCnt implements the RelationServiceImpl interface.
+func (s *RelationServiceImpl) BatchFollowCnt(ctx context.Context, userIds []int64) (resp map[int64]int64, err error) {
+	ctx, span := otel.Tracer("relation").Start(ctx, "BatchFollowCnt")
+	defer span.End()
+
+	resp, err = dal.BatchGetUserFollowCount(ctx, userIds)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, "批量获取用户关注数失败")
+		klog.Error("批量获取用户关注数失败, err: ", err)
+		return
+	}
+
+	return
+}
+
+// BatchFollowerCnt implements the RelationServiceImpl interface.
+func (s *RelationServiceImpl) BatchFollowerCnt(ctx context.Context, userIds []int64) (resp map[int64]int64, err error) {
+	ctx, span := otel.Tracer("relation").Start(ctx, "BatchFollowerCnt")
+	defer span.End()
+
+	resp, err = dal.BatchGetUserFollowerCount(ctx, userIds)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, "批量获取用户粉丝数失败")
+		klog.Error("批量获取用户粉丝数失败, err: ", err)
+		return
+	}
+
+	return
+}
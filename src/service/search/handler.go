@@ -0,0 +1,104 @@
+package main
+
+import (
+	"context"
+	"strings"
+
+	"douyin/src/client"
+	"douyin/src/common/cursor"
+	"douyin/src/dal"
+	"douyin/src/kitex_gen/search"
+	"douyin/src/kitex_gen/user"
+	"douyin/src/kitex_gen/video"
+
+	"github.com/cloudwego/kitex/pkg/klog"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+)
+
+// SearchServiceImpl implements the last service interface defined in the IDL.
+type SearchServiceImpl struct{}
+
+// SearchUsers implements the SearchServiceImpl interface.
+func (s *SearchServiceImpl) SearchUsers(ctx context.Context, req *search.SearchUsersRequest) (resp *search.SearchUsersResponse, err error) {
+	ctx, span := otel.Tracer("search").Start(ctx, "SearchUsers")
+	defer span.End()
+
+	// 解析分页参数
+	offset, limit, err := cursor.ParseOffset(cursor.KindSearchUser, 0, req.Cursor, req.Count)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, "解析分页游标失败")
+		klog.Error("解析分页游标失败, err: ", err)
+		return nil, err
+	}
+
+	// 搜索用户
+	userIDs, err := dal.SearchUsers(ctx, strings.TrimSpace(req.Keyword), offset, limit+1)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, "搜索用户失败")
+		klog.Error("搜索用户失败, err: ", err)
+		return nil, err
+	}
+	userIDs, nextCursor, hasMore := cursor.PaginateOffsetQuery(cursor.KindSearchUser, 0, userIDs, offset, limit)
+
+	// 获取用户信息
+	userList, err := client.UserClient.BatchUserInfo(ctx, &user.BatchUserInfoRequest{
+		UserId:    req.UserId,
+		AuthorIds: userIDs,
+	})
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, "获取用户信息失败")
+		klog.Error("获取用户信息失败, err: ", err)
+		return nil, err
+	}
+
+	// 返回响应
+	resp = &search.SearchUsersResponse{UserList: userList, NextCursor: nextCursor, HasMore: hasMore}
+
+	return
+}
+
+// SearchVideos implements the SearchServiceImpl interface.
+func (s *SearchServiceImpl) SearchVideos(ctx context.Context, req *search.SearchVideosRequest) (resp *search.SearchVideosResponse, err error) {
+	ctx, span := otel.Tracer("search").Start(ctx, "SearchVideos")
+	defer span.End()
+
+	// 解析分页参数
+	offset, limit, err := cursor.ParseOffset(cursor.KindSearchVideo, 0, req.Cursor, req.Count)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, "解析分页游标失败")
+		klog.Error("解析分页游标失败, err: ", err)
+		return nil, err
+	}
+
+	// 搜索视频
+	videoIDs, err := dal.SearchVideos(ctx, strings.TrimSpace(req.Keyword), offset, limit+1)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, "搜索视频失败")
+		klog.Error("搜索视频失败, err: ", err)
+		return nil, err
+	}
+	videoIDs, nextCursor, hasMore := cursor.PaginateOffsetQuery(cursor.KindSearchVideo, 0, videoIDs, offset, limit)
+
+	// 获取视频信息，索引同步存在延迟，已删除或不可见的视频由视频服务过滤
+	videoList, err := client.VideoClient.VideoInfoList(ctx, &video.VideoInfoListRequest{
+		UserId:      req.UserId,
+		VideoIdList: videoIDs,
+	})
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, "获取视频信息失败")
+		klog.Error("获取视频信息失败, err: ", err)
+		return nil, err
+	}
+
+	// 返回响应
+	resp = &search.SearchVideosResponse{VideoList: videoList, NextCursor: nextCursor, HasMore: hasMore}
+
+	return
+}
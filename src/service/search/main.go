@@ -0,0 +1,59 @@
+package main
+
+import (
+	"douyin/src/client"
+	"douyin/src/common/kafka"
+	"douyin/src/common/mtl"
+	"douyin/src/common/serversuite"
+	"douyin/src/config"
+	"douyin/src/dal"
+	"douyin/src/kitex_gen/search/searchservice"
+
+	"github.com/cloudwego/kitex/pkg/klog"
+	"github.com/cloudwego/kitex/server"
+)
+
+func main() {
+	config.Init()
+	go watchConfig()
+	mtl.Init(config.Conf.OpenTelemetryConfig.SearchName)
+	defer mtl.Close()
+	dal.Init()
+	defer dal.Close()
+	kafka.RegisterSearchHandler(syncDocument)
+	kafka.InitSearch()
+	client.Init()
+
+	opts := server.WithSuite(serversuite.CommonServerSuite{
+		RegistryAddr: config.Conf.ConsulConfig.ConsulAddr,
+		ServiceAddr:  config.Conf.ConsulConfig.SearchAddr,
+		ServiceName:  config.Conf.OpenTelemetryConfig.SearchName,
+	})
+
+	svr := searchservice.NewServer(new(SearchServiceImpl), opts)
+
+	if err := svr.Run(); err != nil {
+		klog.Fatal("run server failed: ", err)
+	}
+}
+
+func watchConfig() {
+	for {
+		select {
+		case <-config.NoticeOpenTelemetry:
+			mtl.InitTracing(config.Conf.OpenTelemetryConfig.SearchName)
+
+		case <-config.NoticeLog:
+			mtl.InitLog()
+
+		case <-config.NoticePostgreSQL:
+			dal.InitPostgreSQL()
+
+		case <-config.NoticeRedis:
+			dal.InitRedis()
+
+		case <-config.NoticeKafka:
+			kafka.InitSearch()
+		}
+	}
+}
@@ -0,0 +1,30 @@
+package main
+
+import (
+	"context"
+
+	"douyin/src/common/kafka"
+	"douyin/src/dal"
+)
+
+// syncDocument 根据Debezium捕获的变更同步搜索索引，连接器启动时的快照同样会写入索引
+func syncDocument(ctx context.Context, change *kafka.RowChange) error {
+	if change.ID == 0 {
+		return nil
+	}
+
+	switch change.Table {
+	case "users":
+		if change.Op == "d" {
+			return dal.DeleteUserDocument(ctx, change.ID)
+		}
+		return dal.SyncUserDocument(ctx, change.ID)
+	case "videos":
+		// 视频状态或可见范围变化时，由SyncVideoDocument判断是否保留索引
+		if change.Op == "d" {
+			return dal.DeleteVideoDocument(ctx, change.ID)
+		}
+		return dal.SyncVideoDocument(ctx, change.ID)
+	}
+	return nil
+}
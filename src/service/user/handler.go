@@ -2,7 +2,6 @@ package main
 
 import (
 	"context"
-	"sync"
 
 	"douyin/src/client"
 	"douyin/src/common/jwt"
@@ -13,6 +12,7 @@ import (
 	"go.opentelemetry.io/otel"
 	"go.opentelemetry.io/otel/codes"
 	"golang.org/x/crypto/bcrypt"
+	"golang.org/x/sync/errgroup"
 )
 
 // UserServiceImpl implements the last service interface defined in the IDL.
@@ -130,7 +130,10 @@ func (s *UserServiceImpl) UserInfo(ctx context.Context, req *user.UserInfoReques
 	defer span.End()
 
 	// 查询用户信息
-	mUser, err := dal.GetUserByID(ctx, req.AuthorId)
+	userList, err := s.BatchUserInfo(ctx, &user.BatchUserInfoRequest{
+		UserId:    req.UserId,
+		AuthorIds: []int64{req.AuthorId},
+	})
 	if err != nil {
 		span.RecordError(err)
 		span.SetStatus(codes.Error, "查询用户信息失败")
@@ -138,85 +141,113 @@ func (s *UserServiceImpl) UserInfo(ctx context.Context, req *user.UserInfoReques
 		return nil, err
 	}
 
-	userResponse := &user.User{
-		Id:              mUser.ID,
-		Name:            mUser.Name,
-		Avatar:          mUser.Avatar,
-		BackgroundImage: mUser.BackgroundImage,
-		IsFollow:        false,
-		Signature:       mUser.Signature,
-	}
-	var wg sync.WaitGroup
-	var wgErr error
-	wg.Add(5)
-	go func() {
-		defer wg.Done()
-		cnt, err := client.FavoriteClient.FavoriteCnt(ctx, mUser.ID)
-		if err != nil {
-			wgErr = err
-			return
-		}
-		userResponse.FavoriteCount = cnt
-	}()
-	go func() {
-		defer wg.Done()
-		cnt, err := client.FavoriteClient.TotalFavoritedCnt(ctx, mUser.ID)
-		if err != nil {
-			wgErr = err
-			return
-		}
-		userResponse.TotalFavorited = cnt
-	}()
-	go func() {
-		defer wg.Done()
-		cnt, err := client.RelationClient.FollowCnt(ctx, mUser.ID)
-		if err != nil {
-			wgErr = err
-			return
-		}
-		userResponse.FollowCount = cnt
-	}()
-	go func() {
-		defer wg.Done()
-		cnt, err := client.RelationClient.FollowerCnt(ctx, mUser.ID)
-		if err != nil {
-			wgErr = err
-			return
-		}
-		userResponse.FollowerCount = cnt
-	}()
-	go func() {
-		defer wg.Done()
-		cnt, err := client.VideoClient.WorkCount(ctx, mUser.ID)
-		if err != nil {
-			wgErr = err
+	// 返回响应
+	resp = &user.UserInfoResponse{User: userList[0]}
+
+	return
+}
+
+// BatchUserInfo implements the UserServiceImpl interface.
+func (s *UserServiceImpl) BatchUserInfo(ctx context.Context, req *user.BatchUserInfoRequest) (resp []*user.User, err error) {
+	ctx, span := otel.Tracer("user").Start(ctx, "BatchUserInfo")
+	defer span.End()
+
+	if len(req.AuthorIds) == 0 {
+		return []*user.User{}, nil
+	}
+
+	// 批量查询用户信息
+	mUsers, err := dal.GetUserList(ctx, req.AuthorIds)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, "批量查询用户信息失败")
+		klog.Error("批量查询用户信息失败, err: ", err)
+		return nil, err
+	}
+
+	// 批量查询用户计数和关注状态
+	var (
+		favoriteCnt    map[int64]int64
+		totalFavorited map[int64]int64
+		followCnt      map[int64]int64
+		followerCnt    map[int64]int64
+		workCnt        map[int64]int64
+		isFollow       map[int64]bool
+	)
+	g, gCtx := errgroup.WithContext(ctx)
+	g.Go(func() (err error) {
+		favoriteCnt, err = client.FavoriteClient.BatchUserFavoriteCnt(gCtx, req.AuthorIds)
+		return
+	})
+	g.Go(func() (err error) {
+		totalFavorited, err = client.FavoriteClient.BatchTotalFavoritedCnt(gCtx, req.AuthorIds)
+		return
+	})
+	g.Go(func() (err error) {
+		followCnt, err = client.RelationClient.BatchFollowCnt(gCtx, req.AuthorIds)
+		return
+	})
+	g.Go(func() (err error) {
+		followerCnt, err = client.RelationClient.BatchFollowerCnt(gCtx, req.AuthorIds)
+		return
+	})
+	g.Go(func() (err error) {
+		workCnt, err = client.VideoClient.BatchWorkCount(gCtx, req.AuthorIds)
+		return
+	})
+	if req.UserId != nil {
+		g.Go(func() (err error) {
+			isFollow, err = client.RelationClient.BatchRelationExist(gCtx, *req.UserId, req.AuthorIds)
 			return
+		})
+	}
+	if err := g.Wait(); err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, "批量查询用户信息失败")
+		klog.Error("批量查询用户信息失败, err: ", err)
+		return nil, err
+	}
+
+	resp = make([]*user.User, len(mUsers))
+	for i, mUser := range mUsers {
+		resp[i] = &user.User{
+			Id:              mUser.ID,
+			Name:            mUser.Name,
+			FollowCount:     followCnt[mUser.ID],
+			FollowerCount:   followerCnt[mUser.ID],
+			IsFollow:        isFollow[mUser.ID],
+			Avatar:          mUser.Avatar,
+			BackgroundImage: mUser.BackgroundImage,
+			Signature:       mUser.Signature,
+			TotalFavorited:  totalFavorited[mUser.ID],
+			WorkCount:       workCnt[mUser.ID],
+			FavoriteCount:   favoriteCnt[mUser.ID],
+			MessagePrivacy:  int32(mUser.MessagePrivacy),
 		}
-		userResponse.WorkCount = cnt
-	}()
-	wg.Wait()
-	if wgErr != nil {
-		span.RecordError(wgErr)
-		span.SetStatus(codes.Error, "查询用户信息失败")
-		klog.Error("查询用户信息失败")
-		return nil, wgErr
 	}
 
-	// 判断是否关注
-	if req.UserId == nil {
-		return
+	return
+}
+
+// UpdatePrivacy implements the UserServiceImpl interface.
+func (s *UserServiceImpl) UpdatePrivacy(ctx context.Context, req *user.UserPrivacyRequest) (resp *user.UserPrivacyResponse, err error) {
+	ctx, span := otel.Tracer("user").Start(ctx, "UpdatePrivacy")
+	defer span.End()
+
+	// 更新私信权限
+	err = dal.UpdateMessagePrivacy(ctx, req.UserId, int16(req.MessagePrivacy))
+	if err == dal.ErrUserNotExist {
+		return nil, err
 	}
-	exist, err := client.RelationClient.RelationExist(ctx, *req.UserId, mUser.ID)
 	if err != nil {
 		span.RecordError(err)
-		span.SetStatus(codes.Error, "查询用户信息失败")
-		klog.Error("查询用户信息失败")
+		span.SetStatus(codes.Error, "更新私信权限失败")
+		klog.Error("更新私信权限失败, err: ", err)
 		return nil, err
 	}
-	userResponse.IsFollow = exist
 
 	// 返回响应
-	resp = &user.UserInfoResponse{User: userResponse}
+	resp = &user.UserPrivacyResponse{}
 
 	return
 }
@@ -2,23 +2,45 @@ package main
 
 import (
 	"context"
-	"os"
-	"sync"
+	"path"
+	"strings"
 	"time"
 
 	"douyin/src/client"
+	"douyin/src/common/cursor"
+	"douyin/src/common/kafka"
+	"douyin/src/common/moderation"
 	"douyin/src/common/oss"
 	"douyin/src/dal"
+	"douyin/src/dal/model"
 	"douyin/src/kitex_gen/user"
 	"douyin/src/kitex_gen/video"
+	"douyin/src/service/video/purge"
+	"douyin/src/service/video/rank"
+	"douyin/src/service/video/state"
+	"douyin/src/service/video/topic"
+	"douyin/src/service/video/visibility"
 
 	"github.com/cloudwego/kitex/pkg/klog"
-	"github.com/google/uuid"
 	"go.opentelemetry.io/otel"
 	"go.opentelemetry.io/otel/codes"
+	"golang.org/x/sync/errgroup"
 )
 
-const count = 30
+const (
+	count           = 30
+	candidateSize   = 200 // 推荐Feed候选视频数
+	defaultHotCount = 10  // 热榜默认返回数量
+	maxHotCount     = 50  // 热榜最大返回数量
+)
+
+// Feed模式
+const (
+	feedModeLatest = iota // 按投稿时间倒序
+	feedModeRanked        // 推荐排序
+)
+
+var ranker = rank.NewDefaultRanker()
 
 // VideoServiceImpl implements the last service interface defined in the IDL.
 type VideoServiceImpl struct{}
@@ -35,8 +57,34 @@ func (s *VideoServiceImpl) Feed(ctx context.Context, req *video.FeedRequest) (re
 		latestTime = time.Now()
 	}
 
+	// 推荐排序
+	if req.Mode == feedModeRanked {
+		videoIDs, nextTime, nextCursor, err := rankedFeed(ctx, req.UserId, latestTime, req.Cursor)
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, "查询推荐视频列表失败")
+			klog.Error("service.Feed: 查询推荐视频列表失败, err: ", err)
+			return nil, err
+		}
+
+		videoList, err := s.VideoInfoList(ctx, &video.VideoInfoListRequest{
+			UserId:      req.UserId,
+			VideoIdList: videoIDs,
+		})
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, "查询视频信息失败")
+			klog.Error("service.Feed: 查询视频信息失败, err: ", err)
+			return nil, err
+		}
+
+		// 候选窗口内按游标分页，窗口遍历完后通过next_time进入下一个窗口
+		resp = &video.FeedResponse{VideoList: videoList, NextTime: nextTime, NextCursor: nextCursor}
+		return resp, nil
+	}
+
 	// 查询视频列表
-	videoIDs, err := dal.GetFeedList(ctx, req.UserId, latestTime, count)
+	videoIDs, scanTime, err := dal.GetFeedList(ctx, req.UserId, latestTime, count)
 	if err != nil {
 		span.RecordError(err)
 		span.SetStatus(codes.Error, "查询视频列表失败")
@@ -48,12 +96,18 @@ func (s *VideoServiceImpl) Feed(ctx context.Context, req *video.FeedRequest) (re
 		UserId:      req.UserId,
 		VideoIdList: videoIDs,
 	})
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, "查询视频信息失败")
+		klog.Error("service.Feed: 查询视频信息失败, err: ", err)
+		return nil, err
+	}
 
-	// 计算下次请求的时间
+	// 计算下次请求的时间，本页的视频全部看过时从最后扫描到的位置继续
 	var nextTime *int64
-	if len(videoList) > 0 {
+	if !scanTime.IsZero() {
 		nextTime = new(int64)
-		*nextTime = videoList[len(videoList)-1].UploadTime
+		*nextTime = scanTime.Unix()
 	}
 
 	// 返回响应
@@ -61,43 +115,456 @@ func (s *VideoServiceImpl) Feed(ctx context.Context, req *video.FeedRequest) (re
 	return
 }
 
+// rankedFeed 对latestTime之前最新的candidateSize个候选视频打分排序，从游标处开始返回一页用户没有看过的视频。
+// 候选窗口还有剩余时返回窗口内的下一页游标，否则返回窗口中最早的投稿时间作为下一个窗口的latestTime
+func rankedFeed(ctx context.Context, userID *int64, latestTime time.Time, token *string) (videoIDs []int64, nextTime *int64, nextCursor *string, err error) {
+	var viewerID int64
+	if userID != nil {
+		viewerID = *userID
+	}
+	offset, _, err := cursor.ParseOffset(cursor.KindRankedFeed, viewerID, token, count)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	candidates, err := dal.GetFeedCandidates(ctx, latestTime, candidateSize)
+	if err != nil || len(candidates) == 0 {
+		return nil, nil, nil, err
+	}
+
+	ranked, err := ranker.Rank(ctx, userID, candidates)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	rest := ranked[min(offset, len(ranked)):]
+
+	// 已看视频去重，排序结果可能随计数变化，分页时重复的视频也会被过滤
+	restIDs := make([]int64, len(rest))
+	for i, v := range rest {
+		restIDs[i] = v.ID
+	}
+	if userID != nil {
+		restIDs, err = dal.FilterSeenVideos(ctx, *userID, restIDs)
+		if err != nil {
+			return nil, nil, nil, err
+		}
+	}
+	unseen := make(map[int64]struct{}, len(restIDs))
+	for _, id := range restIDs {
+		unseen[id] = struct{}{}
+	}
+
+	// 从游标处开始凑满一页，消耗的候选数作为下一页的偏移量
+	videoIDs = make([]int64, 0, count)
+	consumed := 0
+	for _, v := range rest {
+		if len(videoIDs) == count {
+			break
+		}
+		consumed++
+		if _, ok := unseen[v.ID]; ok {
+			videoIDs = append(videoIDs, v.ID)
+		}
+	}
+	_, nextCursor, _ = cursor.PaginateOffset(cursor.KindRankedFeed, viewerID, ranked, offset, consumed)
+	if nextCursor == nil {
+		nextTime = new(int64)
+		*nextTime = candidates[len(candidates)-1].UploadTime.Unix()
+	}
+
+	// 记录已看视频
+	if userID != nil {
+		if err := dal.AddSeenVideos(ctx, *userID, videoIDs); err != nil {
+			return nil, nil, nil, err
+		}
+	}
+
+	return videoIDs, nextTime, nextCursor, nil
+}
+
 // PublishAction implements the VideoServiceImpl interface.
 func (s *VideoServiceImpl) PublishAction(ctx context.Context, req *video.PublishActionRequest) (resp *video.PublishActionResponse, err error) {
 	ctx, span := otel.Tracer("video").Start(ctx, "PublishAction")
 	defer span.End()
 
-	// 生成uuid作为文件名
-	uuidName := uuid.New().String()
-	videoName := uuidName + ".mp4"
-	coverName := uuidName + ".jpeg"
-
-	// 保存视频到本地
-	if err := os.WriteFile(videoName, req.Data, 0o644); err != nil {
+	// 审核视频标题
+	title, err := moderation.Moderate(ctx, moderation.SceneVideoTitle, req.UserId, req.Title)
+	if err != nil {
 		span.RecordError(err)
-		span.SetStatus(codes.Error, "保存视频到本地失败")
-		klog.Error("保存视频到本地失败, err: ", err)
+		span.SetStatus(codes.Error, "视频标题审核未通过")
+		klog.Error("视频标题审核未通过, err: ", err)
 		return nil, err
 	}
 
-	// 上传视频到oss
-	go func() {
-		if err := oss.UploadFile(ctx, req.Data, uuidName); err != nil {
-			span.RecordError(err)
-			span.SetStatus(codes.Error, "保存视频到oss失败")
-			klog.Error("保存视频到oss失败, err: ", err)
-		}
-	}()
+	// 未上传自定义封面时，封面与视频同名
+	videoName := req.VideoName
+	coverName := strings.TrimSuffix(videoName, path.Ext(videoName)) + ".jpeg"
+	customCover := req.CoverName != nil && *req.CoverName != ""
+	if customCover {
+		coverName = *req.CoverName
+	}
 
 	// 操作数据库
-	if err := dal.SaveVideo(ctx, req.UserId, videoName, coverName, req.Title); err != nil {
+	mVideo, err := dal.SaveVideo(ctx, req.UserId, oss.VideoURL(videoName), oss.CoverURL(coverName), title, int16(req.Visibility))
+	if err != nil {
 		span.RecordError(err)
 		span.SetStatus(codes.Error, "操作数据库失败")
 		klog.Error("操作数据库失败, err: ", err)
 		return nil, err
 	}
 
+	// 保存标题中的话题，失败不影响发布
+	if err := dal.SaveVideoTags(ctx, mVideo.ID, topic.Extract(title)); err != nil {
+		klog.Error("保存视频话题失败, err: ", err)
+	}
+
+	// 确认视频已上传到对象存储，然后提交转码任务
+	if err := startProcessing(ctx, mVideo, videoName, coverName, customCover); err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, "提交视频处理失败")
+		klog.Error("提交视频处理失败, err: ", err)
+		if err := state.Transit(ctx, mVideo.ID, dal.VideoStatusFailed); err != nil {
+			klog.Error("更新视频状态失败, err: ", err)
+		}
+		return nil, err
+	}
+
+	// 返回响应
+	resp = &video.PublishActionResponse{VideoId: mVideo.ID}
+
+	return
+}
+
+// UpdateCover implements the VideoServiceImpl interface.
+func (s *VideoServiceImpl) UpdateCover(ctx context.Context, req *video.UpdateCoverRequest) (resp *video.UpdateCoverResponse, err error) {
+	ctx, span := otel.Tracer("video").Start(ctx, "UpdateCover")
+	defer span.End()
+
+	// 查询视频状态
+	mVideo, err := dal.GetVideoStatus(ctx, req.VideoId)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, "查询视频状态失败")
+		klog.Error("查询视频状态失败, err: ", err)
+		return nil, err
+	}
+
+	// 只有作者可以修改封面
+	if mVideo.AuthorID != req.UserId {
+		span.SetStatus(codes.Error, "视频作者id与当前用户id不一致")
+		klog.Error("视频作者id与当前用户id不一致")
+		return nil, dal.ErrVideoNotExist
+	}
+
+	// 更新封面地址，只有已发布的视频可以修改封面，避免与转码生成的封面冲突
+	coverURL := oss.CoverURL(req.CoverName)
+	ok, err := dal.UpdateVideoCover(ctx, req.VideoId, coverURL)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, "更新视频封面失败")
+		klog.Error("更新视频封面失败, err: ", err)
+		return nil, err
+	}
+	if !ok {
+		return nil, dal.ErrVideoStatus
+	}
+
+	// 删除旧封面
+	if oldCover := oss.CoverName(mVideo.CoverURL); oldCover != "" && oldCover != req.CoverName {
+		if err := oss.DeleteCover(ctx, oldCover); err != nil {
+			klog.Error("删除旧封面失败, err: ", err)
+		}
+	}
+
+	// 返回响应
+	resp = &video.UpdateCoverResponse{CoverUrl: coverURL}
+
+	return
+}
+
+// DeleteVideo implements the VideoServiceImpl interface.
+func (s *VideoServiceImpl) DeleteVideo(ctx context.Context, req *video.DeleteVideoRequest) (resp *video.DeleteVideoResponse, err error) {
+	ctx, span := otel.Tracer("video").Start(ctx, "DeleteVideo")
+	defer span.End()
+
+	// 查询视频状态
+	mVideo, err := dal.GetVideoStatus(ctx, req.VideoId)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, "查询视频状态失败")
+		klog.Error("查询视频状态失败, err: ", err)
+		return nil, err
+	}
+
+	// 只有作者可以删除视频
+	if mVideo.AuthorID != req.UserId {
+		span.SetStatus(codes.Error, "视频作者id与当前用户id不一致")
+		klog.Error("视频作者id与当前用户id不一致")
+		return nil, dal.ErrVideoNotExist
+	}
+
+	// 标记为已删除，作品数和视频信息缓存由Debezium删除
+	if err := state.Transit(ctx, req.VideoId, dal.VideoStatusDeleted); err != nil {
+		if err == dal.ErrVideoStatus {
+			return nil, dal.ErrVideoNotExist
+		}
+		span.RecordError(err)
+		span.SetStatus(codes.Error, "更新视频状态失败")
+		klog.Error("更新视频状态失败, err: ", err)
+		return nil, err
+	}
+
+	// 保留期结束后彻底清理点赞、评论和文件
+	if err := purge.Schedule(ctx, req.VideoId, mVideo.AuthorID); err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, "加入清理队列失败")
+		klog.Error("加入清理队列失败, err: ", err)
+		return nil, err
+	}
+
+	// 减去话题使用次数，失败不影响删除
+	if names, err := dal.GetVideoTagNames(ctx, req.VideoId); err != nil {
+		klog.Error("查询视频话题失败, err: ", err)
+	} else if err := updateTrendingTags(ctx, mVideo, names, nil, mVideo.Visibility); err != nil {
+		klog.Error("更新话题热度失败, err: ", err)
+	}
+
+	// 返回响应
+	resp = &video.DeleteVideoResponse{}
+
+	return
+}
+
+// UpdateVideo implements the VideoServiceImpl interface.
+func (s *VideoServiceImpl) UpdateVideo(ctx context.Context, req *video.UpdateVideoRequest) (resp *video.UpdateVideoResponse, err error) {
+	ctx, span := otel.Tracer("video").Start(ctx, "UpdateVideo")
+	defer span.End()
+
+	// 审核视频标题
+	var title *string
+	if req.Title != nil {
+		moderated, err := moderation.Moderate(ctx, moderation.SceneVideoTitle, req.UserId, *req.Title)
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, "视频标题审核未通过")
+			klog.Error("视频标题审核未通过, err: ", err)
+			return nil, err
+		}
+		title = &moderated
+	}
+	var newVisibility *int16
+	if req.Visibility != nil {
+		newVisibility = new(int16)
+		*newVisibility = int16(*req.Visibility)
+	}
+
+	// 查询视频作者
+	mVideo, err := dal.GetVideoStatus(ctx, req.VideoId)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, "查询视频状态失败")
+		klog.Error("查询视频状态失败, err: ", err)
+		return nil, err
+	}
+
+	// 只有作者可以修改视频
+	if mVideo.AuthorID != req.UserId {
+		span.SetStatus(codes.Error, "视频作者id与当前用户id不一致")
+		klog.Error("视频作者id与当前用户id不一致")
+		return nil, dal.ErrVideoNotExist
+	}
+
+	// 修改前的话题，用于更新话题使用次数
+	oldNames, err := dal.GetVideoTagNames(ctx, req.VideoId)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, "查询视频话题失败")
+		klog.Error("查询视频话题失败, err: ", err)
+		return nil, err
+	}
+
+	// 更新标题和可见范围，视频信息缓存由Debezium删除
+	ok, err := dal.UpdateVideoInfo(ctx, req.VideoId, title, newVisibility)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, "更新视频信息失败")
+		klog.Error("更新视频信息失败, err: ", err)
+		return nil, err
+	}
+	if !ok {
+		return nil, dal.ErrVideoNotExist
+	}
+
+	// 标题修改后重新保存话题，失败不影响修改
+	newNames := oldNames
+	if title != nil {
+		names := topic.Extract(*title)
+		if err := dal.SaveVideoTags(ctx, req.VideoId, names); err != nil {
+			klog.Error("保存视频话题失败, err: ", err)
+		} else {
+			newNames = names
+		}
+	}
+
+	// 按修改前后的话题和可见范围更新话题使用次数，失败不影响修改
+	visibility := mVideo.Visibility
+	if newVisibility != nil {
+		visibility = *newVisibility
+	}
+	if err := updateTrendingTags(ctx, mVideo, oldNames, newNames, visibility); err != nil {
+		klog.Error("更新话题热度失败, err: ", err)
+	}
+
+	// 返回响应
+	resp = &video.UpdateVideoResponse{}
+
+	return
+}
+
+// TopicFeed implements the VideoServiceImpl interface.
+func (s *VideoServiceImpl) TopicFeed(ctx context.Context, req *video.TopicFeedRequest) (resp *video.TopicFeedResponse, err error) {
+	ctx, span := otel.Tracer("video").Start(ctx, "TopicFeed")
+	defer span.End()
+
+	// 查询话题
+	tag, err := dal.GetTagByName(ctx, topic.Normalize(req.Tag))
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, "查询话题失败")
+		klog.Error("查询话题失败, err: ", err)
+		return nil, err
+	}
+
+	var (
+		videoIDs   []int64
+		nextCursor *string
+		hasMore    bool
+	)
+	if req.Sort != nil && *req.Sort == sortHot {
+		// 按热度排序，热度随时间变化，使用偏移量分页
+		offset, limit, err := cursor.ParseOffset(cursor.KindHotTopicVideo, tag.ID, req.Cursor, req.Count)
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, "解析分页游标失败")
+			klog.Error("解析分页游标失败, err: ", err)
+			return nil, err
+		}
+
+		videoIDs, err = hotTopicVideoList(ctx, tag.ID)
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, "获取话题热门视频失败")
+			klog.Error("获取话题热门视频失败, err: ", err)
+			return nil, err
+		}
+		videoIDs, nextCursor, hasMore = cursor.PaginateOffset(cursor.KindHotTopicVideo, tag.ID, videoIDs, offset, limit)
+	} else {
+		// 按发布时间倒序
+		lastID, limit, err := cursor.Parse(cursor.KindTopicVideo, tag.ID, req.Cursor, req.Count)
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, "解析分页游标失败")
+			klog.Error("解析分页游标失败, err: ", err)
+			return nil, err
+		}
+
+		videoIDs, err = dal.GetTopicVideoList(ctx, tag.ID, lastID, limit+1)
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, "查询话题视频列表失败")
+			klog.Error("查询话题视频列表失败, err: ", err)
+			return nil, err
+		}
+		videoIDs, nextCursor, hasMore = cursor.Paginate(cursor.KindTopicVideo, tag.ID, videoIDs, limit, func(id int64) int64 { return id })
+	}
+
+	// 获取视频信息
+	videoList, err := s.VideoInfoList(ctx, &video.VideoInfoListRequest{
+		UserId:      req.UserId,
+		VideoIdList: videoIDs,
+	})
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, "获取视频信息失败")
+		klog.Error("获取视频信息失败, err: ", err)
+		return nil, err
+	}
+
 	// 返回响应
-	resp = &video.PublishActionResponse{}
+	resp = &video.TopicFeedResponse{VideoList: videoList, NextCursor: nextCursor, HasMore: hasMore}
+
+	return
+}
+
+// TrendingTopics implements the VideoServiceImpl interface.
+func (s *VideoServiceImpl) TrendingTopics(ctx context.Context, req *video.TrendingTopicsRequest) (resp *video.TrendingTopicsResponse, err error) {
+	ctx, span := otel.Tracer("video").Start(ctx, "TrendingTopics")
+	defer span.End()
+
+	cnt := int(req.Count)
+	if cnt <= 0 {
+		cnt = defaultTopicCount
+	}
+	cnt = min(cnt, maxTrendingTopics)
+
+	// 查询热门话题
+	tags, err := dal.GetTrendingTags(ctx, cnt)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, "查询热门话题失败")
+		klog.Error("查询热门话题失败, err: ", err)
+		return nil, err
+	}
+
+	topicList := make([]*video.Topic, len(tags))
+	for i, tag := range tags {
+		topicList[i] = &video.Topic{Name: tag.Member.(string), Count: int64(tag.Score)}
+	}
+
+	// 返回响应
+	resp = &video.TrendingTopicsResponse{TopicList: topicList}
+
+	return
+}
+
+// HotVideos implements the VideoServiceImpl interface.
+func (s *VideoServiceImpl) HotVideos(ctx context.Context, req *video.HotVideosRequest) (resp *video.HotVideosResponse, err error) {
+	ctx, span := otel.Tracer("video").Start(ctx, "HotVideos")
+	defer span.End()
+
+	window := dal.HotWindowDay
+	if req.Window != nil && (*req.Window == dal.HotWindowHour || *req.Window == dal.HotWindowWeek) {
+		window = *req.Window
+	}
+	cnt := int(req.Count)
+	if cnt <= 0 {
+		cnt = defaultHotCount
+	}
+	cnt = min(cnt, maxHotCount)
+
+	// 查询热榜，多取一些以补足被过滤的已删除和不可见视频
+	videoIDs, err := dal.GetHotVideos(ctx, window, cnt*2)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, "查询热榜失败")
+		klog.Error("查询热榜失败, err: ", err)
+		return nil, err
+	}
+
+	// 获取视频信息
+	videoList, err := s.VideoInfoList(ctx, &video.VideoInfoListRequest{
+		UserId:      req.UserId,
+		VideoIdList: videoIDs,
+	})
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, "获取视频信息失败")
+		klog.Error("获取视频信息失败, err: ", err)
+		return nil, err
+	}
+
+	// 返回响应
+	resp = &video.HotVideosResponse{VideoList: videoList[:min(cnt, len(videoList))]}
 
 	return
 }
@@ -107,14 +574,33 @@ func (s *VideoServiceImpl) PublishList(ctx context.Context, req *video.PublishLi
 	ctx, span := otel.Tracer("video").Start(ctx, "PublishList")
 	defer span.End()
 
+	// 解析分页参数
+	lastID, limit, err := cursor.Parse(cursor.KindPublish, req.AuthorId, req.Cursor, req.Count)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, "解析分页游标失败")
+		klog.Error("解析分页游标失败, err: ", err)
+		return nil, err
+	}
+
+	// 查询当前用户可以看到的可见范围
+	visibilities, err := visibility.Allowed(ctx, req.UserId, req.AuthorId)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, "查询可见范围失败")
+		klog.Error("查询可见范围失败, err: ", err)
+		return nil, err
+	}
+
 	// 查询视频列表
-	videoIDs, err := dal.GetPublishList(ctx, req.AuthorId)
+	videoIDs, err := dal.GetPublishList(ctx, req.AuthorId, lastID, limit+1, visibilities)
 	if err != nil {
 		span.RecordError(err)
 		span.SetStatus(codes.Error, "查询视频列表失败")
 		klog.Error("查询视频列表失败, err: ", err)
 		return nil, err
 	}
+	videoIDs, nextCursor, hasMore := cursor.Paginate(cursor.KindPublish, req.AuthorId, videoIDs, limit, func(id int64) int64 { return id })
 
 	videoList, err := s.VideoInfoList(ctx, &video.VideoInfoListRequest{
 		UserId:      req.UserId,
@@ -122,7 +608,49 @@ func (s *VideoServiceImpl) PublishList(ctx context.Context, req *video.PublishLi
 	})
 
 	// 返回响应
-	resp = &video.PublishListResponse{VideoList: videoList}
+	resp = &video.PublishListResponse{VideoList: videoList, NextCursor: nextCursor, HasMore: hasMore}
+
+	return
+}
+
+// PublishStatus implements the VideoServiceImpl interface.
+func (s *VideoServiceImpl) PublishStatus(ctx context.Context, req *video.PublishStatusRequest) (resp *video.PublishStatusResponse, err error) {
+	ctx, span := otel.Tracer("video").Start(ctx, "PublishStatus")
+	defer span.End()
+
+	// 查询视频状态
+	mVideo, err := dal.GetVideoStatus(ctx, req.VideoId)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, "查询视频状态失败")
+		klog.Error("查询视频状态失败, err: ", err)
+		return nil, err
+	}
+
+	// 只有作者可以查询发布进度
+	if mVideo.AuthorID != req.UserId {
+		span.SetStatus(codes.Error, "视频作者id与当前用户id不一致")
+		klog.Error("视频作者id与当前用户id不一致")
+		return nil, dal.ErrVideoNotExist
+	}
+
+	// 获取处理进度
+	var progress int32
+	switch mVideo.Status {
+	case dal.VideoStatusProcessing:
+		progress, err = dal.GetVideoProgress(ctx, req.VideoId)
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, "获取处理进度失败")
+			klog.Error("获取处理进度失败, err: ", err)
+			return nil, err
+		}
+	case dal.VideoStatusPublished:
+		progress = 100
+	}
+
+	// 返回响应
+	resp = &video.PublishStatusResponse{Status: state.Name(mVideo.Status), Progress: progress}
 
 	return
 }
@@ -180,117 +708,239 @@ func (s *VideoServiceImpl) VideoInfo(ctx context.Context, req *video.VideoInfoRe
 	ctx, span := otel.Tracer("video").Start(ctx, "VideoInfo")
 	defer span.End()
 
-	mVideo, err := dal.GetVideoByID(ctx, req.VideoId)
+	videoList, err := s.VideoInfoList(ctx, &video.VideoInfoListRequest{
+		UserId:      req.UserId,
+		VideoIdList: []int64{req.VideoId},
+	})
 	if err != nil {
 		span.RecordError(err)
 		span.SetStatus(codes.Error, "查询视频信息失败")
 		klog.Error("查询视频信息失败, err: ", err)
-		return
+		return nil, err
+	}
+	if len(videoList) == 0 {
+		return nil, dal.ErrVideoNotExist
+	}
+
+	return videoList[0], nil
+}
+
+// VideoInfoList implements the VideoServiceImpl interface.
+func (s *VideoServiceImpl) VideoInfoList(ctx context.Context, req *video.VideoInfoListRequest) (resp []*video.Video, err error) {
+	ctx, span := otel.Tracer("video").Start(ctx, "VideoInfoList")
+	defer span.End()
+
+	// 过滤已删除的视频
+	videoIDs, err := dal.FilterDeletedVideos(ctx, req.VideoIdList)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, "过滤已删除视频失败")
+		klog.Error("过滤已删除视频失败, err: ", err)
+		return nil, err
+	}
+	if len(videoIDs) == 0 {
+		return []*video.Video{}, nil
+	}
+
+	// 批量查询视频信息
+	mVideoList, err := dal.GetVideoList(ctx, videoIDs)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, "查询视频信息失败")
+		klog.Error("查询视频信息失败, err: ", err)
+		return nil, err
+	}
+
+	// 过滤当前用户无权查看的视频
+	mVideoList, err = visibility.Filter(ctx, req.UserId, mVideoList)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, "过滤视频可见范围失败")
+		klog.Error("过滤视频可见范围失败, err: ", err)
+		return nil, err
+	}
+	if len(mVideoList) == 0 {
+		return []*video.Video{}, nil
 	}
 
-	resp = &video.Video{
-		Id:         mVideo.ID,
-		PlayUrl:    mVideo.PlayURL,
-		CoverUrl:   mVideo.CoverURL,
-		IsFavorite: false,
-		Title:      mVideo.Title,
+	authorIDs := make([]int64, len(mVideoList))
+	for i, mVideo := range mVideoList {
+		authorIDs[i] = mVideo.AuthorID
 	}
 
-	var wg sync.WaitGroup
-	var wgErr error
-	wg.Add(3)
-	go func() {
-		defer wg.Done()
-		user, err := client.UserClient.UserInfo(ctx, &user.UserInfoRequest{
-			UserId:   req.UserId,
-			AuthorId: mVideo.AuthorID,
+	// 批量查询作者信息、评论数、点赞数和是否点赞
+	var (
+		authors       []*user.User
+		commentCnt    map[int64]int64
+		favoriteCnt   map[int64]int64
+		favoriteExist map[int64]bool
+	)
+	g, gCtx := errgroup.WithContext(ctx)
+	g.Go(func() (err error) {
+		authors, err = client.UserClient.BatchUserInfo(gCtx, &user.BatchUserInfoRequest{
+			UserId:    req.UserId,
+			AuthorIds: authorIDs,
 		})
-		if err != nil {
-			wgErr = err
-			return
-		}
-		resp.Author = user.User
-	}()
-	go func() {
-		defer wg.Done()
-		cnt, err := client.CommentClient.CommentCnt(ctx, mVideo.ID)
-		if err != nil {
-			wgErr = err
-			return
-		}
-		resp.CommentCount = cnt
-	}()
-	go func() {
-		defer wg.Done()
-		cnt, err := client.FavoriteClient.FavoriteCnt(ctx, mVideo.ID)
-		if err != nil {
-			wgErr = err
+		return
+	})
+	g.Go(func() (err error) {
+		commentCnt, err = client.CommentClient.BatchCommentCnt(gCtx, videoIDs)
+		return
+	})
+	g.Go(func() (err error) {
+		favoriteCnt, err = client.FavoriteClient.BatchFavoriteCnt(gCtx, videoIDs)
+		return
+	})
+	if req.UserId != nil {
+		g.Go(func() (err error) {
+			favoriteExist, err = client.FavoriteClient.BatchFavoriteExist(gCtx, *req.UserId, videoIDs)
 			return
-		}
-		resp.FavoriteCount = cnt
-	}()
-	wg.Wait()
-	if wgErr != nil {
-		span.RecordError(wgErr)
+		})
+	}
+	if err := g.Wait(); err != nil {
+		span.RecordError(err)
 		span.SetStatus(codes.Error, "查询视频信息失败")
-		klog.Error("查询视频信息失败, err: ", wgErr)
-		return nil, wgErr
+		klog.Error("查询视频信息失败, err: ", err)
+		return nil, err
 	}
 
-	// 未登录直接返回
-	if req.UserId == nil {
-		return
+	resp = make([]*video.Video, len(mVideoList))
+	for i, mVideo := range mVideoList {
+		resp[i] = &video.Video{
+			Id:            mVideo.ID,
+			Author:        authors[i],
+			PlayUrl:       mVideo.PlayURL,
+			CoverUrl:      mVideo.CoverURL,
+			FavoriteCount: favoriteCnt[mVideo.ID],
+			CommentCount:  commentCnt[mVideo.ID],
+			UploadTime:    mVideo.UploadTime.Unix(),
+			IsFavorite:    favoriteExist[mVideo.ID],
+			Title:         mVideo.Title,
+			HlsUrl:        mVideo.HlsURL,
+			Duration:      mVideo.Duration,
+			Width:         mVideo.Width,
+			Height:        mVideo.Height,
+			Visibility:    int32(mVideo.Visibility),
+		}
 	}
 
-	// 查询缓存判断是否点赞
-	exist, err := client.FavoriteClient.FavoriteExist(ctx, *req.UserId, mVideo.ID)
+	return
+}
+
+// PublishIDList implements the VideoServiceImpl interface.
+func (s *VideoServiceImpl) PublishIDList(ctx context.Context, userId int64) (resp []int64, err error) {
+	ctx, span := otel.Tracer("video").Start(ctx, "PublishIDList")
+	defer span.End()
+
+	resp, err = dal.GetPublishIDList(ctx, userId)
 	if err != nil {
 		span.RecordError(err)
-		span.SetStatus(codes.Error, "查询视频是否点赞失败")
-		klog.Error("查询视频是否点赞失败, err: ", err)
+		span.SetStatus(codes.Error, "查询视频ID列表失败")
+		klog.Error("查询视频ID列表失败, err: ", err)
 		return
 	}
-	resp.IsFavorite = exist
 
 	return
 }
 
-// VideoInfoList implements the VideoServiceImpl interface.
-func (s *VideoServiceImpl) VideoInfoList(ctx context.Context, req *video.VideoInfoListRequest) (resp []*video.Video, err error) {
-	ctx, span := otel.Tracer("video").Start(ctx, "VideoInfoList")
+// FollowFeed implements the VideoServiceImpl interface.
+func (s *VideoServiceImpl) FollowFeed(ctx context.Context, req *video.FollowFeedRequest) (resp *video.FollowFeedResponse, err error) {
+	ctx, span := otel.Tracer("video").Start(ctx, "FollowFeed")
 	defer span.End()
 
-	resp = make([]*video.Video, len(req.VideoIdList))
-	for i, videoId := range req.VideoIdList {
-		video, err := s.VideoInfo(ctx, &video.VideoInfoRequest{
-			UserId:  req.UserId,
-			VideoId: videoId,
-		})
-		if err != nil {
-			span.RecordError(err)
-			span.SetStatus(codes.Error, "查询视频信息失败")
-			klog.Error("查询视频信息失败, err: ", err)
-			return nil, err
-		}
+	// 参数解析
+	latestTime := time.Unix(req.LatestTime, 0)
+	year := latestTime.Year()
+	if year < 1 || year > 9999 {
+		latestTime = time.Now()
+	}
+
+	// 查询关注视频列表
+	videoIDs, scanTime, scanID, err := dal.GetFollowFeed(ctx, req.UserId, latestTime, req.GetLastId(), count)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, "查询关注视频列表失败")
+		klog.Error("service.FollowFeed: 查询关注视频列表失败, err: ", err)
+		return nil, err
+	}
 
-		resp[i] = video
+	videoList, err := s.VideoInfoList(ctx, &video.VideoInfoListRequest{
+		UserId:      &req.UserId,
+		VideoIdList: videoIDs,
+	})
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, "查询视频信息失败")
+		klog.Error("service.FollowFeed: 查询视频信息失败, err: ", err)
+		return nil, err
+	}
+
+	// 计算下次请求的时间和视频ID，本页的视频全部被过滤时从最后扫描到的位置继续
+	var nextTime, nextID *int64
+	if !scanTime.IsZero() {
+		nextTime = new(int64)
+		*nextTime = scanTime.Unix()
+		nextID = &scanID
 	}
 
+	// 返回响应
+	resp = &video.FollowFeedResponse{VideoList: videoList, NextTime: nextTime, NextId: nextID}
 	return
 }
 
-// PublishIDList implements the VideoServiceImpl interface.
-func (s *VideoServiceImpl) PublishIDList(ctx context.Context, userId int64) (resp []int64, err error) {
-	ctx, span := otel.Tracer("video").Start(ctx, "PublishIDList")
+// ClearSeen implements the VideoServiceImpl interface.
+func (s *VideoServiceImpl) ClearSeen(ctx context.Context, userId int64) (err error) {
+	ctx, span := otel.Tracer("video").Start(ctx, "ClearSeen")
 	defer span.End()
 
-	resp, err = dal.GetPublishList(ctx, userId)
+	err = dal.ClearSeenVideos(ctx, userId)
 	if err != nil {
 		span.RecordError(err)
-		span.SetStatus(codes.Error, "查询视频ID列表失败")
-		klog.Error("查询视频ID列表失败, err: ", err)
+		span.SetStatus(codes.Error, "清空已看视频记录失败")
+		klog.Error("清空已看视频记录失败, err: ", err)
 		return
 	}
 
 	return
 }
+
+// BatchWorkCount implements the VideoServiceImpl interface.
+func (s *VideoServiceImpl) BatchWorkCount(ctx context.Context, userIds []int64) (resp map[int64]int64, err error) {
+	ctx, span := otel.Tracer("video").Start(ctx, "BatchWorkCount")
+	defer span.End()
+
+	resp, err = dal.BatchGetUserWorkCount(ctx, userIds)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, "批量获取用户作品数失败")
+		klog.Error("批量获取用户作品数失败, err: ", err)
+		return
+	}
+
+	return
+}
+
+// startProcessing 检查视频文件后将视频转为转码中状态，并通过kafka提交转码任务
+func startProcessing(ctx context.Context, mVideo *model.Video, videoName, coverName string, customCover bool) error {
+	info, err := oss.StatVideo(ctx, videoName)
+	if err != nil {
+		return err
+	}
+	if info.Size == 0 {
+		return oss.ErrObjectNotExist
+	}
+
+	if err := state.Transit(ctx, mVideo.ID, dal.VideoStatusProcessing); err != nil {
+		return err
+	}
+
+	return kafka.Transcode(ctx, &kafka.TranscodeJob{
+		VideoID:     mVideo.ID,
+		AuthorID:    mVideo.AuthorID,
+		UploadTime:  mVideo.UploadTime,
+		VideoName:   videoName,
+		CoverName:   coverName,
+		CustomCover: customCover,
+		Visibility:  mVideo.Visibility,
+	})
+}
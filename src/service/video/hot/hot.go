@@ -0,0 +1,46 @@
+package hot
+
+import (
+	"context"
+	"time"
+
+	"douyin/src/dal"
+
+	"github.com/cloudwego/kitex/pkg/klog"
+)
+
+const (
+	mergeInterval = time.Minute // 检查小时桶是否需要合并的间隔
+	mergeHours    = 2           // 每次检查最近几个已结束的小时桶，服务短暂停止后可以补上
+)
+
+// Run 定时将已结束的小时桶衰减合并到日榜和周榜，多个实例同时运行时每个小时桶只会合并一次
+func Run(ctx context.Context) {
+	ticker := time.NewTicker(mergeInterval)
+	defer ticker.Stop()
+	for {
+		merge(ctx)
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// merge 按时间顺序合并最近已结束的小时桶
+func merge(ctx context.Context) {
+	now := time.Now().Truncate(time.Hour)
+	for i := mergeHours; i > 0; i-- {
+		hour := now.Add(-time.Duration(i) * time.Hour)
+		merged, err := dal.MergeHotBucket(ctx, hour)
+		if err != nil {
+			klog.Error("合并热榜小时桶失败, err: ", err)
+			return
+		}
+		if merged {
+			klog.Info("合并热榜小时桶: ", hour.Format(time.DateTime))
+		}
+	}
+}
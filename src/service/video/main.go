@@ -1,6 +1,8 @@
 package main
 
 import (
+	"context"
+
 	"douyin/src/client"
 	"douyin/src/common/kafka"
 	"douyin/src/common/mtl"
@@ -10,6 +12,10 @@ import (
 	"douyin/src/config"
 	"douyin/src/dal"
 	"douyin/src/kitex_gen/video/videoservice"
+	"douyin/src/service/video/hot"
+	"douyin/src/service/video/purge"
+	"douyin/src/service/video/transcode"
+	"douyin/src/service/video/upload"
 
 	"github.com/cloudwego/kitex/pkg/klog"
 	"github.com/cloudwego/kitex/server"
@@ -23,8 +29,13 @@ func main() {
 	snowflake.Init()
 	dal.Init()
 	defer dal.Close()
+	oss.Init()
+	kafka.RegisterTranscodeHandler(transcode.Handle)
 	kafka.Init()
 	client.Init()
+	go purge.Run(context.Background())
+	go hot.Run(context.Background())
+	go upload.Run(context.Background())
 
 	opts := server.WithSuite(serversuite.CommonServerSuite{
 		RegistryAddr: config.Conf.ConsulConfig.ConsulAddr,
@@ -0,0 +1,87 @@
+package purge
+
+import (
+	"context"
+	"time"
+
+	"douyin/src/common/oss"
+	"douyin/src/config"
+	"douyin/src/dal"
+
+	"github.com/cloudwego/kitex/pkg/klog"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+)
+
+const (
+	defaultGracePeriod = 72 * time.Hour
+	purgeInterval      = time.Minute // 检查到期视频的间隔
+	purgeBatchSize     = 100         // 每次最多清理的视频数
+	retryDelay         = time.Hour   // 清理失败后的重试间隔
+)
+
+// gracePeriod 视频删除后保留的时间
+func gracePeriod() time.Duration {
+	if config.Conf.VideoConfig == nil || config.Conf.VideoConfig.DeleteGracePeriod <= 0 {
+		return defaultGracePeriod
+	}
+	return config.Conf.VideoConfig.DeleteGracePeriod
+}
+
+// Schedule 视频删除后立即从Feed和列表中隐藏，保留期结束后彻底清理
+func Schedule(ctx context.Context, videoID, authorID int64) error {
+	return dal.ScheduleVideoPurge(ctx, videoID, authorID, time.Now().Add(gracePeriod()))
+}
+
+// Run 定时清理保留期已过的视频，多个实例同时运行时每个视频只会被清理一次
+func Run(ctx context.Context) {
+	ticker := time.NewTicker(purgeInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+
+		videoIDs, err := dal.ClaimVideoPurges(ctx, time.Now(), purgeBatchSize)
+		if err != nil {
+			klog.Error("获取待清理视频失败, err: ", err)
+			continue
+		}
+		for _, videoID := range videoIDs {
+			if err := purge(ctx, videoID); err != nil {
+				if err := dal.RetryVideoPurge(ctx, videoID, time.Now().Add(retryDelay)); err != nil {
+					klog.Error("重新加入清理队列失败, err: ", err)
+				}
+			}
+		}
+	}
+}
+
+// purge 彻底删除视频的数据库记录、缓存和对象存储中的文件
+func purge(ctx context.Context, videoID int64) error {
+	ctx, span := otel.Tracer("video").Start(ctx, "PurgeVideo")
+	defer span.End()
+
+	video, err := dal.PurgeVideo(ctx, videoID)
+	if err == dal.ErrVideoNotExist {
+		// 已被清理
+		return nil
+	}
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, "清理视频数据失败")
+		klog.Error("清理视频数据失败, err: ", err)
+		return err
+	}
+
+	// 数据库记录已删除，文件删除失败只记录日志
+	if err := oss.RemoveVideoFiles(ctx, video.PlayURL, video.CoverURL, video.HlsURL); err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, "删除视频文件失败")
+		klog.Error("删除视频文件失败, videoID: ", videoID, ", err: ", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,83 @@
+package rank
+
+import (
+	"context"
+	"sort"
+
+	"douyin/src/dal/model"
+
+	"golang.org/x/sync/errgroup"
+)
+
+// Scorer 视频打分器，每个Scorer负责一种信号，批量返回与videos顺序一致的分数，分数越大越靠前
+type Scorer interface {
+	Name() string
+	Score(ctx context.Context, userID *int64, videos []*model.Video) ([]float64, error)
+}
+
+type weightedScorer struct {
+	Scorer
+	weight float64
+}
+
+// Ranker 按权重组合多个Scorer对候选视频排序
+type Ranker struct {
+	scorers []weightedScorer
+}
+
+func NewRanker() *Ranker {
+	return &Ranker{}
+}
+
+// NewDefaultRanker 使用项目已有信号组合的默认排序器
+func NewDefaultRanker() *Ranker {
+	return NewRanker().
+		Register(FavoriteScorer{}, 1.0).
+		Register(CommentScorer{}, 1.5).
+		Register(AuthorScorer{}, 0.5).
+		Register(RecencyScorer{HalfLife: defaultHalfLife}, 3.0).
+		Register(FavoritedScorer{}, 2.0)
+}
+
+// Register 注册Scorer及其权重
+func (r *Ranker) Register(s Scorer, weight float64) *Ranker {
+	r.scorers = append(r.scorers, weightedScorer{Scorer: s, weight: weight})
+	return r
+}
+
+// Rank 对候选视频打分并按总分倒序返回，各Scorer并发批量打分
+func (r *Ranker) Rank(ctx context.Context, userID *int64, videos []*model.Video) ([]*model.Video, error) {
+	results := make([][]float64, len(r.scorers))
+
+	g, ctx := errgroup.WithContext(ctx)
+	for i, s := range r.scorers {
+		g.Go(func() (err error) {
+			results[i], err = s.Score(ctx, userID, videos)
+			return
+		})
+	}
+	if err := g.Wait(); err != nil {
+		return nil, err
+	}
+
+	scores := make([]float64, len(videos))
+	for i, s := range r.scorers {
+		for j, score := range results[i] {
+			scores[j] += s.weight * score
+		}
+	}
+
+	ranked := make([]*model.Video, len(videos))
+	idx := make([]int, len(videos))
+	for i := range idx {
+		idx[i] = i
+	}
+	sort.SliceStable(idx, func(i, j int) bool {
+		return scores[idx[i]] > scores[idx[j]]
+	})
+	for i, j := range idx {
+		ranked[i] = videos[j]
+	}
+
+	return ranked, nil
+}
@@ -0,0 +1,104 @@
+package rank
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+
+	"douyin/src/dal/model"
+)
+
+// fixedScorer 按视频ID返回固定分数
+type fixedScorer struct {
+	scores map[int64]float64
+	err    error
+}
+
+func (fixedScorer) Name() string { return "fixed" }
+
+func (s fixedScorer) Score(_ context.Context, _ *int64, videos []*model.Video) ([]float64, error) {
+	if s.err != nil {
+		return nil, s.err
+	}
+	scores := make([]float64, len(videos))
+	for i, v := range videos {
+		scores[i] = s.scores[v.ID]
+	}
+	return scores, nil
+}
+
+func rankedIDs(videos []*model.Video) []int64 {
+	ids := make([]int64, len(videos))
+	for i, v := range videos {
+		ids[i] = v.ID
+	}
+	return ids
+}
+
+func TestRankerRank(t *testing.T) {
+	videos := []*model.Video{{ID: 1}, {ID: 2}, {ID: 3}, {ID: 4}}
+
+	tests := []struct {
+		name   string
+		ranker *Ranker
+		want   []int64
+	}{
+		{
+			name:   "no scorers keeps order",
+			ranker: NewRanker(),
+			want:   []int64{1, 2, 3, 4},
+		},
+		{
+			name:   "single scorer",
+			ranker: NewRanker().Register(fixedScorer{scores: map[int64]float64{1: 1, 2: 3, 3: 2, 4: 0}}, 1),
+			want:   []int64{2, 3, 1, 4},
+		},
+		{
+			name: "weights combine scorers",
+			ranker: NewRanker().
+				Register(fixedScorer{scores: map[int64]float64{1: 1, 2: 0, 3: 0, 4: 0}}, 1).
+				Register(fixedScorer{scores: map[int64]float64{1: 0, 2: 1, 3: 0, 4: 0}}, 2),
+			want: []int64{2, 1, 3, 4},
+		},
+		{
+			name: "negative weight demotes",
+			ranker: NewRanker().
+				Register(fixedScorer{scores: map[int64]float64{1: 1, 2: 1, 3: 1, 4: 1}}, 1).
+				Register(fixedScorer{scores: map[int64]float64{1: -1}}, 2),
+			want: []int64{2, 3, 4, 1},
+		},
+		{
+			name:   "ties keep candidate order",
+			ranker: NewRanker().Register(fixedScorer{scores: map[int64]float64{3: 1, 4: 1}}, 1),
+			want:   []int64{3, 4, 1, 2},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ranked, err := tt.ranker.Rank(context.Background(), nil, videos)
+			if err != nil {
+				t.Fatalf("Rank error: %v", err)
+			}
+			if got := rankedIDs(ranked); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Rank = %v, want %v", got, tt.want)
+			}
+		})
+	}
+
+	// 排序不修改候选列表
+	if got := rankedIDs(videos); !reflect.DeepEqual(got, []int64{1, 2, 3, 4}) {
+		t.Errorf("candidates modified to %v", got)
+	}
+}
+
+func TestRankerRankError(t *testing.T) {
+	errScore := errors.New("score failed")
+	r := NewRanker().
+		Register(fixedScorer{}, 1).
+		Register(fixedScorer{err: errScore}, 1)
+
+	if _, err := r.Rank(context.Background(), nil, []*model.Video{{ID: 1}}); !errors.Is(err, errScore) {
+		t.Errorf("Rank error = %v, want %v", err, errScore)
+	}
+}
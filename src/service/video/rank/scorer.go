@@ -0,0 +1,110 @@
+package rank
+
+import (
+	"context"
+	"math"
+	"time"
+
+	"douyin/src/dal"
+	"douyin/src/dal/model"
+)
+
+const defaultHalfLife = 24 * time.Hour
+
+// FavoriteScorer 视频获赞数
+type FavoriteScorer struct{}
+
+func (FavoriteScorer) Name() string { return "favorite" }
+
+func (FavoriteScorer) Score(ctx context.Context, _ *int64, videos []*model.Video) ([]float64, error) {
+	cnt, err := dal.BatchGetVideoFavoriteCount(ctx, videoIDs(videos))
+	if err != nil {
+		return nil, err
+	}
+	return logScores(videos, cnt, func(v *model.Video) int64 { return v.ID }), nil
+}
+
+// CommentScorer 视频评论数
+type CommentScorer struct{}
+
+func (CommentScorer) Name() string { return "comment" }
+
+func (CommentScorer) Score(ctx context.Context, _ *int64, videos []*model.Video) ([]float64, error) {
+	cnt, err := dal.BatchGetVideoCommentCount(ctx, videoIDs(videos))
+	if err != nil {
+		return nil, err
+	}
+	return logScores(videos, cnt, func(v *model.Video) int64 { return v.ID }), nil
+}
+
+// AuthorScorer 作者粉丝数
+type AuthorScorer struct{}
+
+func (AuthorScorer) Name() string { return "author" }
+
+func (AuthorScorer) Score(ctx context.Context, _ *int64, videos []*model.Video) ([]float64, error) {
+	authorIDs := make([]int64, len(videos))
+	for i, v := range videos {
+		authorIDs[i] = v.AuthorID
+	}
+	cnt, err := dal.BatchGetUserFollowerCount(ctx, authorIDs)
+	if err != nil {
+		return nil, err
+	}
+	return logScores(videos, cnt, func(v *model.Video) int64 { return v.AuthorID }), nil
+}
+
+// RecencyScorer 新鲜度，按半衰期指数衰减，刚发布为1
+type RecencyScorer struct {
+	HalfLife time.Duration
+}
+
+func (RecencyScorer) Name() string { return "recency" }
+
+func (s RecencyScorer) Score(_ context.Context, _ *int64, videos []*model.Video) ([]float64, error) {
+	scores := make([]float64, len(videos))
+	for i, v := range videos {
+		age := max(time.Since(v.UploadTime), 0)
+		scores[i] = math.Exp2(-float64(age) / float64(s.HalfLife))
+	}
+	return scores, nil
+}
+
+// FavoritedScorer 用户已点赞的视频降权，未登录不生效
+type FavoritedScorer struct{}
+
+func (FavoritedScorer) Name() string { return "favorited" }
+
+func (FavoritedScorer) Score(ctx context.Context, userID *int64, videos []*model.Video) ([]float64, error) {
+	scores := make([]float64, len(videos))
+	if userID == nil {
+		return scores, nil
+	}
+	exist, err := dal.BatchCheckFavoriteExist(ctx, *userID, videoIDs(videos))
+	if err != nil {
+		return nil, err
+	}
+	for i, v := range videos {
+		if exist[v.ID] {
+			scores[i] = -1
+		}
+	}
+	return scores, nil
+}
+
+func videoIDs(videos []*model.Video) []int64 {
+	ids := make([]int64, len(videos))
+	for i, v := range videos {
+		ids[i] = v.ID
+	}
+	return ids
+}
+
+// logScores 将计数取对数作为分数，key返回视频在cnt中对应的键
+func logScores(videos []*model.Video, cnt map[int64]int64, key func(*model.Video) int64) []float64 {
+	scores := make([]float64, len(videos))
+	for i, v := range videos {
+		scores[i] = math.Log1p(float64(cnt[key(v)]))
+	}
+	return scores
+}
@@ -0,0 +1,62 @@
+package rank
+
+import (
+	"context"
+	"math"
+	"testing"
+	"time"
+
+	"douyin/src/dal/model"
+)
+
+func TestRecencyScorer(t *testing.T) {
+	now := time.Now()
+	s := RecencyScorer{HalfLife: time.Hour}
+	videos := []*model.Video{
+		{UploadTime: now},
+		{UploadTime: now.Add(-time.Hour)},
+		{UploadTime: now.Add(-2 * time.Hour)},
+		{UploadTime: now.Add(time.Hour)}, // 时钟偏差导致的未来时间按刚发布计算
+	}
+	want := []float64{1, 0.5, 0.25, 1}
+
+	scores, err := s.Score(context.Background(), nil, videos)
+	if err != nil {
+		t.Fatalf("Score error: %v", err)
+	}
+	for i := range want {
+		if math.Abs(scores[i]-want[i]) > 1e-3 {
+			t.Errorf("scores[%d] = %f, want %f", i, scores[i], want[i])
+		}
+	}
+}
+
+func TestFavoritedScorerAnonymous(t *testing.T) {
+	scores, err := FavoritedScorer{}.Score(context.Background(), nil, []*model.Video{{ID: 1}, {ID: 2}})
+	if err != nil {
+		t.Fatalf("Score error: %v", err)
+	}
+	for i, score := range scores {
+		if score != 0 {
+			t.Errorf("scores[%d] = %f, want 0", i, score)
+		}
+	}
+}
+
+func TestLogScores(t *testing.T) {
+	videos := []*model.Video{{ID: 1, AuthorID: 10}, {ID: 2, AuthorID: 20}, {ID: 3, AuthorID: 10}}
+	cnt := map[int64]int64{1: 0, 2: 9, 10: math.MaxInt32}
+
+	byID := logScores(videos, cnt, func(v *model.Video) int64 { return v.ID })
+	wantByID := []float64{0, math.Log(10), 0} // 缺失的计数按0计算
+	for i := range wantByID {
+		if math.Abs(byID[i]-wantByID[i]) > 1e-9 {
+			t.Errorf("byID[%d] = %f, want %f", i, byID[i], wantByID[i])
+		}
+	}
+
+	byAuthor := logScores(videos, cnt, func(v *model.Video) int64 { return v.AuthorID })
+	if byAuthor[0] != byAuthor[2] || byAuthor[1] != 0 {
+		t.Errorf("byAuthor = %v, want same score for same author and 0 for missing", byAuthor)
+	}
+}
@@ -0,0 +1,46 @@
+package state
+
+import (
+	"context"
+
+	"douyin/src/dal"
+)
+
+// transitions 视频状态机，key为目标状态，value为允许转入该状态的当前状态:
+// uploading -> processing -> published，上传和转码失败转为failed，除deleted外均可删除
+var transitions = map[int16][]int16{
+	dal.VideoStatusProcessing: {dal.VideoStatusUploading},
+	dal.VideoStatusPublished:  {dal.VideoStatusProcessing},
+	dal.VideoStatusFailed:     {dal.VideoStatusUploading, dal.VideoStatusProcessing},
+	dal.VideoStatusDeleted:    {dal.VideoStatusUploading, dal.VideoStatusProcessing, dal.VideoStatusPublished, dal.VideoStatusFailed},
+}
+
+var names = map[int16]string{
+	dal.VideoStatusUploading:  "uploading",
+	dal.VideoStatusProcessing: "processing",
+	dal.VideoStatusPublished:  "published",
+	dal.VideoStatusFailed:     "failed",
+	dal.VideoStatusDeleted:    "deleted",
+}
+
+// Transit 将视频转换到目标状态，当前状态不允许转换时返回dal.ErrVideoStatus
+func Transit(ctx context.Context, videoID int64, to int16) error {
+	from, ok := transitions[to]
+	if !ok {
+		return dal.ErrVideoStatus
+	}
+
+	ok, err := dal.UpdateVideoStatus(ctx, videoID, from, to)
+	if err != nil {
+		return err
+	}
+	if !ok {
+		return dal.ErrVideoStatus
+	}
+	return nil
+}
+
+// Name 返回状态名称
+func Name(status int16) string {
+	return names[status]
+}
@@ -0,0 +1,63 @@
+package state
+
+import (
+	"slices"
+	"testing"
+
+	"douyin/src/dal"
+)
+
+var statuses = []int16{
+	dal.VideoStatusUploading,
+	dal.VideoStatusProcessing,
+	dal.VideoStatusPublished,
+	dal.VideoStatusFailed,
+	dal.VideoStatusDeleted,
+}
+
+func TestTransitions(t *testing.T) {
+	type edge struct{ from, to int16 }
+	allowed := map[edge]bool{
+		{dal.VideoStatusUploading, dal.VideoStatusProcessing}: true,
+		{dal.VideoStatusProcessing, dal.VideoStatusPublished}: true,
+		{dal.VideoStatusUploading, dal.VideoStatusFailed}:     true,
+		{dal.VideoStatusProcessing, dal.VideoStatusFailed}:    true,
+		{dal.VideoStatusUploading, dal.VideoStatusDeleted}:    true,
+		{dal.VideoStatusProcessing, dal.VideoStatusDeleted}:   true,
+		{dal.VideoStatusPublished, dal.VideoStatusDeleted}:    true,
+		{dal.VideoStatusFailed, dal.VideoStatusDeleted}:       true,
+	}
+
+	for _, from := range statuses {
+		for _, to := range statuses {
+			got := slices.Contains(transitions[to], from)
+			if want := allowed[edge{from, to}]; got != want {
+				t.Errorf("%s -> %s allowed = %v, want %v", Name(from), Name(to), got, want)
+			}
+		}
+	}
+}
+
+func TestNoTransitionIntoUploading(t *testing.T) {
+	if _, ok := transitions[dal.VideoStatusUploading]; ok {
+		t.Error("uploading must only be the initial status")
+	}
+}
+
+func TestName(t *testing.T) {
+	want := map[int16]string{
+		dal.VideoStatusUploading:  "uploading",
+		dal.VideoStatusProcessing: "processing",
+		dal.VideoStatusPublished:  "published",
+		dal.VideoStatusFailed:     "failed",
+		dal.VideoStatusDeleted:    "deleted",
+	}
+	for _, status := range statuses {
+		if got := Name(status); got != want[status] {
+			t.Errorf("Name(%d) = %q, want %q", status, got, want[status])
+		}
+	}
+	if got := Name(-1); got != "" {
+		t.Errorf("Name(-1) = %q, want empty", got)
+	}
+}
@@ -0,0 +1,46 @@
+package topic
+
+import (
+	"regexp"
+	"strings"
+	"unicode/utf8"
+)
+
+const (
+	maxTagCount  = 10 // 每个视频最多的话题数
+	maxTagLength = 20 // 话题名称的最大长度，单位字符
+)
+
+// hashtagRegexp 匹配标题中的话题，话题由#开头，包含字母、数字、汉字和下划线
+var hashtagRegexp = regexp.MustCompile(`#([\p{L}\p{N}_]+)`)
+
+// Extract 从视频标题中提取话题名称，英文统一转为小写，去重后按出现顺序返回
+func Extract(title string) []string {
+	matches := hashtagRegexp.FindAllStringSubmatch(title, -1)
+	set := make(map[string]struct{}, len(matches))
+	names := make([]string, 0, len(matches))
+	for _, match := range matches {
+		name := Normalize(match[1])
+		if name == "" {
+			continue
+		}
+		if _, ok := set[name]; ok {
+			continue
+		}
+		set[name] = struct{}{}
+		names = append(names, name)
+		if len(names) == maxTagCount {
+			break
+		}
+	}
+	return names
+}
+
+// Normalize 规范化话题名称: 去掉开头的#，英文转为小写，超长时返回空字符串
+func Normalize(name string) string {
+	name = strings.ToLower(strings.TrimPrefix(strings.TrimSpace(name), "#"))
+	if utf8.RuneCountInString(name) > maxTagLength {
+		return ""
+	}
+	return name
+}
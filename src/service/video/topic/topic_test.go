@@ -0,0 +1,60 @@
+package topic
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestExtract(t *testing.T) {
+	tests := []struct {
+		name  string
+		title string
+		want  []string
+	}{
+		{"no hashtag", "今天去爬山", []string{}},
+		{"single", "日落 #旅行", []string{"旅行"}},
+		{"multiple in order", "#Go #编程 学习笔记 #day_1", []string{"go", "编程", "day_1"}},
+		{"lowercase dedupe", "#Travel 和 #travel 还有 #TRAVEL", []string{"travel"}},
+		{"stops at punctuation", "#美食,#探店!", []string{"美食", "探店"}},
+		{"adjacent hashtags", "#a#b", []string{"a", "b"}},
+		{"lone hash ignored", "# 空格 ##", []string{}},
+		{"too long dropped", "#" + strings.Repeat("长", maxTagLength+1) + " #短", []string{"短"}},
+		{"max length kept", "#" + strings.Repeat("长", maxTagLength), []string{strings.Repeat("长", maxTagLength)}},
+		{
+			name:  "count capped",
+			title: "#t0 #t1 #t2 #t3 #t4 #t5 #t6 #t7 #t8 #t9 #t10",
+			want:  []string{"t0", "t1", "t2", "t3", "t4", "t5", "t6", "t7", "t8", "t9"},
+		},
+		{
+			name:  "duplicates do not count toward cap",
+			title: "#a #a #a #a #a #a #a #a #a #a #b",
+			want:  []string{"a", "b"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Extract(tt.title); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Extract(%q) = %v, want %v", tt.title, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestNormalize(t *testing.T) {
+	tests := []struct {
+		name string
+		want string
+	}{
+		{"Go", "go"},
+		{"#Go", "go"},
+		{"  #旅行 ", "旅行"},
+		{"", ""},
+		{strings.Repeat("x", maxTagLength+1), ""},
+	}
+	for _, tt := range tests {
+		if got := Normalize(tt.name); got != tt.want {
+			t.Errorf("Normalize(%q) = %q, want %q", tt.name, got, tt.want)
+		}
+	}
+}
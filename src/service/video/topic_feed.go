@@ -0,0 +1,65 @@
+package main
+
+import (
+	"context"
+
+	"douyin/src/common/hotrank"
+	"douyin/src/dal"
+	"douyin/src/dal/model"
+)
+
+const (
+	sortHot           = "hot" // 按热度排序
+	hotCandidateSize  = 500   // 互动最多和最新的候选视频各取的数量
+	defaultTopicCount = 10    // 热门话题默认返回数量
+	maxTrendingTopics = 50    // 热门话题最大返回数量
+)
+
+// updateTrendingTags 视频修改或删除后更新话题使用次数: 修改前计入的话题减少，修改后计入的话题增加。
+// 只有已发布的公开视频计入，visibility为修改后的可见范围，删除时newNames为nil
+func updateTrendingTags(ctx context.Context, mVideo *model.Video, oldNames, newNames []string, visibility int16) error {
+	if mVideo.Status != dal.VideoStatusPublished {
+		return nil
+	}
+	if mVideo.Visibility == dal.VideoVisibilityPublic {
+		if err := dal.IncrTrendingTags(ctx, oldNames, -1, mVideo.UploadTime); err != nil {
+			return err
+		}
+	}
+	if visibility == dal.VideoVisibilityPublic {
+		return dal.IncrTrendingTags(ctx, newNames, 1, mVideo.UploadTime)
+	}
+	return nil
+}
+
+// hotTopicVideoList 获取话题下互动最多和最新的视频并按热度倒序排列
+func hotTopicVideoList(ctx context.Context, tagID int64) ([]int64, error) {
+	mVideoList, err := dal.GetTopicHotCandidates(ctx, tagID, hotCandidateSize)
+	if err != nil {
+		return nil, err
+	}
+
+	videoIDs := make([]int64, len(mVideoList))
+	for i, v := range mVideoList {
+		videoIDs[i] = v.ID
+	}
+	favoriteCnt, err := dal.BatchGetVideoFavoriteCount(ctx, videoIDs)
+	if err != nil {
+		return nil, err
+	}
+	commentCnt, err := dal.BatchGetVideoCommentCount(ctx, videoIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	// 点赞数和评论数加权后按发布时长衰减
+	hotrank.Sort(mVideoList, func(v *model.Video) float64 {
+		heat := dal.HeatFavorite*float64(favoriteCnt[v.ID]) + dal.HeatComment*float64(commentCnt[v.ID])
+		return hotrank.Score(heat, v.UploadTime)
+	})
+	for i, v := range mVideoList {
+		videoIDs[i] = v.ID
+	}
+
+	return videoIDs, nil
+}
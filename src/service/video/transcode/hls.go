@@ -0,0 +1,137 @@
+package transcode
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"douyin/src/config"
+
+	ffmpeg "github.com/u2takey/ffmpeg-go"
+	"go.opentelemetry.io/otel"
+)
+
+const (
+	masterPlaylist     = "master.m3u8"
+	variantPlaylist    = "index.m3u8"
+	defaultSegmentTime = 6
+)
+
+// 未配置转码清晰度时使用的默认值
+var defaultRenditions = []*config.Rendition{
+	{Name: "1080p", Height: 1080, VideoBitrate: 5000, AudioBitrate: 192},
+	{Name: "720p", Height: 720, VideoBitrate: 2800, AudioBitrate: 128},
+	{Name: "480p", Height: 480, VideoBitrate: 1400, AudioBitrate: 96},
+}
+
+// variant 一路转码输出
+type variant struct {
+	*config.Rendition
+	Width  int
+	Height int
+}
+
+func transcodeConfig() (segmentTime int, renditions []*config.Rendition) {
+	segmentTime, renditions = defaultSegmentTime, defaultRenditions
+	if conf := config.Conf.TranscodeConfig; conf != nil {
+		if conf.SegmentTime > 0 {
+			segmentTime = conf.SegmentTime
+		}
+		if len(conf.Renditions) > 0 {
+			renditions = conf.Renditions
+		}
+	}
+	return
+}
+
+// planVariants 选择不超过原视频清晰度的转码规格，原视频清晰度低于所有规格时按原清晰度输出最低码率
+func planVariants(info *mediaInfo, renditions []*config.Rendition) []*variant {
+	// 以短边衡量清晰度，兼容竖屏视频
+	short, long := info.Height, info.Width
+	if info.Width < info.Height {
+		short, long = info.Width, info.Height
+	}
+
+	sorted := make([]*config.Rendition, len(renditions))
+	copy(sorted, renditions)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i].Height > sorted[j].Height })
+
+	var variants []*variant
+	for _, r := range sorted {
+		if r.Height <= short {
+			variants = append(variants, newVariant(r, r.Height, short, long, info))
+		}
+	}
+	if len(variants) == 0 && len(sorted) > 0 {
+		variants = append(variants, newVariant(sorted[len(sorted)-1], short, short, long, info))
+	}
+	return variants
+}
+
+func newVariant(r *config.Rendition, target, short, long int, info *mediaInfo) *variant {
+	// 长边按比例缩放并取偶数，满足H.264编码要求
+	scaledShort := target &^ 1
+	scaledLong := (long*target/short + 1) &^ 1
+	v := &variant{Rendition: r, Width: scaledLong, Height: scaledShort}
+	if info.Width < info.Height {
+		v.Width, v.Height = scaledShort, scaledLong
+	}
+	return v
+}
+
+// transcodeHLS 将视频转码为多种清晰度的HLS分片，并生成主播放列表，每完成一路转码回调onDone
+func transcodeHLS(ctx context.Context, src, outDir string, info *mediaInfo, onDone func(done, total int)) error {
+	ctx, span := otel.Tracer("transcode").Start(ctx, "TranscodeHLS")
+	defer span.End()
+
+	segmentTime, renditions := transcodeConfig()
+	variants := planVariants(info, renditions)
+
+	for i, v := range variants {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+
+		dir := filepath.Join(outDir, v.Name)
+		if err := os.MkdirAll(dir, 0o755); err != nil {
+			return err
+		}
+		err := ffmpeg.Input(src).
+			Output(filepath.Join(dir, variantPlaylist), ffmpeg.KwArgs{
+				"vf":                   fmt.Sprintf("scale=%d:%d", v.Width, v.Height),
+				"c:v":                  "libx264",
+				"b:v":                  fmt.Sprintf("%dk", v.VideoBitrate),
+				"maxrate":              fmt.Sprintf("%dk", v.VideoBitrate*3/2),
+				"bufsize":              fmt.Sprintf("%dk", v.VideoBitrate*2),
+				"c:a":                  "aac",
+				"b:a":                  fmt.Sprintf("%dk", v.AudioBitrate),
+				"f":                    "hls",
+				"hls_time":             segmentTime,
+				"hls_playlist_type":    "vod",
+				"hls_segment_filename": filepath.Join(dir, "%03d.ts"),
+			}).
+			OverWriteOutput().
+			Run()
+		if err != nil {
+			return err
+		}
+		onDone(i+1, len(variants))
+	}
+
+	return os.WriteFile(filepath.Join(outDir, masterPlaylist), []byte(buildMasterPlaylist(variants)), 0o644)
+}
+
+// buildMasterPlaylist 生成引用各清晰度播放列表的主播放列表
+func buildMasterPlaylist(variants []*variant) string {
+	var builder strings.Builder
+	builder.WriteString("#EXTM3U\n#EXT-X-VERSION:3\n")
+	for _, v := range variants {
+		bandwidth := (v.VideoBitrate + v.AudioBitrate) * 1000
+		fmt.Fprintf(&builder, "#EXT-X-STREAM-INF:BANDWIDTH=%d,RESOLUTION=%dx%d\n", bandwidth, v.Width, v.Height)
+		builder.WriteString(v.Name + "/" + variantPlaylist + "\n")
+	}
+	return builder.String()
+}
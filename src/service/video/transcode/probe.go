@@ -0,0 +1,62 @@
+package transcode
+
+import (
+	"encoding/json"
+	"errors"
+	"strconv"
+	"time"
+
+	ffmpeg "github.com/u2takey/ffmpeg-go"
+)
+
+var errNoVideoStream = errors.New("no video stream")
+
+// mediaInfo 视频元信息
+type mediaInfo struct {
+	Duration time.Duration
+	Width    int
+	Height   int
+}
+
+type probeResult struct {
+	Streams []struct {
+		CodecType string `json:"codec_type"`
+		Width     int    `json:"width"`
+		Height    int    `json:"height"`
+	} `json:"streams"`
+	Format struct {
+		Duration string `json:"duration"`
+	} `json:"format"`
+}
+
+// probe 使用ffprobe获取视频时长和分辨率
+func probe(fileName string) (*mediaInfo, error) {
+	out, err := ffmpeg.Probe(fileName)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &probeResult{}
+	if err := json.Unmarshal([]byte(out), result); err != nil {
+		return nil, err
+	}
+
+	info := &mediaInfo{}
+	for _, stream := range result.Streams {
+		if stream.CodecType == "video" {
+			info.Width, info.Height = stream.Width, stream.Height
+			break
+		}
+	}
+	if info.Width == 0 || info.Height == 0 {
+		return nil, errNoVideoStream
+	}
+
+	seconds, err := strconv.ParseFloat(result.Format.Duration, 64)
+	if err != nil {
+		return nil, err
+	}
+	info.Duration = time.Duration(seconds * float64(time.Second))
+
+	return info, nil
+}
@@ -0,0 +1,142 @@
+package transcode
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"douyin/src/common/kafka"
+	"douyin/src/common/oss"
+	"douyin/src/dal"
+	"douyin/src/dal/model"
+	"douyin/src/service/video/state"
+
+	"github.com/cloudwego/kitex/pkg/klog"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+)
+
+// 各处理阶段完成时的进度
+const (
+	progressDownloaded int32 = 10
+	progressCovered    int32 = 20
+	progressTranscoded int32 = 90
+	progressDone       int32 = 100
+)
+
+// Handle 处理转码任务: 下载原视频，探测元信息，生成封面，转码为多种清晰度的HLS，
+// 上传后发布视频并分发到关注Feed，任一步骤失败则将视频标记为处理失败
+func Handle(ctx context.Context, job *kafka.TranscodeJob) (err error) {
+	ctx, span := otel.Tracer("transcode").Start(ctx, "Handle")
+	defer span.End()
+
+	defer func() {
+		if err == nil {
+			return
+		}
+		span.RecordError(err)
+		span.SetStatus(codes.Error, "视频处理失败")
+		klog.Error("视频处理失败, err: ", err)
+		if err := state.Transit(ctx, job.VideoID, dal.VideoStatusFailed); err != nil {
+			klog.Error("更新视频状态失败, err: ", err)
+		}
+	}()
+
+	workDir, err := os.MkdirTemp("", "transcode-")
+	if err != nil {
+		return err
+	}
+	defer os.RemoveAll(workDir)
+
+	// 下载原视频
+	src := filepath.Join(workDir, job.VideoName)
+	if err := oss.DownloadVideo(ctx, job.VideoName, src); err != nil {
+		return err
+	}
+	setProgress(ctx, job.VideoID, progressDownloaded)
+
+	// 获取时长和分辨率
+	info, err := probe(src)
+	if err != nil {
+		return err
+	}
+
+	// 作者未上传封面时，从视频中挑选一帧作为封面
+	if !job.CustomCover {
+		if err := oss.GenerateCover(ctx, src, job.CoverName, info.Duration); err != nil {
+			return err
+		}
+	}
+	setProgress(ctx, job.VideoID, progressCovered)
+
+	// 转码为HLS
+	hlsDir := filepath.Join(workDir, "hls")
+	onVariantDone := func(done, total int) {
+		setProgress(ctx, job.VideoID, progressCovered+(progressTranscoded-progressCovered)*int32(done)/int32(total))
+	}
+	if err := transcodeHLS(ctx, src, hlsDir, info, onVariantDone); err != nil {
+		return err
+	}
+
+	// 上传HLS文件
+	name := strings.TrimSuffix(job.VideoName, filepath.Ext(job.VideoName))
+	hlsURL, err := oss.UploadHLS(ctx, hlsDir, name, masterPlaylist)
+	if err != nil {
+		return err
+	}
+
+	// 保存元信息并发布视频
+	video := &model.Video{
+		ID:         job.VideoID,
+		AuthorID:   job.AuthorID,
+		UploadTime: job.UploadTime,
+		HlsURL:     hlsURL,
+		Duration:   int32(info.Duration.Milliseconds()),
+		Width:      int32(info.Width),
+		Height:     int32(info.Height),
+		Visibility: job.Visibility,
+	}
+	if err := dal.UpdateVideoMedia(ctx, video); err != nil {
+		return err
+	}
+	if err := state.Transit(ctx, job.VideoID, dal.VideoStatusPublished); err != nil {
+		return err
+	}
+	setProgress(ctx, job.VideoID, progressDone)
+
+	// 公开视频计入话题热度
+	if job.Visibility == dal.VideoVisibilityPublic {
+		if err := incrTrendingTags(ctx, job.VideoID, job.UploadTime); err != nil {
+			klog.Error("更新话题热度失败, err: ", err)
+		}
+	}
+
+	// 通过kafka异步分发到关注Feed，仅自己可见的视频不分发
+	if job.Visibility != dal.VideoVisibilityPrivate {
+		if err := kafka.PushFeed(ctx, video); err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, "分发关注Feed失败")
+			klog.Error("分发关注Feed失败, err: ", err)
+		}
+	}
+
+	return nil
+}
+
+// incrTrendingTags 增加视频所属话题在上传当天的使用次数
+func incrTrendingTags(ctx context.Context, videoID int64, uploadTime time.Time) error {
+	names, err := dal.GetVideoTagNames(ctx, videoID)
+	if err != nil {
+		return err
+	}
+	return dal.IncrTrendingTags(ctx, names, 1, uploadTime)
+}
+
+// setProgress 记录处理进度，失败不影响转码
+func setProgress(ctx context.Context, videoID int64, progress int32) {
+	if err := dal.SetVideoProgress(ctx, videoID, progress); err != nil {
+		klog.Error("记录视频处理进度失败, err: ", err)
+	}
+}
@@ -0,0 +1,50 @@
+package upload
+
+import (
+	"context"
+	"time"
+
+	"douyin/src/common/oss"
+	"douyin/src/dal"
+
+	"github.com/cloudwego/kitex/pkg/klog"
+)
+
+const sweepInterval = time.Hour // 检查过期分片的间隔
+
+// Run 定时清理上传任务已过期或已完成但没有删除的分片，多个实例同时运行时重复删除不会报错
+func Run(ctx context.Context) {
+	ticker := time.NewTicker(sweepInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+
+		sweep(ctx)
+	}
+}
+
+// sweep 删除上传任务已不存在的分片
+func sweep(ctx context.Context) {
+	uploadIDs, err := oss.ListUploads(ctx)
+	if err != nil {
+		klog.Error("获取暂存分片失败, err: ", err)
+		return
+	}
+	for _, uploadID := range uploadIDs {
+		exist, err := dal.UploadSessionExists(ctx, uploadID)
+		if err != nil {
+			klog.Error("获取上传任务失败, err: ", err)
+			return
+		}
+		if exist {
+			continue
+		}
+		if err := oss.DeleteUpload(ctx, uploadID); err == nil {
+			klog.Info("清理过期分片: ", uploadID)
+		}
+	}
+}
@@ -0,0 +1,63 @@
+package visibility
+
+import (
+	"context"
+
+	"douyin/src/dal"
+	"douyin/src/dal/model"
+)
+
+// Allowed 返回viewerID可以看到的authorID发布的视频的可见范围，未登录时viewerID为nil
+func Allowed(ctx context.Context, viewerID *int64, authorID int64) ([]int16, error) {
+	if viewerID == nil {
+		return []int16{dal.VideoVisibilityPublic}, nil
+	}
+	if *viewerID == authorID {
+		return []int16{dal.VideoVisibilityPublic, dal.VideoVisibilityFriends, dal.VideoVisibilityPrivate}, nil
+	}
+
+	friends, err := dal.BatchCheckFriend(ctx, *viewerID, []int64{authorID})
+	if err != nil {
+		return nil, err
+	}
+	if friends[authorID] {
+		return []int16{dal.VideoVisibilityPublic, dal.VideoVisibilityFriends}, nil
+	}
+	return []int16{dal.VideoVisibilityPublic}, nil
+}
+
+// Filter 过滤viewerID无权查看的视频，保持原有顺序，未登录时viewerID为nil。
+// 未发布的视频只有作者可以查看
+func Filter(ctx context.Context, viewerID *int64, videos []*model.Video) ([]*model.Video, error) {
+	// 只有好友可见的视频需要查询好友关系
+	var authorIDs []int64
+	for _, video := range videos {
+		if video.Visibility == dal.VideoVisibilityFriends && viewerID != nil && *viewerID != video.AuthorID {
+			authorIDs = append(authorIDs, video.AuthorID)
+		}
+	}
+	friends := map[int64]bool{}
+	if len(authorIDs) > 0 {
+		var err error
+		friends, err = dal.BatchCheckFriend(ctx, *viewerID, authorIDs)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	result := make([]*model.Video, 0, len(videos))
+	for _, video := range videos {
+		if video.Status != dal.VideoStatusPublished && (viewerID == nil || *viewerID != video.AuthorID) {
+			continue
+		}
+		switch {
+		case video.Visibility == dal.VideoVisibilityPublic:
+		case viewerID != nil && *viewerID == video.AuthorID:
+		case video.Visibility == dal.VideoVisibilityFriends && friends[video.AuthorID]:
+		default:
+			continue
+		}
+		result = append(result, video)
+	}
+	return result, nil
+}